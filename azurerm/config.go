@@ -75,10 +75,22 @@ import (
 	"github.com/hashicorp/go-uuid"
 	"github.com/hashicorp/terraform/httpclient"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/recording"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 	"github.com/terraform-providers/terraform-provider-azurerm/version"
 )
 
+// keyVaultFeatures are the behaviours configured in the `key_vault` block of the Provider
+type keyVaultFeatures struct {
+	// recoverSoftDeleted determines whether a soft-deleted Key Vault, Certificate, Key or Secret with the same name
+	// is recovered when it's created, rather than failing
+	recoverSoftDeleted bool
+
+	// purgeSoftDeleteOnDestroy determines whether a Key Vault, Certificate, Key or Secret is purged once it's been
+	// deleted, so that the name can be reused
+	purgeSoftDeleteOnDestroy bool
+}
+
 // ArmClient contains the handles to all the specific Azure Resource Manager
 // resource classes' respective clients.
 type ArmClient struct {
@@ -89,10 +101,29 @@ type ArmClient struct {
 	usingServicePrincipal    bool
 	environment              az.Environment
 	skipProviderRegistration bool
+	retryPolicy              azure.RetryPolicy
+	providerTags             providerTags
+
+	// resourceProviderRegistrations is nil when Resource Providers shouldn't be registered
+	resourceProviderRegistrations *resourceProviderRegistrations
+
+	// requireResourcesToBeImported determines whether existing resources must be imported into the State
+	// before they can be managed, rather than being silently adopted when they're created
+	requireResourcesToBeImported bool
+
+	// storageUseAzureAD determines whether the Storage data-plane APIs are authenticated using the
+	// storageAuthorizer (an Azure AD token), rather than the Access Key for the Storage Account
+	storageUseAzureAD bool
+	storageAuthorizer autorest.Authorizer
+
+	// keyVaultFeatures determines how Key Vaults (and the Certificates, Keys and Secrets within them) which have been
+	// soft-deleted are handled when they're created and destroyed
+	keyVaultFeatures keyVaultFeatures
 
 	StopContext context.Context
 
-	cosmosDBClient documentdb.DatabaseAccountsClient
+	cosmosDBClient          documentdb.DatabaseAccountsClient
+	cosmosDBResourcesClient azure.CosmosDBResourcesClient
 
 	automationAccountClient               automation.AccountClient
 	automationAgentRegistrationInfoClient automation.AgentRegistrationInformationClient
@@ -104,8 +135,9 @@ type ArmClient struct {
 	automationRunbookDraftClient          automation.RunbookDraftClient
 	automationScheduleClient              automation.ScheduleClient
 
-	dnsClient   dns.RecordSetsClient
-	zonesClient dns.ZonesClient
+	dnsClient        dns.RecordSetsClient
+	zonesClient      dns.ZonesClient
+	privateDnsClient azure.PrivateDnsClient
 
 	containerRegistryClient             containerregistry.RegistriesClient
 	containerRegistryReplicationsClient containerregistry.ReplicationsClient
@@ -113,6 +145,8 @@ type ArmClient struct {
 	kubernetesClustersClient            containerservice.ManagedClustersClient
 	containerGroupsClient               containerinstance.ContainerGroupsClient
 
+	kubernetesClustersExtendedClient azure.KubernetesClustersClient
+
 	eventGridDomainsClient            eventgrid.DomainsClient
 	eventGridEventSubscriptionsClient eventgrid.EventSubscriptionsClient
 	eventGridTopicsClient             eventgrid.TopicsClient
@@ -178,6 +212,9 @@ type ArmClient struct {
 	vmImageClient              compute.VirtualMachineImagesClient
 	vmClient                   compute.VirtualMachinesClient
 
+	vmScaleSetExtendedClient azure.VirtualMachineScaleSetsClient
+	vmScaleSetVMsClient      compute.VirtualMachineScaleSetVMsClient
+
 	// Devices
 	iothubResourceClient devices.IotHubResourceClient
 
@@ -256,9 +293,11 @@ type ArmClient struct {
 	userAssignedIdentitiesClient msi.UserAssignedIdentitiesClient
 
 	// Networking
+	applicationGatewayExtendedClient azure.ApplicationGatewaysClient
+
 	applicationGatewayClient        network.ApplicationGatewaysClient
 	applicationSecurityGroupsClient network.ApplicationSecurityGroupsClient
-	azureFirewallsClient            network.AzureFirewallsClient
+	azureFirewallsClient            azure.AzureFirewallsClient
 	connectionMonitorsClient        network.ConnectionMonitorsClient
 	ddosProtectionPlanClient        network.DdosProtectionPlansClient
 	expressRouteAuthsClient         network.ExpressRouteCircuitAuthorizationsClient
@@ -293,12 +332,15 @@ type ArmClient struct {
 	relayNamespacesClient relay.NamespacesClient
 
 	// Resources
-	managementLocksClient locks.ManagementLocksClient
-	deploymentsClient     resources.DeploymentsClient
-	providersClient       resourcesprofile.ProvidersClient
-	resourcesClient       resources.Client
-	resourceGroupsClient  resources.GroupsClient
-	subscriptionsClient   subscriptions.Client
+	managementLocksClient            locks.ManagementLocksClient
+	deploymentsClient                resources.DeploymentsClient
+	deploymentOperationsClient       azure.TemplateDeploymentOperationsClient
+	managementGroupDeploymentsClient azure.ManagementGroupDeploymentsClient
+	templateDeploymentWhatIfClient   azure.TemplateDeploymentWhatIfClient
+	providersClient                  resourcesprofile.ProvidersClient
+	resourcesClient                  resources.Client
+	resourceGroupsClient             resources.GroupsClient
+	subscriptionsClient              subscriptions.Client
 
 	// Scheduler
 	schedulerJobCollectionsClient scheduler.JobCollectionsClient //nolint: megacheck
@@ -328,8 +370,9 @@ type ArmClient struct {
 	signalRClient signalr.Client
 
 	// Storage
-	storageServiceClient storage.AccountsClient
-	storageUsageClient   storage.UsageClient
+	storageServiceClient            storage.AccountsClient
+	storageUsageClient              storage.UsageClient
+	storageManagementPoliciesClient azure.StorageManagementPoliciesClient
 
 	// Traffic Manager
 	trafficManagerGeographialHierarchiesClient trafficmanager.GeographicHierarchiesClient
@@ -369,10 +412,15 @@ func clientRequestID() string {
 func (c *ArmClient) configureClient(client *autorest.Client, auth autorest.Authorizer) {
 	setUserAgent(client, c.partnerId)
 	client.Authorizer = auth
-	//client.RequestInspector = azure.WithClientID(clientRequestID())
-	client.Sender = azure.BuildSender()
+	client.RequestInspector = az.WithClientID(clientRequestID())
+	client.Sender = azure.BuildSender(c.retryPolicy)
+	azure.DisableSDKRetries(client)
 	client.SkipResourceProviderRegistration = c.skipProviderRegistration
 	client.PollingDuration = 60 * time.Minute
+
+	if recording.CurrentMode() == recording.ModeReplay {
+		client.PollingDelay = 0
+	}
 }
 
 func setUserAgent(client *autorest.Client, partnerID string) {
@@ -397,7 +445,7 @@ func setUserAgent(client *autorest.Client, partnerID string) {
 
 // getArmClient is a helper method which returns a fully instantiated
 // *ArmClient based on the Config's current settings.
-func getArmClient(c *authentication.Config, skipProviderRegistration bool, partnerId string) (*ArmClient, error) {
+func getArmClient(c *authentication.Config, skipProviderRegistration bool, partnerId string, retryPolicy azure.RetryPolicy, auxiliaryTenantIDs []string, storageUseAzureAD bool) (*ArmClient, error) {
 	env, err := authentication.DetermineEnvironment(c.Environment)
 	if err != nil {
 		return nil, err
@@ -412,6 +460,8 @@ func getArmClient(c *authentication.Config, skipProviderRegistration bool, partn
 		environment:              *env,
 		usingServicePrincipal:    c.AuthenticatedAsAServicePrincipal,
 		skipProviderRegistration: skipProviderRegistration,
+		retryPolicy:              retryPolicy,
+		storageUseAzureAD:        storageUseAzureAD,
 	}
 
 	oauthConfig, err := adal.NewOAuthConfig(env.ActiveDirectoryEndpoint, c.TenantID)
@@ -426,21 +476,42 @@ func getArmClient(c *authentication.Config, skipProviderRegistration bool, partn
 
 	// Resource Manager endpoints
 	endpoint := env.ResourceManagerEndpoint
-	auth, err := c.GetAuthorizationToken(oauthConfig, env.TokenAudience)
+	var auth autorest.Authorizer
+	auth, err = c.GetAuthorizationToken(oauthConfig, env.TokenAudience)
 	if err != nil {
 		return nil, err
 	}
 
+	// tokens for any Auxiliary Tenants are sent alongside the Resource Manager token for the resources which can
+	// reference resources in other tenants - Networking resources (such as Virtual Network Peerings and Gateway
+	// Connections), Private DNS Zone Virtual Network Links, Diagnostic Settings and Role Assignments
+	auxiliaryAuths := make([]autorest.Authorizer, 0, len(auxiliaryTenantIDs))
+	for _, tenantID := range auxiliaryTenantIDs {
+		auxiliaryOAuthConfig, err := adal.NewOAuthConfig(env.ActiveDirectoryEndpoint, tenantID)
+		if err != nil {
+			return nil, fmt.Errorf("Error configuring OAuthConfig for Auxiliary Tenant %q: %+v", tenantID, err)
+		}
+
+		auxiliaryAuth, err := c.GetAuthorizationToken(auxiliaryOAuthConfig, env.TokenAudience)
+		if err != nil {
+			return nil, fmt.Errorf("Error obtaining an Authorization Token for Auxiliary Tenant %q: %+v", tenantID, err)
+		}
+
+		auxiliaryAuths = append(auxiliaryAuths, auxiliaryAuth)
+	}
+	auxiliaryTenantsAuth := azure.NewAuxiliaryTenantsAuthorizer(auth, auxiliaryAuths)
+
 	// Graph Endpoints
 	graphEndpoint := env.GraphEndpoint
-	graphAuth, err := c.GetAuthorizationToken(oauthConfig, graphEndpoint)
+	var graphAuth autorest.Authorizer
+	graphAuth, err = c.GetAuthorizationToken(oauthConfig, graphEndpoint)
 	if err != nil {
 		return nil, err
 	}
 
 	// Key Vault Endpoints
-	sender := azure.BuildSender()
-	keyVaultAuth := autorest.NewBearerAuthorizerCallback(sender, func(tenantID, resource string) (*autorest.BearerAuthorizer, error) {
+	sender := azure.BuildSender(retryPolicy)
+	var keyVaultAuth autorest.Authorizer = autorest.NewBearerAuthorizerCallback(sender, func(tenantID, resource string) (*autorest.BearerAuthorizer, error) {
 		keyVaultSpt, err := c.GetAuthorizationToken(oauthConfig, resource)
 		if err != nil {
 			return nil, err
@@ -449,10 +520,27 @@ func getArmClient(c *authentication.Config, skipProviderRegistration bool, partn
 		return keyVaultSpt, nil
 	})
 
+	// Storage Endpoints - a token's only obtained when it's going to be used, since the Access Keys are used otherwise
+	if storageUseAzureAD {
+		client.storageAuthorizer, err = c.GetAuthorizationToken(oauthConfig, azure.StorageAzureADResource)
+		if err != nil {
+			return nil, fmt.Errorf("Error obtaining an Authorization Token for the Storage data-plane APIs: %+v", err)
+		}
+	}
+
+	// recorded responses are replayed without a network connection, so there's no token to obtain
+	if recording.CurrentMode() == recording.ModeReplay {
+		auth = autorest.NullAuthorizer{}
+		graphAuth = autorest.NullAuthorizer{}
+		keyVaultAuth = autorest.NullAuthorizer{}
+		auxiliaryTenantsAuth = autorest.NullAuthorizer{}
+		client.storageAuthorizer = autorest.NullAuthorizer{}
+	}
+
 	client.registerApiManagementServiceClients(endpoint, c.SubscriptionID, auth)
 	client.registerAppInsightsClients(endpoint, c.SubscriptionID, auth)
 	client.registerAutomationClients(endpoint, c.SubscriptionID, auth)
-	client.registerAuthentication(endpoint, graphEndpoint, c.SubscriptionID, c.TenantID, auth, graphAuth)
+	client.registerAuthentication(endpoint, graphEndpoint, c.SubscriptionID, c.TenantID, auxiliaryTenantsAuth, graphAuth)
 	client.registerBatchClients(endpoint, c.SubscriptionID, auth)
 	client.registerCDNClients(endpoint, c.SubscriptionID, auth)
 	client.registerCognitiveServiceClients(endpoint, c.SubscriptionID, auth)
@@ -467,14 +555,14 @@ func getArmClient(c *authentication.Config, skipProviderRegistration bool, partn
 	client.registerDeviceClients(endpoint, c.SubscriptionID, auth)
 	client.registerDevSpaceClients(endpoint, c.SubscriptionID, auth)
 	client.registerDevTestClients(endpoint, c.SubscriptionID, auth)
-	client.registerDNSClients(endpoint, c.SubscriptionID, auth)
+	client.registerDNSClients(endpoint, c.SubscriptionID, auth, auxiliaryTenantsAuth)
 	client.registerEventGridClients(endpoint, c.SubscriptionID, auth)
 	client.registerEventHubClients(endpoint, c.SubscriptionID, auth)
 	client.registerKeyVaultClients(endpoint, c.SubscriptionID, auth, keyVaultAuth)
 	client.registerLogicClients(endpoint, c.SubscriptionID, auth)
 	client.registerMediaServiceClients(endpoint, c.SubscriptionID, auth)
-	client.registerMonitorClients(endpoint, c.SubscriptionID, auth)
-	client.registerNetworkingClients(endpoint, c.SubscriptionID, auth)
+	client.registerMonitorClients(endpoint, c.SubscriptionID, auth, auxiliaryTenantsAuth)
+	client.registerNetworkingClients(endpoint, c.SubscriptionID, auxiliaryTenantsAuth)
 	client.registerNotificationHubsClient(endpoint, c.SubscriptionID, auth)
 	client.registerOperationalInsightsClients(endpoint, c.SubscriptionID, auth)
 	client.registerRecoveryServiceClients(endpoint, c.SubscriptionID, auth)
@@ -586,6 +674,8 @@ func (c *ArmClient) registerAutomationClients(endpoint, subscriptionId string, a
 	c.automationRunbookDraftClient = runbookDraftClient
 }
 
+// registerAuthentication registers the Authorization & Graph clients - the Authorization clients are passed an Authorizer
+// for any Auxiliary Tenants, since a Role Assignment can be made at a Scope within another Tenant
 func (c *ArmClient) registerAuthentication(endpoint, graphEndpoint, subscriptionId, tenantId string, auth, graphAuth autorest.Authorizer) {
 	assignmentsClient := authorization.NewRoleAssignmentsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&assignmentsClient.Client, auth)
@@ -638,6 +728,9 @@ func (c *ArmClient) registerCosmosDBClients(endpoint, subscriptionId string, aut
 	cdb := documentdb.NewDatabaseAccountsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&cdb.Client, auth)
 	c.cosmosDBClient = cdb
+
+	// the Databases, Containers etc within a Cosmos DB Account are managed using the client for the Account
+	c.cosmosDBResourcesClient = azure.NewCosmosDBResourcesClient(cdb)
 }
 
 func (c *ArmClient) registerMediaServiceClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
@@ -682,6 +775,11 @@ func (c *ArmClient) registerComputeClients(endpoint, subscriptionId string, auth
 	scaleSetsClient := compute.NewVirtualMachineScaleSetsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&scaleSetsClient.Client, auth)
 	c.vmScaleSetClient = scaleSetsClient
+	c.vmScaleSetExtendedClient = azure.NewVirtualMachineScaleSetsClient(scaleSetsClient)
+
+	scaleSetVMsClient := compute.NewVirtualMachineScaleSetVMsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&scaleSetVMsClient.Client, auth)
+	c.vmScaleSetVMsClient = scaleSetVMsClient
 
 	virtualMachinesClient := compute.NewVirtualMachinesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&virtualMachinesClient.Client, auth)
@@ -727,6 +825,7 @@ func (c *ArmClient) registerContainerServicesClients(endpoint, subscriptionId st
 	kubernetesClustersClient := containerservice.NewManagedClustersClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&kubernetesClustersClient.Client, auth)
 	c.kubernetesClustersClient = kubernetesClustersClient
+	c.kubernetesClustersExtendedClient = azure.NewKubernetesClustersClient(kubernetesClustersClient)
 }
 
 func (c *ArmClient) registerDatabricksClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
@@ -875,7 +974,7 @@ func (c *ArmClient) registerDevSpaceClients(endpoint, subscriptionId string, aut
 	c.devSpaceControllerClient = controllersClient
 }
 
-func (c *ArmClient) registerDNSClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
+func (c *ArmClient) registerDNSClients(endpoint, subscriptionId string, auth, auxiliaryTenantsAuth autorest.Authorizer) {
 	dn := dns.NewRecordSetsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&dn.Client, auth)
 	c.dnsClient = dn
@@ -883,6 +982,11 @@ func (c *ArmClient) registerDNSClients(endpoint, subscriptionId string, auth aut
 	zo := dns.NewZonesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&zo.Client, auth)
 	c.zonesClient = zo
+
+	// a Private DNS Zone can be linked to a Virtual Network in another Tenant
+	privateZonesClient := dns.NewZonesClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&privateZonesClient.Client, auxiliaryTenantsAuth)
+	c.privateDnsClient = azure.NewPrivateDnsClient(privateZonesClient)
 }
 
 func (c *ArmClient) registerEventGridClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
@@ -929,7 +1033,7 @@ func (c *ArmClient) registerLogicClients(endpoint, subscriptionId string, auth a
 	c.logicWorkflowsClient = workflowsClient
 }
 
-func (c *ArmClient) registerMonitorClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
+func (c *ArmClient) registerMonitorClients(endpoint, subscriptionId string, auth, auxiliaryTenantsAuth autorest.Authorizer) {
 	agc := insights.NewActionGroupsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&agc.Client, auth)
 	c.monitorActionGroupsClient = agc
@@ -954,8 +1058,9 @@ func (c *ArmClient) registerMonitorClients(endpoint, subscriptionId string, auth
 	c.configureClient(&autoscaleSettingsClient.Client, auth)
 	c.autoscaleSettingsClient = autoscaleSettingsClient
 
+	// the destinations of a Diagnostic Setting (e.g. a Log Analytics Workspace) can be in another Tenant
 	monitoringInsightsClient := insights.NewDiagnosticSettingsClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&monitoringInsightsClient.Client, auth)
+	c.configureClient(&monitoringInsightsClient.Client, auxiliaryTenantsAuth)
 	c.monitorDiagnosticSettingsClient = monitoringInsightsClient
 
 	monitoringCategorySettingsClient := insights.NewDiagnosticSettingsCategoryClientWithBaseURI(endpoint, subscriptionId)
@@ -967,6 +1072,7 @@ func (c *ArmClient) registerNetworkingClients(endpoint, subscriptionId string, a
 	applicationGatewaysClient := network.NewApplicationGatewaysClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&applicationGatewaysClient.Client, auth)
 	c.applicationGatewayClient = applicationGatewaysClient
+	c.applicationGatewayExtendedClient = azure.NewApplicationGatewaysClient(applicationGatewaysClient)
 
 	appSecurityGroupsClient := network.NewApplicationSecurityGroupsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&appSecurityGroupsClient.Client, auth)
@@ -974,7 +1080,7 @@ func (c *ArmClient) registerNetworkingClients(endpoint, subscriptionId string, a
 
 	azureFirewallsClient := network.NewAzureFirewallsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&azureFirewallsClient.Client, auth)
-	c.azureFirewallsClient = azureFirewallsClient
+	c.azureFirewallsClient = azure.NewAzureFirewallsClient(azureFirewallsClient)
 
 	connectionMonitorsClient := network.NewConnectionMonitorsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&connectionMonitorsClient.Client, auth)
@@ -1128,6 +1234,18 @@ func (c *ArmClient) registerResourcesClients(endpoint, subscriptionId string, au
 	c.configureClient(&deploymentsClient.Client, auth)
 	c.deploymentsClient = deploymentsClient
 
+	deploymentOperationsClient := azure.NewTemplateDeploymentOperationsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&deploymentOperationsClient.Client, auth)
+	c.deploymentOperationsClient = deploymentOperationsClient
+
+	managementGroupDeploymentsClient := azure.NewManagementGroupDeploymentsClientWithBaseURI(endpoint)
+	c.configureClient(&managementGroupDeploymentsClient.Client, auth)
+	c.managementGroupDeploymentsClient = managementGroupDeploymentsClient
+
+	templateDeploymentWhatIfClient := azure.NewTemplateDeploymentWhatIfClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&templateDeploymentWhatIfClient.Client, auth)
+	c.templateDeploymentWhatIfClient = templateDeploymentWhatIfClient
+
 	resourcesClient := resources.NewClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&resourcesClient.Client, auth)
 	c.resourcesClient = resourcesClient
@@ -1224,6 +1342,8 @@ func (c *ArmClient) registerStorageClients(endpoint, subscriptionId string, auth
 	usageClient := storage.NewUsageClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&usageClient.Client, auth)
 	c.storageUsageClient = usageClient
+
+	c.storageManagementPoliciesClient = azure.NewStorageManagementPoliciesClient(accountsClient)
 }
 
 func (c *ArmClient) registerTrafficManagerClients(endpoint, subscriptionId string, auth autorest.Authorizer) {
@@ -1324,7 +1444,29 @@ func (c *ArmClient) getKeyForStorageAccount(ctx context.Context, resourceGroupNa
 	return key, true, nil
 }
 
-func (c *ArmClient) getBlobStorageClientForStorageAccount(ctx context.Context, resourceGroupName, storageAccountName string) (*mainStorage.BlobStorageClient, bool, error) {
+// getStorageClientForStorageAccount returns a client for the Storage data-plane APIs of the Storage Account, which is
+// authenticated using Azure AD when `storage_use_azuread` is enabled and using the Storage Account's Access Key otherwise
+func (c *ArmClient) getStorageClientForStorageAccount(ctx context.Context, resourceGroupName, storageAccountName string) (*mainStorage.Client, bool, error) {
+	if c.storageUseAzureAD {
+		account, err := c.storageServiceClient.GetProperties(ctx, resourceGroupName, storageAccountName)
+		if err != nil {
+			if utils.ResponseWasNotFound(account.Response) {
+				return nil, false, nil
+			}
+
+			// We assume this is a transient error rather than a 404 (which is caught above),  so assume the
+			// storeAccount still exists.
+			return nil, true, fmt.Errorf("Error retrieving storage storeAccount %q: %s", storageAccountName, err)
+		}
+
+		storageClient := azure.NewStorageClientUsingAzureAD(storageAccountName, c.environment, c.storageAuthorizer)
+		return &storageClient, true, nil
+	}
+
+	return c.getStorageClientForStorageAccountUsingAccessKey(ctx, resourceGroupName, storageAccountName)
+}
+
+func (c *ArmClient) getStorageClientForStorageAccountUsingAccessKey(ctx context.Context, resourceGroupName, storageAccountName string) (*mainStorage.Client, bool, error) {
 	key, accountExists, err := c.getKeyForStorageAccount(ctx, resourceGroupName, storageAccountName)
 	if err != nil {
 		return nil, accountExists, err
@@ -1339,23 +1481,57 @@ func (c *ArmClient) getBlobStorageClientForStorageAccount(ctx context.Context, r
 		return nil, true, fmt.Errorf("Error creating storage client for storage storeAccount %q: %s", storageAccountName, err)
 	}
 
+	return &storageClient, true, nil
+}
+
+// getStorageServicePropertiesClientForStorageAccount returns a client for the properties of the specified Service
+// (e.g. `blob` or `queue`) within the Storage Account, authenticated in the same way as getStorageClientForStorageAccount
+func (c *ArmClient) getStorageServicePropertiesClientForStorageAccount(ctx context.Context, resourceGroupName, storageAccountName, service string) (*azure.StorageServicePropertiesClient, bool, error) {
+	authorizer := c.storageAuthorizer
+	if !c.storageUseAzureAD {
+		key, accountExists, err := c.getKeyForStorageAccount(ctx, resourceGroupName, storageAccountName)
+		if err != nil || !accountExists {
+			return nil, accountExists, err
+		}
+
+		sharedKeyAuthorizer, err := azure.NewStorageSharedKeyAuthorizer(storageAccountName, key)
+		if err != nil {
+			return nil, true, err
+		}
+		authorizer = sharedKeyAuthorizer
+	}
+
+	endpoint := fmt.Sprintf("https://%s.%s.%s", storageAccountName, service, c.environment.StorageEndpointSuffix)
+	client := azure.NewStorageServicePropertiesClient(endpoint)
+	// the Correlation Request ID is set by the Shared Key Authorizer, since any `x-ms-*` headers added once the request
+	// has been signed (such as the Client Request ID from configureClient) would invalidate the signature
+	setUserAgent(&client.Client, c.partnerId)
+	client.Authorizer = authorizer
+	client.Sender = azure.BuildSender(c.retryPolicy)
+	azure.DisableSDKRetries(&client.Client)
+
+	return &client, true, nil
+}
+
+func (c *ArmClient) getBlobStorageClientForStorageAccount(ctx context.Context, resourceGroupName, storageAccountName string) (*mainStorage.BlobStorageClient, bool, error) {
+	storageClient, accountExists, err := c.getStorageClientForStorageAccount(ctx, resourceGroupName, storageAccountName)
+	if err != nil || !accountExists {
+		return nil, accountExists, err
+	}
+
 	blobClient := storageClient.GetBlobService()
 	return &blobClient, true, nil
 }
 
 func (c *ArmClient) getFileServiceClientForStorageAccount(ctx context.Context, resourceGroupName, storageAccountName string) (*mainStorage.FileServiceClient, bool, error) {
-	key, accountExists, err := c.getKeyForStorageAccount(ctx, resourceGroupName, storageAccountName)
-	if err != nil {
-		return nil, accountExists, err
-	}
-	if !accountExists {
-		return nil, false, nil
+	// the File Service doesn't support Azure AD authentication for managing File Shares, so the Access Key is always used
+	if c.storageUseAzureAD {
+		log.Printf("[WARN] The File Service doesn't support Azure AD authentication for managing File Shares - using the Access Key for Storage Account %q instead", storageAccountName)
 	}
 
-	storageClient, err := mainStorage.NewClient(storageAccountName, key, c.environment.StorageEndpointSuffix,
-		mainStorage.DefaultAPIVersion, true)
-	if err != nil {
-		return nil, true, fmt.Errorf("Error creating storage client for storage storeAccount %q: %s", storageAccountName, err)
+	storageClient, accountExists, err := c.getStorageClientForStorageAccountUsingAccessKey(ctx, resourceGroupName, storageAccountName)
+	if err != nil || !accountExists {
+		return nil, accountExists, err
 	}
 
 	fileClient := storageClient.GetFileService()
@@ -1363,38 +1539,20 @@ func (c *ArmClient) getFileServiceClientForStorageAccount(ctx context.Context, r
 }
 
 func (c *ArmClient) getTableServiceClientForStorageAccount(ctx context.Context, resourceGroupName, storageAccountName string) (*mainStorage.TableServiceClient, bool, error) {
-	key, accountExists, err := c.getKeyForStorageAccount(ctx, resourceGroupName, storageAccountName)
-	if err != nil {
+	storageClient, accountExists, err := c.getStorageClientForStorageAccount(ctx, resourceGroupName, storageAccountName)
+	if err != nil || !accountExists {
 		return nil, accountExists, err
 	}
-	if !accountExists {
-		return nil, false, nil
-	}
-
-	storageClient, err := mainStorage.NewClient(storageAccountName, key, c.environment.StorageEndpointSuffix,
-		mainStorage.DefaultAPIVersion, true)
-	if err != nil {
-		return nil, true, fmt.Errorf("Error creating storage client for storage storeAccount %q: %s", storageAccountName, err)
-	}
 
 	tableClient := storageClient.GetTableService()
 	return &tableClient, true, nil
 }
 
 func (c *ArmClient) getQueueServiceClientForStorageAccount(ctx context.Context, resourceGroupName, storageAccountName string) (*mainStorage.QueueServiceClient, bool, error) {
-	key, accountExists, err := c.getKeyForStorageAccount(ctx, resourceGroupName, storageAccountName)
-	if err != nil {
+	storageClient, accountExists, err := c.getStorageClientForStorageAccount(ctx, resourceGroupName, storageAccountName)
+	if err != nil || !accountExists {
 		return nil, accountExists, err
 	}
-	if !accountExists {
-		return nil, false, nil
-	}
-
-	storageClient, err := mainStorage.NewClient(storageAccountName, key, c.environment.StorageEndpointSuffix,
-		mainStorage.DefaultAPIVersion, true)
-	if err != nil {
-		return nil, true, fmt.Errorf("Error creating storage client for storage storeAccount %q: %s", storageAccountName, err)
-	}
 
 	queueClient := storageClient.GetQueueService()
 	return &queueClient, true, nil
@@ -0,0 +1,67 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+type crudFunc func(*schema.ResourceData, interface{}) error
+
+// withCorrelationRequestIds wraps the CRUD functions of the resource so that the requests made by each
+// invocation share a unique Correlation Request ID, which is appended to any error returned
+func withCorrelationRequestIds(resourceType string, r *schema.Resource) *schema.Resource {
+	if r.Create != nil {
+		r.Create = schema.CreateFunc(withCorrelationRequestId(resourceType, r, crudFunc(r.Create)))
+	}
+	if r.Read != nil {
+		r.Read = schema.ReadFunc(withCorrelationRequestId(resourceType, r, crudFunc(r.Read)))
+	}
+	if r.Update != nil {
+		r.Update = schema.UpdateFunc(withCorrelationRequestId(resourceType, r, crudFunc(r.Update)))
+	}
+	if r.Delete != nil {
+		r.Delete = schema.DeleteFunc(withCorrelationRequestId(resourceType, r, crudFunc(r.Delete)))
+	}
+
+	return r
+}
+
+func withCorrelationRequestId(resourceType string, r *schema.Resource, f crudFunc) crudFunc {
+	return func(d *schema.ResourceData, meta interface{}) error {
+		id, err := uuid.GenerateUUID()
+		if err != nil {
+			log.Printf("[WARN] Unable to generate a Correlation Request ID: %+v", err)
+			return f(d, meta)
+		}
+
+		// the Correlation is attached to the context of each request by the `timeouts` helpers, rather than the
+		// (shared) client - since the context is built from the ResourceData for the operation
+		done := azure.StartCorrelatedOperation(d, azure.Correlation{
+			RequestID:    id,
+			ResourceType: resourceType,
+			ResourceName: correlatedResourceName(r, d),
+		})
+		defer done()
+
+		if err := f(d, meta); err != nil {
+			return fmt.Errorf("%s\n\nCorrelation Request ID: %s\nClient Request ID: %s", err, id, clientRequestID())
+		}
+
+		return nil
+	}
+}
+
+// correlatedResourceName returns the name of the resource in Azure where it's known, falling back to its ID
+func correlatedResourceName(r *schema.Resource, d *schema.ResourceData) string {
+	if s, ok := r.Schema["name"]; ok && s.Type == schema.TypeString {
+		if name, ok := d.Get("name").(string); ok && name != "" {
+			return name
+		}
+	}
+
+	return d.Id()
+}
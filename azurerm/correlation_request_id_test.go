@@ -0,0 +1,75 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
+)
+
+func TestWithCorrelationRequestIds(t *testing.T) {
+	var correlation azure.Correlation
+	var client *ArmClient
+
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+		Read: func(d *schema.ResourceData, meta interface{}) error {
+			client = meta.(*ArmClient)
+
+			ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+			defer cancel()
+
+			correlation, _ = azure.CorrelationFromContext(ctx)
+			return fmt.Errorf("Error retrieving %q", d.Get("name").(string))
+		},
+	}
+	withCorrelationRequestIds("azurerm_example", r)
+
+	meta := &ArmClient{StopContext: context.Background()}
+	d := r.Data(&terraform.InstanceState{
+		ID: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example",
+		Attributes: map[string]string{
+			"name": "example",
+		},
+	})
+
+	err := r.Read(d, meta)
+	if err == nil {
+		t.Fatalf("Expected an error but didn't get one")
+	}
+
+	// the client is shared between concurrent operations, so the Correlation mustn't be attached to it
+	if client != meta {
+		t.Fatalf("Expected the client to be passed through unchanged")
+	}
+	if _, ok := azure.CorrelationFromContext(meta.StopContext); ok {
+		t.Fatalf("Expected the StopContext of the client not to have a Correlation")
+	}
+
+	if correlation.RequestID == "" {
+		t.Fatalf("Expected the context to have a Correlation Request ID")
+	}
+	if correlation.ResourceType != "azurerm_example" || correlation.ResourceName != "example" {
+		t.Fatalf("Expected the resource to be %q / %q but got %q / %q", "azurerm_example", "example", correlation.ResourceType, correlation.ResourceName)
+	}
+	if !strings.Contains(err.Error(), fmt.Sprintf("Correlation Request ID: %s", correlation.RequestID)) {
+		t.Fatalf("Expected the error to include the Correlation Request ID but got %q", err.Error())
+	}
+
+	// once the operation has completed the Correlation is no longer attached
+	ctx, cancel := timeouts.ForRead(meta.StopContext, d)
+	defer cancel()
+	if v, ok := azure.CorrelationFromContext(ctx); ok {
+		t.Fatalf("Expected no Correlation once the operation has completed but got %+v", v)
+	}
+}
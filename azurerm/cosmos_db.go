@@ -0,0 +1,397 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func cosmosDBAccountNameSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+		ValidateFunc: validation.StringMatch(
+			regexp.MustCompile("^[-a-z0-9]{3,50}$"),
+			"Cosmos DB Account name must be 3 - 50 characters long, contain only letters, numbers and hyphens.",
+		),
+	}
+}
+
+func cosmosDBEntityNameSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		ValidateFunc: validate.CosmosDBEntityName(),
+	}
+}
+
+func cosmosDBThroughputSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Computed:     true,
+		ValidateFunc: validate.CosmosDBThroughput,
+	}
+}
+
+func cosmosDBDefaultTTLSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		ValidateFunc: validation.IntAtLeast(-1),
+	}
+}
+
+func cosmosDBPartitionKeyPathSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		ForceNew: true,
+		ValidateFunc: validation.StringMatch(
+			regexp.MustCompile("^/.+"),
+			"The Partition Key Path must start with a `/`.",
+		),
+	}
+}
+
+func cosmosDBUniqueKeySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		ForceNew: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"paths": {
+					Type:     schema.TypeList,
+					Required: true,
+					ForceNew: true,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validation.NoZeroValues,
+					},
+				},
+			},
+		},
+	}
+}
+
+func cosmosDBIndexingPolicySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Computed: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"indexing_mode": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					Default:          "Consistent",
+					DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+					ValidateFunc: validation.StringInSlice([]string{
+						"Consistent",
+						"Lazy",
+						"None",
+					}, true),
+				},
+
+				"included_path": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"path": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.NoZeroValues,
+							},
+						},
+					},
+				},
+
+				"excluded_path": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"path": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.NoZeroValues,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// cosmosDBCheckForExisting returns an error when `require_resources_to_be_imported` is enabled and the resource
+// being created already exists within the Cosmos DB Account
+func cosmosDBCheckForExisting(ctx context.Context, d *schema.ResourceData, meta interface{}, resourceType string, id string, description string) error {
+	if !meta.(*ArmClient).requireResourcesToBeImported || !d.IsNewResource() {
+		return nil
+	}
+
+	client := meta.(*ArmClient).cosmosDBResourcesClient
+	var existing azure.CosmosDBNamedResource
+	resp, err := client.Get(ctx, id, &existing)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			return nil
+		}
+
+		return fmt.Errorf("Error checking for presence of existing %s: %+v", description, err)
+	}
+
+	return tf.ImportAsExistsError(resourceType, id)
+}
+
+// cosmosDBCreateUpdate creates or updates the resource within a Cosmos DB Account, provisioning the `throughput`
+// when it's created - and then updating the throughput separately, since it's managed as a child resource
+func cosmosDBCreateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}, id string, description string, resource interface{}) error {
+	client := meta.(*ArmClient).cosmosDBResourcesClient
+
+	throughput := 0
+	if d.IsNewResource() {
+		throughput = d.Get("throughput").(int)
+	}
+
+	log.Printf("[INFO] Creating/updating %s..", description)
+	future, err := client.CreateUpdate(ctx, id, resource, throughput)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating %s: %+v", description, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of %s: %+v", description, err)
+	}
+
+	if d.IsNewResource() || !d.HasChange("throughput") {
+		return nil
+	}
+
+	// when throughput isn't specified the current value (or lack of dedicated throughput) is kept
+	throughput = d.Get("throughput").(int)
+	if throughput == 0 {
+		return nil
+	}
+
+	log.Printf("[INFO] Updating the throughput of %s to %d..", description, throughput)
+	future, err = client.UpdateThroughput(ctx, id, throughput)
+	if err != nil {
+		return fmt.Errorf("Error updating the throughput of %s: %+v", description, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for the throughput of %s to be updated: %+v", description, err)
+	}
+
+	return nil
+}
+
+// cosmosDBRead retrieves the resource within a Cosmos DB Account, returning false when it no longer exists
+func cosmosDBRead(ctx context.Context, d *schema.ResourceData, meta interface{}, id string, description string, resource interface{}) (bool, error) {
+	client := meta.(*ArmClient).cosmosDBResourcesClient
+
+	resp, err := client.Get(ctx, id, resource)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			log.Printf("[DEBUG] %s was not found - removing from state", description)
+			d.SetId("")
+			return false, nil
+		}
+
+		return false, fmt.Errorf("Error retrieving %s: %+v", description, err)
+	}
+
+	throughput, resp, err := client.GetThroughput(ctx, id)
+	if err != nil {
+		// resources using the shared throughput of their Account or Database don't have their own throughput
+		if !utils.ResponseWasNotFound(resp) {
+			return false, fmt.Errorf("Error retrieving the throughput of %s: %+v", description, err)
+		}
+	}
+
+	if throughput != nil {
+		d.Set("throughput", int(*throughput))
+	} else {
+		d.Set("throughput", 0)
+	}
+
+	return true, nil
+}
+
+func cosmosDBDelete(ctx context.Context, meta interface{}, id string, description string) error {
+	client := meta.(*ArmClient).cosmosDBResourcesClient
+
+	future, err := client.Delete(ctx, id)
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting %s: %+v", description, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error waiting for deletion of %s: %+v", description, err)
+		}
+	}
+
+	return nil
+}
+
+func expandCosmosDBPartitionKey(d *schema.ResourceData) *azure.CosmosDBPartitionKey {
+	path := d.Get("partition_key_path").(string)
+	if path == "" {
+		return nil
+	}
+
+	return &azure.CosmosDBPartitionKey{
+		Paths: &[]string{path},
+		Kind:  "Hash",
+	}
+}
+
+func flattenCosmosDBPartitionKey(input *azure.CosmosDBPartitionKey) string {
+	if input == nil || input.Paths == nil || len(*input.Paths) == 0 {
+		return ""
+	}
+
+	return (*input.Paths)[0]
+}
+
+func expandCosmosDBDefaultTTL(d *schema.ResourceData) *int32 {
+	if v, ok := d.GetOk("default_ttl"); ok {
+		return utils.Int32(int32(v.(int)))
+	}
+
+	return nil
+}
+
+func flattenCosmosDBDefaultTTL(input *int32) int {
+	if input == nil {
+		return 0
+	}
+
+	return int(*input)
+}
+
+func expandCosmosDBUniqueKeyPolicy(input []interface{}) *azure.CosmosDBUniqueKeyPolicy {
+	if len(input) == 0 {
+		return nil
+	}
+
+	keys := make([]azure.CosmosDBUniqueKey, 0)
+	for _, v := range input {
+		if v == nil {
+			continue
+		}
+
+		key := v.(map[string]interface{})
+		keys = append(keys, azure.CosmosDBUniqueKey{
+			Paths: utils.ExpandStringArray(key["paths"].([]interface{})),
+		})
+	}
+
+	return &azure.CosmosDBUniqueKeyPolicy{
+		UniqueKeys: &keys,
+	}
+}
+
+func flattenCosmosDBUniqueKeyPolicy(input *azure.CosmosDBUniqueKeyPolicy) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil || input.UniqueKeys == nil {
+		return results
+	}
+
+	for _, key := range *input.UniqueKeys {
+		results = append(results, map[string]interface{}{
+			"paths": utils.FlattenStringArray(key.Paths),
+		})
+	}
+
+	return results
+}
+
+func expandCosmosDBIndexingPolicy(input []interface{}) *azure.CosmosDBIndexingPolicy {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	mode := v["indexing_mode"].(string)
+
+	return &azure.CosmosDBIndexingPolicy{
+		// the Index can only be maintained automatically when Indexing is enabled
+		Automatic:     utils.Bool(!strings.EqualFold(mode, "None")),
+		IndexingMode:  mode,
+		IncludedPaths: expandCosmosDBIndexPaths(v["included_path"].([]interface{})),
+		ExcludedPaths: expandCosmosDBIndexPaths(v["excluded_path"].([]interface{})),
+	}
+}
+
+func expandCosmosDBIndexPaths(input []interface{}) *[]azure.CosmosDBIndexPath {
+	paths := make([]azure.CosmosDBIndexPath, 0)
+	for _, v := range input {
+		if v == nil {
+			continue
+		}
+
+		path := v.(map[string]interface{})
+		paths = append(paths, azure.CosmosDBIndexPath{
+			Path: utils.String(path["path"].(string)),
+		})
+	}
+
+	return &paths
+}
+
+func flattenCosmosDBIndexingPolicy(input *azure.CosmosDBIndexingPolicy) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"indexing_mode": input.IndexingMode,
+			"included_path": flattenCosmosDBIndexPaths(input.IncludedPaths),
+			"excluded_path": flattenCosmosDBIndexPaths(input.ExcludedPaths),
+		},
+	}
+}
+
+func flattenCosmosDBIndexPaths(input *[]azure.CosmosDBIndexPath) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, v := range *input {
+		path := ""
+		if v.Path != nil {
+			path = *v.Path
+		}
+
+		results = append(results, map[string]interface{}{
+			"path": path,
+		})
+	}
+
+	return results
+}
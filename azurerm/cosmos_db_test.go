@@ -0,0 +1,131 @@
+package azurerm
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestCosmosDBIndexingPolicy_roundTrip(t *testing.T) {
+	input := []interface{}{
+		map[string]interface{}{
+			"indexing_mode": "Consistent",
+			"included_path": []interface{}{
+				map[string]interface{}{"path": "/*"},
+			},
+			"excluded_path": []interface{}{
+				map[string]interface{}{"path": "/\"_etag\"/?"},
+				map[string]interface{}{"path": "/large/*"},
+			},
+		},
+	}
+
+	policy := expandCosmosDBIndexingPolicy(input)
+	if policy.Automatic == nil || !*policy.Automatic {
+		t.Fatalf("Expected the Index to be maintained automatically")
+	}
+
+	if output := flattenCosmosDBIndexingPolicy(policy); !reflect.DeepEqual(input, output) {
+		t.Fatalf("Expected %+v but got %+v", input, output)
+	}
+
+	none := expandCosmosDBIndexingPolicy([]interface{}{
+		map[string]interface{}{
+			"indexing_mode": "None",
+			"included_path": []interface{}{},
+			"excluded_path": []interface{}{},
+		},
+	})
+	if none.Automatic == nil || *none.Automatic {
+		t.Fatalf("Expected the Index not to be maintained automatically when Indexing is disabled")
+	}
+}
+
+func TestCosmosDBMongoCollectionIndexes(t *testing.T) {
+	input := []interface{}{
+		map[string]interface{}{
+			"keys":   []interface{}{"email"},
+			"unique": true,
+		},
+	}
+
+	indexes := expandCosmosDBMongoCollectionIndexes(input, 3600)
+	if len(*indexes) != 2 {
+		t.Fatalf("Expected 2 Indexes but got %d", len(*indexes))
+	}
+
+	// the `_id` Index is created by the service and shouldn't be flattened
+	returned := append(*indexes, azure.CosmosDBMongoIndex{
+		Key: &azure.CosmosDBMongoIndexKeys{
+			Keys: &[]string{"_id"},
+		},
+		Options: &azure.CosmosDBMongoIndexOptions{
+			Unique: utils.Bool(true),
+		},
+	})
+
+	output, ttl := flattenCosmosDBMongoCollectionIndexes(&returned)
+	if ttl != 3600 {
+		t.Fatalf("Expected a TTL of 3600 but got %d", ttl)
+	}
+
+	if !reflect.DeepEqual(input, output) {
+		t.Fatalf("Expected %+v but got %+v", input, output)
+	}
+}
+
+func testCheckAzureRMCosmosDBResourceExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).cosmosDBResourcesClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		var existing azure.CosmosDBNamedResource
+		resp, err := client.Get(ctx, rs.Primary.ID, &existing)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp) {
+				return fmt.Errorf("Bad: %q does not exist", rs.Primary.ID)
+			}
+
+			return fmt.Errorf("Bad: Get on cosmosDBResourcesClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMCosmosDBResourceDestroy(resourceType string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*ArmClient).cosmosDBResourcesClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != resourceType {
+				continue
+			}
+
+			var existing azure.CosmosDBNamedResource
+			resp, err := client.Get(ctx, rs.Primary.ID, &existing)
+			if err != nil {
+				if !utils.ResponseWasNotFound(resp) {
+					return err
+				}
+				continue
+			}
+
+			return fmt.Errorf("%q still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
@@ -157,7 +157,7 @@ func dataSourceApiManagementRead(d *schema.ResourceData, meta interface{}) error
 	name := d.Get("name").(string)
 	resourceGroup := d.Get("resource_group_name").(string)
 
-	ctx := meta.(*ArmClient).StopContext
+	ctx := azure.CorrelatedContext(meta.(*ArmClient).StopContext, d)
 	resp, err := client.Get(ctx, resourceGroup, name)
 
 	if err != nil {
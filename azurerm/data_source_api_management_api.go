@@ -105,7 +105,7 @@ func dataSourceApiManagementApi() *schema.Resource {
 }
 
 func dataSourceApiManagementApiRead(d *schema.ResourceData, meta interface{}) error {
-	ctx := meta.(*ArmClient).StopContext
+	ctx := azure.CorrelatedContext(meta.(*ArmClient).StopContext, d)
 	client := meta.(*ArmClient).apiManagementApiClient
 
 	resourceGroup := d.Get("resource_group_name").(string)
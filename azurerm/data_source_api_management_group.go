@@ -45,7 +45,7 @@ func dataSourceApiManagementGroup() *schema.Resource {
 
 func dataSourceApiManagementGroupRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).apiManagementGroupClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx := azure.CorrelatedContext(meta.(*ArmClient).StopContext, d)
 
 	resourceGroup := d.Get("resource_group_name").(string)
 	serviceName := d.Get("api_management_name").(string)
@@ -59,7 +59,7 @@ func dataSourceApiManagementProduct() *schema.Resource {
 }
 func dataSourceApiManagementProductRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).apiManagementProductsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx := azure.CorrelatedContext(meta.(*ArmClient).StopContext, d)
 
 	resourceGroup := d.Get("resource_group_name").(string)
 	serviceName := d.Get("api_management_name").(string)
@@ -49,7 +49,7 @@ func dataSourceArmApiManagementUser() *schema.Resource {
 
 func dataSourceArmApiManagementUserRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).apiManagementUsersClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx := azure.CorrelatedContext(meta.(*ArmClient).StopContext, d)
 
 	resourceGroup := d.Get("resource_group_name").(string)
 	serviceName := d.Get("api_management_name").(string)
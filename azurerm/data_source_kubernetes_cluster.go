@@ -6,6 +6,7 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/services/containerservice/mgmt/2018-03-31/containerservice"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/kubernetes"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
@@ -24,6 +25,12 @@ func dataSourceArmKubernetesCluster() *schema.Resource {
 
 			"location": locationForDataSourceSchema(),
 
+			"api_server_authorized_ip_ranges": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
 			"addon_profile": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -112,6 +119,38 @@ func dataSourceArmKubernetesCluster() *schema.Resource {
 							Type:     schema.TypeInt,
 							Computed: true,
 						},
+
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"enable_auto_scaling": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+
+						"min_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+
+						"max_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+
+						"availability_zones": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+
+						"node_taints": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
 					},
 				},
 			},
@@ -126,6 +165,39 @@ func dataSourceArmKubernetesCluster() *schema.Resource {
 				Computed: true,
 			},
 
+			"identity": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"principal_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"tenant_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"private_cluster_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"private_fqdn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
 			"kubernetes_version": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -273,6 +345,11 @@ func dataSourceArmKubernetesCluster() *schema.Resource {
 							Type:     schema.TypeString,
 							Computed: true,
 						},
+
+						"load_balancer_sku": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
 					},
 				},
 			},
@@ -337,12 +414,13 @@ func dataSourceArmKubernetesCluster() *schema.Resource {
 
 func dataSourceArmKubernetesClusterRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).kubernetesClustersClient
-	ctx := meta.(*ArmClient).StopContext
+	extendedClient := meta.(*ArmClient).kubernetesClustersExtendedClient
+	ctx := azure.CorrelatedContext(meta.(*ArmClient).StopContext, d)
 
 	name := d.Get("name").(string)
 	resourceGroup := d.Get("resource_group_name").(string)
 
-	resp, err := client.Get(ctx, resourceGroup, name)
+	resp, extended, err := extendedClient.Get(ctx, resourceGroup, name)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
 			return fmt.Errorf("Error: Managed Kubernetes Cluster %q was not found in Resource Group %q", name, resourceGroup)
@@ -364,6 +442,29 @@ func dataSourceArmKubernetesClusterRead(d *schema.ResourceData, meta interface{}
 		d.Set("location", azureRMNormalizeLocation(*location))
 	}
 
+	kubeConfigRaw, kubeConfig := flattenKubernetesClusterDataSourceAccessProfile(profile)
+
+	if err := d.Set("identity", flattenKubernetesClusterIdentity(extended.Identity)); err != nil {
+		return fmt.Errorf("Error setting `identity`: %+v", err)
+	}
+
+	authorizedIPRanges, privateCluster := flattenKubernetesClusterAPIServerAccessProfile(extended.APIServerAccessProfile)
+	if err := d.Set("api_server_authorized_ip_ranges", authorizedIPRanges); err != nil {
+		return fmt.Errorf("Error setting `api_server_authorized_ip_ranges`: %+v", err)
+	}
+	d.Set("private_cluster_enabled", privateCluster)
+
+	// the Private FQDN of the API Server isn't returned by the API, however it's the Server within the Kube Config
+	privateFqdn := ""
+	if privateCluster && kubeConfigRaw != nil {
+		host, err := kubernetes.ParseKubeConfigServerHost(*kubeConfigRaw)
+		if err != nil {
+			return fmt.Errorf("Error parsing the Private FQDN for Managed Kubernetes Cluster %q (Resource Group %q) from the Kube Config: %+v", name, resourceGroup, err)
+		}
+		privateFqdn = host
+	}
+	d.Set("private_fqdn", privateFqdn)
+
 	if props := resp.ManagedClusterProperties; props != nil {
 		d.Set("dns_prefix", props.DNSPrefix)
 		d.Set("fqdn", props.Fqdn)
@@ -375,7 +476,7 @@ func dataSourceArmKubernetesClusterRead(d *schema.ResourceData, meta interface{}
 			return fmt.Errorf("Error setting `addon_profile`: %+v", err)
 		}
 
-		agentPoolProfiles := flattenKubernetesClusterDataSourceAgentPoolProfiles(props.AgentPoolProfiles)
+		agentPoolProfiles := flattenKubernetesClusterDataSourceAgentPoolProfiles(props.AgentPoolProfiles, extended.AgentPoolProfiles)
 		if err := d.Set("agent_pool_profile", agentPoolProfiles); err != nil {
 			return fmt.Errorf("Error setting `agent_pool_profile`: %+v", err)
 		}
@@ -385,7 +486,7 @@ func dataSourceArmKubernetesClusterRead(d *schema.ResourceData, meta interface{}
 			return fmt.Errorf("Error setting `linux_profile`: %+v", err)
 		}
 
-		networkProfile := flattenKubernetesClusterDataSourceNetworkProfile(props.NetworkProfile)
+		networkProfile := flattenKubernetesClusterDataSourceNetworkProfile(props.NetworkProfile, extended.LoadBalancerSku)
 		if err := d.Set("network_profile", networkProfile); err != nil {
 			return fmt.Errorf("Error setting `network_profile`: %+v", err)
 		}
@@ -418,7 +519,6 @@ func dataSourceArmKubernetesClusterRead(d *schema.ResourceData, meta interface{}
 		}
 	}
 
-	kubeConfigRaw, kubeConfig := flattenKubernetesClusterDataSourceAccessProfile(profile)
 	d.Set("kube_config_raw", kubeConfigRaw)
 	if err := d.Set("kube_config", kubeConfig); err != nil {
 		return fmt.Errorf("Error setting `kube_config`: %+v", err)
@@ -541,7 +641,7 @@ func flattenKubernetesClusterDataSourceAddonProfiles(profile map[string]*contain
 	return []interface{}{values}
 }
 
-func flattenKubernetesClusterDataSourceAgentPoolProfiles(input *[]containerservice.ManagedClusterAgentPoolProfile) []interface{} {
+func flattenKubernetesClusterDataSourceAgentPoolProfiles(input *[]containerservice.ManagedClusterAgentPoolProfile, extended map[string]azure.KubernetesAgentPoolExtendedProperties) []interface{} {
 	agentPoolProfiles := make([]interface{}, 0)
 
 	if input == nil {
@@ -579,6 +679,15 @@ func flattenKubernetesClusterDataSourceAgentPoolProfiles(input *[]containerservi
 			agentPoolProfile["max_pods"] = int(*profile.MaxPods)
 		}
 
+		if profile.Name != nil {
+			props := extended[*profile.Name]
+			agentPoolProfile["type"] = props.Type
+
+			for k, v := range flattenKubernetesAgentPoolExtendedProperties(props) {
+				agentPoolProfile[k] = v
+			}
+		}
+
 		agentPoolProfiles = append(agentPoolProfiles, agentPoolProfile)
 	}
 
@@ -612,7 +721,7 @@ func flattenKubernetesClusterDataSourceLinuxProfile(input *containerservice.Linu
 	return []interface{}{values}
 }
 
-func flattenKubernetesClusterDataSourceNetworkProfile(profile *containerservice.NetworkProfile) []interface{} {
+func flattenKubernetesClusterDataSourceNetworkProfile(profile *containerservice.NetworkProfile, loadBalancerSku string) []interface{} {
 	values := make(map[string]interface{})
 
 	values["network_plugin"] = profile.NetworkPlugin
@@ -637,11 +746,14 @@ func flattenKubernetesClusterDataSourceNetworkProfile(profile *containerservice.
 		values["pod_cidr"] = *profile.PodCidr
 	}
 
+	values["load_balancer_sku"] = loadBalancerSku
+
 	return []interface{}{values}
 }
 
 func flattenKubernetesClusterDataSourceServicePrincipalProfile(profile *containerservice.ManagedClusterServicePrincipalProfile) []interface{} {
-	if profile == nil {
+	// a Cluster using a Managed Identity has a placeholder Service Principal
+	if profile == nil || (profile.ClientID != nil && *profile.ClientID == azure.KubernetesClusterManagedIdentityClientID) {
 		return []interface{}{}
 	}
 
@@ -38,7 +38,7 @@ func dataSourceArmMonitorDiagnosticCategories() *schema.Resource {
 
 func dataSourceArmMonitorDiagnosticCategoriesRead(d *schema.ResourceData, meta interface{}) error {
 	categoriesClient := meta.(*ArmClient).monitorDiagnosticSettingsCategoryClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx := azure.CorrelatedContext(meta.(*ArmClient).StopContext, d)
 
 	actualResourceId := d.Get("resource_id").(string)
 	// trim off the leading `/` since the CheckExistenceByID / List methods don't expect it
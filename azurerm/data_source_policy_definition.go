@@ -57,7 +57,7 @@ func dataSourceArmPolicyDefinition() *schema.Resource {
 
 func dataSourceArmPolicyDefinitionRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).policyDefinitionsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx := azure.CorrelatedContext(meta.(*ArmClient).StopContext, d)
 
 	name := d.Get("display_name").(string)
 	managementGroupID := d.Get("management_group_id").(string)
@@ -18,7 +18,7 @@ func dataSourceArmSubscription() *schema.Resource {
 func dataSourceArmSubscriptionRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient)
 	groupClient := client.subscriptionsClient
-	ctx := client.StopContext
+	ctx := azure.CorrelatedContext(client.StopContext, d)
 
 	subscriptionId := d.Get("subscription_id").(string)
 	if subscriptionId == "" {
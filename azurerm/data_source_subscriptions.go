@@ -35,7 +35,7 @@ func dataSourceArmSubscriptions() *schema.Resource {
 func dataSourceArmSubscriptionsRead(d *schema.ResourceData, meta interface{}) error {
 	armClient := meta.(*ArmClient)
 	subClient := armClient.subscriptionsClient
-	ctx := armClient.StopContext
+	ctx := azure.CorrelatedContext(armClient.StopContext, d)
 
 	displayNamePrefix := strings.ToLower(d.Get("display_name_prefix").(string))
 	displayNameContains := strings.ToLower(d.Get("display_name_contains").(string))
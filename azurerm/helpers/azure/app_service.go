@@ -1,14 +1,19 @@
 package azure
 
 import (
+	"fmt"
 	"log"
 	"net"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2018-02-01/web"
+	"github.com/Azure/go-autorest/autorest/date"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -468,3 +473,926 @@ func FlattenAppServiceSiteConfig(input *web.SiteConfig) []interface{} {
 
 	return append(results, result)
 }
+
+func SchemaAppServiceAuthSettings() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Computed: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"enabled": {
+					Type:     schema.TypeBool,
+					Required: true,
+				},
+
+				"additional_login_params": {
+					Type:     schema.TypeMap,
+					Optional: true,
+				},
+
+				"allowed_external_redirect_urls": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+
+				"default_provider": {
+					Type:     schema.TypeString,
+					Optional: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(web.AzureActiveDirectory),
+						string(web.Facebook),
+						string(web.Google),
+						string(web.MicrosoftAccount),
+						string(web.Twitter),
+					}, false),
+				},
+
+				"issuer": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validate.URLIsHTTPOrHTTPS,
+				},
+
+				"runtime_version": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Computed: true,
+				},
+
+				"token_refresh_extension_hours": {
+					Type:     schema.TypeFloat,
+					Optional: true,
+					Default:  72,
+				},
+
+				"token_store_enabled": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+
+				"unauthenticated_client_action": {
+					Type:     schema.TypeString,
+					Optional: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(web.AllowAnonymous),
+						string(web.RedirectToLoginPage),
+					}, false),
+				},
+
+				"active_directory": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"client_id": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validate.NoEmptyStrings,
+							},
+							"client_secret": {
+								Type:      schema.TypeString,
+								Optional:  true,
+								Sensitive: true,
+							},
+							"allowed_audiences": {
+								Type:     schema.TypeList,
+								Optional: true,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+						},
+					},
+				},
+
+				"facebook": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"app_id": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validate.NoEmptyStrings,
+							},
+							"app_secret": {
+								Type:         schema.TypeString,
+								Required:     true,
+								Sensitive:    true,
+								ValidateFunc: validate.NoEmptyStrings,
+							},
+							"oauth_scopes": {
+								Type:     schema.TypeList,
+								Optional: true,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+						},
+					},
+				},
+
+				"google": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"client_id": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validate.NoEmptyStrings,
+							},
+							"client_secret": {
+								Type:         schema.TypeString,
+								Required:     true,
+								Sensitive:    true,
+								ValidateFunc: validate.NoEmptyStrings,
+							},
+							"oauth_scopes": {
+								Type:     schema.TypeList,
+								Optional: true,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+						},
+					},
+				},
+
+				"microsoft": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"client_id": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validate.NoEmptyStrings,
+							},
+							"client_secret": {
+								Type:         schema.TypeString,
+								Required:     true,
+								Sensitive:    true,
+								ValidateFunc: validate.NoEmptyStrings,
+							},
+							"oauth_scopes": {
+								Type:     schema.TypeList,
+								Optional: true,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+						},
+					},
+				},
+
+				"twitter": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"consumer_key": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validate.NoEmptyStrings,
+							},
+							"consumer_secret": {
+								Type:         schema.TypeString,
+								Required:     true,
+								Sensitive:    true,
+								ValidateFunc: validate.NoEmptyStrings,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func SchemaAppServiceBackup() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+
+				"enabled": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  true,
+				},
+
+				"storage_account_url": {
+					Type:         schema.TypeString,
+					Required:     true,
+					Sensitive:    true,
+					ValidateFunc: validate.URLIsHTTPS,
+				},
+
+				"schedule": {
+					Type:     schema.TypeList,
+					Required: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"frequency_interval": {
+								Type:         schema.TypeInt,
+								Required:     true,
+								ValidateFunc: validation.IntBetween(1, 1000),
+							},
+
+							"frequency_unit": {
+								Type:     schema.TypeString,
+								Required: true,
+								ValidateFunc: validation.StringInSlice([]string{
+									string(web.Day),
+									string(web.Hour),
+								}, false),
+							},
+
+							"keep_at_least_one_backup": {
+								Type:     schema.TypeBool,
+								Optional: true,
+								Default:  false,
+							},
+
+							"retention_period_in_days": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Default:      30,
+								ValidateFunc: validation.IntBetween(0, 9999999),
+							},
+
+							"start_time": {
+								Type:             schema.TypeString,
+								Optional:         true,
+								Computed:         true,
+								DiffSuppressFunc: suppress.RFC3339Time,
+								ValidateFunc:     validate.RFC3339Time,
+							},
+
+							"last_execution_time": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func SchemaAppServiceLogsConfig() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Computed: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"application_logs": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Computed: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"azure_blob_storage": {
+								Type:     schema.TypeList,
+								Optional: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"level": {
+											Type:     schema.TypeString,
+											Required: true,
+											ValidateFunc: validation.StringInSlice([]string{
+												string(web.Error),
+												string(web.Information),
+												string(web.Off),
+												string(web.Verbose),
+												string(web.Warning),
+											}, false),
+										},
+										"sas_url": {
+											Type:         schema.TypeString,
+											Required:     true,
+											Sensitive:    true,
+											ValidateFunc: validate.URLIsHTTPS,
+										},
+										"retention_in_days": {
+											Type:         schema.TypeInt,
+											Required:     true,
+											ValidateFunc: validation.IntAtLeast(0),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+
+				"http_logs": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Computed: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"file_system": {
+								Type:          schema.TypeList,
+								Optional:      true,
+								MaxItems:      1,
+								ConflictsWith: []string{"logs.0.http_logs.0.azure_blob_storage"},
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"retention_in_mb": {
+											Type:         schema.TypeInt,
+											Required:     true,
+											ValidateFunc: validation.IntBetween(25, 100),
+										},
+										"retention_in_days": {
+											Type:         schema.TypeInt,
+											Required:     true,
+											ValidateFunc: validation.IntAtLeast(0),
+										},
+									},
+								},
+							},
+							"azure_blob_storage": {
+								Type:          schema.TypeList,
+								Optional:      true,
+								MaxItems:      1,
+								ConflictsWith: []string{"logs.0.http_logs.0.file_system"},
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"sas_url": {
+											Type:         schema.TypeString,
+											Required:     true,
+											Sensitive:    true,
+											ValidateFunc: validate.URLIsHTTPS,
+										},
+										"retention_in_days": {
+											Type:         schema.TypeInt,
+											Required:     true,
+											ValidateFunc: validation.IntAtLeast(0),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func SchemaAppServiceStorageAccounts() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+
+				"type": {
+					Type:     schema.TypeString,
+					Required: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(web.AzureBlob),
+						string(web.AzureFiles),
+					}, false),
+				},
+
+				"account_name": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+
+				"share_name": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+
+				"access_key": {
+					Type:         schema.TypeString,
+					Required:     true,
+					Sensitive:    true,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+
+				"mount_path": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+			},
+		},
+	}
+}
+
+func ExpandAppServiceAuthSettings(input []interface{}) web.SiteAuthSettingsProperties {
+	siteAuthSettings := web.SiteAuthSettingsProperties{}
+
+	if len(input) == 0 || input[0] == nil {
+		// removing the block disables authentication
+		siteAuthSettings.Enabled = utils.Bool(false)
+		return siteAuthSettings
+	}
+
+	setting := input[0].(map[string]interface{})
+
+	if v, ok := setting["enabled"]; ok {
+		siteAuthSettings.Enabled = utils.Bool(v.(bool))
+	}
+
+	if v, ok := setting["additional_login_params"]; ok {
+		input := v.(map[string]interface{})
+
+		// the API expects these as a list of `key=value` strings
+		additionalLoginParams := make([]string, 0)
+		for k, v := range input {
+			additionalLoginParams = append(additionalLoginParams, fmt.Sprintf("%s=%s", k, v.(string)))
+		}
+		sort.Strings(additionalLoginParams)
+
+		siteAuthSettings.AdditionalLoginParams = &additionalLoginParams
+	}
+
+	if v, ok := setting["allowed_external_redirect_urls"]; ok {
+		siteAuthSettings.AllowedExternalRedirectUrls = utils.ExpandStringArray(v.([]interface{}))
+	}
+
+	if v, ok := setting["default_provider"]; ok {
+		siteAuthSettings.DefaultProvider = web.BuiltInAuthenticationProvider(v.(string))
+	}
+
+	if v, ok := setting["issuer"]; ok && v.(string) != "" {
+		siteAuthSettings.Issuer = utils.String(v.(string))
+	}
+
+	if v, ok := setting["runtime_version"]; ok && v.(string) != "" {
+		siteAuthSettings.RuntimeVersion = utils.String(v.(string))
+	}
+
+	if v, ok := setting["token_refresh_extension_hours"]; ok {
+		siteAuthSettings.TokenRefreshExtensionHours = utils.Float(v.(float64))
+	}
+
+	if v, ok := setting["token_store_enabled"]; ok {
+		siteAuthSettings.TokenStoreEnabled = utils.Bool(v.(bool))
+	}
+
+	if v, ok := setting["unauthenticated_client_action"]; ok {
+		siteAuthSettings.UnauthenticatedClientAction = web.UnauthenticatedClientAction(v.(string))
+	}
+
+	if v, ok := setting["active_directory"]; ok {
+		if activeDirectories := v.([]interface{}); len(activeDirectories) > 0 && activeDirectories[0] != nil {
+			activeDirectory := activeDirectories[0].(map[string]interface{})
+			siteAuthSettings.ClientID = utils.String(activeDirectory["client_id"].(string))
+			if secret := activeDirectory["client_secret"].(string); secret != "" {
+				siteAuthSettings.ClientSecret = utils.String(secret)
+			}
+			siteAuthSettings.AllowedAudiences = utils.ExpandStringArray(activeDirectory["allowed_audiences"].([]interface{}))
+		}
+	}
+
+	if v, ok := setting["facebook"]; ok {
+		if facebooks := v.([]interface{}); len(facebooks) > 0 && facebooks[0] != nil {
+			facebook := facebooks[0].(map[string]interface{})
+			siteAuthSettings.FacebookAppID = utils.String(facebook["app_id"].(string))
+			siteAuthSettings.FacebookAppSecret = utils.String(facebook["app_secret"].(string))
+			siteAuthSettings.FacebookOAuthScopes = utils.ExpandStringArray(facebook["oauth_scopes"].([]interface{}))
+		}
+	}
+
+	if v, ok := setting["google"]; ok {
+		if googles := v.([]interface{}); len(googles) > 0 && googles[0] != nil {
+			google := googles[0].(map[string]interface{})
+			siteAuthSettings.GoogleClientID = utils.String(google["client_id"].(string))
+			siteAuthSettings.GoogleClientSecret = utils.String(google["client_secret"].(string))
+			siteAuthSettings.GoogleOAuthScopes = utils.ExpandStringArray(google["oauth_scopes"].([]interface{}))
+		}
+	}
+
+	if v, ok := setting["microsoft"]; ok {
+		if microsofts := v.([]interface{}); len(microsofts) > 0 && microsofts[0] != nil {
+			microsoft := microsofts[0].(map[string]interface{})
+			siteAuthSettings.MicrosoftAccountClientID = utils.String(microsoft["client_id"].(string))
+			siteAuthSettings.MicrosoftAccountClientSecret = utils.String(microsoft["client_secret"].(string))
+			siteAuthSettings.MicrosoftAccountOAuthScopes = utils.ExpandStringArray(microsoft["oauth_scopes"].([]interface{}))
+		}
+	}
+
+	if v, ok := setting["twitter"]; ok {
+		if twitters := v.([]interface{}); len(twitters) > 0 && twitters[0] != nil {
+			twitter := twitters[0].(map[string]interface{})
+			siteAuthSettings.TwitterConsumerKey = utils.String(twitter["consumer_key"].(string))
+			siteAuthSettings.TwitterConsumerSecret = utils.String(twitter["consumer_secret"].(string))
+		}
+	}
+
+	return siteAuthSettings
+}
+
+func FlattenAppServiceAuthSettings(input *web.SiteAuthSettingsProperties) []interface{} {
+	results := make([]interface{}, 0)
+	result := make(map[string]interface{})
+
+	if input == nil {
+		log.Printf("[DEBUG] SiteAuthSettingsProperties is nil")
+		return results
+	}
+
+	if input.Enabled != nil {
+		result["enabled"] = *input.Enabled
+	}
+
+	additionalLoginParams := make(map[string]interface{})
+	if params := input.AdditionalLoginParams; params != nil {
+		for _, param := range *params {
+			parts := strings.SplitN(param, "=", 2)
+			if len(parts) != 2 {
+				continue
+			}
+			additionalLoginParams[parts[0]] = parts[1]
+		}
+	}
+	result["additional_login_params"] = additionalLoginParams
+
+	result["allowed_external_redirect_urls"] = utils.FlattenStringArray(input.AllowedExternalRedirectUrls)
+	result["default_provider"] = string(input.DefaultProvider)
+
+	if input.Issuer != nil {
+		result["issuer"] = *input.Issuer
+	}
+
+	if input.RuntimeVersion != nil {
+		result["runtime_version"] = *input.RuntimeVersion
+	}
+
+	if input.TokenRefreshExtensionHours != nil {
+		result["token_refresh_extension_hours"] = *input.TokenRefreshExtensionHours
+	}
+
+	if input.TokenStoreEnabled != nil {
+		result["token_store_enabled"] = *input.TokenStoreEnabled
+	}
+
+	result["unauthenticated_client_action"] = string(input.UnauthenticatedClientAction)
+
+	activeDirectories := make([]interface{}, 0)
+	if input.ClientID != nil {
+		activeDirectory := map[string]interface{}{
+			"client_id":         *input.ClientID,
+			"allowed_audiences": utils.FlattenStringArray(input.AllowedAudiences),
+		}
+		if input.ClientSecret != nil {
+			activeDirectory["client_secret"] = *input.ClientSecret
+		}
+		activeDirectories = append(activeDirectories, activeDirectory)
+	}
+	result["active_directory"] = activeDirectories
+
+	facebooks := make([]interface{}, 0)
+	if input.FacebookAppID != nil {
+		facebook := map[string]interface{}{
+			"app_id":       *input.FacebookAppID,
+			"oauth_scopes": utils.FlattenStringArray(input.FacebookOAuthScopes),
+		}
+		if input.FacebookAppSecret != nil {
+			facebook["app_secret"] = *input.FacebookAppSecret
+		}
+		facebooks = append(facebooks, facebook)
+	}
+	result["facebook"] = facebooks
+
+	googles := make([]interface{}, 0)
+	if input.GoogleClientID != nil {
+		google := map[string]interface{}{
+			"client_id":    *input.GoogleClientID,
+			"oauth_scopes": utils.FlattenStringArray(input.GoogleOAuthScopes),
+		}
+		if input.GoogleClientSecret != nil {
+			google["client_secret"] = *input.GoogleClientSecret
+		}
+		googles = append(googles, google)
+	}
+	result["google"] = googles
+
+	microsofts := make([]interface{}, 0)
+	if input.MicrosoftAccountClientID != nil {
+		microsoft := map[string]interface{}{
+			"client_id":    *input.MicrosoftAccountClientID,
+			"oauth_scopes": utils.FlattenStringArray(input.MicrosoftAccountOAuthScopes),
+		}
+		if input.MicrosoftAccountClientSecret != nil {
+			microsoft["client_secret"] = *input.MicrosoftAccountClientSecret
+		}
+		microsofts = append(microsofts, microsoft)
+	}
+	result["microsoft"] = microsofts
+
+	twitters := make([]interface{}, 0)
+	if input.TwitterConsumerKey != nil {
+		twitter := map[string]interface{}{
+			"consumer_key": *input.TwitterConsumerKey,
+		}
+		if input.TwitterConsumerSecret != nil {
+			twitter["consumer_secret"] = *input.TwitterConsumerSecret
+		}
+		twitters = append(twitters, twitter)
+	}
+	result["twitter"] = twitters
+
+	return append(results, result)
+}
+
+// ExpandAppServiceBackup returns the Backup Configuration, or nil when there's no `backup` block - in which case
+// any existing Backup Configuration should be deleted
+func ExpandAppServiceBackup(input []interface{}) (*web.BackupRequest, error) {
+	if len(input) == 0 || input[0] == nil {
+		return nil, nil
+	}
+
+	backup := input[0].(map[string]interface{})
+
+	schedules := backup["schedule"].([]interface{})
+	schedule := schedules[0].(map[string]interface{})
+
+	backupSchedule := web.BackupSchedule{
+		FrequencyInterval:     utils.Int32(int32(schedule["frequency_interval"].(int))),
+		FrequencyUnit:         web.FrequencyUnit(schedule["frequency_unit"].(string)),
+		KeepAtLeastOneBackup:  utils.Bool(schedule["keep_at_least_one_backup"].(bool)),
+		RetentionPeriodInDays: utils.Int32(int32(schedule["retention_period_in_days"].(int))),
+	}
+
+	if v := schedule["start_time"].(string); v != "" {
+		startTime, err := date.ParseTime(time.RFC3339, v)
+		if err != nil {
+			return nil, fmt.Errorf("Error parsing `start_time` %q: %+v", v, err)
+		}
+		backupSchedule.StartTime = &date.Time{Time: startTime}
+	}
+
+	return &web.BackupRequest{
+		BackupRequestProperties: &web.BackupRequestProperties{
+			BackupName:        utils.String(backup["name"].(string)),
+			Enabled:           utils.Bool(backup["enabled"].(bool)),
+			StorageAccountURL: utils.String(backup["storage_account_url"].(string)),
+			BackupSchedule:    &backupSchedule,
+		},
+	}, nil
+}
+
+func FlattenAppServiceBackup(input *web.BackupRequestProperties) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		log.Printf("[DEBUG] BackupRequestProperties is nil")
+		return results
+	}
+
+	result := make(map[string]interface{})
+
+	if input.BackupName != nil {
+		result["name"] = *input.BackupName
+	}
+
+	if input.Enabled != nil {
+		result["enabled"] = *input.Enabled
+	}
+
+	if input.StorageAccountURL != nil {
+		result["storage_account_url"] = *input.StorageAccountURL
+	}
+
+	schedules := make([]interface{}, 0)
+	if schedule := input.BackupSchedule; schedule != nil {
+		output := make(map[string]interface{})
+
+		if schedule.FrequencyInterval != nil {
+			output["frequency_interval"] = int(*schedule.FrequencyInterval)
+		}
+
+		output["frequency_unit"] = string(schedule.FrequencyUnit)
+
+		if schedule.KeepAtLeastOneBackup != nil {
+			output["keep_at_least_one_backup"] = *schedule.KeepAtLeastOneBackup
+		}
+
+		if schedule.RetentionPeriodInDays != nil {
+			output["retention_period_in_days"] = int(*schedule.RetentionPeriodInDays)
+		}
+
+		if schedule.StartTime != nil && !schedule.StartTime.IsZero() {
+			output["start_time"] = schedule.StartTime.Format(time.RFC3339)
+		}
+
+		if schedule.LastExecutionTime != nil && !schedule.LastExecutionTime.IsZero() {
+			output["last_execution_time"] = schedule.LastExecutionTime.Format(time.RFC3339)
+		}
+
+		schedules = append(schedules, output)
+	}
+	result["schedule"] = schedules
+
+	return append(results, result)
+}
+
+func ExpandAppServiceLogs(input []interface{}) web.SiteLogsConfigProperties {
+	// logging which isn't specified is explicitly disabled, so that removing it from the configuration turns it off
+	siteLogsConfig := web.SiteLogsConfigProperties{
+		ApplicationLogs: &web.ApplicationLogsConfig{
+			AzureBlobStorage: &web.AzureBlobStorageApplicationLogsConfig{
+				Level: web.Off,
+			},
+		},
+		HTTPLogs: &web.HTTPLogsConfig{
+			FileSystem: &web.FileSystemHTTPLogsConfig{
+				Enabled: utils.Bool(false),
+			},
+			AzureBlobStorage: &web.AzureBlobStorageHTTPLogsConfig{
+				Enabled: utils.Bool(false),
+			},
+		},
+	}
+
+	if len(input) == 0 || input[0] == nil {
+		return siteLogsConfig
+	}
+
+	config := input[0].(map[string]interface{})
+
+	if v, ok := config["application_logs"]; ok {
+		if applicationLogs := v.([]interface{}); len(applicationLogs) > 0 && applicationLogs[0] != nil {
+			applicationLog := applicationLogs[0].(map[string]interface{})
+
+			if blobStorages := applicationLog["azure_blob_storage"].([]interface{}); len(blobStorages) > 0 && blobStorages[0] != nil {
+				blobStorage := blobStorages[0].(map[string]interface{})
+				siteLogsConfig.ApplicationLogs.AzureBlobStorage = &web.AzureBlobStorageApplicationLogsConfig{
+					Level:           web.LogLevel(blobStorage["level"].(string)),
+					SasURL:          utils.String(blobStorage["sas_url"].(string)),
+					RetentionInDays: utils.Int32(int32(blobStorage["retention_in_days"].(int))),
+				}
+			}
+		}
+	}
+
+	if v, ok := config["http_logs"]; ok {
+		if httpLogs := v.([]interface{}); len(httpLogs) > 0 && httpLogs[0] != nil {
+			httpLog := httpLogs[0].(map[string]interface{})
+
+			if fileSystems := httpLog["file_system"].([]interface{}); len(fileSystems) > 0 && fileSystems[0] != nil {
+				fileSystem := fileSystems[0].(map[string]interface{})
+				siteLogsConfig.HTTPLogs.FileSystem = &web.FileSystemHTTPLogsConfig{
+					Enabled:         utils.Bool(true),
+					RetentionInMb:   utils.Int32(int32(fileSystem["retention_in_mb"].(int))),
+					RetentionInDays: utils.Int32(int32(fileSystem["retention_in_days"].(int))),
+				}
+			}
+
+			if blobStorages := httpLog["azure_blob_storage"].([]interface{}); len(blobStorages) > 0 && blobStorages[0] != nil {
+				blobStorage := blobStorages[0].(map[string]interface{})
+				siteLogsConfig.HTTPLogs.AzureBlobStorage = &web.AzureBlobStorageHTTPLogsConfig{
+					Enabled:         utils.Bool(true),
+					SasURL:          utils.String(blobStorage["sas_url"].(string)),
+					RetentionInDays: utils.Int32(int32(blobStorage["retention_in_days"].(int))),
+				}
+			}
+		}
+	}
+
+	return siteLogsConfig
+}
+
+func FlattenAppServiceLogs(input *web.SiteLogsConfigProperties) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		log.Printf("[DEBUG] SiteLogsConfigProperties is nil")
+		return results
+	}
+
+	result := make(map[string]interface{})
+
+	applicationLogs := make([]interface{}, 0)
+	if appLogs := input.ApplicationLogs; appLogs != nil {
+		blobStorages := make([]interface{}, 0)
+		if blobStorage := appLogs.AzureBlobStorage; blobStorage != nil && blobStorage.Level != web.Off && blobStorage.SasURL != nil {
+			output := map[string]interface{}{
+				"level":   string(blobStorage.Level),
+				"sas_url": *blobStorage.SasURL,
+			}
+			if blobStorage.RetentionInDays != nil {
+				output["retention_in_days"] = int(*blobStorage.RetentionInDays)
+			}
+			blobStorages = append(blobStorages, output)
+		}
+
+		applicationLogs = append(applicationLogs, map[string]interface{}{
+			"azure_blob_storage": blobStorages,
+		})
+	}
+	result["application_logs"] = applicationLogs
+
+	httpLogs := make([]interface{}, 0)
+	if logs := input.HTTPLogs; logs != nil {
+		fileSystems := make([]interface{}, 0)
+		if fileSystem := logs.FileSystem; fileSystem != nil && fileSystem.Enabled != nil && *fileSystem.Enabled {
+			output := make(map[string]interface{})
+			if fileSystem.RetentionInMb != nil {
+				output["retention_in_mb"] = int(*fileSystem.RetentionInMb)
+			}
+			if fileSystem.RetentionInDays != nil {
+				output["retention_in_days"] = int(*fileSystem.RetentionInDays)
+			}
+			fileSystems = append(fileSystems, output)
+		}
+
+		blobStorages := make([]interface{}, 0)
+		if blobStorage := logs.AzureBlobStorage; blobStorage != nil && blobStorage.Enabled != nil && *blobStorage.Enabled && blobStorage.SasURL != nil {
+			output := map[string]interface{}{
+				"sas_url": *blobStorage.SasURL,
+			}
+			if blobStorage.RetentionInDays != nil {
+				output["retention_in_days"] = int(*blobStorage.RetentionInDays)
+			}
+			blobStorages = append(blobStorages, output)
+		}
+
+		httpLogs = append(httpLogs, map[string]interface{}{
+			"file_system":        fileSystems,
+			"azure_blob_storage": blobStorages,
+		})
+	}
+	result["http_logs"] = httpLogs
+
+	return append(results, result)
+}
+
+func ExpandAppServiceStorageAccounts(input []interface{}) map[string]*web.AzureStorageInfoValue {
+	output := make(map[string]*web.AzureStorageInfoValue, len(input))
+
+	for _, v := range input {
+		vals := v.(map[string]interface{})
+
+		storageAccount := &web.AzureStorageInfoValue{
+			Type:        web.AzureStorageType(vals["type"].(string)),
+			AccountName: utils.String(vals["account_name"].(string)),
+			ShareName:   utils.String(vals["share_name"].(string)),
+			AccessKey:   utils.String(vals["access_key"].(string)),
+		}
+
+		if mountPath := vals["mount_path"].(string); mountPath != "" {
+			storageAccount.MountPath = utils.String(mountPath)
+		}
+
+		output[vals["name"].(string)] = storageAccount
+	}
+
+	return output
+}
+
+func FlattenAppServiceStorageAccounts(input map[string]*web.AzureStorageInfoValue) []interface{} {
+	results := make([]interface{}, 0)
+
+	for k, v := range input {
+		if v == nil {
+			continue
+		}
+
+		result := make(map[string]interface{})
+		result["name"] = k
+		result["type"] = string(v.Type)
+		if v.AccountName != nil {
+			result["account_name"] = *v.AccountName
+		}
+		if v.ShareName != nil {
+			result["share_name"] = *v.ShareName
+		}
+		if v.AccessKey != nil {
+			result["access_key"] = *v.AccessKey
+		}
+		if v.MountPath != nil {
+			result["mount_path"] = *v.MountPath
+		}
+		results = append(results, result)
+	}
+
+	return results
+}
@@ -0,0 +1,482 @@
+package azure
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2018-02-01/web"
+)
+
+func TestAppServiceAuthSettingsRoundTrip(t *testing.T) {
+	empty := map[string]interface{}{
+		"enabled":                        false,
+		"additional_login_params":        map[string]interface{}{},
+		"allowed_external_redirect_urls": []interface{}{},
+		"default_provider":               "",
+		"token_refresh_extension_hours":  float64(72),
+		"token_store_enabled":            false,
+		"unauthenticated_client_action":  "",
+		"active_directory":               []interface{}{},
+		"facebook":                       []interface{}{},
+		"google":                         []interface{}{},
+		"microsoft":                      []interface{}{},
+		"twitter":                        []interface{}{},
+	}
+
+	cases := []struct {
+		Name     string
+		Input    []interface{}
+		Expected []interface{}
+	}{
+		{
+			Name:  "Disabled",
+			Input: []interface{}{empty},
+		},
+		{
+			Name:  "Removed",
+			Input: []interface{}{},
+			Expected: []interface{}{
+				map[string]interface{}{
+					"enabled":                        false,
+					"additional_login_params":        map[string]interface{}{},
+					"allowed_external_redirect_urls": []interface{}{},
+					"default_provider":               "",
+					"unauthenticated_client_action":  "",
+					"active_directory":               []interface{}{},
+					"facebook":                       []interface{}{},
+					"google":                         []interface{}{},
+					"microsoft":                      []interface{}{},
+					"twitter":                        []interface{}{},
+				},
+			},
+		},
+		{
+			Name: "Active Directory",
+			Input: []interface{}{
+				map[string]interface{}{
+					"enabled": true,
+					"additional_login_params": map[string]interface{}{
+						"response_type": "code id_token",
+						"resource":      "https://example.com=1",
+					},
+					"allowed_external_redirect_urls": []interface{}{"https://example.com/redirect"},
+					"default_provider":               string(web.AzureActiveDirectory),
+					"issuer":                         "https://sts.windows.net/00000000-0000-0000-0000-000000000000",
+					"runtime_version":                "1.0",
+					"token_refresh_extension_hours":  float64(24),
+					"token_store_enabled":            true,
+					"unauthenticated_client_action":  string(web.RedirectToLoginPage),
+					"active_directory": []interface{}{
+						map[string]interface{}{
+							"client_id":         "00000000-0000-0000-0000-000000000001",
+							"client_secret":     "secret",
+							"allowed_audiences": []interface{}{"https://example.com"},
+						},
+					},
+					"facebook":  []interface{}{},
+					"google":    []interface{}{},
+					"microsoft": []interface{}{},
+					"twitter":   []interface{}{},
+				},
+			},
+		},
+		{
+			Name: "Active Directory without a Client Secret",
+			Input: []interface{}{
+				map[string]interface{}{
+					"enabled":                        true,
+					"additional_login_params":        map[string]interface{}{},
+					"allowed_external_redirect_urls": []interface{}{},
+					"default_provider":               string(web.AzureActiveDirectory),
+					"token_refresh_extension_hours":  float64(72),
+					"token_store_enabled":            false,
+					"unauthenticated_client_action":  string(web.AllowAnonymous),
+					"active_directory": []interface{}{
+						map[string]interface{}{
+							"client_id":         "00000000-0000-0000-0000-000000000001",
+							"client_secret":     "",
+							"allowed_audiences": []interface{}{},
+						},
+					},
+					"facebook":  []interface{}{},
+					"google":    []interface{}{},
+					"microsoft": []interface{}{},
+					"twitter":   []interface{}{},
+				},
+			},
+			Expected: []interface{}{
+				map[string]interface{}{
+					"enabled":                        true,
+					"additional_login_params":        map[string]interface{}{},
+					"allowed_external_redirect_urls": []interface{}{},
+					"default_provider":               string(web.AzureActiveDirectory),
+					"token_refresh_extension_hours":  float64(72),
+					"token_store_enabled":            false,
+					"unauthenticated_client_action":  string(web.AllowAnonymous),
+					"active_directory": []interface{}{
+						map[string]interface{}{
+							"client_id":         "00000000-0000-0000-0000-000000000001",
+							"allowed_audiences": []interface{}{},
+						},
+					},
+					"facebook":  []interface{}{},
+					"google":    []interface{}{},
+					"microsoft": []interface{}{},
+					"twitter":   []interface{}{},
+				},
+			},
+		},
+		{
+			Name: "Social Providers",
+			Input: []interface{}{
+				map[string]interface{}{
+					"enabled":                        true,
+					"additional_login_params":        map[string]interface{}{},
+					"allowed_external_redirect_urls": []interface{}{},
+					"default_provider":               string(web.Google),
+					"token_refresh_extension_hours":  float64(72),
+					"token_store_enabled":            false,
+					"unauthenticated_client_action":  string(web.RedirectToLoginPage),
+					"active_directory":               []interface{}{},
+					"facebook": []interface{}{
+						map[string]interface{}{
+							"app_id":       "facebook-app",
+							"app_secret":   "facebook-secret",
+							"oauth_scopes": []interface{}{"email"},
+						},
+					},
+					"google": []interface{}{
+						map[string]interface{}{
+							"client_id":     "google-client",
+							"client_secret": "google-secret",
+							"oauth_scopes":  []interface{}{"openid", "profile"},
+						},
+					},
+					"microsoft": []interface{}{
+						map[string]interface{}{
+							"client_id":     "microsoft-client",
+							"client_secret": "microsoft-secret",
+							"oauth_scopes":  []interface{}{"wl.basic"},
+						},
+					},
+					"twitter": []interface{}{
+						map[string]interface{}{
+							"consumer_key":    "twitter-key",
+							"consumer_secret": "twitter-secret",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, v := range cases {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		expanded := ExpandAppServiceAuthSettings(v.Input)
+		actual := FlattenAppServiceAuthSettings(&expanded)
+
+		expected := v.Expected
+		if expected == nil {
+			expected = v.Input
+		}
+		if !reflect.DeepEqual(expected, actual) {
+			t.Fatalf("Expected %+v but got %+v", expected, actual)
+		}
+	}
+}
+
+func TestAppServiceBackupRoundTrip(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Input    []interface{}
+		Expected []interface{}
+		Error    bool
+	}{
+		{
+			Name:     "None",
+			Input:    []interface{}{},
+			Expected: []interface{}{},
+		},
+		{
+			Name: "With a Start Time",
+			Input: []interface{}{
+				map[string]interface{}{
+					"name":                "backup1",
+					"enabled":             true,
+					"storage_account_url": "https://example.blob.core.windows.net/backups?sv=2018-03-28",
+					"schedule": []interface{}{
+						map[string]interface{}{
+							"frequency_interval":       7,
+							"frequency_unit":           string(web.Day),
+							"keep_at_least_one_backup": true,
+							"retention_period_in_days": 30,
+							"start_time":               "2019-04-20T10:00:00Z",
+						},
+					},
+				},
+			},
+		},
+		{
+			Name: "Without a Start Time",
+			Input: []interface{}{
+				map[string]interface{}{
+					"name":                "backup1",
+					"enabled":             false,
+					"storage_account_url": "https://example.blob.core.windows.net/backups?sv=2018-03-28",
+					"schedule": []interface{}{
+						map[string]interface{}{
+							"frequency_interval":       12,
+							"frequency_unit":           string(web.Hour),
+							"keep_at_least_one_backup": false,
+							"retention_period_in_days": 0,
+							"start_time":               "",
+						},
+					},
+				},
+			},
+			Expected: []interface{}{
+				map[string]interface{}{
+					"name":                "backup1",
+					"enabled":             false,
+					"storage_account_url": "https://example.blob.core.windows.net/backups?sv=2018-03-28",
+					"schedule": []interface{}{
+						map[string]interface{}{
+							"frequency_interval":       12,
+							"frequency_unit":           string(web.Hour),
+							"keep_at_least_one_backup": false,
+							"retention_period_in_days": 0,
+						},
+					},
+				},
+			},
+		},
+		{
+			Name: "Invalid Start Time",
+			Input: []interface{}{
+				map[string]interface{}{
+					"name":                "backup1",
+					"enabled":             true,
+					"storage_account_url": "https://example.blob.core.windows.net/backups?sv=2018-03-28",
+					"schedule": []interface{}{
+						map[string]interface{}{
+							"frequency_interval":       1,
+							"frequency_unit":           string(web.Day),
+							"keep_at_least_one_backup": false,
+							"retention_period_in_days": 30,
+							"start_time":               "20th April",
+						},
+					},
+				},
+			},
+			Error: true,
+		},
+	}
+
+	for _, v := range cases {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		expanded, err := ExpandAppServiceBackup(v.Input)
+		if v.Error {
+			if err == nil {
+				t.Fatalf("Expected an error but didn't get one")
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+
+		var properties *web.BackupRequestProperties
+		if expanded != nil {
+			properties = expanded.BackupRequestProperties
+		}
+		actual := FlattenAppServiceBackup(properties)
+
+		expected := v.Expected
+		if expected == nil {
+			expected = v.Input
+		}
+		if !reflect.DeepEqual(expected, actual) {
+			t.Fatalf("Expected %+v but got %+v", expected, actual)
+		}
+	}
+}
+
+func TestAppServiceLogsRoundTrip(t *testing.T) {
+	disabled := []interface{}{
+		map[string]interface{}{
+			"application_logs": []interface{}{
+				map[string]interface{}{
+					"azure_blob_storage": []interface{}{},
+				},
+			},
+			"http_logs": []interface{}{
+				map[string]interface{}{
+					"file_system":        []interface{}{},
+					"azure_blob_storage": []interface{}{},
+				},
+			},
+		},
+	}
+
+	cases := []struct {
+		Name     string
+		Input    []interface{}
+		Expected []interface{}
+	}{
+		{
+			Name:     "None",
+			Input:    []interface{}{},
+			Expected: disabled,
+		},
+		{
+			Name:  "Disabled",
+			Input: disabled,
+		},
+		{
+			Name: "Application Logs and File System HTTP Logs",
+			Input: []interface{}{
+				map[string]interface{}{
+					"application_logs": []interface{}{
+						map[string]interface{}{
+							"azure_blob_storage": []interface{}{
+								map[string]interface{}{
+									"level":             string(web.Warning),
+									"sas_url":           "https://example.blob.core.windows.net/application?sv=2018-03-28",
+									"retention_in_days": 7,
+								},
+							},
+						},
+					},
+					"http_logs": []interface{}{
+						map[string]interface{}{
+							"file_system": []interface{}{
+								map[string]interface{}{
+									"retention_in_mb":   35,
+									"retention_in_days": 14,
+								},
+							},
+							"azure_blob_storage": []interface{}{},
+						},
+					},
+				},
+			},
+		},
+		{
+			Name: "Blob Storage HTTP Logs",
+			Input: []interface{}{
+				map[string]interface{}{
+					"application_logs": []interface{}{
+						map[string]interface{}{
+							"azure_blob_storage": []interface{}{},
+						},
+					},
+					"http_logs": []interface{}{
+						map[string]interface{}{
+							"file_system": []interface{}{},
+							"azure_blob_storage": []interface{}{
+								map[string]interface{}{
+									"sas_url":           "https://example.blob.core.windows.net/http?sv=2018-03-28",
+									"retention_in_days": 0,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, v := range cases {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		expanded := ExpandAppServiceLogs(v.Input)
+		actual := FlattenAppServiceLogs(&expanded)
+
+		expected := v.Expected
+		if expected == nil {
+			expected = v.Input
+		}
+		if !reflect.DeepEqual(expected, actual) {
+			t.Fatalf("Expected %+v but got %+v", expected, actual)
+		}
+	}
+}
+
+func TestAppServiceStorageAccountsRoundTrip(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Input    []interface{}
+		Expected []interface{}
+	}{
+		{
+			Name:  "None",
+			Input: []interface{}{},
+		},
+		{
+			Name: "Multiple",
+			Input: []interface{}{
+				map[string]interface{}{
+					"name":         "blob",
+					"type":         string(web.AzureBlob),
+					"account_name": "account1",
+					"share_name":   "container1",
+					"access_key":   "key1",
+					"mount_path":   "/mnt/blob",
+				},
+				map[string]interface{}{
+					"name":         "files",
+					"type":         string(web.AzureFiles),
+					"account_name": "account2",
+					"share_name":   "share1",
+					"access_key":   "key2",
+					"mount_path":   "/mnt/files",
+				},
+			},
+		},
+		{
+			Name: "Without a Mount Path",
+			Input: []interface{}{
+				map[string]interface{}{
+					"name":         "files",
+					"type":         string(web.AzureFiles),
+					"account_name": "account2",
+					"share_name":   "share1",
+					"access_key":   "key2",
+					"mount_path":   "",
+				},
+			},
+			Expected: []interface{}{
+				map[string]interface{}{
+					"name":         "files",
+					"type":         string(web.AzureFiles),
+					"account_name": "account2",
+					"share_name":   "share1",
+					"access_key":   "key2",
+				},
+			},
+		},
+	}
+
+	for _, v := range cases {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		expanded := ExpandAppServiceStorageAccounts(v.Input)
+		actual := FlattenAppServiceStorageAccounts(expanded)
+
+		// the Storage Accounts are returned as a map, so the flattened order isn't stable
+		sort.Slice(actual, func(i, j int) bool {
+			return actual[i].(map[string]interface{})["name"].(string) < actual[j].(map[string]interface{})["name"].(string)
+		})
+
+		expected := v.Expected
+		if expected == nil {
+			expected = v.Input
+		}
+		if !reflect.DeepEqual(expected, actual) {
+			t.Fatalf("Expected %+v but got %+v", expected, actual)
+		}
+	}
+}
@@ -0,0 +1,467 @@
+package azure
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-08-01/network"
+	"github.com/Azure/go-autorest/autorest"
+	az "github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// applicationGatewayAPIVersion is the version of the Network API which supports Rewrite Rule Sets, a Maximum
+// Capacity for Autoscaling, Managed Identities and SSL Certificates sourced from Key Vault
+const applicationGatewayAPIVersion = "2019-04-01"
+
+// ApplicationGatewaysClient is a client for Application Gateways which supports the properties which aren't
+// available in the version of the Network SDK we're using - the request is built from the SDK model, with
+// the additional properties merged into it before it's sent
+type ApplicationGatewaysClient struct {
+	network.BaseClient
+}
+
+// NewApplicationGatewaysClient returns an ApplicationGatewaysClient built on the (configured) SDK client
+func NewApplicationGatewaysClient(client network.ApplicationGatewaysClient) ApplicationGatewaysClient {
+	return ApplicationGatewaysClient{
+		BaseClient: client.BaseClient,
+	}
+}
+
+// ApplicationGatewayExtendedProperties are the properties of an Application Gateway which aren't available
+// in the version of the Network SDK we're using
+type ApplicationGatewayExtendedProperties struct {
+	Identity             *ApplicationGatewayIdentity
+	AutoscaleMaxCapacity *int32
+	RewriteRuleSets      *[]ApplicationGatewayRewriteRuleSet
+
+	// SslCertificateKeyVaultSecretIDs are the Key Vault Secret IDs of the SSL Certificates, keyed by name
+	SslCertificateKeyVaultSecretIDs map[string]string
+
+	// RequestRoutingRuleRewriteRuleSetIDs are the Rewrite Rule Set IDs used by the Request Routing Rules, keyed by name
+	RequestRoutingRuleRewriteRuleSetIDs map[string]string
+
+	// PathRuleRewriteRuleSetIDs are the Rewrite Rule Set IDs used by the Path Rules, keyed by
+	// the name of the URL Path Map and then by the name of the Path Rule
+	PathRuleRewriteRuleSetIDs map[string]map[string]string
+}
+
+// ApplicationGatewayIdentity is the Managed Identity assigned to an Application Gateway
+type ApplicationGatewayIdentity struct {
+	Type                   string                                             `json:"type,omitempty"`
+	PrincipalID            *string                                            `json:"principalId,omitempty"`
+	TenantID               *string                                            `json:"tenantId,omitempty"`
+	UserAssignedIdentities map[string]*ApplicationGatewayUserAssignedIdentity `json:"userAssignedIdentities,omitempty"`
+}
+
+// ApplicationGatewayUserAssignedIdentity is a User Assigned Identity assigned to an Application Gateway
+type ApplicationGatewayUserAssignedIdentity struct {
+	PrincipalID *string `json:"principalId,omitempty"`
+	ClientID    *string `json:"clientId,omitempty"`
+}
+
+// ApplicationGatewayRewriteRuleSet is a set of Rewrite Rules which can be used by Request Routing Rules and Path Rules
+type ApplicationGatewayRewriteRuleSet struct {
+	ID         *string                                     `json:"id,omitempty"`
+	Name       *string                                     `json:"name,omitempty"`
+	Properties *ApplicationGatewayRewriteRuleSetProperties `json:"properties,omitempty"`
+}
+
+// ApplicationGatewayRewriteRuleSetProperties are the properties of a Rewrite Rule Set
+type ApplicationGatewayRewriteRuleSetProperties struct {
+	RewriteRules *[]ApplicationGatewayRewriteRule `json:"rewriteRules,omitempty"`
+}
+
+// ApplicationGatewayRewriteRule is a Rewrite Rule which rewrites the headers of a Request or Response
+// when all of its Conditions are met
+type ApplicationGatewayRewriteRule struct {
+	Name         *string                                   `json:"name,omitempty"`
+	RuleSequence *int32                                    `json:"ruleSequence,omitempty"`
+	Conditions   *[]ApplicationGatewayRewriteRuleCondition `json:"conditions,omitempty"`
+	ActionSet    *ApplicationGatewayRewriteRuleActionSet   `json:"actionSet,omitempty"`
+}
+
+// ApplicationGatewayRewriteRuleCondition is a Condition which must be met for a Rewrite Rule to be applied
+type ApplicationGatewayRewriteRuleCondition struct {
+	Variable   *string `json:"variable,omitempty"`
+	Pattern    *string `json:"pattern,omitempty"`
+	IgnoreCase *bool   `json:"ignoreCase,omitempty"`
+	Negate     *bool   `json:"negate,omitempty"`
+}
+
+// ApplicationGatewayRewriteRuleActionSet are the headers rewritten by a Rewrite Rule
+type ApplicationGatewayRewriteRuleActionSet struct {
+	RequestHeaderConfigurations  *[]ApplicationGatewayHeaderConfiguration `json:"requestHeaderConfigurations,omitempty"`
+	ResponseHeaderConfigurations *[]ApplicationGatewayHeaderConfiguration `json:"responseHeaderConfigurations,omitempty"`
+}
+
+// ApplicationGatewayHeaderConfiguration is the value a header is rewritten to - where an empty value removes the header
+type ApplicationGatewayHeaderConfiguration struct {
+	HeaderName  *string `json:"headerName,omitempty"`
+	HeaderValue *string `json:"headerValue"`
+}
+
+type applicationGatewayExtendedResponse struct {
+	Identity   *ApplicationGatewayIdentity                   `json:"identity,omitempty"`
+	Properties *applicationGatewayExtendedResponseProperties `json:"properties,omitempty"`
+}
+
+type applicationGatewayExtendedResponseProperties struct {
+	AutoscaleConfiguration *applicationGatewayAutoscaleConfiguration `json:"autoscaleConfiguration,omitempty"`
+	RewriteRuleSets        *[]ApplicationGatewayRewriteRuleSet       `json:"rewriteRuleSets,omitempty"`
+	SslCertificates        *[]applicationGatewayChildResource        `json:"sslCertificates,omitempty"`
+	RequestRoutingRules    *[]applicationGatewayChildResource        `json:"requestRoutingRules,omitempty"`
+	URLPathMaps            *[]applicationGatewayChildResource        `json:"urlPathMaps,omitempty"`
+}
+
+type applicationGatewayAutoscaleConfiguration struct {
+	MaxCapacity *int32 `json:"maxCapacity,omitempty"`
+}
+
+type applicationGatewayChildResource struct {
+	Name       *string                                    `json:"name,omitempty"`
+	Properties *applicationGatewayChildResourceProperties `json:"properties,omitempty"`
+}
+
+type applicationGatewayChildResourceProperties struct {
+	KeyVaultSecretID *string                            `json:"keyVaultSecretId,omitempty"`
+	RewriteRuleSet   *network.SubResource               `json:"rewriteRuleSet,omitempty"`
+	PathRules        *[]applicationGatewayChildResource `json:"pathRules,omitempty"`
+}
+
+// CreateOrUpdate creates or updates the specified Application Gateway, including its extended properties
+func (client ApplicationGatewaysClient) CreateOrUpdate(ctx context.Context, resourceGroup string, name string, gateway network.ApplicationGateway, extended ApplicationGatewayExtendedProperties) (result az.Future, err error) {
+	body, err := expandApplicationGatewayRequestBody(gateway, extended)
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "azure.ApplicationGatewaysClient", "CreateOrUpdate", nil, "Failure building request body")
+	}
+
+	req, err := client.preparer(ctx, resourceGroup, name,
+		autorest.AsPut(),
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.WithJSON(body))
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "azure.ApplicationGatewaysClient", "CreateOrUpdate", nil, "Failure preparing request")
+	}
+
+	resp, err := client.send(req)
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "azure.ApplicationGatewaysClient", "CreateOrUpdate", resp, "Failure sending request")
+	}
+
+	return az.NewFutureFromResponse(resp)
+}
+
+// Get retrieves the specified Application Gateway, along with its extended properties
+func (client ApplicationGatewaysClient) Get(ctx context.Context, resourceGroup string, name string) (result network.ApplicationGateway, extended *ApplicationGatewayExtendedProperties, err error) {
+	req, err := client.preparer(ctx, resourceGroup, name, autorest.AsGet())
+	if err != nil {
+		return result, nil, autorest.NewErrorWithError(err, "azure.ApplicationGatewaysClient", "Get", nil, "Failure preparing request")
+	}
+
+	resp, err := client.send(req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		return result, nil, autorest.NewErrorWithError(err, "azure.ApplicationGatewaysClient", "Get", resp, "Failure sending request")
+	}
+
+	var body json.RawMessage
+	err = autorest.Respond(
+		resp,
+		client.ByInspecting(),
+		az.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&body),
+		autorest.ByClosing())
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		return result, nil, autorest.NewErrorWithError(err, "azure.ApplicationGatewaysClient", "Get", resp, "Failure responding to request")
+	}
+
+	result, extended, err = parseApplicationGatewayResponseBody(body)
+	result.Response = autorest.Response{Response: resp}
+	if err != nil {
+		return result, nil, autorest.NewErrorWithError(err, "azure.ApplicationGatewaysClient", "Get", resp, "Failure parsing response body")
+	}
+
+	return result, extended, nil
+}
+
+func (client ApplicationGatewaysClient) preparer(ctx context.Context, resourceGroup string, name string, decorators ...autorest.PrepareDecorator) (*http.Request, error) {
+	pathParameters := map[string]interface{}{
+		"applicationGatewayName": autorest.Encode("path", name),
+		"resourceGroupName":      autorest.Encode("path", resourceGroup),
+		"subscriptionId":         autorest.Encode("path", client.SubscriptionID),
+	}
+
+	queryParameters := map[string]interface{}{
+		"api-version": applicationGatewayAPIVersion,
+	}
+
+	decorators = append(decorators,
+		autorest.WithBaseURL(client.BaseURI),
+		autorest.WithPathParameters("/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.Network/applicationGateways/{applicationGatewayName}", pathParameters),
+		autorest.WithQueryParameters(queryParameters))
+	return autorest.CreatePreparer(decorators...).Prepare((&http.Request{}).WithContext(ctx))
+}
+
+func (client ApplicationGatewaysClient) send(req *http.Request) (*http.Response, error) {
+	return autorest.SendWithSender(client, req)
+}
+
+// expandApplicationGatewayRequestBody merges the extended properties into the request body built from the SDK model
+func expandApplicationGatewayRequestBody(gateway network.ApplicationGateway, extended ApplicationGatewayExtendedProperties) (map[string]interface{}, error) {
+	raw, err := json.Marshal(gateway)
+	if err != nil {
+		return nil, fmt.Errorf("Error serializing Application Gateway: %+v", err)
+	}
+
+	body := make(map[string]interface{})
+	if err := json.Unmarshal(raw, &body); err != nil {
+		return nil, fmt.Errorf("Error deserializing Application Gateway: %+v", err)
+	}
+
+	if extended.Identity != nil {
+		body["identity"] = extended.Identity
+	}
+
+	properties, ok := body["properties"].(map[string]interface{})
+	if !ok {
+		properties = make(map[string]interface{})
+		body["properties"] = properties
+	}
+
+	if extended.RewriteRuleSets != nil {
+		properties["rewriteRuleSets"] = extended.RewriteRuleSets
+	}
+
+	if extended.AutoscaleMaxCapacity != nil {
+		autoscale, ok := properties["autoscaleConfiguration"].(map[string]interface{})
+		if !ok {
+			autoscale = make(map[string]interface{})
+			properties["autoscaleConfiguration"] = autoscale
+		}
+		autoscale["maxCapacity"] = *extended.AutoscaleMaxCapacity
+	}
+
+	for name, props := range applicationGatewayChildProperties(properties, "sslCertificates") {
+		if id, ok := extended.SslCertificateKeyVaultSecretIDs[name]; ok {
+			props["keyVaultSecretId"] = id
+		}
+	}
+
+	for name, props := range applicationGatewayChildProperties(properties, "requestRoutingRules") {
+		if id, ok := extended.RequestRoutingRuleRewriteRuleSetIDs[name]; ok {
+			props["rewriteRuleSet"] = map[string]interface{}{
+				"id": id,
+			}
+		}
+	}
+
+	for urlPathMapName, urlPathMapProps := range applicationGatewayChildProperties(properties, "urlPathMaps") {
+		ids := extended.PathRuleRewriteRuleSetIDs[urlPathMapName]
+		for name, props := range applicationGatewayChildProperties(urlPathMapProps, "pathRules") {
+			if id, ok := ids[name]; ok {
+				props["rewriteRuleSet"] = map[string]interface{}{
+					"id": id,
+				}
+			}
+		}
+	}
+
+	return body, nil
+}
+
+// applicationGatewayChildProperties returns the `properties` of each of the named child resources within the
+// specified key of the request body, keyed by name - creating the `properties` when they're not present
+func applicationGatewayChildProperties(parent map[string]interface{}, key string) map[string]map[string]interface{} {
+	results := make(map[string]map[string]interface{})
+
+	children, ok := parent[key].([]interface{})
+	if !ok {
+		return results
+	}
+
+	for _, raw := range children {
+		child, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		name, ok := child["name"].(string)
+		if !ok {
+			continue
+		}
+
+		props, ok := child["properties"].(map[string]interface{})
+		if !ok {
+			props = make(map[string]interface{})
+			child["properties"] = props
+		}
+
+		results[name] = props
+	}
+
+	return results
+}
+
+// parseApplicationGatewayResponseBody parses the response body into the SDK model and the extended properties
+func parseApplicationGatewayResponseBody(body []byte) (network.ApplicationGateway, *ApplicationGatewayExtendedProperties, error) {
+	var gateway network.ApplicationGateway
+	if err := json.Unmarshal(body, &gateway); err != nil {
+		return gateway, nil, fmt.Errorf("Error deserializing Application Gateway: %+v", err)
+	}
+
+	var response applicationGatewayExtendedResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return gateway, nil, fmt.Errorf("Error deserializing the extended properties of Application Gateway: %+v", err)
+	}
+
+	extended := ApplicationGatewayExtendedProperties{
+		Identity:                            response.Identity,
+		SslCertificateKeyVaultSecretIDs:     make(map[string]string),
+		RequestRoutingRuleRewriteRuleSetIDs: make(map[string]string),
+		PathRuleRewriteRuleSetIDs:           make(map[string]map[string]string),
+	}
+
+	props := response.Properties
+	if props == nil {
+		return gateway, &extended, nil
+	}
+
+	extended.RewriteRuleSets = props.RewriteRuleSets
+
+	if autoscale := props.AutoscaleConfiguration; autoscale != nil {
+		extended.AutoscaleMaxCapacity = autoscale.MaxCapacity
+	}
+
+	for name, childProps := range applicationGatewayChildResourcesByName(props.SslCertificates) {
+		if childProps.KeyVaultSecretID != nil {
+			extended.SslCertificateKeyVaultSecretIDs[name] = *childProps.KeyVaultSecretID
+		}
+	}
+
+	for name, childProps := range applicationGatewayChildResourcesByName(props.RequestRoutingRules) {
+		if ruleSet := childProps.RewriteRuleSet; ruleSet != nil && ruleSet.ID != nil {
+			extended.RequestRoutingRuleRewriteRuleSetIDs[name] = *ruleSet.ID
+		}
+	}
+
+	for urlPathMapName, urlPathMapProps := range applicationGatewayChildResourcesByName(props.URLPathMaps) {
+		ids := make(map[string]string)
+		for name, childProps := range applicationGatewayChildResourcesByName(urlPathMapProps.PathRules) {
+			if ruleSet := childProps.RewriteRuleSet; ruleSet != nil && ruleSet.ID != nil {
+				ids[name] = *ruleSet.ID
+			}
+		}
+		extended.PathRuleRewriteRuleSetIDs[urlPathMapName] = ids
+	}
+
+	return gateway, &extended, nil
+}
+
+func applicationGatewayChildResourcesByName(input *[]applicationGatewayChildResource) map[string]applicationGatewayChildResourceProperties {
+	results := make(map[string]applicationGatewayChildResourceProperties)
+	if input == nil {
+		return results
+	}
+
+	for _, v := range *input {
+		if v.Name == nil || v.Properties == nil {
+			continue
+		}
+
+		results[*v.Name] = *v.Properties
+	}
+
+	return results
+}
+
+// ApplicationGatewayCustomizeDiff validates at plan time that the features used by an Application Gateway are
+// supported by its SKU Tier, that the instance count is configured either by `capacity` or by autoscaling and that
+// the source of each SSL Certificate has been specified
+func ApplicationGatewayCustomizeDiff(d *schema.ResourceDiff, _ interface{}) error {
+	if !valuesKnown(d, "sku") {
+		return nil
+	}
+
+	skus := d.Get("sku").([]interface{})
+	if len(skus) == 0 || skus[0] == nil {
+		return nil
+	}
+
+	sku := skus[0].(map[string]interface{})
+	tier := sku["tier"].(string)
+	capacity := sku["capacity"].(int)
+	isV2 := strings.EqualFold(tier, string(network.ApplicationGatewayTierStandardV2)) || strings.EqualFold(tier, string(network.ApplicationGatewayTierWAFV2))
+
+	autoscaleEnabled := len(d.Get("autoscale_configuration").([]interface{})) > 0
+	identityEnabled := len(d.Get("identity").([]interface{})) > 0
+
+	if isV2 {
+		if autoscaleEnabled && capacity > 0 {
+			return fmt.Errorf("`capacity` cannot be specified within the `sku` block when `autoscale_configuration` is specified")
+		}
+
+		if !autoscaleEnabled && capacity == 0 {
+			return fmt.Errorf("One of `capacity` within the `sku` block or `autoscale_configuration` must be specified for the %q tier", tier)
+		}
+	} else {
+		for _, key := range []string{"autoscale_configuration", "identity", "rewrite_rule_set", "trusted_root_certificate", "zones"} {
+			if v, ok := d.GetOk(key); ok && len(v.([]interface{})) > 0 {
+				return fmt.Errorf("`%s` can only be specified for the %q and %q tiers", key, string(network.ApplicationGatewayTierStandardV2), string(network.ApplicationGatewayTierWAFV2))
+			}
+		}
+
+		if capacity == 0 {
+			return fmt.Errorf("`capacity` must be specified within the `sku` block for the %q tier", tier)
+		}
+
+		if capacity > 32 {
+			return fmt.Errorf("`capacity` must be between 1 and 32 for the %q tier", tier)
+		}
+	}
+
+	for i, raw := range d.Get("ssl_certificate").([]interface{}) {
+		prefix := fmt.Sprintf("ssl_certificate.%d", i)
+		if raw == nil || !valuesKnown(d, prefix+".data", prefix+".password", prefix+".key_vault_secret_id") {
+			continue
+		}
+
+		certificate := raw.(map[string]interface{})
+		name := certificate["name"].(string)
+		data := certificate["data"].(string)
+		password := certificate["password"].(string)
+		keyVaultSecretID := certificate["key_vault_secret_id"].(string)
+
+		if data == "" && keyVaultSecretID == "" {
+			return fmt.Errorf("One of `data` or `key_vault_secret_id` must be specified for the SSL Certificate %q", name)
+		}
+
+		if data != "" && keyVaultSecretID != "" {
+			return fmt.Errorf("Only one of `data` or `key_vault_secret_id` can be specified for the SSL Certificate %q", name)
+		}
+
+		if data != "" && password == "" {
+			return fmt.Errorf("`password` must be specified when `data` is specified for the SSL Certificate %q", name)
+		}
+
+		if keyVaultSecretID != "" {
+			if password != "" {
+				return fmt.Errorf("`password` cannot be specified when `key_vault_secret_id` is specified for the SSL Certificate %q", name)
+			}
+
+			if !isV2 {
+				return fmt.Errorf("`key_vault_secret_id` can only be specified for the %q and %q tiers", string(network.ApplicationGatewayTierStandardV2), string(network.ApplicationGatewayTierWAFV2))
+			}
+
+			if !identityEnabled {
+				return fmt.Errorf("An `identity` with access to the Key Vault must be specified to use the `key_vault_secret_id` of the SSL Certificate %q", name)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,194 @@
+package azure
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-08-01/network"
+)
+
+func TestExpandApplicationGatewayRequestBody(t *testing.T) {
+	gatewayID := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/applicationGateways/gateway1"
+	ruleSetID := gatewayID + "/rewriteRuleSets/set1"
+	identityID := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.ManagedIdentity/userAssignedIdentities/identity1"
+	secretID := "https://vault1.vault.azure.net/secrets/certificate1"
+
+	name := func(v string) *string {
+		return &v
+	}
+	minCapacity := int32(2)
+	maxCapacity := int32(10)
+	sequence := int32(100)
+
+	gateway := network.ApplicationGateway{
+		Location: name("westeurope"),
+		ApplicationGatewayPropertiesFormat: &network.ApplicationGatewayPropertiesFormat{
+			AutoscaleConfiguration: &network.ApplicationGatewayAutoscaleConfiguration{
+				MinCapacity: &minCapacity,
+			},
+			SslCertificates: &[]network.ApplicationGatewaySslCertificate{
+				{
+					Name: name("certificate1"),
+					ApplicationGatewaySslCertificatePropertiesFormat: &network.ApplicationGatewaySslCertificatePropertiesFormat{},
+				},
+				{
+					Name: name("certificate2"),
+					ApplicationGatewaySslCertificatePropertiesFormat: &network.ApplicationGatewaySslCertificatePropertiesFormat{
+						Data:     name("abc123"),
+						Password: name("secret"),
+					},
+				},
+			},
+			RequestRoutingRules: &[]network.ApplicationGatewayRequestRoutingRule{
+				{
+					Name: name("rule1"),
+					ApplicationGatewayRequestRoutingRulePropertiesFormat: &network.ApplicationGatewayRequestRoutingRulePropertiesFormat{
+						RuleType: network.Basic,
+					},
+				},
+			},
+			URLPathMaps: &[]network.ApplicationGatewayURLPathMap{
+				{
+					Name: name("map1"),
+					ApplicationGatewayURLPathMapPropertiesFormat: &network.ApplicationGatewayURLPathMapPropertiesFormat{
+						PathRules: &[]network.ApplicationGatewayPathRule{
+							{
+								Name: name("path1"),
+								ApplicationGatewayPathRulePropertiesFormat: &network.ApplicationGatewayPathRulePropertiesFormat{
+									Paths: &[]string{"/api/*"},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	extended := ApplicationGatewayExtendedProperties{
+		Identity: &ApplicationGatewayIdentity{
+			Type: "UserAssigned",
+			UserAssignedIdentities: map[string]*ApplicationGatewayUserAssignedIdentity{
+				identityID: {},
+			},
+		},
+		AutoscaleMaxCapacity: &maxCapacity,
+		RewriteRuleSets: &[]ApplicationGatewayRewriteRuleSet{
+			{
+				Name: name("set1"),
+				Properties: &ApplicationGatewayRewriteRuleSetProperties{
+					RewriteRules: &[]ApplicationGatewayRewriteRule{
+						{
+							Name:         name("rule1"),
+							RuleSequence: &sequence,
+							ActionSet: &ApplicationGatewayRewriteRuleActionSet{
+								ResponseHeaderConfigurations: &[]ApplicationGatewayHeaderConfiguration{
+									{
+										HeaderName:  name("X-Powered-By"),
+										HeaderValue: name(""),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		SslCertificateKeyVaultSecretIDs: map[string]string{
+			"certificate1": secretID,
+		},
+		RequestRoutingRuleRewriteRuleSetIDs: map[string]string{
+			"rule1": ruleSetID,
+		},
+		PathRuleRewriteRuleSetIDs: map[string]map[string]string{
+			"map1": {
+				"path1": ruleSetID,
+			},
+		},
+	}
+
+	body, err := expandApplicationGatewayRequestBody(gateway, extended)
+	if err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+
+	// round-trip the request body to confirm the extended properties are parsed from where they were written
+	raw, err := json.Marshal(body)
+	if err != nil {
+		t.Fatalf("Error serializing the request body: %+v", err)
+	}
+
+	parsed, parsedExtended, err := parseApplicationGatewayResponseBody(raw)
+	if err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+
+	if parsed.Location == nil || *parsed.Location != "westeurope" {
+		t.Fatalf("Expected the Location to be %q but got %+v", "westeurope", parsed.Location)
+	}
+
+	props := parsed.ApplicationGatewayPropertiesFormat
+	if props == nil || props.AutoscaleConfiguration == nil || props.AutoscaleConfiguration.MinCapacity == nil || *props.AutoscaleConfiguration.MinCapacity != minCapacity {
+		t.Fatalf("Expected the Minimum Capacity to be %d", minCapacity)
+	}
+
+	if parsedExtended.AutoscaleMaxCapacity == nil || *parsedExtended.AutoscaleMaxCapacity != maxCapacity {
+		t.Fatalf("Expected the Maximum Capacity to be %d but got %+v", maxCapacity, parsedExtended.AutoscaleMaxCapacity)
+	}
+
+	if identity := parsedExtended.Identity; identity == nil || identity.Type != "UserAssigned" || len(identity.UserAssignedIdentities) != 1 {
+		t.Fatalf("Expected a single User Assigned Identity but got %+v", identity)
+	}
+	if _, ok := parsedExtended.Identity.UserAssignedIdentities[identityID]; !ok {
+		t.Fatalf("Expected the User Assigned Identity %q to be assigned", identityID)
+	}
+
+	if len(parsedExtended.SslCertificateKeyVaultSecretIDs) != 1 || parsedExtended.SslCertificateKeyVaultSecretIDs["certificate1"] != secretID {
+		t.Fatalf("Expected only `certificate1` to be sourced from Key Vault but got %+v", parsedExtended.SslCertificateKeyVaultSecretIDs)
+	}
+
+	if parsedExtended.RequestRoutingRuleRewriteRuleSetIDs["rule1"] != ruleSetID {
+		t.Fatalf("Expected the Request Routing Rule to use the Rewrite Rule Set %q but got %+v", ruleSetID, parsedExtended.RequestRoutingRuleRewriteRuleSetIDs)
+	}
+
+	if parsedExtended.PathRuleRewriteRuleSetIDs["map1"]["path1"] != ruleSetID {
+		t.Fatalf("Expected the Path Rule to use the Rewrite Rule Set %q but got %+v", ruleSetID, parsedExtended.PathRuleRewriteRuleSetIDs)
+	}
+
+	if parsedExtended.RewriteRuleSets == nil || len(*parsedExtended.RewriteRuleSets) != 1 {
+		t.Fatalf("Expected a single Rewrite Rule Set but got %+v", parsedExtended.RewriteRuleSets)
+	}
+	ruleSet := (*parsedExtended.RewriteRuleSets)[0]
+	rules := *ruleSet.Properties.RewriteRules
+	headers := *rules[0].ActionSet.ResponseHeaderConfigurations
+	if *rules[0].RuleSequence != sequence || *headers[0].HeaderName != "X-Powered-By" || headers[0].HeaderValue == nil || *headers[0].HeaderValue != "" {
+		t.Fatalf("Expected the Rewrite Rule to remove the `X-Powered-By` header but got %+v", rules[0])
+	}
+}
+
+func TestParseApplicationGatewayResponseBodyWithoutExtendedProperties(t *testing.T) {
+	body := `{
+  "name": "gateway1",
+  "properties": {
+    "sku": { "name": "Standard_Small", "tier": "Standard", "capacity": 2 },
+    "requestRoutingRules": [ { "name": "rule1", "properties": { "ruleType": "Basic" } } ]
+  }
+}`
+
+	gateway, extended, err := parseApplicationGatewayResponseBody([]byte(body))
+	if err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+
+	if gateway.Name == nil || *gateway.Name != "gateway1" {
+		t.Fatalf("Expected the Name to be %q but got %+v", "gateway1", gateway.Name)
+	}
+
+	if extended.Identity != nil || extended.AutoscaleMaxCapacity != nil || extended.RewriteRuleSets != nil {
+		t.Fatalf("Expected no extended properties but got %+v", extended)
+	}
+
+	if len(extended.RequestRoutingRuleRewriteRuleSetIDs) != 0 || len(extended.SslCertificateKeyVaultSecretIDs) != 0 {
+		t.Fatalf("Expected no Rewrite Rule Sets or Key Vault Secrets to be used but got %+v", extended)
+	}
+}
@@ -0,0 +1,56 @@
+package azure
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+const auxiliaryAuthorizationHeader = "x-ms-authorization-auxiliary"
+
+// auxiliaryTenantsAuthorizer authorizes requests using the primary Authorizer, and additionally sends a token
+// for each of the auxiliary tenants - which allows a single request to reference resources in other tenants
+// (for example the remote Virtual Network in a cross-tenant Virtual Network Peering)
+type auxiliaryTenantsAuthorizer struct {
+	primary   autorest.Authorizer
+	auxiliary []autorest.Authorizer
+}
+
+// NewAuxiliaryTenantsAuthorizer returns an Authorizer which sends the tokens for each of the auxiliary Authorizers
+// in the `x-ms-authorization-auxiliary` header, in addition to authorizing the request using the primary Authorizer
+func NewAuxiliaryTenantsAuthorizer(primary autorest.Authorizer, auxiliary []autorest.Authorizer) autorest.Authorizer {
+	if len(auxiliary) == 0 {
+		return primary
+	}
+
+	return auxiliaryTenantsAuthorizer{
+		primary:   primary,
+		auxiliary: auxiliary,
+	}
+}
+
+func (a auxiliaryTenantsAuthorizer) WithAuthorization() autorest.PrepareDecorator {
+	return func(p autorest.Preparer) autorest.Preparer {
+		return autorest.PreparerFunc(func(r *http.Request) (*http.Request, error) {
+			r, err := a.primary.WithAuthorization()(p).Prepare(r)
+			if err != nil {
+				return r, err
+			}
+
+			tokens := make([]string, 0, len(a.auxiliary))
+			for _, auxiliary := range a.auxiliary {
+				// the auxiliary Authorizer sets the `Authorization` header on a throwaway request, which is then re-used
+				tokenRequest := (&http.Request{Header: http.Header{}, URL: r.URL}).WithContext(r.Context())
+				tokenRequest, err = autorest.Prepare(tokenRequest, auxiliary.WithAuthorization())
+				if err != nil {
+					return r, err
+				}
+
+				tokens = append(tokens, tokenRequest.Header.Get("Authorization"))
+			}
+
+			return autorest.Prepare(r, autorest.WithHeader(auxiliaryAuthorizationHeader, strings.Join(tokens, ", ")))
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package azure
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/adal"
+)
+
+func TestAuxiliaryTenantsAuthorizer(t *testing.T) {
+	primary := autorest.NewBearerAuthorizer(&adal.Token{AccessToken: "primary"})
+	auxiliary := []autorest.Authorizer{
+		autorest.NewBearerAuthorizer(&adal.Token{AccessToken: "first"}),
+		autorest.NewBearerAuthorizer(&adal.Token{AccessToken: "second"}),
+	}
+
+	req, err := http.NewRequest(http.MethodGet, "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000", nil)
+	if err != nil {
+		t.Fatalf("Error building request: %+v", err)
+	}
+
+	req, err = autorest.Prepare(req, NewAuxiliaryTenantsAuthorizer(primary, auxiliary).WithAuthorization())
+	if err != nil {
+		t.Fatalf("Error preparing request: %+v", err)
+	}
+
+	if v := req.Header.Get("Authorization"); v != "Bearer primary" {
+		t.Fatalf("Expected the Authorization header to be %q but got %q", "Bearer primary", v)
+	}
+
+	expected := "Bearer first, Bearer second"
+	if v := req.Header.Get(auxiliaryAuthorizationHeader); v != expected {
+		t.Fatalf("Expected the %s header to be %q but got %q", auxiliaryAuthorizationHeader, expected, v)
+	}
+}
+
+func TestAuxiliaryTenantsAuthorizerWithoutAuxiliaryTenants(t *testing.T) {
+	primary := autorest.NewBearerAuthorizer(&adal.Token{AccessToken: "primary"})
+
+	if authorizer := NewAuxiliaryTenantsAuthorizer(primary, nil); authorizer != primary {
+		t.Fatalf("Expected the primary Authorizer to be returned when there are no auxiliary tenants")
+	}
+}
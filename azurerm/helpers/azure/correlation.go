@@ -0,0 +1,99 @@
+package azure
+
+import (
+	"context"
+	"net/http"
+	"sync"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const correlationRequestIDHeader = "x-ms-correlation-request-id"
+
+// Correlation identifies the Terraform operation on whose behalf a request is made
+type Correlation struct {
+	// RequestID is the Correlation Request ID sent with each of the requests made by the operation
+	RequestID string
+
+	// ResourceType is the type of the Terraform resource or data source, e.g. `azurerm_resource_group`
+	ResourceType string
+
+	// ResourceName is the name of the resource in Azure, or its ID where it doesn't have a `name`
+	ResourceName string
+}
+
+type correlationContextKey struct{}
+
+// operationCorrelations are the Correlations of the in-flight CRUD operations, keyed by their ResourceData - since
+// the ArmClient (and its StopContext) is shared between the concurrent operations
+var operationCorrelations sync.Map
+
+// WithCorrelation returns a copy of the context which sends the Correlation Request ID with each request made using it
+// and includes the Correlation in the logged requests, allowing the requests for a single operation to be traced
+func WithCorrelation(ctx context.Context, correlation Correlation) context.Context {
+	return context.WithValue(ctx, correlationContextKey{}, correlation)
+}
+
+// CorrelationFromContext returns the Correlation associated with the context, if any
+func CorrelationFromContext(ctx context.Context) (Correlation, bool) {
+	v, ok := ctx.Value(correlationContextKey{}).(Correlation)
+	return v, ok
+}
+
+// WithCorrelationRequestID returns a copy of the context which sends the specified Correlation Request ID with
+// each request made using it, allowing the requests for a single operation to be traced in Azure
+func WithCorrelationRequestID(ctx context.Context, correlationRequestID string) context.Context {
+	correlation, _ := CorrelationFromContext(ctx)
+	correlation.RequestID = correlationRequestID
+	return WithCorrelation(ctx, correlation)
+}
+
+// CorrelationRequestID returns the Correlation Request ID associated with the context, if any
+func CorrelationRequestID(ctx context.Context) (string, bool) {
+	v, ok := CorrelationFromContext(ctx)
+	return v.RequestID, ok && v.RequestID != ""
+}
+
+// StartCorrelatedOperation associates the Correlation with the CRUD operation for the ResourceData until the
+// returned func is called, so that it's attached to the contexts built for the operation by CorrelatedContext
+func StartCorrelatedOperation(d *schema.ResourceData, correlation Correlation) func() {
+	previous, existed := operationCorrelations.Load(d)
+	operationCorrelations.Store(d, correlation)
+
+	return func() {
+		if existed {
+			operationCorrelations.Store(d, previous)
+			return
+		}
+
+		operationCorrelations.Delete(d)
+	}
+}
+
+// CorrelatedContext returns a copy of the context with the Correlation of the in-flight CRUD operation for the
+// ResourceData, or the context itself when there isn't one
+func CorrelatedContext(ctx context.Context, d *schema.ResourceData) context.Context {
+	if d == nil {
+		return ctx
+	}
+
+	v, ok := operationCorrelations.Load(d)
+	if !ok {
+		return ctx
+	}
+
+	return WithCorrelation(ctx, v.(Correlation))
+}
+
+func withCorrelationRequestID() autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			if id, ok := CorrelationRequestID(r.Context()); ok && r.Header.Get(correlationRequestIDHeader) == "" {
+				r.Header.Set(correlationRequestIDHeader, id)
+			}
+
+			return s.Do(r)
+		})
+	}
+}
@@ -0,0 +1,21 @@
+package azure
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// CosmosDBThroughputCustomizeDiff forces a new resource to be created when throughput is provisioned for a Database,
+// Container, Keyspace, Graph or Table which was created without dedicated throughput - since Cosmos DB doesn't support
+// switching between shared and dedicated throughput once the resource exists
+func CosmosDBThroughputCustomizeDiff(d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" || !valuesKnown(d, "throughput") {
+		return nil
+	}
+
+	old, new := d.GetChange("throughput")
+	if old.(int) == 0 && new.(int) > 0 {
+		return d.ForceNew("throughput")
+	}
+
+	return nil
+}
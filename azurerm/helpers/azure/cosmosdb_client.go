@@ -0,0 +1,259 @@
+package azure
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/cosmos-db/mgmt/2015-04-08/documentdb"
+	"github.com/Azure/go-autorest/autorest"
+	az "github.com/Azure/go-autorest/autorest/azure"
+)
+
+// cosmosDBAPIVersion is the version of the Cosmos DB API used for the Databases, Containers etc within an Account
+const cosmosDBAPIVersion = "2015-04-08"
+
+// CosmosDBResourcesClient is a client for the Databases, Containers, Keyspaces, Graphs and Tables within a Cosmos DB
+// Account - which aren't supported by the version of the Cosmos DB SDK we're using, so these requests are sent using
+// the (configured) client for Cosmos DB Accounts, to the Resource ID of each resource
+type CosmosDBResourcesClient struct {
+	documentdb.BaseClient
+}
+
+// NewCosmosDBResourcesClient returns a CosmosDBResourcesClient built on the client for Cosmos DB Accounts
+func NewCosmosDBResourcesClient(accountsClient documentdb.DatabaseAccountsClient) CosmosDBResourcesClient {
+	return CosmosDBResourcesClient{
+		BaseClient: accountsClient.BaseClient,
+	}
+}
+
+// CosmosDBNamedResource is a resource within a Cosmos DB Account which is defined only by its name, such as a Database
+type CosmosDBNamedResource struct {
+	ID *string `json:"id,omitempty"`
+}
+
+// CosmosDBIndexingPolicy is the Indexing Policy of a SQL Container or Gremlin Graph
+type CosmosDBIndexingPolicy struct {
+	Automatic     *bool                `json:"automatic,omitempty"`
+	IndexingMode  string               `json:"indexingMode,omitempty"`
+	IncludedPaths *[]CosmosDBIndexPath `json:"includedPaths,omitempty"`
+	ExcludedPaths *[]CosmosDBIndexPath `json:"excludedPaths,omitempty"`
+}
+
+// CosmosDBIndexPath is a path which is included in, or excluded from, an Indexing Policy
+type CosmosDBIndexPath struct {
+	Path *string `json:"path,omitempty"`
+}
+
+// CosmosDBPartitionKey is the Partition Key of a SQL Container or Gremlin Graph
+type CosmosDBPartitionKey struct {
+	Paths *[]string `json:"paths,omitempty"`
+	Kind  string    `json:"kind,omitempty"`
+}
+
+// CosmosDBUniqueKeyPolicy is the Unique Key Policy of a SQL Container or Gremlin Graph
+type CosmosDBUniqueKeyPolicy struct {
+	UniqueKeys *[]CosmosDBUniqueKey `json:"uniqueKeys,omitempty"`
+}
+
+// CosmosDBUniqueKey is a set of paths which must be unique within a Partition
+type CosmosDBUniqueKey struct {
+	Paths *[]string `json:"paths,omitempty"`
+}
+
+// CosmosDBContainerResource is a SQL Container or a Gremlin Graph
+type CosmosDBContainerResource struct {
+	ID              *string                  `json:"id,omitempty"`
+	IndexingPolicy  *CosmosDBIndexingPolicy  `json:"indexingPolicy,omitempty"`
+	PartitionKey    *CosmosDBPartitionKey    `json:"partitionKey,omitempty"`
+	DefaultTTL      *int32                   `json:"defaultTtl,omitempty"`
+	UniqueKeyPolicy *CosmosDBUniqueKeyPolicy `json:"uniqueKeyPolicy,omitempty"`
+}
+
+// CosmosDBMongoCollectionResource is a MongoDB Collection
+type CosmosDBMongoCollectionResource struct {
+	ID       *string               `json:"id,omitempty"`
+	ShardKey map[string]*string    `json:"shardKey,omitempty"`
+	Indexes  *[]CosmosDBMongoIndex `json:"indexes,omitempty"`
+}
+
+// CosmosDBMongoIndex is an Index on a MongoDB Collection
+type CosmosDBMongoIndex struct {
+	Key     *CosmosDBMongoIndexKeys    `json:"key,omitempty"`
+	Options *CosmosDBMongoIndexOptions `json:"options,omitempty"`
+}
+
+// CosmosDBMongoIndexKeys are the keys of an Index on a MongoDB Collection
+type CosmosDBMongoIndexKeys struct {
+	Keys *[]string `json:"keys,omitempty"`
+}
+
+// CosmosDBMongoIndexOptions are the options for an Index on a MongoDB Collection
+type CosmosDBMongoIndexOptions struct {
+	ExpireAfterSeconds *int32 `json:"expireAfterSeconds,omitempty"`
+	Unique             *bool  `json:"unique,omitempty"`
+}
+
+// CosmosDBCassandraTableResource is a Cassandra Table
+type CosmosDBCassandraTableResource struct {
+	ID         *string                       `json:"id,omitempty"`
+	DefaultTTL *int32                        `json:"defaultTtl,omitempty"`
+	Schema     *CosmosDBCassandraTableSchema `json:"schema,omitempty"`
+}
+
+// CosmosDBCassandraTableSchema is the Schema of a Cassandra Table
+type CosmosDBCassandraTableSchema struct {
+	Columns       *[]CosmosDBCassandraColumn     `json:"columns,omitempty"`
+	PartitionKeys *[]CosmosDBCassandraColumn     `json:"partitionKeys,omitempty"`
+	ClusterKeys   *[]CosmosDBCassandraClusterKey `json:"clusterKeys,omitempty"`
+}
+
+// CosmosDBCassandraColumn is a Column (or a Partition Key) within the Schema of a Cassandra Table
+type CosmosDBCassandraColumn struct {
+	Name *string `json:"name,omitempty"`
+	Type *string `json:"type,omitempty"`
+}
+
+// CosmosDBCassandraClusterKey is a Cluster Key within the Schema of a Cassandra Table
+type CosmosDBCassandraClusterKey struct {
+	Name    *string `json:"name,omitempty"`
+	OrderBy *string `json:"orderBy,omitempty"`
+}
+
+type cosmosDBCreateUpdateParameters struct {
+	Properties cosmosDBCreateUpdateProperties `json:"properties"`
+}
+
+type cosmosDBCreateUpdateProperties struct {
+	Resource interface{}       `json:"resource"`
+	Options  map[string]string `json:"options"`
+}
+
+type cosmosDBGetResult struct {
+	Properties interface{} `json:"properties"`
+}
+
+type cosmosDBThroughput struct {
+	Throughput *int32 `json:"throughput,omitempty"`
+}
+
+// CreateUpdate creates or updates the resource with the specified Resource ID - where the throughput is only
+// provisioned for the resource when it's greater than zero
+func (client CosmosDBResourcesClient) CreateUpdate(ctx context.Context, id string, resource interface{}, throughput int) (result az.Future, err error) {
+	options := make(map[string]string)
+	if throughput > 0 {
+		options["throughput"] = strconv.Itoa(throughput)
+	}
+
+	parameters := cosmosDBCreateUpdateParameters{
+		Properties: cosmosDBCreateUpdateProperties{
+			Resource: resource,
+			Options:  options,
+		},
+	}
+
+	return client.put(ctx, "CreateUpdate", id, parameters)
+}
+
+// Get retrieves the resource with the specified Resource ID, unmarshalling its properties into the result
+func (client CosmosDBResourcesClient) Get(ctx context.Context, id string, result interface{}) (autorest.Response, error) {
+	return client.get(ctx, "Get", id, &cosmosDBGetResult{Properties: result})
+}
+
+// Delete deletes the resource with the specified Resource ID
+func (client CosmosDBResourcesClient) Delete(ctx context.Context, id string) (result az.Future, err error) {
+	req, err := client.preparer(ctx, id, autorest.AsDelete())
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "azure.CosmosDBResourcesClient", "Delete", nil, "Failure preparing request")
+	}
+
+	resp, err := client.send(req)
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "azure.CosmosDBResourcesClient", "Delete", resp, "Failure sending request")
+	}
+
+	return az.NewFutureFromResponse(resp)
+}
+
+// GetThroughput retrieves the throughput provisioned for the resource with the specified Resource ID
+func (client CosmosDBResourcesClient) GetThroughput(ctx context.Context, id string) (*int32, autorest.Response, error) {
+	var throughput cosmosDBThroughput
+	resp, err := client.get(ctx, "GetThroughput", cosmosDBThroughputID(id), &cosmosDBGetResult{Properties: &throughput})
+	return throughput.Throughput, resp, err
+}
+
+// UpdateThroughput updates the throughput provisioned for the resource with the specified Resource ID
+func (client CosmosDBResourcesClient) UpdateThroughput(ctx context.Context, id string, throughput int) (az.Future, error) {
+	value := int32(throughput)
+	parameters := map[string]interface{}{
+		"properties": map[string]interface{}{
+			"resource": cosmosDBThroughput{
+				Throughput: &value,
+			},
+		},
+	}
+
+	return client.put(ctx, "UpdateThroughput", cosmosDBThroughputID(id), parameters)
+}
+
+func (client CosmosDBResourcesClient) put(ctx context.Context, method string, id string, parameters interface{}) (result az.Future, err error) {
+	req, err := client.preparer(ctx, id,
+		autorest.AsPut(),
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.WithJSON(parameters))
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "azure.CosmosDBResourcesClient", method, nil, "Failure preparing request")
+	}
+
+	resp, err := client.send(req)
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "azure.CosmosDBResourcesClient", method, resp, "Failure sending request")
+	}
+
+	return az.NewFutureFromResponse(resp)
+}
+
+func (client CosmosDBResourcesClient) get(ctx context.Context, method string, id string, result interface{}) (autorest.Response, error) {
+	req, err := client.preparer(ctx, id, autorest.AsGet())
+	if err != nil {
+		return autorest.Response{}, autorest.NewErrorWithError(err, "azure.CosmosDBResourcesClient", method, nil, "Failure preparing request")
+	}
+
+	resp, err := client.send(req)
+	if err != nil {
+		return autorest.Response{Response: resp}, autorest.NewErrorWithError(err, "azure.CosmosDBResourcesClient", method, resp, "Failure sending request")
+	}
+
+	err = autorest.Respond(
+		resp,
+		client.ByInspecting(),
+		az.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(result),
+		autorest.ByClosing())
+	if err != nil {
+		return autorest.Response{Response: resp}, autorest.NewErrorWithError(err, "azure.CosmosDBResourcesClient", method, resp, "Failure responding to request")
+	}
+
+	return autorest.Response{Response: resp}, nil
+}
+
+func (client CosmosDBResourcesClient) preparer(ctx context.Context, id string, decorators ...autorest.PrepareDecorator) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": cosmosDBAPIVersion,
+	}
+
+	decorators = append(decorators,
+		autorest.WithBaseURL(client.BaseURI),
+		autorest.WithPath(id),
+		autorest.WithQueryParameters(queryParameters))
+	return autorest.CreatePreparer(decorators...).Prepare((&http.Request{}).WithContext(ctx))
+}
+
+func (client CosmosDBResourcesClient) send(req *http.Request) (*http.Response, error) {
+	return autorest.SendWithSender(client, req)
+}
+
+func cosmosDBThroughputID(id string) string {
+	return strings.TrimSuffix(id, "/") + "/settings/throughput"
+}
@@ -0,0 +1,38 @@
+package azure
+
+import (
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// CustomizeDiffAll returns a CustomizeDiffFunc which runs each of the CustomizeDiffFuncs in turn - returning all of the
+// validation errors at once, so that each of the invalid combinations can be fixed in a single pass at plan time
+func CustomizeDiffAll(funcs ...schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
+	return func(d *schema.ResourceDiff, meta interface{}) error {
+		var result *multierror.Error
+
+		for _, f := range funcs {
+			if f == nil {
+				continue
+			}
+
+			if err := f(d, meta); err != nil {
+				result = multierror.Append(result, err)
+			}
+		}
+
+		return result.ErrorOrNil()
+	}
+}
+
+// valuesKnown returns whether the values for each of the keys are known at plan time - since values
+// which are interpolated from other resources can't be validated until they're available during the apply
+func valuesKnown(d *schema.ResourceDiff, keys ...string) bool {
+	for _, key := range keys {
+		if !d.NewValueKnown(key) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,1373 @@
+package azure
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func testCustomizeDiff(t *testing.T, resource *schema.Resource, input map[string]interface{}) error {
+	raw, err := config.NewRawConfig(input)
+	if err != nil {
+		t.Fatalf("Error building the config: %+v", err)
+	}
+
+	_, err = resource.Diff(nil, terraform.NewResourceConfig(raw), nil)
+	return err
+}
+
+func TestCustomizeDiffAll(t *testing.T) {
+	failing := func(d *schema.ResourceDiff, _ interface{}) error {
+		return fmt.Errorf("`sku_name` is invalid")
+	}
+
+	resource := &schema.Resource{
+		Schema:        testRedisCacheSchema(),
+		CustomizeDiff: CustomizeDiffAll(RedisCacheCustomizeDiff, failing),
+	}
+
+	err := testCustomizeDiff(t, resource, map[string]interface{}{
+		"sku_name": "Basic",
+		"family":   "P",
+		"capacity": 1,
+	})
+	if err == nil {
+		t.Fatalf("Expected an error but didn't get one")
+	}
+
+	// both of the errors should be returned together
+	if errors, ok := err.(interface{ WrappedErrors() []error }); !ok || len(errors.WrappedErrors()) != 2 {
+		t.Fatalf("Expected both errors to be returned but got: %+v", err)
+	}
+}
+
+func TestZonesCustomizeDiff(t *testing.T) {
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"location": SchemaLocation(),
+			"zones":    SchemaZones(),
+		},
+		CustomizeDiff: ZonesCustomizeDiff,
+	}
+
+	cases := []struct {
+		Location string
+		Zones    []interface{}
+		Valid    bool
+	}{
+		{
+			Location: "West Europe",
+			Zones:    []interface{}{"1", "2"},
+			Valid:    true,
+		},
+		{
+			Location: "westus2",
+			Zones:    []interface{}{"3"},
+			Valid:    true,
+		},
+		{
+			// Regions which aren't known either way only log a warning
+			Location: "Australia East",
+			Zones:    []interface{}{"1"},
+			Valid:    true,
+		},
+		{
+			Location: "West Central US",
+			Zones:    []interface{}{"1"},
+			Valid:    false,
+		},
+		{
+			Location: "westcentralus",
+			Zones:    []interface{}{"1", "2", "3"},
+			Valid:    false,
+		},
+		{
+			Location: "West Central US",
+			Zones:    []interface{}{},
+			Valid:    true,
+		},
+	}
+
+	for _, v := range cases {
+		t.Logf("[DEBUG] Testing %q with zones %+v", v.Location, v.Zones)
+
+		err := testCustomizeDiff(t, resource, map[string]interface{}{
+			"location": v.Location,
+			"zones":    v.Zones,
+		})
+		if v.Valid && err != nil {
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+		if !v.Valid && err == nil {
+			t.Fatalf("Expected an error but didn't get one")
+		}
+	}
+}
+
+func TestLocationSupportsZones(t *testing.T) {
+	cases := []struct {
+		Location string
+		Expected bool
+	}{
+		{
+			Location: "West Europe",
+			Expected: true,
+		},
+		{
+			Location: "westus2",
+			Expected: true,
+		},
+		{
+			Location: "West Central US",
+			Expected: false,
+		},
+	}
+
+	for _, v := range cases {
+		if actual := LocationSupportsZones(v.Location); actual != v.Expected {
+			t.Fatalf("Expected %q to return %t but got %t", v.Location, v.Expected, actual)
+		}
+	}
+}
+
+func TestRedisCacheCustomizeDiff(t *testing.T) {
+	resource := &schema.Resource{
+		Schema:        testRedisCacheSchema(),
+		CustomizeDiff: RedisCacheCustomizeDiff,
+	}
+
+	cases := []struct {
+		Name  string
+		Input map[string]interface{}
+		Valid bool
+	}{
+		{
+			Name:  "Standard",
+			Input: map[string]interface{}{"sku_name": "Standard", "family": "C", "capacity": 1},
+			Valid: true,
+		},
+		{
+			Name:  "Premium with a Shard Count",
+			Input: map[string]interface{}{"sku_name": "Premium", "family": "P", "capacity": 1, "shard_count": 3},
+			Valid: true,
+		},
+		{
+			Name:  "Premium with the wrong Family",
+			Input: map[string]interface{}{"sku_name": "Premium", "family": "C", "capacity": 1},
+			Valid: false,
+		},
+		{
+			Name:  "Basic with the wrong Family",
+			Input: map[string]interface{}{"sku_name": "Basic", "family": "P", "capacity": 1},
+			Valid: false,
+		},
+		{
+			Name:  "Premium with an invalid Capacity",
+			Input: map[string]interface{}{"sku_name": "Premium", "family": "P", "capacity": 0},
+			Valid: false,
+		},
+		{
+			Name:  "Standard with a Shard Count",
+			Input: map[string]interface{}{"sku_name": "Standard", "family": "C", "capacity": 1, "shard_count": 3},
+			Valid: false,
+		},
+		{
+			Name:  "Standard with a Subnet",
+			Input: map[string]interface{}{"sku_name": "Standard", "family": "C", "capacity": 1, "subnet_id": "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1"},
+			Valid: false,
+		},
+		{
+			Name:  "Private Static IP Address without a Subnet",
+			Input: map[string]interface{}{"sku_name": "Premium", "family": "P", "capacity": 1, "private_static_ip_address": "10.0.1.20"},
+			Valid: false,
+		},
+	}
+
+	for _, v := range cases {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		err := testCustomizeDiff(t, resource, v.Input)
+		if v.Valid && err != nil {
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+		if !v.Valid && err == nil {
+			t.Fatalf("Expected an error but didn't get one")
+		}
+	}
+}
+
+func TestStorageAccountCustomizeDiff(t *testing.T) {
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"account_kind": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "Storage",
+			},
+			"account_tier": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"account_replication_type": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"access_tier": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"is_hns_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+		},
+		CustomizeDiff: StorageAccountCustomizeDiff,
+	}
+	for _, key := range []string{"blob_properties", "queue_properties", "static_website"} {
+		resource.Schema[key] = &schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"enabled": {
+						Type:     schema.TypeBool,
+						Optional: true,
+					},
+				},
+			},
+		}
+	}
+	enabled := []interface{}{map[string]interface{}{"enabled": true}}
+
+	cases := []struct {
+		Name  string
+		Input map[string]interface{}
+		Valid bool
+	}{
+		{
+			Name:  "Standard ZRS",
+			Input: map[string]interface{}{"account_kind": "StorageV2", "account_tier": "Standard", "account_replication_type": "ZRS"},
+			Valid: true,
+		},
+		{
+			Name:  "Premium LRS",
+			Input: map[string]interface{}{"account_tier": "Premium", "account_replication_type": "LRS"},
+			Valid: true,
+		},
+		{
+			Name:  "Premium GRS",
+			Input: map[string]interface{}{"account_tier": "Premium", "account_replication_type": "GRS"},
+			Valid: false,
+		},
+		{
+			Name:  "Blob Storage ZRS",
+			Input: map[string]interface{}{"account_kind": "BlobStorage", "account_tier": "Standard", "account_replication_type": "ZRS"},
+			Valid: false,
+		},
+		{
+			Name:  "Blob Storage Premium",
+			Input: map[string]interface{}{"account_kind": "BlobStorage", "account_tier": "Premium", "account_replication_type": "LRS"},
+			Valid: false,
+		},
+		{
+			Name:  "Blob Storage with an Access Tier",
+			Input: map[string]interface{}{"account_kind": "BlobStorage", "account_tier": "Standard", "account_replication_type": "LRS", "access_tier": "Cool"},
+			Valid: true,
+		},
+		{
+			Name:  "Storage with an Access Tier",
+			Input: map[string]interface{}{"account_tier": "Standard", "account_replication_type": "LRS", "access_tier": "Cool"},
+			Valid: false,
+		},
+		{
+			Name:  "Storage with a Hierarchical Namespace",
+			Input: map[string]interface{}{"account_tier": "Standard", "account_replication_type": "LRS", "is_hns_enabled": true},
+			Valid: false,
+		},
+		{
+			Name:  "Storage with Blob and Queue Properties",
+			Input: map[string]interface{}{"account_tier": "Standard", "account_replication_type": "LRS", "blob_properties": enabled, "queue_properties": enabled},
+			Valid: true,
+		},
+		{
+			Name:  "Premium Storage with Blob Properties",
+			Input: map[string]interface{}{"account_tier": "Premium", "account_replication_type": "LRS", "blob_properties": enabled},
+			Valid: false,
+		},
+		{
+			Name:  "Blob Storage with Queue Properties",
+			Input: map[string]interface{}{"account_kind": "BlobStorage", "account_tier": "Standard", "account_replication_type": "LRS", "queue_properties": enabled},
+			Valid: false,
+		},
+		{
+			Name:  "Storage with a Static Website",
+			Input: map[string]interface{}{"account_tier": "Standard", "account_replication_type": "LRS", "static_website": enabled},
+			Valid: false,
+		},
+		{
+			Name:  "StorageV2 with a Static Website",
+			Input: map[string]interface{}{"account_kind": "StorageV2", "account_tier": "Standard", "account_replication_type": "LRS", "static_website": enabled},
+			Valid: true,
+		},
+	}
+
+	for _, v := range cases {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		err := testCustomizeDiff(t, resource, v.Input)
+		if v.Valid && err != nil {
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+		if !v.Valid && err == nil {
+			t.Fatalf("Expected an error but didn't get one")
+		}
+	}
+}
+
+func testRedisCacheSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"sku_name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"family": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"capacity": {
+			Type:     schema.TypeInt,
+			Required: true,
+		},
+		"shard_count": {
+			Type:     schema.TypeInt,
+			Optional: true,
+		},
+		"subnet_id": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"private_static_ip_address": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+		},
+	}
+}
+
+func TestApplicationGatewayCustomizeDiff(t *testing.T) {
+	block := func(s map[string]*schema.Schema) *schema.Schema {
+		return &schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: s,
+			},
+		}
+	}
+	optionalString := &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+	}
+
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"sku": block(map[string]*schema.Schema{
+				"tier": optionalString,
+				"capacity": {
+					Type:     schema.TypeInt,
+					Optional: true,
+				},
+			}),
+			"autoscale_configuration": block(map[string]*schema.Schema{
+				"min_capacity": {
+					Type:     schema.TypeInt,
+					Optional: true,
+				},
+			}),
+			"identity": block(map[string]*schema.Schema{
+				"type": optionalString,
+			}),
+			"rewrite_rule_set":         block(map[string]*schema.Schema{"name": optionalString}),
+			"trusted_root_certificate": block(map[string]*schema.Schema{"name": optionalString}),
+			"zones": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"ssl_certificate": block(map[string]*schema.Schema{
+				"name":                optionalString,
+				"data":                optionalString,
+				"password":            optionalString,
+				"key_vault_secret_id": optionalString,
+			}),
+		},
+		CustomizeDiff: ApplicationGatewayCustomizeDiff,
+	}
+
+	sku := func(tier string, capacity int) []interface{} {
+		return []interface{}{
+			map[string]interface{}{
+				"tier":     tier,
+				"capacity": capacity,
+			},
+		}
+	}
+	autoscale := []interface{}{
+		map[string]interface{}{
+			"min_capacity": 2,
+		},
+	}
+	identity := []interface{}{
+		map[string]interface{}{
+			"type": "UserAssigned",
+		},
+	}
+	secretID := "https://vault1.vault.azure.net/secrets/certificate1"
+
+	cases := []struct {
+		Name  string
+		Input map[string]interface{}
+		Valid bool
+	}{
+		{
+			Name:  "Standard with a Capacity",
+			Input: map[string]interface{}{"sku": sku("Standard", 2)},
+			Valid: true,
+		},
+		{
+			Name:  "Standard without a Capacity",
+			Input: map[string]interface{}{"sku": sku("Standard", 0)},
+			Valid: false,
+		},
+		{
+			Name:  "Standard with too large a Capacity",
+			Input: map[string]interface{}{"sku": sku("WAF", 50)},
+			Valid: false,
+		},
+		{
+			Name:  "Standard with Autoscaling",
+			Input: map[string]interface{}{"sku": sku("Standard", 2), "autoscale_configuration": autoscale},
+			Valid: false,
+		},
+		{
+			Name:  "Standard with Zones",
+			Input: map[string]interface{}{"sku": sku("Standard", 2), "zones": []interface{}{"1"}},
+			Valid: false,
+		},
+		{
+			Name:  "Standard_v2 with Autoscaling",
+			Input: map[string]interface{}{"sku": sku("Standard_v2", 0), "autoscale_configuration": autoscale, "zones": []interface{}{"1", "2"}},
+			Valid: true,
+		},
+		{
+			Name:  "Standard_v2 with a Capacity and Autoscaling",
+			Input: map[string]interface{}{"sku": sku("Standard_v2", 2), "autoscale_configuration": autoscale},
+			Valid: false,
+		},
+		{
+			Name:  "WAF_v2 without a Capacity or Autoscaling",
+			Input: map[string]interface{}{"sku": sku("WAF_v2", 0)},
+			Valid: false,
+		},
+		{
+			Name: "SSL Certificate with Data",
+			Input: map[string]interface{}{
+				"sku":             sku("Standard", 2),
+				"ssl_certificate": []interface{}{map[string]interface{}{"name": "cert1", "data": "abc123", "password": "secret"}},
+			},
+			Valid: true,
+		},
+		{
+			Name: "SSL Certificate with Data but no Password",
+			Input: map[string]interface{}{
+				"sku":             sku("Standard", 2),
+				"ssl_certificate": []interface{}{map[string]interface{}{"name": "cert1", "data": "abc123"}},
+			},
+			Valid: false,
+		},
+		{
+			Name: "SSL Certificate without Data or a Key Vault Secret",
+			Input: map[string]interface{}{
+				"sku":             sku("Standard", 2),
+				"ssl_certificate": []interface{}{map[string]interface{}{"name": "cert1"}},
+			},
+			Valid: false,
+		},
+		{
+			Name: "SSL Certificate from Key Vault",
+			Input: map[string]interface{}{
+				"sku":             sku("Standard_v2", 2),
+				"identity":        identity,
+				"ssl_certificate": []interface{}{map[string]interface{}{"name": "cert1", "key_vault_secret_id": secretID}},
+			},
+			Valid: true,
+		},
+		{
+			Name: "SSL Certificate from Key Vault without an Identity",
+			Input: map[string]interface{}{
+				"sku":             sku("Standard_v2", 2),
+				"ssl_certificate": []interface{}{map[string]interface{}{"name": "cert1", "key_vault_secret_id": secretID}},
+			},
+			Valid: false,
+		},
+		{
+			Name: "SSL Certificate with both Data and a Key Vault Secret",
+			Input: map[string]interface{}{
+				"sku":             sku("Standard_v2", 2),
+				"identity":        identity,
+				"ssl_certificate": []interface{}{map[string]interface{}{"name": "cert1", "data": "abc123", "password": "secret", "key_vault_secret_id": secretID}},
+			},
+			Valid: false,
+		},
+	}
+
+	for _, v := range cases {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		err := testCustomizeDiff(t, resource, v.Input)
+		if v.Valid && err != nil {
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+		if !v.Valid && err == nil {
+			t.Fatalf("Expected an error but didn't get one")
+		}
+	}
+}
+
+func TestAzureFirewallCustomizeDiff(t *testing.T) {
+	optionalComputedString := &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		Computed: true,
+	}
+
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"location": SchemaLocation(),
+			"ip_configuration": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"subnet_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"public_ip_address_id":          optionalComputedString,
+						"internal_public_ip_address_id": optionalComputedString,
+					},
+				},
+			},
+			"management_ip_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"subnet_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"public_ip_address_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"zones": SchemaZones(),
+		},
+		CustomizeDiff: CustomizeDiffAll(ZonesCustomizeDiff, AzureFirewallCustomizeDiff),
+	}
+
+	networkId := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1"
+	otherNetworkId := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network2"
+	publicIPAddressId := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/publicIPAddresses/firewall"
+	managementPublicIPAddressId := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/publicIPAddresses/management"
+
+	ipConfiguration := func(key string, publicIPAddressId string) []interface{} {
+		return []interface{}{
+			map[string]interface{}{
+				"name":      "configuration",
+				"subnet_id": networkId + "/subnets/AzureFirewallSubnet",
+				key:         publicIPAddressId,
+			},
+		}
+	}
+	managementIPConfiguration := func(networkId string, publicIPAddressId string) []interface{} {
+		return []interface{}{
+			map[string]interface{}{
+				"name":                 "management",
+				"subnet_id":            networkId + "/subnets/AzureFirewallManagementSubnet",
+				"public_ip_address_id": publicIPAddressId,
+			},
+		}
+	}
+
+	cases := []struct {
+		Name  string
+		Input map[string]interface{}
+		Valid bool
+	}{
+		{
+			Name: "Zones without a Management IP Configuration",
+			Input: map[string]interface{}{
+				"location":         "West Europe",
+				"ip_configuration": ipConfiguration("public_ip_address_id", publicIPAddressId),
+				"zones":            []interface{}{"1", "2", "3"},
+			},
+			Valid: true,
+		},
+		{
+			Name: "Zones with a Management IP Configuration",
+			Input: map[string]interface{}{
+				"location":                    "West Europe",
+				"ip_configuration":            ipConfiguration("public_ip_address_id", publicIPAddressId),
+				"management_ip_configuration": managementIPConfiguration(networkId, managementPublicIPAddressId),
+				"zones":                       []interface{}{"1", "2", "3"},
+			},
+			Valid: true,
+		},
+		{
+			Name: "Zones in a Region which doesn't support them with a Management IP Configuration",
+			Input: map[string]interface{}{
+				"location":                    "West Central US",
+				"ip_configuration":            ipConfiguration("public_ip_address_id", publicIPAddressId),
+				"management_ip_configuration": managementIPConfiguration(networkId, managementPublicIPAddressId),
+				"zones":                       []interface{}{"1"},
+			},
+			Valid: false,
+		},
+		{
+			Name: "Invalid Zone with a Management IP Configuration",
+			Input: map[string]interface{}{
+				"location":                    "West Europe",
+				"ip_configuration":            ipConfiguration("public_ip_address_id", publicIPAddressId),
+				"management_ip_configuration": managementIPConfiguration(networkId, managementPublicIPAddressId),
+				"zones":                       []interface{}{"4"},
+			},
+			Valid: false,
+		},
+		{
+			Name: "Management IP Configuration in another Virtual Network",
+			Input: map[string]interface{}{
+				"location":                    "West Europe",
+				"ip_configuration":            ipConfiguration("public_ip_address_id", publicIPAddressId),
+				"management_ip_configuration": managementIPConfiguration(otherNetworkId, managementPublicIPAddressId),
+				"zones":                       []interface{}{"1", "2", "3"},
+			},
+			Valid: false,
+		},
+		{
+			Name: "Management IP Configuration sharing the Public IP Address",
+			Input: map[string]interface{}{
+				"location":                    "West Europe",
+				"ip_configuration":            ipConfiguration("public_ip_address_id", publicIPAddressId),
+				"management_ip_configuration": managementIPConfiguration(networkId, publicIPAddressId),
+			},
+			Valid: false,
+		},
+		{
+			Name: "Management IP Configuration sharing the (deprecated) Internal Public IP Address",
+			Input: map[string]interface{}{
+				"location":                    "West Europe",
+				"ip_configuration":            ipConfiguration("internal_public_ip_address_id", publicIPAddressId),
+				"management_ip_configuration": managementIPConfiguration(networkId, strings.ToLower(publicIPAddressId)),
+			},
+			Valid: false,
+		},
+	}
+
+	for _, v := range cases {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		raw, err := config.NewRawConfig(v.Input)
+		if err != nil {
+			t.Fatalf("Error building the config: %+v", err)
+		}
+		resourceConfig := terraform.NewResourceConfig(raw)
+
+		// the schema validation is run by Terraform Core prior to the diff
+		_, validationErrors := resource.Validate(resourceConfig)
+		if len(validationErrors) == 0 {
+			_, err = resource.Diff(nil, resourceConfig, nil)
+		}
+
+		valid := len(validationErrors) == 0 && err == nil
+		if v.Valid && !valid {
+			t.Fatalf("Expected no error but got: %+v / %+v", validationErrors, err)
+		}
+		if !v.Valid && valid {
+			t.Fatalf("Expected an error but didn't get one")
+		}
+	}
+}
+
+func testKubernetesAgentPoolSchema(countKey string) map[string]*schema.Schema {
+	optionalInt := &schema.Schema{
+		Type:     schema.TypeInt,
+		Optional: true,
+	}
+	optionalString := &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+	}
+
+	return map[string]*schema.Schema{
+		"name":    optionalString,
+		"type":    optionalString,
+		"os_type": optionalString,
+		countKey:  optionalInt,
+		"enable_auto_scaling": {
+			Type:     schema.TypeBool,
+			Optional: true,
+		},
+		"min_count": optionalInt,
+		"max_count": optionalInt,
+		"availability_zones": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+	}
+}
+
+func TestKubernetesClusterCustomizeDiff(t *testing.T) {
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"agent_pool_profile": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: testKubernetesAgentPoolSchema("count"),
+				},
+			},
+		},
+		CustomizeDiff: KubernetesClusterCustomizeDiff,
+	}
+
+	pool := func(values map[string]interface{}) map[string]interface{} {
+		profile := map[string]interface{}{
+			"name":    "default",
+			"type":    "AvailabilitySet",
+			"os_type": "Linux",
+			"count":   1,
+		}
+		for k, v := range values {
+			profile[k] = v
+		}
+
+		return map[string]interface{}{
+			"agent_pool_profile": []interface{}{profile},
+		}
+	}
+
+	cases := []struct {
+		Name  string
+		Input map[string]interface{}
+		Valid bool
+	}{
+		{
+			Name:  "Availability Set",
+			Input: pool(nil),
+			Valid: true,
+		},
+		{
+			Name:  "Availability Set with Autoscaling",
+			Input: pool(map[string]interface{}{"enable_auto_scaling": true, "min_count": 1, "max_count": 3}),
+			Valid: false,
+		},
+		{
+			Name:  "Availability Set with Availability Zones",
+			Input: pool(map[string]interface{}{"availability_zones": []interface{}{"1", "2"}}),
+			Valid: false,
+		},
+		{
+			Name:  "Virtual Machine Scale Sets with Autoscaling and Availability Zones",
+			Input: pool(map[string]interface{}{"type": "VirtualMachineScaleSets", "enable_auto_scaling": true, "min_count": 1, "max_count": 3, "availability_zones": []interface{}{"1", "2"}}),
+			Valid: true,
+		},
+		{
+			Name:  "Windows",
+			Input: pool(map[string]interface{}{"type": "VirtualMachineScaleSets", "os_type": "Windows"}),
+			Valid: false,
+		},
+	}
+
+	for _, v := range cases {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		err := testCustomizeDiff(t, resource, v.Input)
+		if v.Valid && err != nil {
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+		if !v.Valid && err == nil {
+			t.Fatalf("Expected an error but didn't get one")
+		}
+	}
+}
+
+func TestKubernetesClusterAccessCustomizeDiff(t *testing.T) {
+	optionalString := &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+	}
+
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"service_principal": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"client_id":     optionalString,
+						"client_secret": optionalString,
+					},
+				},
+			},
+			"identity": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": optionalString,
+					},
+				},
+			},
+			"api_server_authorized_ip_ranges": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"private_cluster_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"network_profile": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"load_balancer_sku": optionalString,
+					},
+				},
+			},
+		},
+		CustomizeDiff: KubernetesClusterAccessCustomizeDiff,
+	}
+
+	servicePrincipal := []interface{}{
+		map[string]interface{}{"client_id": "00000000-0000-0000-0000-000000000000", "client_secret": "secret"},
+	}
+	identity := []interface{}{
+		map[string]interface{}{"type": "SystemAssigned"},
+	}
+	networkProfile := func(sku string) []interface{} {
+		return []interface{}{
+			map[string]interface{}{"load_balancer_sku": sku},
+		}
+	}
+
+	cases := []struct {
+		Name  string
+		Input map[string]interface{}
+		Valid bool
+	}{
+		{
+			Name:  "Service Principal",
+			Input: map[string]interface{}{"service_principal": servicePrincipal},
+			Valid: true,
+		},
+		{
+			Name:  "Managed Identity",
+			Input: map[string]interface{}{"identity": identity},
+			Valid: true,
+		},
+		{
+			Name:  "Neither a Service Principal or a Managed Identity",
+			Input: map[string]interface{}{},
+			Valid: false,
+		},
+		{
+			Name:  "Authorized IP Ranges",
+			Input: map[string]interface{}{"identity": identity, "api_server_authorized_ip_ranges": []interface{}{"10.0.0.0/16"}, "network_profile": networkProfile("Standard")},
+			Valid: true,
+		},
+		{
+			Name:  "Authorized IP Ranges with a Basic Load Balancer",
+			Input: map[string]interface{}{"identity": identity, "api_server_authorized_ip_ranges": []interface{}{"10.0.0.0/16"}, "network_profile": networkProfile("basic")},
+			Valid: false,
+		},
+		{
+			Name:  "Private Cluster",
+			Input: map[string]interface{}{"service_principal": servicePrincipal, "private_cluster_enabled": true, "network_profile": networkProfile("standard")},
+			Valid: true,
+		},
+		{
+			Name:  "Private Cluster with a Basic Load Balancer",
+			Input: map[string]interface{}{"service_principal": servicePrincipal, "private_cluster_enabled": true, "network_profile": networkProfile("Basic")},
+			Valid: false,
+		},
+		{
+			Name:  "Private Cluster with Authorized IP Ranges",
+			Input: map[string]interface{}{"service_principal": servicePrincipal, "private_cluster_enabled": true, "api_server_authorized_ip_ranges": []interface{}{"10.0.0.0/16"}},
+			Valid: false,
+		},
+	}
+
+	for _, v := range cases {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		err := testCustomizeDiff(t, resource, v.Input)
+		if v.Valid && err != nil {
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+		if !v.Valid && err == nil {
+			t.Fatalf("Expected an error but didn't get one")
+		}
+	}
+}
+
+func TestLinuxVirtualMachineAuthenticationCustomizeDiff(t *testing.T) {
+	optionalString := &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+	}
+
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"admin_username": optionalString,
+			"admin_password": optionalString,
+			"admin_ssh_key": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"username":   optionalString,
+						"public_key": optionalString,
+					},
+				},
+			},
+			"disable_password_authentication": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+		},
+		CustomizeDiff: LinuxVirtualMachineAuthenticationCustomizeDiff,
+	}
+
+	sshKey := func(username string) []interface{} {
+		return []interface{}{
+			map[string]interface{}{"username": username, "public_key": "ssh-rsa AAAA"},
+		}
+	}
+
+	cases := []struct {
+		Name  string
+		Input map[string]interface{}
+		Valid bool
+	}{
+		{
+			Name:  "SSH Key",
+			Input: map[string]interface{}{"admin_username": "adminuser", "admin_ssh_key": sshKey("adminuser")},
+			Valid: true,
+		},
+		{
+			Name:  "SSH Key for another user",
+			Input: map[string]interface{}{"admin_username": "adminuser", "admin_ssh_key": sshKey("otheruser")},
+			Valid: false,
+		},
+		{
+			Name:  "No SSH Key",
+			Input: map[string]interface{}{"admin_username": "adminuser"},
+			Valid: false,
+		},
+		{
+			Name:  "Password with Password Authentication disabled",
+			Input: map[string]interface{}{"admin_username": "adminuser", "admin_password": "P@ssw0rd1234!", "admin_ssh_key": sshKey("adminuser")},
+			Valid: false,
+		},
+		{
+			Name:  "Password",
+			Input: map[string]interface{}{"admin_username": "adminuser", "admin_password": "P@ssw0rd1234!", "disable_password_authentication": false},
+			Valid: true,
+		},
+		{
+			Name:  "Password and SSH Key",
+			Input: map[string]interface{}{"admin_username": "adminuser", "admin_password": "P@ssw0rd1234!", "admin_ssh_key": sshKey("adminuser"), "disable_password_authentication": false},
+			Valid: true,
+		},
+		{
+			Name:  "No Password with Password Authentication enabled",
+			Input: map[string]interface{}{"admin_username": "adminuser", "disable_password_authentication": false},
+			Valid: false,
+		},
+	}
+
+	for _, v := range cases {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		err := testCustomizeDiff(t, resource, v.Input)
+		if v.Valid && err != nil {
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+		if !v.Valid && err == nil {
+			t.Fatalf("Expected an error but didn't get one")
+		}
+	}
+}
+
+func TestVirtualMachineIdentityCustomizeDiff(t *testing.T) {
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"identity": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"identity_ids": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+		CustomizeDiff: VirtualMachineIdentityCustomizeDiff,
+	}
+
+	identity := func(identityType string, identityIds ...interface{}) []interface{} {
+		return []interface{}{
+			map[string]interface{}{"type": identityType, "identity_ids": identityIds},
+		}
+	}
+	identityId := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.ManagedIdentity/userAssignedIdentities/identity1"
+
+	cases := []struct {
+		Name  string
+		Input map[string]interface{}
+		Valid bool
+	}{
+		{
+			Name:  "No Identity",
+			Input: map[string]interface{}{},
+			Valid: true,
+		},
+		{
+			Name:  "System Assigned",
+			Input: map[string]interface{}{"identity": identity("SystemAssigned")},
+			Valid: true,
+		},
+		{
+			Name:  "System Assigned with Identity IDs",
+			Input: map[string]interface{}{"identity": identity("SystemAssigned", identityId)},
+			Valid: false,
+		},
+		{
+			Name:  "User Assigned",
+			Input: map[string]interface{}{"identity": identity("UserAssigned", identityId)},
+			Valid: true,
+		},
+		{
+			Name:  "User Assigned without Identity IDs",
+			Input: map[string]interface{}{"identity": identity("SystemAssigned, UserAssigned")},
+			Valid: false,
+		},
+	}
+
+	for _, v := range cases {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		err := testCustomizeDiff(t, resource, v.Input)
+		if v.Valid && err != nil {
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+		if !v.Valid && err == nil {
+			t.Fatalf("Expected an error but didn't get one")
+		}
+	}
+}
+
+func TestKubernetesClusterNodePoolCustomizeDiff(t *testing.T) {
+	resource := &schema.Resource{
+		Schema:        testKubernetesAgentPoolSchema("node_count"),
+		CustomizeDiff: KubernetesClusterNodePoolCustomizeDiff,
+	}
+
+	cases := []struct {
+		Name  string
+		Input map[string]interface{}
+		Valid bool
+	}{
+		{
+			Name:  "Fixed Count",
+			Input: map[string]interface{}{"name": "pool1", "os_type": "Linux", "node_count": 3},
+			Valid: true,
+		},
+		{
+			Name:  "Fixed Count with a Minimum Count",
+			Input: map[string]interface{}{"name": "pool1", "os_type": "Linux", "node_count": 3, "min_count": 1},
+			Valid: false,
+		},
+		{
+			Name:  "Autoscaling",
+			Input: map[string]interface{}{"name": "pool1", "os_type": "Linux", "node_count": 2, "enable_auto_scaling": true, "min_count": 1, "max_count": 5},
+			Valid: true,
+		},
+		{
+			Name:  "Autoscaling without a Maximum Count",
+			Input: map[string]interface{}{"name": "pool1", "os_type": "Linux", "node_count": 2, "enable_auto_scaling": true, "min_count": 1},
+			Valid: false,
+		},
+		{
+			Name:  "Autoscaling with a Minimum Count larger than the Maximum Count",
+			Input: map[string]interface{}{"name": "pool1", "os_type": "Linux", "node_count": 2, "enable_auto_scaling": true, "min_count": 5, "max_count": 2},
+			Valid: false,
+		},
+		{
+			Name:  "Autoscaling with a Count outside of the range",
+			Input: map[string]interface{}{"name": "pool1", "os_type": "Linux", "node_count": 10, "enable_auto_scaling": true, "min_count": 1, "max_count": 5},
+			Valid: false,
+		},
+		{
+			Name:  "Windows",
+			Input: map[string]interface{}{"name": "win1", "os_type": "Windows", "node_count": 1, "availability_zones": []interface{}{"1"}},
+			Valid: true,
+		},
+		{
+			Name:  "Windows with too long a name",
+			Input: map[string]interface{}{"name": "windows1", "os_type": "Windows", "node_count": 1},
+			Valid: false,
+		},
+	}
+
+	for _, v := range cases {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		err := testCustomizeDiff(t, resource, v.Input)
+		if v.Valid && err != nil {
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+		if !v.Valid && err == nil {
+			t.Fatalf("Expected an error but didn't get one")
+		}
+	}
+}
+
+func TestStorageManagementPolicyCustomizeDiff(t *testing.T) {
+	days := func() *schema.Schema {
+		return &schema.Schema{
+			Type:     schema.TypeInt,
+			Optional: true,
+			Default:  -1,
+		}
+	}
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"rule": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"actions": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"base_blob": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"tier_to_cool_after_days_since_modification_greater_than":    days(),
+												"tier_to_archive_after_days_since_modification_greater_than": days(),
+												"delete_after_days_since_modification_greater_than":          days(),
+											},
+										},
+									},
+									"snapshot": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"delete_after_days_since_creation_greater_than": days(),
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		CustomizeDiff: StorageManagementPolicyCustomizeDiff,
+	}
+	rule := func(name string, baseBlob map[string]interface{}, snapshot map[string]interface{}) map[string]interface{} {
+		actions := map[string]interface{}{}
+		if baseBlob != nil {
+			actions["base_blob"] = []interface{}{baseBlob}
+		}
+		if snapshot != nil {
+			actions["snapshot"] = []interface{}{snapshot}
+		}
+		return map[string]interface{}{
+			"name":    name,
+			"actions": []interface{}{actions},
+		}
+	}
+
+	cases := []struct {
+		Name  string
+		Input map[string]interface{}
+		Valid bool
+	}{
+		{
+			Name: "Tiered in order",
+			Input: map[string]interface{}{"rule": []interface{}{
+				rule("rule1", map[string]interface{}{
+					"tier_to_cool_after_days_since_modification_greater_than":    10,
+					"tier_to_archive_after_days_since_modification_greater_than": 50,
+					"delete_after_days_since_modification_greater_than":          100,
+				}, nil),
+			}},
+			Valid: true,
+		},
+		{
+			Name: "Snapshot only",
+			Input: map[string]interface{}{"rule": []interface{}{
+				rule("rule1", nil, map[string]interface{}{"delete_after_days_since_creation_greater_than": 0}),
+			}},
+			Valid: true,
+		},
+		{
+			Name: "No actions",
+			Input: map[string]interface{}{"rule": []interface{}{
+				rule("rule1", map[string]interface{}{}, nil),
+			}},
+			Valid: false,
+		},
+		{
+			Name: "Archived before Cool",
+			Input: map[string]interface{}{"rule": []interface{}{
+				rule("rule1", map[string]interface{}{
+					"tier_to_cool_after_days_since_modification_greater_than":    50,
+					"tier_to_archive_after_days_since_modification_greater_than": 10,
+				}, nil),
+			}},
+			Valid: false,
+		},
+		{
+			Name: "Deleted before Archived",
+			Input: map[string]interface{}{"rule": []interface{}{
+				rule("rule1", map[string]interface{}{
+					"tier_to_archive_after_days_since_modification_greater_than": 50,
+					"delete_after_days_since_modification_greater_than":          50,
+				}, nil),
+			}},
+			Valid: false,
+		},
+		{
+			Name: "Duplicate names",
+			Input: map[string]interface{}{"rule": []interface{}{
+				rule("rule1", map[string]interface{}{"delete_after_days_since_modification_greater_than": 10}, nil),
+				rule("rule1", nil, map[string]interface{}{"delete_after_days_since_creation_greater_than": 10}),
+			}},
+			Valid: false,
+		},
+	}
+
+	for _, v := range cases {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		err := testCustomizeDiff(t, resource, v.Input)
+		if v.Valid && err != nil {
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+		if !v.Valid && err == nil {
+			t.Fatalf("Expected an error but didn't get one")
+		}
+	}
+}
+
+func TestKeyVaultCustomizeDiff(t *testing.T) {
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"soft_delete_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"purge_protection_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+		},
+		CustomizeDiff: KeyVaultCustomizeDiff,
+	}
+
+	cases := []struct {
+		Name  string
+		Input map[string]interface{}
+		Valid bool
+	}{
+		{
+			Name:  "Neither",
+			Input: map[string]interface{}{},
+			Valid: true,
+		},
+		{
+			Name:  "Soft Delete",
+			Input: map[string]interface{}{"soft_delete_enabled": true},
+			Valid: true,
+		},
+		{
+			Name:  "Soft Delete and Purge Protection",
+			Input: map[string]interface{}{"soft_delete_enabled": true, "purge_protection_enabled": true},
+			Valid: true,
+		},
+		{
+			Name:  "Purge Protection without Soft Delete",
+			Input: map[string]interface{}{"purge_protection_enabled": true},
+			Valid: false,
+		},
+	}
+
+	for _, v := range cases {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		err := testCustomizeDiff(t, resource, v.Input)
+		if v.Valid && err != nil {
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+		if !v.Valid && err == nil {
+			t.Fatalf("Expected an error but didn't get one")
+		}
+	}
+}
@@ -0,0 +1,185 @@
+package azure
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-08-01/network"
+	"github.com/Azure/go-autorest/autorest"
+	az "github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// azureFirewallAPIVersion is the version of the Network API which supports the Threat Intelligence Mode,
+// Availability Zones and the Management IP Configuration of an Azure Firewall
+const azureFirewallAPIVersion = "2020-05-01"
+
+// AzureFirewallsClient is a client for Azure Firewalls which supports the properties which aren't available in the
+// version of the Network SDK we're using. Since the Rule Collections are managed by updating the Firewall, every
+// request for a Firewall is sent using this client, so that these properties aren't reset when it's updated
+type AzureFirewallsClient struct {
+	network.BaseClient
+}
+
+// NewAzureFirewallsClient returns an AzureFirewallsClient built on the (configured) SDK client
+func NewAzureFirewallsClient(client network.AzureFirewallsClient) AzureFirewallsClient {
+	return AzureFirewallsClient{
+		BaseClient: client.BaseClient,
+	}
+}
+
+// AzureFirewall is an Azure Firewall
+type AzureFirewall struct {
+	autorest.Response              `json:"-"`
+	*AzureFirewallPropertiesFormat `json:"properties,omitempty"`
+	Zones                          *[]string          `json:"zones,omitempty"`
+	Etag                           *string            `json:"etag,omitempty"`
+	ID                             *string            `json:"id,omitempty"`
+	Name                           *string            `json:"name,omitempty"`
+	Type                           *string            `json:"type,omitempty"`
+	Location                       *string            `json:"location,omitempty"`
+	Tags                           map[string]*string `json:"tags"`
+}
+
+// AzureFirewallPropertiesFormat are the properties of an Azure Firewall
+type AzureFirewallPropertiesFormat struct {
+	ApplicationRuleCollections *[]network.AzureFirewallApplicationRuleCollection `json:"applicationRuleCollections,omitempty"`
+	NatRuleCollections         *[]network.AzureFirewallNatRuleCollection         `json:"natRuleCollections,omitempty"`
+	NetworkRuleCollections     *[]network.AzureFirewallNetworkRuleCollection     `json:"networkRuleCollections,omitempty"`
+	IPConfigurations           *[]network.AzureFirewallIPConfiguration           `json:"ipConfigurations,omitempty"`
+
+	// ManagementIPConfiguration is the IP Configuration used for the management traffic of a Firewall
+	// which is force tunnelling its traffic - which must be within the `AzureFirewallManagementSubnet`
+	ManagementIPConfiguration *network.AzureFirewallIPConfiguration `json:"managementIpConfiguration,omitempty"`
+
+	// ThreatIntelMode is the operation mode for Threat Intelligence - one of `Alert`, `Deny` or `Off`
+	ThreatIntelMode   string                    `json:"threatIntelMode,omitempty"`
+	ProvisioningState network.ProvisioningState `json:"provisioningState,omitempty"`
+}
+
+// CreateOrUpdate creates or updates the specified Azure Firewall
+func (client AzureFirewallsClient) CreateOrUpdate(ctx context.Context, resourceGroup string, name string, parameters AzureFirewall) (result az.Future, err error) {
+	req, err := client.preparer(ctx, resourceGroup, name,
+		autorest.AsPut(),
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.WithJSON(parameters))
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "azure.AzureFirewallsClient", "CreateOrUpdate", nil, "Failure preparing request")
+	}
+
+	resp, err := client.send(req)
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "azure.AzureFirewallsClient", "CreateOrUpdate", resp, "Failure sending request")
+	}
+
+	return az.NewFutureFromResponse(resp)
+}
+
+// Get retrieves the specified Azure Firewall
+func (client AzureFirewallsClient) Get(ctx context.Context, resourceGroup string, name string) (result AzureFirewall, err error) {
+	req, err := client.preparer(ctx, resourceGroup, name, autorest.AsGet())
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "azure.AzureFirewallsClient", "Get", nil, "Failure preparing request")
+	}
+
+	resp, err := client.send(req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		return result, autorest.NewErrorWithError(err, "azure.AzureFirewallsClient", "Get", resp, "Failure sending request")
+	}
+
+	err = autorest.Respond(
+		resp,
+		client.ByInspecting(),
+		az.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "azure.AzureFirewallsClient", "Get", resp, "Failure responding to request")
+	}
+
+	return result, nil
+}
+
+// Delete deletes the specified Azure Firewall
+func (client AzureFirewallsClient) Delete(ctx context.Context, resourceGroup string, name string) (result az.Future, err error) {
+	req, err := client.preparer(ctx, resourceGroup, name, autorest.AsDelete())
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "azure.AzureFirewallsClient", "Delete", nil, "Failure preparing request")
+	}
+
+	resp, err := client.send(req)
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "azure.AzureFirewallsClient", "Delete", resp, "Failure sending request")
+	}
+
+	return az.NewFutureFromResponse(resp)
+}
+
+func (client AzureFirewallsClient) preparer(ctx context.Context, resourceGroup string, name string, decorators ...autorest.PrepareDecorator) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": azureFirewallAPIVersion,
+	}
+
+	decorators = append(decorators,
+		autorest.WithBaseURL(client.BaseURI),
+		autorest.WithPath(NewFirewallID(client.SubscriptionID, resourceGroup, name).ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return autorest.CreatePreparer(decorators...).Prepare((&http.Request{}).WithContext(ctx))
+}
+
+func (client AzureFirewallsClient) send(req *http.Request) (*http.Response, error) {
+	return autorest.SendWithSender(client, req)
+}
+
+// AzureFirewallCustomizeDiff validates at plan time that the Management IP Configuration of a Firewall which is force
+// tunnelling its traffic is within the same Virtual Network as the Firewall, using a different Public IP Address
+func AzureFirewallCustomizeDiff(d *schema.ResourceDiff, _ interface{}) error {
+	configs := d.Get("management_ip_configuration").([]interface{})
+	if len(configs) == 0 || configs[0] == nil {
+		return nil
+	}
+
+	if !valuesKnown(d, "ip_configuration.0.subnet_id", "management_ip_configuration.0.subnet_id", "management_ip_configuration.0.public_ip_address_id") {
+		return nil
+	}
+
+	management := configs[0].(map[string]interface{})
+
+	ipConfigs := d.Get("ip_configuration").([]interface{})
+	if len(ipConfigs) == 0 || ipConfigs[0] == nil {
+		return nil
+	}
+	ipConfig := ipConfigs[0].(map[string]interface{})
+
+	subnetId, err := ParseSubnetID(ipConfig["subnet_id"].(string))
+	if err != nil {
+		return nil
+	}
+	managementSubnetId, err := ParseSubnetID(management["subnet_id"].(string))
+	if err != nil {
+		return nil
+	}
+
+	virtualNetworkId := NewVirtualNetworkID(subnetId.SubscriptionID, subnetId.ResourceGroup, subnetId.VirtualNetworkName).ID()
+	managementVirtualNetworkId := NewVirtualNetworkID(managementSubnetId.SubscriptionID, managementSubnetId.ResourceGroup, managementSubnetId.VirtualNetworkName).ID()
+	if !strings.EqualFold(virtualNetworkId, managementVirtualNetworkId) {
+		return fmt.Errorf("the `subnet_id` within the `management_ip_configuration` block must be in the same Virtual Network as the `subnet_id` within the `ip_configuration` block (%q)", virtualNetworkId)
+	}
+
+	// only one of these is specified, the other being computed from it
+	for _, key := range []string{"public_ip_address_id", "internal_public_ip_address_id"} {
+		if !valuesKnown(d, "ip_configuration.0."+key) {
+			continue
+		}
+
+		if v := ipConfig[key].(string); v != "" && strings.EqualFold(v, management["public_ip_address_id"].(string)) {
+			return fmt.Errorf("the `public_ip_address_id` within the `management_ip_configuration` block must be a different Public IP Address to the one used by the `ip_configuration` block")
+		}
+	}
+
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/Azure/azure-sdk-for-go/services/keyvault/mgmt/2018-02-14/keyvault"
+	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -119,3 +120,59 @@ func KeyVaultExists(ctx context.Context, client keyvault.VaultsClient, keyVaultI
 
 	return true, nil
 }
+
+// KeyVaultSoftDeleteEnabled returns whether Soft Delete and Purge Protection are enabled on the specified Key Vault
+func KeyVaultSoftDeleteEnabled(ctx context.Context, client keyvault.VaultsClient, keyVaultId string) (bool, bool, error) {
+	id, err := ParseAzureResourceID(keyVaultId)
+	if err != nil {
+		return false, false, err
+	}
+	resourceGroup := id.ResourceGroup
+
+	vaultName, ok := id.Path["vaults"]
+	if !ok {
+		return false, false, fmt.Errorf("resource id does not contain `vaults`: %q", keyVaultId)
+	}
+
+	resp, err := client.Get(ctx, resourceGroup, vaultName)
+	if err != nil {
+		return false, false, fmt.Errorf("Error making Read request on KeyVault %q (Resource Group %q): %+v", vaultName, resourceGroup, err)
+	}
+
+	softDeleteEnabled := false
+	purgeProtectionEnabled := false
+	if props := resp.Properties; props != nil {
+		if props.EnableSoftDelete != nil {
+			softDeleteEnabled = *props.EnableSoftDelete
+		}
+		if props.EnablePurgeProtection != nil {
+			purgeProtectionEnabled = *props.EnablePurgeProtection
+		}
+	}
+
+	return softDeleteEnabled, purgeProtectionEnabled, nil
+}
+
+// KeyVaultCustomizeDiff validates at plan time that Purge Protection is only enabled alongside Soft Delete, and
+// that neither are disabled once they've been enabled - since the API doesn't allow this
+func KeyVaultCustomizeDiff(d *schema.ResourceDiff, _ interface{}) error {
+	if !valuesKnown(d, "soft_delete_enabled", "purge_protection_enabled") {
+		return nil
+	}
+
+	if d.Get("purge_protection_enabled").(bool) && !d.Get("soft_delete_enabled").(bool) {
+		return fmt.Errorf("`soft_delete_enabled` must be set to `true` when `purge_protection_enabled` is enabled")
+	}
+
+	if d.Id() == "" {
+		return nil
+	}
+
+	for _, key := range []string{"soft_delete_enabled", "purge_protection_enabled"} {
+		if old, new := d.GetChange(key); old.(bool) && !new.(bool) {
+			return fmt.Errorf("`%s` cannot be disabled once it's been enabled on a Key Vault", key)
+		}
+	}
+
+	return nil
+}
@@ -1,12 +1,28 @@
 package azure
 
 import (
+	"context"
 	"fmt"
+	"log"
 	"net/url"
 	"regexp"
 	"strings"
+	"time"
 
+	"github.com/Azure/azure-sdk-for-go/services/keyvault/2016-10-01/keyvault"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// KeyVaultChildType is the type of a Certificate, Key or Secret within a Key Vault
+type KeyVaultChildType string
+
+const (
+	KeyVaultChildTypeCertificate KeyVaultChildType = "Certificate"
+	KeyVaultChildTypeKey         KeyVaultChildType = "Key"
+	KeyVaultChildTypeSecret      KeyVaultChildType = "Secret"
 )
 
 type KeyVaultChildID struct {
@@ -72,3 +88,143 @@ func ValidateKeyVaultChildId(i interface{}, k string) (warnings []string, errors
 
 	return warnings, errors
 }
+
+// KeyVaultChildIsSoftDeleted returns whether a soft-deleted Certificate, Key or Secret with the specified name exists
+// within the Key Vault - which prevents a new one being created with the same name until it's been recovered or purged
+func KeyVaultChildIsSoftDeleted(ctx context.Context, client keyvault.BaseClient, childType KeyVaultChildType, keyVaultBaseUrl, name string) (bool, error) {
+	resp, err := getDeletedKeyVaultChild(ctx, client, childType, keyVaultBaseUrl, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			return false, nil
+		}
+
+		return false, fmt.Errorf("Error checking for a soft-deleted %s %q in Key Vault %q: %+v", childType, name, keyVaultBaseUrl, err)
+	}
+
+	return true, nil
+}
+
+// RecoverSoftDeletedKeyVaultChild recovers a soft-deleted Certificate, Key or Secret, waiting until it's available
+func RecoverSoftDeletedKeyVaultChild(ctx context.Context, client keyvault.BaseClient, childType KeyVaultChildType, keyVaultBaseUrl, name string) error {
+	log.Printf("[DEBUG] Recovering soft-deleted %s %q in Key Vault %q", childType, name, keyVaultBaseUrl)
+
+	var err error
+	switch childType {
+	case KeyVaultChildTypeCertificate:
+		_, err = client.RecoverDeletedCertificate(ctx, keyVaultBaseUrl, name)
+	case KeyVaultChildTypeKey:
+		_, err = client.RecoverDeletedKey(ctx, keyVaultBaseUrl, name)
+	case KeyVaultChildTypeSecret:
+		_, err = client.RecoverDeletedSecret(ctx, keyVaultBaseUrl, name)
+	default:
+		return fmt.Errorf("Unsupported Key Vault Child Type %q", childType)
+	}
+	if err != nil {
+		return fmt.Errorf("Error recovering soft-deleted %s %q in Key Vault %q: %+v", childType, name, keyVaultBaseUrl, err)
+	}
+
+	// recovery happens asynchronously, so we need to wait for it to become available again
+	stateConf := &resource.StateChangeConf{
+		Pending:                   []string{"pending"},
+		Target:                    []string{"available"},
+		Refresh:                   keyVaultChildRefreshFunc(ctx, client, childType, keyVaultBaseUrl, name, getKeyVaultChild),
+		MinTimeout:                5 * time.Second,
+		ContinuousTargetOccurence: 3,
+		Timeout:                   10 * time.Minute,
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		stateConf.Timeout = time.Until(deadline)
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for soft-deleted %s %q in Key Vault %q to be recovered: %+v", childType, name, keyVaultBaseUrl, err)
+	}
+
+	return nil
+}
+
+// PurgeSoftDeletedKeyVaultChild permanently deletes a Certificate, Key or Secret which has been deleted from a Key Vault
+// with Soft Delete enabled - waiting until it's been soft-deleted (since deletion is asynchronous) before purging it
+func PurgeSoftDeletedKeyVaultChild(ctx context.Context, client keyvault.BaseClient, childType KeyVaultChildType, keyVaultBaseUrl, name string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"pending"},
+		Target:     []string{"available"},
+		Refresh:    keyVaultChildRefreshFunc(ctx, client, childType, keyVaultBaseUrl, name, getDeletedKeyVaultChild),
+		MinTimeout: 5 * time.Second,
+		Timeout:    10 * time.Minute,
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		stateConf.Timeout = time.Until(deadline)
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for %s %q in Key Vault %q to be soft-deleted: %+v", childType, name, keyVaultBaseUrl, err)
+	}
+
+	log.Printf("[DEBUG] Purging soft-deleted %s %q in Key Vault %q", childType, name, keyVaultBaseUrl)
+
+	var err error
+	switch childType {
+	case KeyVaultChildTypeCertificate:
+		_, err = client.PurgeDeletedCertificate(ctx, keyVaultBaseUrl, name)
+	case KeyVaultChildTypeKey:
+		_, err = client.PurgeDeletedKey(ctx, keyVaultBaseUrl, name)
+	case KeyVaultChildTypeSecret:
+		_, err = client.PurgeDeletedSecret(ctx, keyVaultBaseUrl, name)
+	}
+	if err != nil {
+		return fmt.Errorf("Error purging soft-deleted %s %q in Key Vault %q: %+v", childType, name, keyVaultBaseUrl, err)
+	}
+
+	return nil
+}
+
+type keyVaultChildGetFunc func(ctx context.Context, client keyvault.BaseClient, childType KeyVaultChildType, keyVaultBaseUrl, name string) (autorest.Response, error)
+
+func getKeyVaultChild(ctx context.Context, client keyvault.BaseClient, childType KeyVaultChildType, keyVaultBaseUrl, name string) (autorest.Response, error) {
+	// an empty version retrieves the latest version
+	switch childType {
+	case KeyVaultChildTypeCertificate:
+		resp, err := client.GetCertificate(ctx, keyVaultBaseUrl, name, "")
+		return resp.Response, err
+	case KeyVaultChildTypeKey:
+		resp, err := client.GetKey(ctx, keyVaultBaseUrl, name, "")
+		return resp.Response, err
+	case KeyVaultChildTypeSecret:
+		resp, err := client.GetSecret(ctx, keyVaultBaseUrl, name, "")
+		return resp.Response, err
+	}
+
+	return autorest.Response{}, fmt.Errorf("Unsupported Key Vault Child Type %q", childType)
+}
+
+func getDeletedKeyVaultChild(ctx context.Context, client keyvault.BaseClient, childType KeyVaultChildType, keyVaultBaseUrl, name string) (autorest.Response, error) {
+	switch childType {
+	case KeyVaultChildTypeCertificate:
+		resp, err := client.GetDeletedCertificate(ctx, keyVaultBaseUrl, name)
+		return resp.Response, err
+	case KeyVaultChildTypeKey:
+		resp, err := client.GetDeletedKey(ctx, keyVaultBaseUrl, name)
+		return resp.Response, err
+	case KeyVaultChildTypeSecret:
+		resp, err := client.GetDeletedSecret(ctx, keyVaultBaseUrl, name)
+		return resp.Response, err
+	}
+
+	return autorest.Response{}, fmt.Errorf("Unsupported Key Vault Child Type %q", childType)
+}
+
+func keyVaultChildRefreshFunc(ctx context.Context, client keyvault.BaseClient, childType KeyVaultChildType, keyVaultBaseUrl, name string, get keyVaultChildGetFunc) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := get(ctx, client, childType, keyVaultBaseUrl, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp) {
+				return resp, "pending", nil
+			}
+
+			return nil, "", fmt.Errorf("Error retrieving %s %q in Key Vault %q: %+v", childType, name, keyVaultBaseUrl, err)
+		}
+
+		return resp, "available", nil
+	}
+}
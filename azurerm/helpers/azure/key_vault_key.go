@@ -0,0 +1,58 @@
+package azure
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// KeyVaultKeyRotationDue returns whether the current version of a Key Vault Key, created at the specified time (in
+// RFC3339 format), is older than the number of days after which it should be rotated
+func KeyVaultKeyRotationDue(created string, rotateAfterDays int, now time.Time) (bool, error) {
+	if created == "" || rotateAfterDays <= 0 {
+		return false, nil
+	}
+
+	createdAt, err := time.Parse(time.RFC3339, created)
+	if err != nil {
+		return false, fmt.Errorf("Error parsing the creation time %q of the Key Vault Key: %+v", created, err)
+	}
+
+	return now.After(createdAt.Add(time.Duration(rotateAfterDays) * 24 * time.Hour)), nil
+}
+
+// KeyVaultKeyRotationCustomizeDiff determines at plan time whether the current version of a Key Vault Key is older
+// than its `rotation_policy` allows - in which case the version (and the attributes which depend on it) are marked as
+// changing, so that a new version of the Key is created when this is applied
+func KeyVaultKeyRotationCustomizeDiff(d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" || !valuesKnown(d, "rotation_policy") {
+		return nil
+	}
+
+	policies := d.Get("rotation_policy").([]interface{})
+	if len(policies) == 0 || policies[0] == nil {
+		return nil
+	}
+	policy := policies[0].(map[string]interface{})
+	rotateAfterDays := policy["rotate_after_days"].(int)
+
+	created := d.Get("created").(string)
+	due, err := KeyVaultKeyRotationDue(created, rotateAfterDays, time.Now())
+	if err != nil {
+		return err
+	}
+	if !due {
+		return nil
+	}
+
+	log.Printf("[DEBUG] Key Vault Key %q was created at %q and is due to be rotated after %d days", d.Id(), created, rotateAfterDays)
+	for _, key := range []string{"version", "created", "n", "e"} {
+		if err := d.SetNewComputed(key); err != nil {
+			return fmt.Errorf("Error marking `%s` as changing: %+v", key, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,75 @@
+package azure
+
+import (
+	"testing"
+	"time"
+)
+
+func TestKeyVaultKeyRotationDue(t *testing.T) {
+	now := time.Date(2019, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		Name            string
+		Created         string
+		RotateAfterDays int
+		Expected        bool
+		ExpectError     bool
+	}{
+		{
+			Name:            "Not Yet Created",
+			Created:         "",
+			RotateAfterDays: 90,
+			Expected:        false,
+		},
+		{
+			Name:            "Rotation Disabled",
+			Created:         "2018-01-01T00:00:00Z",
+			RotateAfterDays: 0,
+			Expected:        false,
+		},
+		{
+			Name:            "Within Rotation Period",
+			Created:         "2019-05-01T12:00:00Z",
+			RotateAfterDays: 90,
+			Expected:        false,
+		},
+		{
+			Name:            "Exactly Rotation Period",
+			Created:         "2019-03-03T12:00:00Z",
+			RotateAfterDays: 90,
+			Expected:        false,
+		},
+		{
+			Name:            "Exceeds Rotation Period",
+			Created:         "2019-03-03T11:59:59Z",
+			RotateAfterDays: 90,
+			Expected:        true,
+		},
+		{
+			Name:            "Invalid Creation Time",
+			Created:         "yesterday",
+			RotateAfterDays: 90,
+			ExpectError:     true,
+		},
+	}
+
+	for _, v := range cases {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual, err := KeyVaultKeyRotationDue(v.Created, v.RotateAfterDays, now)
+		if err != nil {
+			if v.ExpectError {
+				continue
+			}
+
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+		if v.ExpectError {
+			t.Fatalf("Expected an error but didn't get one")
+		}
+
+		if actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
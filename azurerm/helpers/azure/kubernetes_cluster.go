@@ -0,0 +1,525 @@
+package azure
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/containerservice/mgmt/2018-03-31/containerservice"
+	"github.com/Azure/go-autorest/autorest"
+	az "github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// kubernetesClusterAPIVersion is the version of the Container Service API which supports Agent Pools backed by
+// Virtual Machine Scale Sets, with Autoscaling, Availability Zones, Taints and Windows Nodes - as well as Managed
+// Identities, Private Clusters and Authorized IP Ranges for the API Server
+const kubernetesClusterAPIVersion = "2020-02-01"
+
+// KubernetesClusterManagedIdentityClientID is the Client ID of the Service Principal Profile used by a Cluster
+// which authenticates using a Managed Identity rather than a Service Principal
+const KubernetesClusterManagedIdentityClientID = "msi"
+
+const (
+	// KubernetesAgentPoolTypeAvailabilitySet is the type of an Agent Pool backed by an Availability Set
+	KubernetesAgentPoolTypeAvailabilitySet = "AvailabilitySet"
+
+	// KubernetesAgentPoolTypeVirtualMachineScaleSets is the type of an Agent Pool backed by a Virtual Machine Scale Set
+	KubernetesAgentPoolTypeVirtualMachineScaleSets = "VirtualMachineScaleSets"
+)
+
+const (
+	// KubernetesLoadBalancerSkuBasic is the SKU of a Basic Load Balancer used by a Cluster
+	KubernetesLoadBalancerSkuBasic = "Basic"
+
+	// KubernetesLoadBalancerSkuStandard is the SKU of a Standard Load Balancer used by a Cluster, which is
+	// required for Private Clusters and Authorized IP Ranges
+	KubernetesLoadBalancerSkuStandard = "Standard"
+)
+
+// KubernetesClustersClient is a client for Managed Kubernetes Clusters and their Agent Pools which supports the
+// properties which aren't available in the version of the Container Service SDK we're using - the request for a
+// Cluster is built from the SDK model, with the additional properties merged into it before it's sent
+type KubernetesClustersClient struct {
+	containerservice.BaseClient
+}
+
+// NewKubernetesClustersClient returns a KubernetesClustersClient built on the (configured) SDK client
+func NewKubernetesClustersClient(client containerservice.ManagedClustersClient) KubernetesClustersClient {
+	return KubernetesClustersClient{
+		BaseClient: client.BaseClient,
+	}
+}
+
+// KubernetesClusterExtendedProperties are the properties of a Managed Kubernetes Cluster which aren't available
+// in the version of the Container Service SDK we're using
+type KubernetesClusterExtendedProperties struct {
+	// AgentPoolProfiles are the extended properties of each Agent Pool Profile, keyed by the name of the Agent Pool
+	AgentPoolProfiles map[string]KubernetesAgentPoolExtendedProperties
+
+	APIServerAccessProfile *KubernetesClusterAPIServerAccessProfile
+	Identity               *KubernetesClusterIdentity
+	LoadBalancerSku        string
+	WindowsProfile         *KubernetesClusterWindowsProfile
+}
+
+// KubernetesClusterAPIServerAccessProfile controls how the API Server of a Cluster can be accessed
+type KubernetesClusterAPIServerAccessProfile struct {
+	AuthorizedIPRanges   *[]string `json:"authorizedIPRanges,omitempty"`
+	EnablePrivateCluster *bool     `json:"enablePrivateCluster,omitempty"`
+}
+
+// KubernetesClusterIdentity is the Managed Identity used by a Cluster in place of a Service Principal
+type KubernetesClusterIdentity struct {
+	Type        string  `json:"type,omitempty"`
+	PrincipalID *string `json:"principalId,omitempty"`
+	TenantID    *string `json:"tenantId,omitempty"`
+}
+
+// KubernetesAgentPoolExtendedProperties are the properties of an Agent Pool which aren't available in the
+// version of the Container Service SDK we're using
+type KubernetesAgentPoolExtendedProperties struct {
+	Type                string    `json:"type,omitempty"`
+	EnableAutoScaling   *bool     `json:"enableAutoScaling,omitempty"`
+	MinCount            *int32    `json:"minCount,omitempty"`
+	MaxCount            *int32    `json:"maxCount,omitempty"`
+	AvailabilityZones   *[]string `json:"availabilityZones,omitempty"`
+	NodeTaints          *[]string `json:"nodeTaints,omitempty"`
+	OrchestratorVersion *string   `json:"orchestratorVersion,omitempty"`
+}
+
+// KubernetesClusterWindowsProfile is the Administrator account used for the Windows Nodes within a Cluster
+type KubernetesClusterWindowsProfile struct {
+	AdminUsername *string `json:"adminUsername,omitempty"`
+	AdminPassword *string `json:"adminPassword,omitempty"`
+}
+
+// KubernetesAgentPool is an Agent Pool within a Managed Kubernetes Cluster, which is managed independently of the Cluster
+type KubernetesAgentPool struct {
+	autorest.Response `json:"-"`
+
+	ID         *string                        `json:"id,omitempty"`
+	Name       *string                        `json:"name,omitempty"`
+	Properties *KubernetesAgentPoolProperties `json:"properties,omitempty"`
+}
+
+// KubernetesAgentPoolProperties are the properties of an Agent Pool
+type KubernetesAgentPoolProperties struct {
+	KubernetesAgentPoolExtendedProperties
+
+	Count             *int32  `json:"count,omitempty"`
+	VMSize            string  `json:"vmSize,omitempty"`
+	OsDiskSizeGB      *int32  `json:"osDiskSizeGB,omitempty"`
+	VnetSubnetID      *string `json:"vnetSubnetID,omitempty"`
+	MaxPods           *int32  `json:"maxPods,omitempty"`
+	OsType            string  `json:"osType,omitempty"`
+	ProvisioningState *string `json:"provisioningState,omitempty"`
+}
+
+type kubernetesClusterExtendedResponse struct {
+	Identity   *KubernetesClusterIdentity                   `json:"identity,omitempty"`
+	Properties *kubernetesClusterExtendedResponseProperties `json:"properties,omitempty"`
+}
+
+type kubernetesClusterExtendedResponseProperties struct {
+	AgentPoolProfiles      *[]kubernetesClusterAgentPoolProfile     `json:"agentPoolProfiles,omitempty"`
+	APIServerAccessProfile *KubernetesClusterAPIServerAccessProfile `json:"apiServerAccessProfile,omitempty"`
+	NetworkProfile         *kubernetesClusterNetworkProfile         `json:"networkProfile,omitempty"`
+	WindowsProfile         *KubernetesClusterWindowsProfile         `json:"windowsProfile,omitempty"`
+}
+
+type kubernetesClusterNetworkProfile struct {
+	LoadBalancerSku string `json:"loadBalancerSku,omitempty"`
+}
+
+type kubernetesClusterAgentPoolProfile struct {
+	KubernetesAgentPoolExtendedProperties
+
+	Name *string `json:"name,omitempty"`
+}
+
+// CreateOrUpdate creates or updates the specified Managed Kubernetes Cluster, including its extended properties
+func (client KubernetesClustersClient) CreateOrUpdate(ctx context.Context, resourceGroup string, name string, cluster containerservice.ManagedCluster, extended KubernetesClusterExtendedProperties) (result az.Future, err error) {
+	body, err := expandKubernetesClusterRequestBody(cluster, extended)
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "azure.KubernetesClustersClient", "CreateOrUpdate", nil, "Failure building request body")
+	}
+
+	id := NewKubernetesClusterID(client.SubscriptionID, resourceGroup, name).ID()
+	return client.put(ctx, "CreateOrUpdate", id, body)
+}
+
+// Get retrieves the specified Managed Kubernetes Cluster, along with its extended properties
+func (client KubernetesClustersClient) Get(ctx context.Context, resourceGroup string, name string) (result containerservice.ManagedCluster, extended *KubernetesClusterExtendedProperties, err error) {
+	var body json.RawMessage
+	id := NewKubernetesClusterID(client.SubscriptionID, resourceGroup, name).ID()
+	result.Response, err = client.get(ctx, "Get", id, &body)
+	if err != nil {
+		return result, nil, err
+	}
+
+	resp := result.Response
+	result, extended, err = parseKubernetesClusterResponseBody(body)
+	result.Response = resp
+	if err != nil {
+		return result, nil, autorest.NewErrorWithError(err, "azure.KubernetesClustersClient", "Get", resp.Response, "Failure parsing response body")
+	}
+
+	return result, extended, nil
+}
+
+// ResetServicePrincipalProfile updates the credentials of the Service Principal used by the specified Managed
+// Kubernetes Cluster, which (unlike the other properties of the Service Principal Profile) can't be changed by
+// updating the Cluster
+func (client KubernetesClustersClient) ResetServicePrincipalProfile(ctx context.Context, resourceGroup string, name string, profile containerservice.ManagedClusterServicePrincipalProfile) (result az.Future, err error) {
+	id := NewKubernetesClusterID(client.SubscriptionID, resourceGroup, name).ID()
+	req, err := client.preparer(ctx, id+"/resetServicePrincipalProfile",
+		autorest.AsPost(),
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.WithJSON(profile))
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "azure.KubernetesClustersClient", "ResetServicePrincipalProfile", nil, "Failure preparing request")
+	}
+
+	resp, err := client.send(req)
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "azure.KubernetesClustersClient", "ResetServicePrincipalProfile", resp, "Failure sending request")
+	}
+
+	return az.NewFutureFromResponse(resp)
+}
+
+// CreateOrUpdateAgentPool creates or updates the specified Agent Pool within a Managed Kubernetes Cluster
+func (client KubernetesClustersClient) CreateOrUpdateAgentPool(ctx context.Context, resourceGroup string, clusterName string, name string, pool KubernetesAgentPool) (result az.Future, err error) {
+	id := NewKubernetesClusterNodePoolID(client.SubscriptionID, resourceGroup, clusterName, name).ID()
+	return client.put(ctx, "CreateOrUpdateAgentPool", id, pool)
+}
+
+// GetAgentPool retrieves the specified Agent Pool within a Managed Kubernetes Cluster
+func (client KubernetesClustersClient) GetAgentPool(ctx context.Context, resourceGroup string, clusterName string, name string) (result KubernetesAgentPool, err error) {
+	id := NewKubernetesClusterNodePoolID(client.SubscriptionID, resourceGroup, clusterName, name).ID()
+	result.Response, err = client.get(ctx, "GetAgentPool", id, &result)
+	return result, err
+}
+
+// DeleteAgentPool deletes the specified Agent Pool within a Managed Kubernetes Cluster
+func (client KubernetesClustersClient) DeleteAgentPool(ctx context.Context, resourceGroup string, clusterName string, name string) (result az.Future, err error) {
+	id := NewKubernetesClusterNodePoolID(client.SubscriptionID, resourceGroup, clusterName, name).ID()
+	req, err := client.preparer(ctx, id, autorest.AsDelete())
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "azure.KubernetesClustersClient", "DeleteAgentPool", nil, "Failure preparing request")
+	}
+
+	resp, err := client.send(req)
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "azure.KubernetesClustersClient", "DeleteAgentPool", resp, "Failure sending request")
+	}
+
+	return az.NewFutureFromResponse(resp)
+}
+
+func (client KubernetesClustersClient) put(ctx context.Context, method string, id string, parameters interface{}) (result az.Future, err error) {
+	req, err := client.preparer(ctx, id,
+		autorest.AsPut(),
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.WithJSON(parameters))
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "azure.KubernetesClustersClient", method, nil, "Failure preparing request")
+	}
+
+	resp, err := client.send(req)
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "azure.KubernetesClustersClient", method, resp, "Failure sending request")
+	}
+
+	return az.NewFutureFromResponse(resp)
+}
+
+func (client KubernetesClustersClient) get(ctx context.Context, method string, id string, result interface{}) (autorest.Response, error) {
+	req, err := client.preparer(ctx, id, autorest.AsGet())
+	if err != nil {
+		return autorest.Response{}, autorest.NewErrorWithError(err, "azure.KubernetesClustersClient", method, nil, "Failure preparing request")
+	}
+
+	resp, err := client.send(req)
+	if err != nil {
+		return autorest.Response{Response: resp}, autorest.NewErrorWithError(err, "azure.KubernetesClustersClient", method, resp, "Failure sending request")
+	}
+
+	err = autorest.Respond(
+		resp,
+		client.ByInspecting(),
+		az.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(result),
+		autorest.ByClosing())
+	if err != nil {
+		return autorest.Response{Response: resp}, autorest.NewErrorWithError(err, "azure.KubernetesClustersClient", method, resp, "Failure responding to request")
+	}
+
+	return autorest.Response{Response: resp}, nil
+}
+
+func (client KubernetesClustersClient) preparer(ctx context.Context, id string, decorators ...autorest.PrepareDecorator) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": kubernetesClusterAPIVersion,
+	}
+
+	decorators = append(decorators,
+		autorest.WithBaseURL(client.BaseURI),
+		autorest.WithPath(id),
+		autorest.WithQueryParameters(queryParameters))
+	return autorest.CreatePreparer(decorators...).Prepare((&http.Request{}).WithContext(ctx))
+}
+
+func (client KubernetesClustersClient) send(req *http.Request) (*http.Response, error) {
+	return autorest.SendWithSender(client, req)
+}
+
+// expandKubernetesClusterRequestBody merges the extended properties into the request body built from the SDK model
+func expandKubernetesClusterRequestBody(cluster containerservice.ManagedCluster, extended KubernetesClusterExtendedProperties) (map[string]interface{}, error) {
+	raw, err := json.Marshal(cluster)
+	if err != nil {
+		return nil, fmt.Errorf("Error serializing Managed Kubernetes Cluster: %+v", err)
+	}
+
+	body := make(map[string]interface{})
+	if err := json.Unmarshal(raw, &body); err != nil {
+		return nil, fmt.Errorf("Error deserializing Managed Kubernetes Cluster: %+v", err)
+	}
+
+	properties, ok := body["properties"].(map[string]interface{})
+	if !ok {
+		properties = make(map[string]interface{})
+		body["properties"] = properties
+	}
+
+	if extended.Identity != nil {
+		body["identity"] = extended.Identity
+	}
+
+	if extended.APIServerAccessProfile != nil {
+		properties["apiServerAccessProfile"] = extended.APIServerAccessProfile
+	}
+
+	if extended.LoadBalancerSku != "" {
+		if networkProfile, ok := properties["networkProfile"].(map[string]interface{}); ok {
+			networkProfile["loadBalancerSku"] = extended.LoadBalancerSku
+		}
+	}
+
+	if extended.WindowsProfile != nil {
+		properties["windowsProfile"] = extended.WindowsProfile
+	}
+
+	profiles, _ := properties["agentPoolProfiles"].([]interface{})
+	for _, v := range profiles {
+		profile, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		name, _ := profile["name"].(string)
+		profileExtended, ok := extended.AgentPoolProfiles[name]
+		if !ok {
+			continue
+		}
+
+		// the extended properties sit alongside the other properties of the Agent Pool Profile
+		raw, err := json.Marshal(profileExtended)
+		if err != nil {
+			return nil, fmt.Errorf("Error serializing Agent Pool Profile %q: %+v", name, err)
+		}
+
+		values := make(map[string]interface{})
+		if err := json.Unmarshal(raw, &values); err != nil {
+			return nil, fmt.Errorf("Error deserializing Agent Pool Profile %q: %+v", name, err)
+		}
+
+		for key, value := range values {
+			profile[key] = value
+		}
+	}
+
+	return body, nil
+}
+
+// parseKubernetesClusterResponseBody parses the response body into the SDK model and the extended properties
+func parseKubernetesClusterResponseBody(body []byte) (containerservice.ManagedCluster, *KubernetesClusterExtendedProperties, error) {
+	var cluster containerservice.ManagedCluster
+	if err := json.Unmarshal(body, &cluster); err != nil {
+		return cluster, nil, fmt.Errorf("Error deserializing Managed Kubernetes Cluster: %+v", err)
+	}
+
+	var response kubernetesClusterExtendedResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return cluster, nil, fmt.Errorf("Error deserializing the extended properties of Managed Kubernetes Cluster: %+v", err)
+	}
+
+	extended := KubernetesClusterExtendedProperties{
+		AgentPoolProfiles: make(map[string]KubernetesAgentPoolExtendedProperties),
+		Identity:          response.Identity,
+	}
+
+	props := response.Properties
+	if props == nil {
+		return cluster, &extended, nil
+	}
+
+	extended.APIServerAccessProfile = props.APIServerAccessProfile
+	extended.WindowsProfile = props.WindowsProfile
+
+	if networkProfile := props.NetworkProfile; networkProfile != nil {
+		extended.LoadBalancerSku = networkProfile.LoadBalancerSku
+	}
+
+	if profiles := props.AgentPoolProfiles; profiles != nil {
+		for _, profile := range *profiles {
+			if profile.Name == nil {
+				continue
+			}
+
+			extended.AgentPoolProfiles[*profile.Name] = profile.KubernetesAgentPoolExtendedProperties
+		}
+	}
+
+	return cluster, &extended, nil
+}
+
+// KubernetesClusterCustomizeDiff validates at plan time that the features used by the Agent Pool defined within
+// a Managed Kubernetes Cluster are supported by its type - and that it's running Linux, since Windows Nodes can
+// only be added to a Cluster in additional Node Pools
+func KubernetesClusterCustomizeDiff(d *schema.ResourceDiff, _ interface{}) error {
+	prefix := "agent_pool_profile.0."
+	if _, ok := d.GetOk("agent_pool_profile"); !ok {
+		return nil
+	}
+
+	if valuesKnown(d, prefix+"os_type") && strings.EqualFold(d.Get(prefix+"os_type").(string), string(containerservice.Windows)) {
+		return fmt.Errorf("The `agent_pool_profile` must run Linux - Windows Nodes can be added using the `azurerm_kubernetes_cluster_node_pool` resource")
+	}
+
+	if !valuesKnown(d, prefix+"type") {
+		return nil
+	}
+
+	poolType := d.Get(prefix + "type").(string)
+	return validateKubernetesAgentPoolDiff(d, prefix, "count", poolType == KubernetesAgentPoolTypeVirtualMachineScaleSets)
+}
+
+// KubernetesClusterNodePoolCustomizeDiff validates at plan time the Autoscaling configuration of a Node Pool, and
+// that the name of a Windows Node Pool is short enough to be used as the prefix of the Windows computer names
+func KubernetesClusterNodePoolCustomizeDiff(d *schema.ResourceDiff, _ interface{}) error {
+	if valuesKnown(d, "name", "os_type") && strings.EqualFold(d.Get("os_type").(string), string(containerservice.Windows)) {
+		if name := d.Get("name").(string); len(name) > 6 {
+			return fmt.Errorf("The `name` of a Windows Node Pool must be at most 6 characters but got %q", name)
+		}
+	}
+
+	// Node Pools managed independently of the Cluster are always backed by a Virtual Machine Scale Set
+	return validateKubernetesAgentPoolDiff(d, "", "node_count", true)
+}
+
+// KubernetesClusterAccessCustomizeDiff validates at plan time that a Cluster authenticates using either a Service
+// Principal or a Managed Identity, and that access to the API Server is restricted in a way which is supported
+func KubernetesClusterAccessCustomizeDiff(d *schema.ResourceDiff, _ interface{}) error {
+	if valuesKnown(d, "service_principal", "identity") {
+		servicePrincipals := d.Get("service_principal").([]interface{})
+		identities := d.Get("identity").([]interface{})
+		if len(servicePrincipals) == 0 && len(identities) == 0 {
+			return fmt.Errorf("Either a `service_principal` or an `identity` block must be specified")
+		}
+	}
+
+	if !valuesKnown(d, "private_cluster_enabled", "api_server_authorized_ip_ranges") {
+		return nil
+	}
+
+	privateCluster := d.Get("private_cluster_enabled").(bool)
+	authorizedIPRanges := d.Get("api_server_authorized_ip_ranges").(*schema.Set).Len() > 0
+	if privateCluster && authorizedIPRanges {
+		return fmt.Errorf("`api_server_authorized_ip_ranges` can't be specified when `private_cluster_enabled` is enabled, since the API Server isn't exposed publicly")
+	}
+
+	if !privateCluster && !authorizedIPRanges {
+		return nil
+	}
+
+	// when the Load Balancer SKU isn't specified it's defaulted by the API
+	if valuesKnown(d, "network_profile.0.load_balancer_sku") {
+		if sku := d.Get("network_profile.0.load_balancer_sku").(string); sku != "" && !strings.EqualFold(sku, KubernetesLoadBalancerSkuStandard) {
+			return fmt.Errorf("`private_cluster_enabled` and `api_server_authorized_ip_ranges` require the `load_balancer_sku` within the `network_profile` to be `%s`", KubernetesLoadBalancerSkuStandard)
+		}
+	}
+
+	return nil
+}
+
+func validateKubernetesAgentPoolDiff(d *schema.ResourceDiff, prefix string, countKey string, virtualMachineScaleSets bool) error {
+	if !virtualMachineScaleSets {
+		if v, ok := d.GetOk(prefix + "availability_zones"); ok && len(v.([]interface{})) > 0 {
+			return fmt.Errorf("`%savailability_zones` can only be specified when the `type` is `%s`", prefix, KubernetesAgentPoolTypeVirtualMachineScaleSets)
+		}
+	}
+
+	if !valuesKnown(d, prefix+"enable_auto_scaling", prefix+countKey, prefix+"min_count", prefix+"max_count") {
+		return nil
+	}
+
+	count := d.Get(prefix + countKey).(int)
+	minCount := d.Get(prefix + "min_count").(int)
+	maxCount := d.Get(prefix + "max_count").(int)
+
+	if !d.Get(prefix + "enable_auto_scaling").(bool) {
+		if minCount > 0 || maxCount > 0 {
+			return fmt.Errorf("`%smin_count` and `%smax_count` can only be specified when `%senable_auto_scaling` is enabled", prefix, prefix, prefix)
+		}
+
+		return nil
+	}
+
+	if !virtualMachineScaleSets {
+		return fmt.Errorf("`%senable_auto_scaling` can only be enabled when the `type` is `%s`", prefix, KubernetesAgentPoolTypeVirtualMachineScaleSets)
+	}
+
+	if minCount == 0 || maxCount == 0 {
+		return fmt.Errorf("`%smin_count` and `%smax_count` must be specified when `%senable_auto_scaling` is enabled", prefix, prefix, prefix)
+	}
+
+	if minCount > maxCount {
+		return fmt.Errorf("`%smin_count` (%d) must be less than or equal to `%smax_count` (%d)", prefix, minCount, prefix, maxCount)
+	}
+
+	// the count is only used for the initial size of the pool, after which it's managed by the Autoscaler
+	if d.Id() == "" && (count < minCount || count > maxCount) {
+		return fmt.Errorf("`%s%s` (%d) must be between `%smin_count` (%d) and `%smax_count` (%d) when `%senable_auto_scaling` is enabled", prefix, countKey, count, prefix, minCount, prefix, maxCount, prefix)
+	}
+
+	return nil
+}
+
+// ValidateKubernetesAgentPoolVersion validates that the version of Kubernetes used by an Agent Pool isn't newer
+// than the version used by the control plane of the Cluster - since the control plane has to be upgraded first
+func ValidateKubernetesAgentPoolVersion(poolVersion string, clusterVersion string) error {
+	if poolVersion == "" || clusterVersion == "" {
+		return nil
+	}
+
+	pool, err := version.NewVersion(poolVersion)
+	if err != nil {
+		return fmt.Errorf("Error parsing Kubernetes Version %q: %+v", poolVersion, err)
+	}
+
+	cluster, err := version.NewVersion(clusterVersion)
+	if err != nil {
+		return fmt.Errorf("Error parsing Kubernetes Version %q: %+v", clusterVersion, err)
+	}
+
+	if pool.GreaterThan(cluster) {
+		return fmt.Errorf("The Kubernetes Version of the Node Pool (%q) can't be newer than the Kubernetes Version of the Cluster (%q) - the Cluster must be upgraded first", poolVersion, clusterVersion)
+	}
+
+	return nil
+}
@@ -0,0 +1,201 @@
+package azure
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/containerservice/mgmt/2018-03-31/containerservice"
+)
+
+func TestExpandKubernetesClusterRequestBody(t *testing.T) {
+	name := func(v string) *string {
+		return &v
+	}
+	count := int32(2)
+	minCount := int32(1)
+	maxCount := int32(5)
+	enabled := true
+
+	cluster := containerservice.ManagedCluster{
+		Location: name("westeurope"),
+		ManagedClusterProperties: &containerservice.ManagedClusterProperties{
+			DNSPrefix: name("cluster1"),
+			NetworkProfile: &containerservice.NetworkProfile{
+				NetworkPlugin: containerservice.Azure,
+			},
+			AgentPoolProfiles: &[]containerservice.ManagedClusterAgentPoolProfile{
+				{
+					Name:   name("default"),
+					Count:  &count,
+					VMSize: containerservice.StandardDS2V2,
+					OsType: containerservice.Linux,
+				},
+				{
+					Name:   name("other"),
+					Count:  &count,
+					VMSize: containerservice.StandardDS2V2,
+					OsType: containerservice.Linux,
+				},
+			},
+		},
+	}
+
+	extended := KubernetesClusterExtendedProperties{
+		AgentPoolProfiles: map[string]KubernetesAgentPoolExtendedProperties{
+			"default": {
+				Type:                KubernetesAgentPoolTypeVirtualMachineScaleSets,
+				EnableAutoScaling:   &enabled,
+				MinCount:            &minCount,
+				MaxCount:            &maxCount,
+				AvailabilityZones:   &[]string{"1", "2"},
+				NodeTaints:          &[]string{"key=value:NoSchedule"},
+				OrchestratorVersion: name("1.13.5"),
+			},
+		},
+		APIServerAccessProfile: &KubernetesClusterAPIServerAccessProfile{
+			AuthorizedIPRanges: &[]string{"10.0.0.0/16"},
+		},
+		Identity: &KubernetesClusterIdentity{
+			Type: "SystemAssigned",
+		},
+		LoadBalancerSku: "standard",
+		WindowsProfile: &KubernetesClusterWindowsProfile{
+			AdminUsername: name("azureuser"),
+			AdminPassword: name("P@ssword1234!"),
+		},
+	}
+
+	body, err := expandKubernetesClusterRequestBody(cluster, extended)
+	if err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+
+	// round-trip the request body to confirm the extended properties are parsed from where they were written
+	raw, err := json.Marshal(body)
+	if err != nil {
+		t.Fatalf("Error serializing the request body: %+v", err)
+	}
+
+	parsed, parsedExtended, err := parseKubernetesClusterResponseBody(raw)
+	if err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+
+	props := parsed.ManagedClusterProperties
+	if props == nil || props.DNSPrefix == nil || *props.DNSPrefix != "cluster1" {
+		t.Fatalf("Expected the DNS Prefix to be %q", "cluster1")
+	}
+	if props.AgentPoolProfiles == nil || len(*props.AgentPoolProfiles) != 2 {
+		t.Fatalf("Expected 2 Agent Pool Profiles but got %+v", props.AgentPoolProfiles)
+	}
+	if profile := (*props.AgentPoolProfiles)[0]; profile.Count == nil || *profile.Count != count || profile.VMSize != containerservice.StandardDS2V2 {
+		t.Fatalf("Expected the properties of the Agent Pool Profile to be retained but got %+v", profile)
+	}
+
+	pool, ok := parsedExtended.AgentPoolProfiles["default"]
+	if !ok {
+		t.Fatalf("Expected the extended properties of the `default` Agent Pool but got %+v", parsedExtended.AgentPoolProfiles)
+	}
+	if pool.Type != KubernetesAgentPoolTypeVirtualMachineScaleSets || pool.EnableAutoScaling == nil || !*pool.EnableAutoScaling {
+		t.Fatalf("Expected the `default` Agent Pool to be an Autoscaled Virtual Machine Scale Set but got %+v", pool)
+	}
+	if *pool.MinCount != minCount || *pool.MaxCount != maxCount || len(*pool.AvailabilityZones) != 2 || (*pool.NodeTaints)[0] != "key=value:NoSchedule" || *pool.OrchestratorVersion != "1.13.5" {
+		t.Fatalf("Expected the extended properties of the `default` Agent Pool to be retained but got %+v", pool)
+	}
+
+	if other := parsedExtended.AgentPoolProfiles["other"]; other.Type != "" || other.EnableAutoScaling != nil {
+		t.Fatalf("Expected no extended properties for the `other` Agent Pool but got %+v", other)
+	}
+
+	if identity := parsedExtended.Identity; identity == nil || identity.Type != "SystemAssigned" {
+		t.Fatalf("Expected the Identity to be retained but got %+v", identity)
+	}
+
+	if profile := parsedExtended.APIServerAccessProfile; profile == nil || profile.AuthorizedIPRanges == nil || (*profile.AuthorizedIPRanges)[0] != "10.0.0.0/16" {
+		t.Fatalf("Expected the API Server Access Profile to be retained but got %+v", profile)
+	}
+
+	if parsedExtended.LoadBalancerSku != "standard" || props.NetworkProfile == nil || props.NetworkProfile.NetworkPlugin != containerservice.Azure {
+		t.Fatalf("Expected the Load Balancer SKU to be merged into the Network Profile but got %q / %+v", parsedExtended.LoadBalancerSku, props.NetworkProfile)
+	}
+
+	if profile := parsedExtended.WindowsProfile; profile == nil || *profile.AdminUsername != "azureuser" {
+		t.Fatalf("Expected the Windows Profile to be retained but got %+v", profile)
+	}
+}
+
+func TestParseKubernetesAgentPool(t *testing.T) {
+	body := `{
+  "id": "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.ContainerService/managedClusters/cluster1/agentPools/pool1",
+  "name": "pool1",
+  "properties": {
+    "count": 3,
+    "vmSize": "Standard_DS2_v2",
+    "osType": "Windows",
+    "type": "VirtualMachineScaleSets",
+    "enableAutoScaling": true,
+    "minCount": 1,
+    "maxCount": 5,
+    "orchestratorVersion": "1.14.3",
+    "provisioningState": "Succeeded"
+  }
+}`
+
+	var pool KubernetesAgentPool
+	if err := json.Unmarshal([]byte(body), &pool); err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+
+	props := pool.Properties
+	if props == nil || props.Count == nil || *props.Count != 3 || props.OsType != "Windows" {
+		t.Fatalf("Expected the properties of the Agent Pool to be parsed but got %+v", props)
+	}
+
+	if props.Type != KubernetesAgentPoolTypeVirtualMachineScaleSets || props.MaxCount == nil || *props.MaxCount != 5 || *props.OrchestratorVersion != "1.14.3" {
+		t.Fatalf("Expected the extended properties of the Agent Pool to be parsed but got %+v", props.KubernetesAgentPoolExtendedProperties)
+	}
+}
+
+func TestValidateKubernetesAgentPoolVersion(t *testing.T) {
+	cases := []struct {
+		PoolVersion    string
+		ClusterVersion string
+		Valid          bool
+	}{
+		{
+			PoolVersion:    "",
+			ClusterVersion: "1.14.3",
+			Valid:          true,
+		},
+		{
+			PoolVersion:    "1.13.7",
+			ClusterVersion: "1.14.3",
+			Valid:          true,
+		},
+		{
+			PoolVersion:    "1.14.3",
+			ClusterVersion: "1.14.3",
+			Valid:          true,
+		},
+		{
+			PoolVersion:    "1.14.3",
+			ClusterVersion: "1.13.7",
+			Valid:          false,
+		},
+		{
+			PoolVersion:    "latest",
+			ClusterVersion: "1.13.7",
+			Valid:          false,
+		},
+	}
+
+	for _, v := range cases {
+		err := ValidateKubernetesAgentPoolVersion(v.PoolVersion, v.ClusterVersion)
+		if v.Valid && err != nil {
+			t.Fatalf("Expected no error for %q / %q but got: %+v", v.PoolVersion, v.ClusterVersion, err)
+		}
+		if !v.Valid && err == nil {
+			t.Fatalf("Expected an error for %q / %q but didn't get one", v.PoolVersion, v.ClusterVersion)
+		}
+	}
+}
@@ -0,0 +1,238 @@
+package azure
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform/helper/logging"
+)
+
+// requestLogEvent is the structured log entry emitted for each request sent to Azure
+type requestLogEvent struct {
+	Method               string          `json:"method"`
+	URL                  string          `json:"url"`
+	ResourceID           string          `json:"resource_id,omitempty"`
+	ResourceType         string          `json:"resource_type,omitempty"`
+	ResourceName         string          `json:"resource_name,omitempty"`
+	StatusCode           int             `json:"status_code,omitempty"`
+	DurationMs           int64           `json:"duration_ms"`
+	RequestID            string          `json:"request_id,omitempty"`
+	CorrelationRequestID string          `json:"correlation_request_id,omitempty"`
+	ClientRequestID      string          `json:"client_request_id,omitempty"`
+	Error                string          `json:"error,omitempty"`
+	RequestBody          json.RawMessage `json:"request_body,omitempty"`
+	ResponseBody         json.RawMessage `json:"response_body,omitempty"`
+}
+
+// the (normalized) names of fields which are always redacted from logged bodies
+var redactedFieldNames = map[string]bool{
+	"accesskey":                  true,
+	"adminpassword":              true,
+	"administratorloginpassword": true,
+	"clientsecret":               true,
+	"key":                        true,
+	"primarykey":                 true,
+	"primarymasterkey":           true,
+	"primaryreadonlymasterkey":   true,
+	"protectedsettings":          true,
+	"sastoken":                   true,
+	"secondarykey":               true,
+	"secondarymasterkey":         true,
+	"secondaryreadonlymasterkey": true,
+	"secret":                     true,
+	"sharedkey":                  true,
+	"storageaccountaccesskey":    true,
+	"storageaccountkey":          true,
+}
+
+// fields ending with any of these suffixes are also redacted, e.g. `certificatePassword` or `primaryConnectionString`
+var redactedFieldSuffixes = []string{
+	"connectionstring",
+	"password",
+	"secret",
+}
+
+// the (lower-cased) first segment of the paths of the Key Vault data-plane APIs whose `value` fields contain the
+// contents of a Secret, Key or Certificate (or the result of a cryptographic operation using a Key)
+var keyVaultDataPlaneCollections = map[string]bool{
+	"certificates":        true,
+	"deletedcertificates": true,
+	"deletedkeys":         true,
+	"deletedsecrets":      true,
+	"keys":                true,
+	"secrets":             true,
+}
+
+const redactedValue = "**REDACTED**"
+
+func withRequestLogging() autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			// requests are only logged at the DEBUG level, so there's no need to read (and redact) the bodies otherwise
+			if !logging.IsDebugOrHigher() {
+				return s.Do(r)
+			}
+
+			redactValues := isKeyVaultDataPlaneRequest(r.URL)
+			event := requestLogEvent{
+				Method:               r.Method,
+				URL:                  r.URL.String(),
+				ResourceID:           resourceIDFromPath(r.URL.Path),
+				CorrelationRequestID: r.Header.Get(correlationRequestIDHeader),
+				ClientRequestID:      r.Header.Get("x-ms-client-request-id"),
+			}
+
+			// the Terraform resource (or data source) which the request is made on behalf of
+			if correlation, ok := CorrelationFromContext(r.Context()); ok {
+				event.ResourceType = correlation.ResourceType
+				event.ResourceName = correlation.ResourceName
+			}
+
+			if r.Body != nil {
+				body, err := ioutil.ReadAll(r.Body)
+				r.Body.Close()
+				if err != nil {
+					return nil, fmt.Errorf("Error reading the body of the request to %s: %+v", r.URL, err)
+				}
+				r.Body = ioutil.NopCloser(bytes.NewReader(body))
+				event.RequestBody = redactBody(body, redactValues)
+			}
+
+			start := time.Now()
+			resp, err := s.Do(r)
+			event.DurationMs = int64(time.Since(start) / time.Millisecond)
+
+			if err != nil {
+				event.Error = err.Error()
+			}
+
+			if resp != nil {
+				event.StatusCode = resp.StatusCode
+				event.RequestID = resp.Header.Get("x-ms-request-id")
+				if v := resp.Header.Get(correlationRequestIDHeader); v != "" {
+					event.CorrelationRequestID = v
+				}
+				if v := resp.Header.Get("x-ms-client-request-id"); v != "" {
+					event.ClientRequestID = v
+				}
+
+				if resp.Body != nil {
+					body, readErr := ioutil.ReadAll(resp.Body)
+					resp.Body.Close()
+					if readErr != nil {
+						return resp, fmt.Errorf("Error reading the body of the response from %s: %+v", r.URL, readErr)
+					}
+					resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+					event.ResponseBody = redactBody(body, redactValues)
+				}
+			}
+
+			if output, marshalErr := json.Marshal(event); marshalErr == nil {
+				log.Printf("[DEBUG] AzureRM Request: %s", output)
+			} else {
+				// fallback to basic message
+				log.Printf("[DEBUG] AzureRM Request: %s to %s", r.Method, r.URL)
+			}
+
+			return resp, err
+		})
+	}
+}
+
+// resourceIDFromPath returns the Azure Resource ID targeted by a Resource Manager request, if any
+func resourceIDFromPath(path string) string {
+	if strings.HasPrefix(strings.ToLower(path), "/subscriptions/") || strings.HasPrefix(strings.ToLower(path), "/providers/") {
+		return path
+	}
+
+	return ""
+}
+
+// isKeyVaultDataPlaneRequest returns whether the request is to the Key Vault data-plane APIs for Secrets, Keys or
+// Certificates, for example `https://example.vault.azure.net/secrets/example`
+func isKeyVaultDataPlaneRequest(u *url.URL) bool {
+	if !strings.Contains(strings.ToLower(u.Hostname()), ".vault.") {
+		return false
+	}
+
+	segments := strings.Split(strings.Trim(u.Path, "/"), "/")
+	return keyVaultDataPlaneCollections[strings.ToLower(segments[0])]
+}
+
+// redactBody returns a copy of the JSON body with the values of any sensitive fields redacted - including any string
+// `value` fields when redactValues is set, since these contain the contents of Key Vault Secrets, Keys and Certificates
+//
+// Bodies which aren't JSON can't be safely redacted, so only their length is logged
+func redactBody(body []byte, redactValues bool) json.RawMessage {
+	if len(bytes.TrimSpace(body)) == 0 {
+		return nil
+	}
+
+	var parsed interface{}
+	if err := json.Unmarshal(body, &parsed); err != nil {
+		placeholder, _ := json.Marshal(fmt.Sprintf("(%d bytes of non-JSON content)", len(body)))
+		return placeholder
+	}
+
+	redacted, err := json.Marshal(redactValue(parsed, redactValues))
+	if err != nil {
+		return nil
+	}
+
+	return redacted
+}
+
+func redactValue(input interface{}, redactValues bool) interface{} {
+	switch v := input.(type) {
+	case map[string]interface{}:
+		// Access Keys are returned as a list of `{ "keyName": "key1", "value": "..." }`
+		_, isAccessKey := v["keyName"]
+
+		output := make(map[string]interface{}, len(v))
+		for key, value := range v {
+			// Key Vault returns a list of items within a `value` field too, which is left as-is
+			_, isString := value.(string)
+			isSensitiveValue := strings.EqualFold(key, "value") && (isAccessKey || (redactValues && isString))
+
+			if shouldRedactField(key) || isSensitiveValue {
+				output[key] = redactedValue
+				continue
+			}
+
+			output[key] = redactValue(value, redactValues)
+		}
+		return output
+
+	case []interface{}:
+		output := make([]interface{}, len(v))
+		for i, value := range v {
+			output[i] = redactValue(value, redactValues)
+		}
+		return output
+	}
+
+	return input
+}
+
+func shouldRedactField(name string) bool {
+	normalized := strings.ToLower(strings.Replace(name, "_", "", -1))
+	if redactedFieldNames[normalized] {
+		return true
+	}
+
+	for _, suffix := range redactedFieldSuffixes {
+		if strings.HasSuffix(normalized, suffix) {
+			return true
+		}
+	}
+
+	return false
+}
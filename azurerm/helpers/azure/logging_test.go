@@ -0,0 +1,279 @@
+package azure
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+func TestRedactBody(t *testing.T) {
+	cases := []struct {
+		Name         string
+		Input        string
+		RedactValues bool
+		Expected     string
+	}{
+		{
+			Name:     "empty",
+			Input:    "",
+			Expected: "",
+		},
+		{
+			Name:     "not json",
+			Input:    "hello",
+			Expected: `"(5 bytes of non-JSON content)"`,
+		},
+		{
+			Name:     "nothing sensitive",
+			Input:    `{"location":"westeurope","tags":{"env":"prod"}}`,
+			Expected: `{"location":"westeurope","tags":{"env":"prod"}}`,
+		},
+		{
+			Name:     "nested password",
+			Input:    `{"properties":{"osProfile":{"adminUsername":"admin","adminPassword":"P@ssw0rd"}}}`,
+			Expected: `{"properties":{"osProfile":{"adminPassword":"**REDACTED**","adminUsername":"admin"}}}`,
+		},
+		{
+			Name:     "connection strings",
+			Input:    `{"primaryConnectionString":"Endpoint=sb://","name":"example"}`,
+			Expected: `{"name":"example","primaryConnectionString":"**REDACTED**"}`,
+		},
+		{
+			Name:     "access keys",
+			Input:    `{"keys":[{"keyName":"key1","value":"abc"},{"keyName":"key2","value":"def"}]}`,
+			Expected: `{"keys":[{"keyName":"key1","value":"**REDACTED**"},{"keyName":"key2","value":"**REDACTED**"}]}`,
+		},
+		{
+			Name:     "value without a key name",
+			Input:    `{"value":[{"name":"example"}]}`,
+			Expected: `{"value":[{"name":"example"}]}`,
+		},
+		{
+			Name:     "key vault secret outside of key vault",
+			Input:    `{"value":"hello","id":"https://example.vault.azure.net/secrets/example"}`,
+			Expected: `{"id":"https://example.vault.azure.net/secrets/example","value":"hello"}`,
+		},
+		{
+			Name:         "key vault secret",
+			Input:        `{"value":"hello","id":"https://example.vault.azure.net/secrets/example","attributes":{"enabled":true}}`,
+			RedactValues: true,
+			Expected:     `{"attributes":{"enabled":true},"id":"https://example.vault.azure.net/secrets/example","value":"**REDACTED**"}`,
+		},
+		{
+			Name:         "key vault list",
+			Input:        `{"value":[{"id":"https://example.vault.azure.net/secrets/example"}],"nextLink":null}`,
+			RedactValues: true,
+			Expected:     `{"nextLink":null,"value":[{"id":"https://example.vault.azure.net/secrets/example"}]}`,
+		},
+	}
+
+	for _, v := range cases {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual := string(redactBody([]byte(v.Input), v.RedactValues))
+		if actual != v.Expected {
+			t.Fatalf("Expected %q but got %q", v.Expected, actual)
+		}
+	}
+}
+
+func TestShouldRedactField(t *testing.T) {
+	cases := map[string]bool{
+		"name":                       false,
+		"keyName":                    false,
+		"passwordProfile":            false,
+		"adminPassword":              true,
+		"administratorLoginPassword": true,
+		"client_secret":              true,
+		"primaryKey":                 true,
+		"protectedSettings":          true,
+		"storageAccountAccessKey":    true,
+		"secondaryConnectionString":  true,
+	}
+
+	for input, expected := range cases {
+		if actual := shouldRedactField(input); actual != expected {
+			t.Fatalf("Expected %q to return %t but got %t", input, expected, actual)
+		}
+	}
+}
+
+func TestIsKeyVaultDataPlaneRequest(t *testing.T) {
+	cases := map[string]bool{
+		"https://example.vault.azure.net/secrets/example/abc123":                                                         true,
+		"https://example.vault.azure.net/keys/example/abc123/sign":                                                       true,
+		"https://example.vault.azure.cn/certificates/example/import":                                                     true,
+		"https://example.vault.azure.net/deletedsecrets/example":                                                         true,
+		"https://example.vault.azure.net/certificates/contacts":                                                          true,
+		"https://example.vault.azure.net/":                                                                               false,
+		"https://example.blob.core.windows.net/secrets/example":                                                          false,
+		"https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/secrets": false,
+	}
+
+	for input, expected := range cases {
+		u, err := url.Parse(input)
+		if err != nil {
+			t.Fatalf("Error parsing %q: %+v", input, err)
+		}
+
+		if actual := isKeyVaultDataPlaneRequest(u); actual != expected {
+			t.Fatalf("Expected %q to return %t but got %t", input, expected, actual)
+		}
+	}
+}
+
+func TestRequestLogging_KeyVault(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"value":"response-secret","id":"https://example.vault.azure.net/secrets/example/abc123"}`)) // nolint: errcheck
+	}))
+	defer server.Close()
+
+	os.Setenv("TF_LOG", "DEBUG")
+	defer os.Unsetenv("TF_LOG")
+
+	var output bytes.Buffer
+	log.SetOutput(&output)
+	defer log.SetOutput(os.Stderr)
+
+	// the request is sent to the test server, but appears to be for a Key Vault
+	req, err := http.NewRequest(http.MethodPut, "https://example.vault.azure.net/secrets/example", strings.NewReader(`{"value":"request-secret"}`))
+	if err != nil {
+		t.Fatalf("Error building request: %+v", err)
+	}
+	serverURL, _ := url.Parse(server.URL)
+	client := &http.Client{
+		Transport: &http.Transport{
+			Proxy: http.ProxyURL(serverURL),
+		},
+	}
+	req.URL.Scheme = "http"
+
+	sender := autorest.DecorateSender(client, withRequestLogging())
+	resp, err := sender.Do(req)
+	if err != nil {
+		t.Fatalf("Error sending request: %+v", err)
+	}
+	resp.Body.Close()
+
+	line := output.String()
+	if !strings.Contains(line, "AzureRM Request: ") {
+		t.Fatalf("Expected a request to be logged but got %q", line)
+	}
+	if strings.Contains(line, "request-secret") || strings.Contains(line, "response-secret") {
+		t.Fatalf("Expected the Secret's value to be redacted from the request and response but got %q", line)
+	}
+}
+
+func TestRequestLogging_NotDebug(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	os.Setenv("TF_LOG", "INFO")
+	defer os.Unsetenv("TF_LOG")
+
+	var output bytes.Buffer
+	log.SetOutput(&output)
+	defer log.SetOutput(os.Stderr)
+
+	req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+	if err != nil {
+		t.Fatalf("Error building request: %+v", err)
+	}
+
+	sender := autorest.DecorateSender(&http.Client{}, withRequestLogging())
+	resp, err := sender.Do(req)
+	if err != nil {
+		t.Fatalf("Error sending request: %+v", err)
+	}
+	resp.Body.Close()
+
+	if strings.Contains(output.String(), "AzureRM Request: ") {
+		t.Fatalf("Expected the request not to be logged but got %q", output.String())
+	}
+}
+
+func TestRequestLogging(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("x-ms-request-id", "request-123")
+		w.Header().Set(correlationRequestIDHeader, r.Header.Get(correlationRequestIDHeader))
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"properties":{"primaryKey":"secret-value"}}`)) // nolint: errcheck
+	}))
+	defer server.Close()
+
+	os.Setenv("TF_LOG", "DEBUG")
+	defer os.Unsetenv("TF_LOG")
+
+	var output bytes.Buffer
+	log.SetOutput(&output)
+	defer log.SetOutput(os.Stderr)
+
+	ctx := WithCorrelation(context.Background(), Correlation{
+		RequestID:    "correlation-123",
+		ResourceType: "azurerm_resource_group",
+		ResourceName: "example",
+	})
+	req, err := http.NewRequest(http.MethodPut, server.URL+"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example", strings.NewReader(`{"location":"westeurope"}`))
+	if err != nil {
+		t.Fatalf("Error building request: %+v", err)
+	}
+	req = req.WithContext(ctx)
+
+	sender := autorest.DecorateSender(&http.Client{}, withRequestLogging(), withCorrelationRequestID())
+	resp, err := sender.Do(req)
+	if err != nil {
+		t.Fatalf("Error sending request: %+v", err)
+	}
+
+	// the body must still be readable by the caller
+	body, _ := ioutil.ReadAll(resp.Body)
+	if !strings.Contains(string(body), "secret-value") {
+		t.Fatalf("Expected the response body to be unmodified but got %q", string(body))
+	}
+
+	line := output.String()
+	index := strings.Index(line, "AzureRM Request: ")
+	if index == -1 {
+		t.Fatalf("Expected a request to be logged but got %q", line)
+	}
+
+	var event requestLogEvent
+	if err := json.Unmarshal([]byte(strings.TrimSpace(line[index+len("AzureRM Request: "):])), &event); err != nil {
+		t.Fatalf("Error parsing the logged request %q: %+v", line, err)
+	}
+
+	if event.Method != http.MethodPut {
+		t.Fatalf("Expected the method to be %q but got %q", http.MethodPut, event.Method)
+	}
+	if event.ResourceID != "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example" {
+		t.Fatalf("Unexpected Resource ID %q", event.ResourceID)
+	}
+	if event.StatusCode != http.StatusOK {
+		t.Fatalf("Expected the status code to be %d but got %d", http.StatusOK, event.StatusCode)
+	}
+	if event.RequestID != "request-123" {
+		t.Fatalf("Expected the request ID to be %q but got %q", "request-123", event.RequestID)
+	}
+	if event.CorrelationRequestID != "correlation-123" {
+		t.Fatalf("Expected the correlation request ID to be %q but got %q", "correlation-123", event.CorrelationRequestID)
+	}
+	if event.ResourceType != "azurerm_resource_group" || event.ResourceName != "example" {
+		t.Fatalf("Expected the resource to be %q / %q but got %q / %q", "azurerm_resource_group", "example", event.ResourceType, event.ResourceName)
+	}
+	if strings.Contains(string(event.ResponseBody), "secret-value") {
+		t.Fatalf("Expected the response body to be redacted but got %s", event.ResponseBody)
+	}
+}
@@ -0,0 +1,137 @@
+package azure
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2018-05-01/resources"
+	"github.com/Azure/go-autorest/autorest"
+	az "github.com/Azure/go-autorest/autorest/azure"
+)
+
+// managementGroupDeploymentsAPIVersion is the earliest version of the Resources API which supports Template
+// Deployments scoped to a Management Group (which requires a Location)
+const managementGroupDeploymentsAPIVersion = "2019-10-01"
+
+// ManagementGroupDeploymentsClient is a client for Template Deployments scoped to a Management Group - which aren't
+// supported by the version of the Resources SDK we're using, so these requests are built using the models from it
+type ManagementGroupDeploymentsClient struct {
+	resources.BaseClient
+}
+
+// NewManagementGroupDeploymentsClientWithBaseURI returns a ManagementGroupDeploymentsClient using the specified endpoint
+func NewManagementGroupDeploymentsClientWithBaseURI(baseURI string) ManagementGroupDeploymentsClient {
+	return ManagementGroupDeploymentsClient{
+		BaseClient: resources.NewWithBaseURI(baseURI, ""),
+	}
+}
+
+// CreateOrUpdate deploys the Template to the Management Group
+func (client ManagementGroupDeploymentsClient) CreateOrUpdate(ctx context.Context, managementGroupName string, deploymentName string, parameters resources.Deployment) (result resources.DeploymentsCreateOrUpdateFuture, err error) {
+	req, err := client.preparer(ctx, managementGroupName, deploymentName, "",
+		autorest.AsPut(),
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.WithJSON(parameters))
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "azure.ManagementGroupDeploymentsClient", "CreateOrUpdate", nil, "Failure preparing request")
+	}
+
+	resp, err := client.send(req)
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "azure.ManagementGroupDeploymentsClient", "CreateOrUpdate", resp, "Failure sending request")
+	}
+
+	result.Future, err = az.NewFutureFromResponse(resp)
+	return result, err
+}
+
+// Get retrieves the Template Deployment within the Management Group
+func (client ManagementGroupDeploymentsClient) Get(ctx context.Context, managementGroupName string, deploymentName string) (result resources.DeploymentExtended, err error) {
+	req, err := client.preparer(ctx, managementGroupName, deploymentName, "", autorest.AsGet())
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "azure.ManagementGroupDeploymentsClient", "Get", nil, "Failure preparing request")
+	}
+
+	resp, err := client.send(req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		return result, autorest.NewErrorWithError(err, "azure.ManagementGroupDeploymentsClient", "Get", resp, "Failure sending request")
+	}
+
+	err = autorest.Respond(
+		resp,
+		client.ByInspecting(),
+		az.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "azure.ManagementGroupDeploymentsClient", "Get", resp, "Failure responding to request")
+	}
+
+	return result, nil
+}
+
+// Delete deletes the Template Deployment within the Management Group (but not the resources it deployed)
+func (client ManagementGroupDeploymentsClient) Delete(ctx context.Context, managementGroupName string, deploymentName string) (result resources.DeploymentsDeleteFuture, err error) {
+	req, err := client.preparer(ctx, managementGroupName, deploymentName, "", autorest.AsDelete())
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "azure.ManagementGroupDeploymentsClient", "Delete", nil, "Failure preparing request")
+	}
+
+	resp, err := client.send(req)
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "azure.ManagementGroupDeploymentsClient", "Delete", resp, "Failure sending request")
+	}
+
+	result.Future, err = az.NewFutureFromResponse(resp)
+	return result, err
+}
+
+// ExportTemplate exports the Template used by the Template Deployment within the Management Group
+func (client ManagementGroupDeploymentsClient) ExportTemplate(ctx context.Context, managementGroupName string, deploymentName string) (result resources.DeploymentExportResult, err error) {
+	req, err := client.preparer(ctx, managementGroupName, deploymentName, "/exportTemplate", autorest.AsPost())
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "azure.ManagementGroupDeploymentsClient", "ExportTemplate", nil, "Failure preparing request")
+	}
+
+	resp, err := client.send(req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		return result, autorest.NewErrorWithError(err, "azure.ManagementGroupDeploymentsClient", "ExportTemplate", resp, "Failure sending request")
+	}
+
+	err = autorest.Respond(
+		resp,
+		client.ByInspecting(),
+		az.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "azure.ManagementGroupDeploymentsClient", "ExportTemplate", resp, "Failure responding to request")
+	}
+
+	return result, nil
+}
+
+func (client ManagementGroupDeploymentsClient) preparer(ctx context.Context, managementGroupName string, deploymentName string, suffix string, decorators ...autorest.PrepareDecorator) (*http.Request, error) {
+	pathParameters := map[string]interface{}{
+		"deploymentName": autorest.Encode("path", deploymentName),
+		"groupId":        autorest.Encode("path", managementGroupName),
+	}
+
+	queryParameters := map[string]interface{}{
+		"api-version": managementGroupDeploymentsAPIVersion,
+	}
+
+	decorators = append(decorators,
+		autorest.WithBaseURL(client.BaseURI),
+		autorest.WithPathParameters("/providers/Microsoft.Management/managementGroups/{groupId}/providers/Microsoft.Resources/deployments/{deploymentName}"+suffix, pathParameters),
+		autorest.WithQueryParameters(queryParameters))
+	return autorest.CreatePreparer(decorators...).Prepare((&http.Request{}).WithContext(ctx))
+}
+
+func (client ManagementGroupDeploymentsClient) send(req *http.Request) (*http.Response, error) {
+	return autorest.SendWithSender(client, req)
+}
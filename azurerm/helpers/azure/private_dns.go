@@ -0,0 +1,225 @@
+package azure
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/dns/mgmt/2018-03-01-preview/dns"
+	"github.com/Azure/go-autorest/autorest"
+	az "github.com/Azure/go-autorest/autorest/azure"
+)
+
+// privateDnsAPIVersion is the version of the Private DNS API (`Microsoft.Network/privateDnsZones`)
+const privateDnsAPIVersion = "2018-09-01"
+
+// PrivateDnsClient is a client for Private DNS Zones, their Virtual Network Links and their Record Sets - which aren't
+// available in the version of the SDK we're using, so these requests are sent using the (configured) client for
+// DNS Zones. The Record Sets within a Private DNS Zone share their schema with those in a (Public) DNS Zone, so these
+// are sent and received as a `dns.RecordSet`
+type PrivateDnsClient struct {
+	dns.BaseClient
+}
+
+// NewPrivateDnsClient returns a PrivateDnsClient built on the client for DNS Zones
+func NewPrivateDnsClient(zonesClient dns.ZonesClient) PrivateDnsClient {
+	return PrivateDnsClient{
+		BaseClient: zonesClient.BaseClient,
+	}
+}
+
+// PrivateDnsZone is a Private DNS Zone
+type PrivateDnsZone struct {
+	autorest.Response `json:"-"`
+	ID                *string                   `json:"id,omitempty"`
+	Name              *string                   `json:"name,omitempty"`
+	Location          *string                   `json:"location,omitempty"`
+	Tags              map[string]*string        `json:"tags"`
+	Properties        *PrivateDnsZoneProperties `json:"properties,omitempty"`
+}
+
+// PrivateDnsZoneProperties are the (read-only) properties of a Private DNS Zone
+type PrivateDnsZoneProperties struct {
+	MaxNumberOfRecordSets                          *int64 `json:"maxNumberOfRecordSets,omitempty"`
+	NumberOfRecordSets                             *int64 `json:"numberOfRecordSets,omitempty"`
+	MaxNumberOfVirtualNetworkLinks                 *int64 `json:"maxNumberOfVirtualNetworkLinks,omitempty"`
+	NumberOfVirtualNetworkLinks                    *int64 `json:"numberOfVirtualNetworkLinks,omitempty"`
+	MaxNumberOfVirtualNetworkLinksWithRegistration *int64 `json:"maxNumberOfVirtualNetworkLinksWithRegistration,omitempty"`
+	NumberOfVirtualNetworkLinksWithRegistration    *int64 `json:"numberOfVirtualNetworkLinksWithRegistration,omitempty"`
+}
+
+// PrivateDnsZoneVirtualNetworkLink links a Virtual Network to a Private DNS Zone
+type PrivateDnsZoneVirtualNetworkLink struct {
+	autorest.Response `json:"-"`
+	ID                *string                                     `json:"id,omitempty"`
+	Name              *string                                     `json:"name,omitempty"`
+	Location          *string                                     `json:"location,omitempty"`
+	Tags              map[string]*string                          `json:"tags"`
+	Properties        *PrivateDnsZoneVirtualNetworkLinkProperties `json:"properties,omitempty"`
+}
+
+// PrivateDnsZoneVirtualNetworkLinkProperties are the properties of a Virtual Network Link, where the
+// Virtual Network can (optionally) automatically register the hostnames of its Virtual Machines in the Zone
+type PrivateDnsZoneVirtualNetworkLinkProperties struct {
+	VirtualNetwork      *dns.SubResource `json:"virtualNetwork,omitempty"`
+	RegistrationEnabled *bool            `json:"registrationEnabled,omitempty"`
+}
+
+// CreateOrUpdateZone creates or updates the Private DNS Zone
+func (client PrivateDnsClient) CreateOrUpdateZone(ctx context.Context, id PrivateDnsZoneID, zone PrivateDnsZone) (az.Future, error) {
+	return client.put(ctx, "CreateOrUpdateZone", id.ID(), zone)
+}
+
+// GetZone retrieves the Private DNS Zone
+func (client PrivateDnsClient) GetZone(ctx context.Context, id PrivateDnsZoneID) (result PrivateDnsZone, err error) {
+	result.Response, err = client.get(ctx, "GetZone", id.ID(), &result)
+	return result, err
+}
+
+// DeleteZone deletes the Private DNS Zone, which must not have any Virtual Network Links
+func (client PrivateDnsClient) DeleteZone(ctx context.Context, id PrivateDnsZoneID) (az.Future, error) {
+	return client.delete(ctx, "DeleteZone", id.ID())
+}
+
+// CreateOrUpdateVirtualNetworkLink creates or updates the Virtual Network Link within a Private DNS Zone
+func (client PrivateDnsClient) CreateOrUpdateVirtualNetworkLink(ctx context.Context, id PrivateDnsZoneVirtualNetworkLinkID, link PrivateDnsZoneVirtualNetworkLink) (az.Future, error) {
+	return client.put(ctx, "CreateOrUpdateVirtualNetworkLink", id.ID(), link)
+}
+
+// GetVirtualNetworkLink retrieves the Virtual Network Link within a Private DNS Zone
+func (client PrivateDnsClient) GetVirtualNetworkLink(ctx context.Context, id PrivateDnsZoneVirtualNetworkLinkID) (result PrivateDnsZoneVirtualNetworkLink, err error) {
+	result.Response, err = client.get(ctx, "GetVirtualNetworkLink", id.ID(), &result)
+	return result, err
+}
+
+// DeleteVirtualNetworkLink deletes the Virtual Network Link within a Private DNS Zone
+func (client PrivateDnsClient) DeleteVirtualNetworkLink(ctx context.Context, id PrivateDnsZoneVirtualNetworkLinkID) (az.Future, error) {
+	return client.delete(ctx, "DeleteVirtualNetworkLink", id.ID())
+}
+
+// CreateOrUpdateRecordSet creates or updates the Record Set with the specified Resource ID within a Private DNS Zone
+func (client PrivateDnsClient) CreateOrUpdateRecordSet(ctx context.Context, id string, recordSet dns.RecordSet) error {
+	req, err := client.preparer(ctx, id,
+		autorest.AsPut(),
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.WithJSON(recordSet))
+	if err != nil {
+		return autorest.NewErrorWithError(err, "azure.PrivateDnsClient", "CreateOrUpdateRecordSet", nil, "Failure preparing request")
+	}
+
+	resp, err := client.send(req)
+	if err != nil {
+		return autorest.NewErrorWithError(err, "azure.PrivateDnsClient", "CreateOrUpdateRecordSet", resp, "Failure sending request")
+	}
+
+	err = autorest.Respond(
+		resp,
+		client.ByInspecting(),
+		az.WithErrorUnlessStatusCode(http.StatusOK, http.StatusCreated),
+		autorest.ByClosing())
+	if err != nil {
+		return autorest.NewErrorWithError(err, "azure.PrivateDnsClient", "CreateOrUpdateRecordSet", resp, "Failure responding to request")
+	}
+
+	return nil
+}
+
+// GetRecordSet retrieves the Record Set with the specified Resource ID within a Private DNS Zone
+func (client PrivateDnsClient) GetRecordSet(ctx context.Context, id string) (result dns.RecordSet, err error) {
+	result.Response, err = client.get(ctx, "GetRecordSet", id, &result)
+	return result, err
+}
+
+// DeleteRecordSet deletes the Record Set with the specified Resource ID within a Private DNS Zone
+func (client PrivateDnsClient) DeleteRecordSet(ctx context.Context, id string) (autorest.Response, error) {
+	req, err := client.preparer(ctx, id, autorest.AsDelete())
+	if err != nil {
+		return autorest.Response{}, autorest.NewErrorWithError(err, "azure.PrivateDnsClient", "DeleteRecordSet", nil, "Failure preparing request")
+	}
+
+	resp, err := client.send(req)
+	if err != nil {
+		return autorest.Response{Response: resp}, autorest.NewErrorWithError(err, "azure.PrivateDnsClient", "DeleteRecordSet", resp, "Failure sending request")
+	}
+
+	err = autorest.Respond(
+		resp,
+		client.ByInspecting(),
+		az.WithErrorUnlessStatusCode(http.StatusOK, http.StatusNoContent),
+		autorest.ByClosing())
+	if err != nil {
+		return autorest.Response{Response: resp}, autorest.NewErrorWithError(err, "azure.PrivateDnsClient", "DeleteRecordSet", resp, "Failure responding to request")
+	}
+
+	return autorest.Response{Response: resp}, nil
+}
+
+func (client PrivateDnsClient) put(ctx context.Context, method string, id string, parameters interface{}) (result az.Future, err error) {
+	req, err := client.preparer(ctx, id,
+		autorest.AsPut(),
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.WithJSON(parameters))
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "azure.PrivateDnsClient", method, nil, "Failure preparing request")
+	}
+
+	resp, err := client.send(req)
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "azure.PrivateDnsClient", method, resp, "Failure sending request")
+	}
+
+	return az.NewFutureFromResponse(resp)
+}
+
+func (client PrivateDnsClient) get(ctx context.Context, method string, id string, result interface{}) (autorest.Response, error) {
+	req, err := client.preparer(ctx, id, autorest.AsGet())
+	if err != nil {
+		return autorest.Response{}, autorest.NewErrorWithError(err, "azure.PrivateDnsClient", method, nil, "Failure preparing request")
+	}
+
+	resp, err := client.send(req)
+	if err != nil {
+		return autorest.Response{Response: resp}, autorest.NewErrorWithError(err, "azure.PrivateDnsClient", method, resp, "Failure sending request")
+	}
+
+	err = autorest.Respond(
+		resp,
+		client.ByInspecting(),
+		az.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(result),
+		autorest.ByClosing())
+	if err != nil {
+		return autorest.Response{Response: resp}, autorest.NewErrorWithError(err, "azure.PrivateDnsClient", method, resp, "Failure responding to request")
+	}
+
+	return autorest.Response{Response: resp}, nil
+}
+
+func (client PrivateDnsClient) delete(ctx context.Context, method string, id string) (result az.Future, err error) {
+	req, err := client.preparer(ctx, id, autorest.AsDelete())
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "azure.PrivateDnsClient", method, nil, "Failure preparing request")
+	}
+
+	resp, err := client.send(req)
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "azure.PrivateDnsClient", method, resp, "Failure sending request")
+	}
+
+	return az.NewFutureFromResponse(resp)
+}
+
+func (client PrivateDnsClient) preparer(ctx context.Context, id string, decorators ...autorest.PrepareDecorator) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": privateDnsAPIVersion,
+	}
+
+	decorators = append(decorators,
+		autorest.WithBaseURL(client.BaseURI),
+		autorest.WithPath(id),
+		autorest.WithQueryParameters(queryParameters))
+	return autorest.CreatePreparer(decorators...).Prepare((&http.Request{}).WithContext(ctx))
+}
+
+func (client PrivateDnsClient) send(req *http.Request) (*http.Response, error) {
+	return autorest.SendWithSender(client, req)
+}
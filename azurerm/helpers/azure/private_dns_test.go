@@ -0,0 +1,359 @@
+package azure
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/dns/mgmt/2018-03-01-preview/dns"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// testPrivateDnsServer is a stub of the Private DNS API which stores the body of each PUT against its path,
+// returning it (with the `id` the API would assign) on a subsequent GET
+func testPrivateDnsServer(t *testing.T) *httptest.Server {
+	var lock sync.Mutex
+	resources := make(map[string][]byte)
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+
+		if v := r.URL.Query().Get("api-version"); v != privateDnsAPIVersion {
+			t.Errorf("Expected the API Version to be %q but got %q", privateDnsAPIVersion, v)
+		}
+
+		switch r.Method {
+		case http.MethodPut:
+			body := make(map[string]interface{})
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			body["id"] = r.URL.Path
+
+			resources[r.URL.Path], _ = json.Marshal(body)
+			w.WriteHeader(http.StatusOK)
+			w.Write(resources[r.URL.Path]) // nolint: errcheck
+
+		case http.MethodGet:
+			body, ok := resources[r.URL.Path]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+			w.Write(body) // nolint: errcheck
+
+		case http.MethodDelete:
+			if _, ok := resources[r.URL.Path]; !ok {
+				w.WriteHeader(http.StatusNoContent)
+				return
+			}
+			delete(resources, r.URL.Path)
+			w.WriteHeader(http.StatusOK)
+
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	}))
+}
+
+func TestPrivateDnsClient_RecordSetRoundTrip(t *testing.T) {
+	server := testPrivateDnsServer(t)
+	defer server.Close()
+
+	subscriptionId := "00000000-0000-0000-0000-000000000000"
+	client := NewPrivateDnsClient(dns.NewZonesClientWithBaseURI(server.URL, subscriptionId))
+	ctx := context.Background()
+
+	cases := []struct {
+		Name       string
+		ID         string
+		Parse      func(input string) (zoneName string, name string, err error)
+		Properties dns.RecordSetProperties
+	}{
+		{
+			Name: "A",
+			ID:   NewPrivateDnsARecordID(subscriptionId, "group1", "example.internal", "www").ID(),
+			Parse: func(input string) (string, string, error) {
+				id, err := ParsePrivateDnsARecordID(input)
+				if err != nil {
+					return "", "", err
+				}
+				return id.ZoneName, id.Name, nil
+			},
+			Properties: dns.RecordSetProperties{
+				TTL: utils.Int64(300),
+				ARecords: &[]dns.ARecord{
+					{Ipv4Address: utils.String("10.0.0.4")},
+					{Ipv4Address: utils.String("10.0.0.5")},
+				},
+			},
+		},
+		{
+			Name: "AAAA",
+			ID:   NewPrivateDnsAaaaRecordID(subscriptionId, "group1", "example.internal", "www").ID(),
+			Parse: func(input string) (string, string, error) {
+				id, err := ParsePrivateDnsAaaaRecordID(input)
+				if err != nil {
+					return "", "", err
+				}
+				return id.ZoneName, id.Name, nil
+			},
+			Properties: dns.RecordSetProperties{
+				TTL: utils.Int64(300),
+				AaaaRecords: &[]dns.AaaaRecord{
+					{Ipv6Address: utils.String("fd00::4")},
+				},
+			},
+		},
+		{
+			Name: "CNAME",
+			ID:   NewPrivateDnsCNameRecordID(subscriptionId, "group1", "example.internal", "alias").ID(),
+			Parse: func(input string) (string, string, error) {
+				id, err := ParsePrivateDnsCNameRecordID(input)
+				if err != nil {
+					return "", "", err
+				}
+				return id.ZoneName, id.Name, nil
+			},
+			Properties: dns.RecordSetProperties{
+				TTL:         utils.Int64(60),
+				CnameRecord: &dns.CnameRecord{Cname: utils.String("www.example.internal")},
+			},
+		},
+		{
+			Name: "MX",
+			ID:   NewPrivateDnsMxRecordID(subscriptionId, "group1", "example.internal", "@").ID(),
+			Parse: func(input string) (string, string, error) {
+				id, err := ParsePrivateDnsMxRecordID(input)
+				if err != nil {
+					return "", "", err
+				}
+				return id.ZoneName, id.Name, nil
+			},
+			Properties: dns.RecordSetProperties{
+				TTL: utils.Int64(3600),
+				MxRecords: &[]dns.MxRecord{
+					{Preference: utils.Int32(10), Exchange: utils.String("mail1.example.internal")},
+					{Preference: utils.Int32(20), Exchange: utils.String("mail2.example.internal")},
+				},
+			},
+		},
+		{
+			Name: "PTR",
+			ID:   NewPrivateDnsPtrRecordID(subscriptionId, "group1", "0.10.in-addr.arpa", "4").ID(),
+			Parse: func(input string) (string, string, error) {
+				id, err := ParsePrivateDnsPtrRecordID(input)
+				if err != nil {
+					return "", "", err
+				}
+				return id.ZoneName, id.Name, nil
+			},
+			Properties: dns.RecordSetProperties{
+				TTL: utils.Int64(300),
+				PtrRecords: &[]dns.PtrRecord{
+					{Ptrdname: utils.String("www.example.internal")},
+				},
+			},
+		},
+		{
+			Name: "SRV",
+			ID:   NewPrivateDnsSrvRecordID(subscriptionId, "group1", "example.internal", "_sip._tcp").ID(),
+			Parse: func(input string) (string, string, error) {
+				id, err := ParsePrivateDnsSrvRecordID(input)
+				if err != nil {
+					return "", "", err
+				}
+				return id.ZoneName, id.Name, nil
+			},
+			Properties: dns.RecordSetProperties{
+				TTL: utils.Int64(300),
+				SrvRecords: &[]dns.SrvRecord{
+					{
+						Priority: utils.Int32(1),
+						Weight:   utils.Int32(5),
+						Port:     utils.Int32(5060),
+						Target:   utils.String("sip.example.internal"),
+					},
+				},
+			},
+		},
+		{
+			Name: "TXT",
+			ID:   NewPrivateDnsTxtRecordID(subscriptionId, "group1", "example.internal", "@").ID(),
+			Parse: func(input string) (string, string, error) {
+				id, err := ParsePrivateDnsTxtRecordID(input)
+				if err != nil {
+					return "", "", err
+				}
+				return id.ZoneName, id.Name, nil
+			},
+			Properties: dns.RecordSetProperties{
+				TTL:      utils.Int64(300),
+				Metadata: map[string]*string{"environment": utils.String("test")},
+				TxtRecords: &[]dns.TxtRecord{
+					{Value: &[]string{"v=spf1 -all"}},
+				},
+			},
+		},
+	}
+
+	for _, v := range cases {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		properties := v.Properties
+		recordSet := dns.RecordSet{
+			RecordSetProperties: &properties,
+		}
+		if err := client.CreateOrUpdateRecordSet(ctx, v.ID, recordSet); err != nil {
+			t.Fatalf("Error creating the Record Set: %+v", err)
+		}
+
+		actual, err := client.GetRecordSet(ctx, v.ID)
+		if err != nil {
+			t.Fatalf("Error retrieving the Record Set: %+v", err)
+		}
+		if !reflect.DeepEqual(&v.Properties, actual.RecordSetProperties) {
+			t.Fatalf("Expected the properties %+v but got %+v", v.Properties, actual.RecordSetProperties)
+		}
+
+		// the ID returned from the API must parse as the same type of Record
+		if actual.ID == nil {
+			t.Fatalf("Expected the Record Set to have an ID")
+		}
+		zoneName, name, err := v.Parse(*actual.ID)
+		if err != nil {
+			t.Fatalf("Expected %q to parse but got: %+v", *actual.ID, err)
+		}
+		expectedZoneName, expectedName, _ := v.Parse(v.ID)
+		if zoneName != expectedZoneName || name != expectedName {
+			t.Fatalf("Expected the Zone Name / Name to be %q / %q but got %q / %q", expectedZoneName, expectedName, zoneName, name)
+		}
+
+		if _, err := client.DeleteRecordSet(ctx, v.ID); err != nil {
+			t.Fatalf("Error deleting the Record Set: %+v", err)
+		}
+
+		deleted, err := client.GetRecordSet(ctx, v.ID)
+		if err == nil || !utils.ResponseWasNotFound(deleted.Response) {
+			t.Fatalf("Expected the Record Set to have been deleted but got: %+v", err)
+		}
+
+		// deleting a Record Set which doesn't exist is a no-op
+		if _, err := client.DeleteRecordSet(ctx, v.ID); err != nil {
+			t.Fatalf("Expected deleting a missing Record Set to succeed but got: %+v", err)
+		}
+	}
+}
+
+func TestPrivateDnsClient_ZoneAndVirtualNetworkLinkRoundTrip(t *testing.T) {
+	server := testPrivateDnsServer(t)
+	defer server.Close()
+
+	subscriptionId := "00000000-0000-0000-0000-000000000000"
+	client := NewPrivateDnsClient(dns.NewZonesClientWithBaseURI(server.URL, subscriptionId))
+	ctx := context.Background()
+
+	zoneId := NewPrivateDnsZoneID(subscriptionId, "group1", "example.internal")
+	zone := PrivateDnsZone{
+		Location: utils.String("global"),
+		Tags:     map[string]*string{"environment": utils.String("test")},
+	}
+	if _, err := client.CreateOrUpdateZone(ctx, zoneId, zone); err != nil {
+		t.Fatalf("Error creating the Private DNS Zone: %+v", err)
+	}
+
+	actualZone, err := client.GetZone(ctx, zoneId)
+	if err != nil {
+		t.Fatalf("Error retrieving the Private DNS Zone: %+v", err)
+	}
+	if actualZone.ID == nil || *actualZone.ID != zoneId.ID() {
+		t.Fatalf("Expected the Private DNS Zone ID to be %q but got %+v", zoneId.ID(), actualZone.ID)
+	}
+	if !reflect.DeepEqual(zone.Tags, actualZone.Tags) || *actualZone.Location != "global" {
+		t.Fatalf("Expected the Private DNS Zone %+v but got %+v", zone, actualZone)
+	}
+
+	linkId := NewPrivateDnsZoneVirtualNetworkLinkID(subscriptionId, "group1", "example.internal", "link1")
+	virtualNetworkId := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1"
+	link := PrivateDnsZoneVirtualNetworkLink{
+		Location: utils.String("global"),
+		Tags:     map[string]*string{},
+		Properties: &PrivateDnsZoneVirtualNetworkLinkProperties{
+			VirtualNetwork:      &dns.SubResource{ID: utils.String(virtualNetworkId)},
+			RegistrationEnabled: utils.Bool(true),
+		},
+	}
+	if _, err := client.CreateOrUpdateVirtualNetworkLink(ctx, linkId, link); err != nil {
+		t.Fatalf("Error creating the Virtual Network Link: %+v", err)
+	}
+
+	actualLink, err := client.GetVirtualNetworkLink(ctx, linkId)
+	if err != nil {
+		t.Fatalf("Error retrieving the Virtual Network Link: %+v", err)
+	}
+	if !reflect.DeepEqual(link.Properties, actualLink.Properties) {
+		t.Fatalf("Expected the Virtual Network Link properties %+v but got %+v", link.Properties, actualLink.Properties)
+	}
+
+	parsed, err := ParsePrivateDnsZoneVirtualNetworkLinkID(*actualLink.ID)
+	if err != nil {
+		t.Fatalf("Expected %q to parse but got: %+v", *actualLink.ID, err)
+	}
+	if parsed.ZoneName != "example.internal" || parsed.Name != "link1" {
+		t.Fatalf("Expected the Zone Name / Name to be %q / %q but got %q / %q", "example.internal", "link1", parsed.ZoneName, parsed.Name)
+	}
+
+	if _, err := client.DeleteVirtualNetworkLink(ctx, linkId); err != nil {
+		t.Fatalf("Error deleting the Virtual Network Link: %+v", err)
+	}
+	if _, err := client.DeleteZone(ctx, zoneId); err != nil {
+		t.Fatalf("Error deleting the Private DNS Zone: %+v", err)
+	}
+	if resp, err := client.GetZone(ctx, zoneId); err == nil || !utils.ResponseWasNotFound(resp.Response) {
+		t.Fatalf("Expected the Private DNS Zone to have been deleted but got: %+v", err)
+	}
+}
+
+func TestPrivateDnsRecordIDsAreDistinct(t *testing.T) {
+	subscriptionId := "00000000-0000-0000-0000-000000000000"
+	ids := map[string]string{
+		"A":     NewPrivateDnsARecordID(subscriptionId, "group1", "example.internal", "record1").ID(),
+		"AAAA":  NewPrivateDnsAaaaRecordID(subscriptionId, "group1", "example.internal", "record1").ID(),
+		"CNAME": NewPrivateDnsCNameRecordID(subscriptionId, "group1", "example.internal", "record1").ID(),
+		"MX":    NewPrivateDnsMxRecordID(subscriptionId, "group1", "example.internal", "record1").ID(),
+		"PTR":   NewPrivateDnsPtrRecordID(subscriptionId, "group1", "example.internal", "record1").ID(),
+		"SRV":   NewPrivateDnsSrvRecordID(subscriptionId, "group1", "example.internal", "record1").ID(),
+		"TXT":   NewPrivateDnsTxtRecordID(subscriptionId, "group1", "example.internal", "record1").ID(),
+		// a Record in a (Public) DNS Zone isn't a Record in a Private DNS Zone
+		"Public": "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/dnszones/example.internal/A/record1",
+	}
+
+	validators := map[string]func(interface{}, string) ([]string, []error){
+		"A":     ValidatePrivateDnsARecordID,
+		"AAAA":  ValidatePrivateDnsAaaaRecordID,
+		"CNAME": ValidatePrivateDnsCNameRecordID,
+		"MX":    ValidatePrivateDnsMxRecordID,
+		"PTR":   ValidatePrivateDnsPtrRecordID,
+		"SRV":   ValidatePrivateDnsSrvRecordID,
+		"TXT":   ValidatePrivateDnsTxtRecordID,
+	}
+
+	for recordType, validator := range validators {
+		for idType, id := range ids {
+			_, errors := validator(id, "id")
+			if recordType == idType && len(errors) > 0 {
+				t.Fatalf("Expected the %s Record ID %q to be valid but got: %+v", idType, id, errors)
+			}
+			if recordType != idType && len(errors) == 0 {
+				t.Fatalf("Expected the %s Record ID %q not to be a valid %s Record ID", idType, id, recordType)
+			}
+		}
+	}
+}
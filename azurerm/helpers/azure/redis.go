@@ -0,0 +1,78 @@
+package azure
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// redisCacheSkus are the `family` and range of `capacity` supported by each Redis Cache `sku_name`
+var redisCacheSkus = map[string]struct {
+	family      string
+	minCapacity int
+	maxCapacity int
+}{
+	"basic":    {family: "C", minCapacity: 0, maxCapacity: 6},
+	"standard": {family: "C", minCapacity: 0, maxCapacity: 6},
+	"premium":  {family: "P", minCapacity: 1, maxCapacity: 5},
+}
+
+// RedisCacheCustomizeDiff validates at plan time that the `family`, `capacity` and Premium-only features
+// of a Redis Cache are supported by its `sku_name`, rather than being rejected by the API during the apply
+func RedisCacheCustomizeDiff(d *schema.ResourceDiff, _ interface{}) error {
+	if !valuesKnown(d, "sku_name", "family", "capacity") {
+		return nil
+	}
+
+	skuName := d.Get("sku_name").(string)
+	family := d.Get("family").(string)
+	capacity := d.Get("capacity").(int)
+
+	sku, ok := redisCacheSkus[strings.ToLower(skuName)]
+	if !ok {
+		// this is caught by the ValidateFunc
+		return nil
+	}
+
+	if !strings.EqualFold(family, sku.family) {
+		return fmt.Errorf("A `family` of %q must be used with the %q SKU, but got %q", sku.family, skuName, family)
+	}
+
+	if capacity < sku.minCapacity || capacity > sku.maxCapacity {
+		return fmt.Errorf("The %q SKU supports a `capacity` between %d and %d, but got %d", skuName, sku.minCapacity, sku.maxCapacity, capacity)
+	}
+
+	if valuesKnown(d, "subnet_id", "private_static_ip_address") {
+		if d.Get("private_static_ip_address").(string) != "" && d.Get("subnet_id").(string) == "" {
+			return fmt.Errorf("`private_static_ip_address` can only be specified when `subnet_id` is set")
+		}
+	}
+
+	if strings.EqualFold(skuName, "Premium") {
+		return nil
+	}
+
+	premiumOnly := make([]string, 0)
+	if v, ok := d.GetOk("shard_count"); ok && v.(int) > 0 {
+		premiumOnly = append(premiumOnly, "shard_count")
+	}
+	if v, ok := d.GetOk("subnet_id"); ok && v.(string) != "" {
+		premiumOnly = append(premiumOnly, "subnet_id")
+	}
+	if v, ok := d.GetOk("zones"); ok && len(v.([]interface{})) > 0 {
+		premiumOnly = append(premiumOnly, "zones")
+	}
+	if v, ok := d.GetOk("redis_configuration.0.rdb_backup_enabled"); ok && v.(bool) {
+		premiumOnly = append(premiumOnly, "redis_configuration.0.rdb_backup_enabled")
+	}
+	if v, ok := d.GetOk("patch_schedule"); ok && len(v.([]interface{})) > 0 {
+		premiumOnly = append(premiumOnly, "patch_schedule")
+	}
+
+	if len(premiumOnly) > 0 {
+		return fmt.Errorf("`%s` can only be specified when using the Premium SKU, but got %q", strings.Join(premiumOnly, "`, `"), skuName)
+	}
+
+	return nil
+}
@@ -0,0 +1,88 @@
+package azure
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// parseResourceIDWithFormat parses the Resource ID using the format (for example
+// `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}`) - returning the value of each placeholder,
+// in the order they're defined in the format.
+//
+// The static segments (such as `resourceGroups`) are matched case-insensitively, since Azure isn't consistent
+// in the casing it returns, whereas the values are returned exactly as they appear in the Resource ID.
+func parseResourceIDWithFormat(resourceType, format, input string) ([]string, error) {
+	idURL, err := url.ParseRequestURI(input)
+	if err != nil {
+		return nil, fmt.Errorf("Cannot parse %s ID %q: %s", resourceType, input, err)
+	}
+
+	segments := splitResourceIDPath(idURL.Path)
+	formatSegments := splitResourceIDPath(format)
+	if len(segments) != len(formatSegments) {
+		return nil, fmt.Errorf("Expected the %s ID %q to be in the format %q", resourceType, input, format)
+	}
+
+	values := make([]string, 0)
+	for i, formatSegment := range formatSegments {
+		segment := segments[i]
+
+		if isResourceIDPlaceholder(formatSegment) {
+			if segment == "" {
+				return nil, fmt.Errorf("Expected the %s ID %q to contain a value for %s", resourceType, input, formatSegment)
+			}
+
+			values = append(values, segment)
+			continue
+		}
+
+		if !strings.EqualFold(segment, formatSegment) {
+			return nil, fmt.Errorf("Expected the %s ID %q to be in the format %q: expected segment %d to be %q but got %q", resourceType, input, format, i, formatSegment, segment)
+		}
+	}
+
+	return values, nil
+}
+
+// formatResourceID returns the Resource ID for the format, with each of the placeholders replaced by the values in order
+func formatResourceID(format string, values ...string) string {
+	formatSegments := splitResourceIDPath(format)
+
+	segments := make([]string, 0, len(formatSegments))
+	for _, formatSegment := range formatSegments {
+		if isResourceIDPlaceholder(formatSegment) && len(values) > 0 {
+			segments = append(segments, values[0])
+			values = values[1:]
+			continue
+		}
+
+		segments = append(segments, formatSegment)
+	}
+
+	return "/" + strings.Join(segments, "/")
+}
+
+func splitResourceIDPath(path string) []string {
+	path = strings.TrimPrefix(path, "/")
+	path = strings.TrimSuffix(path, "/")
+	return strings.Split(path, "/")
+}
+
+func isResourceIDPlaceholder(segment string) bool {
+	return strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}")
+}
+
+func validateResourceIDWithParser(i interface{}, k string, parse func(string) error) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if err := parse(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a resource id: %v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,6 @@
+package azure
+
+// The typed Resource IDs (e.g. `SubnetID`) are generated from the definitions in `resource_ids.txt` - to add a
+// new Resource ID, add it to that file and then run `go generate` in this directory.
+
+//go:generate go run ../../../scripts/generate-resource-ids/main.go -input=resource_ids.txt -output=resource_ids_generated.go -test-output=resource_ids_generated_test.go
@@ -0,0 +1,4314 @@
+// Code generated by scripts/generate-resource-ids; DO NOT EDIT.
+
+package azure
+
+const applicationGatewayIDFormat = "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/applicationGateways/{name}"
+
+// ApplicationGatewayID is the Resource ID of an Application Gateway
+type ApplicationGatewayID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewApplicationGatewayID returns the Resource ID of the Application Gateway
+func NewApplicationGatewayID(subscriptionID, resourceGroup, name string) ApplicationGatewayID {
+	return ApplicationGatewayID{
+		SubscriptionID: subscriptionID,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the formatted Resource ID of the Application Gateway
+func (id ApplicationGatewayID) ID() string {
+	return formatResourceID(applicationGatewayIDFormat, id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+// ParseApplicationGatewayID parses the Resource ID of an Application Gateway, matching the segments of the ID case-insensitively
+func ParseApplicationGatewayID(input string) (*ApplicationGatewayID, error) {
+	values, err := parseResourceIDWithFormat("Application Gateway", applicationGatewayIDFormat, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ApplicationGatewayID{
+		SubscriptionID: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// ValidateApplicationGatewayID validates that the value is the Resource ID of an Application Gateway
+func ValidateApplicationGatewayID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDWithParser(i, k, func(input string) error {
+		_, err := ParseApplicationGatewayID(input)
+		return err
+	})
+}
+
+// ValidateApplicationGatewayIDOrEmpty validates that the value is either empty or the Resource ID of an Application Gateway
+func ValidateApplicationGatewayIDOrEmpty(i interface{}, k string) (warnings []string, errors []error) {
+	if v, ok := i.(string); ok && v == "" {
+		return
+	}
+
+	return ValidateApplicationGatewayID(i, k)
+}
+
+const applicationInsightsIDFormat = "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/microsoft.insights/components/{name}"
+
+// ApplicationInsightsID is the Resource ID of an Application Insights
+type ApplicationInsightsID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewApplicationInsightsID returns the Resource ID of the Application Insights
+func NewApplicationInsightsID(subscriptionID, resourceGroup, name string) ApplicationInsightsID {
+	return ApplicationInsightsID{
+		SubscriptionID: subscriptionID,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the formatted Resource ID of the Application Insights
+func (id ApplicationInsightsID) ID() string {
+	return formatResourceID(applicationInsightsIDFormat, id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+// ParseApplicationInsightsID parses the Resource ID of an Application Insights, matching the segments of the ID case-insensitively
+func ParseApplicationInsightsID(input string) (*ApplicationInsightsID, error) {
+	values, err := parseResourceIDWithFormat("Application Insights", applicationInsightsIDFormat, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ApplicationInsightsID{
+		SubscriptionID: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// ValidateApplicationInsightsID validates that the value is the Resource ID of an Application Insights
+func ValidateApplicationInsightsID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDWithParser(i, k, func(input string) error {
+		_, err := ParseApplicationInsightsID(input)
+		return err
+	})
+}
+
+// ValidateApplicationInsightsIDOrEmpty validates that the value is either empty or the Resource ID of an Application Insights
+func ValidateApplicationInsightsIDOrEmpty(i interface{}, k string) (warnings []string, errors []error) {
+	if v, ok := i.(string); ok && v == "" {
+		return
+	}
+
+	return ValidateApplicationInsightsID(i, k)
+}
+
+const applicationSecurityGroupIDFormat = "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/applicationSecurityGroups/{name}"
+
+// ApplicationSecurityGroupID is the Resource ID of an Application Security Group
+type ApplicationSecurityGroupID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewApplicationSecurityGroupID returns the Resource ID of the Application Security Group
+func NewApplicationSecurityGroupID(subscriptionID, resourceGroup, name string) ApplicationSecurityGroupID {
+	return ApplicationSecurityGroupID{
+		SubscriptionID: subscriptionID,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the formatted Resource ID of the Application Security Group
+func (id ApplicationSecurityGroupID) ID() string {
+	return formatResourceID(applicationSecurityGroupIDFormat, id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+// ParseApplicationSecurityGroupID parses the Resource ID of an Application Security Group, matching the segments of the ID case-insensitively
+func ParseApplicationSecurityGroupID(input string) (*ApplicationSecurityGroupID, error) {
+	values, err := parseResourceIDWithFormat("Application Security Group", applicationSecurityGroupIDFormat, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ApplicationSecurityGroupID{
+		SubscriptionID: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// ValidateApplicationSecurityGroupID validates that the value is the Resource ID of an Application Security Group
+func ValidateApplicationSecurityGroupID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDWithParser(i, k, func(input string) error {
+		_, err := ParseApplicationSecurityGroupID(input)
+		return err
+	})
+}
+
+// ValidateApplicationSecurityGroupIDOrEmpty validates that the value is either empty or the Resource ID of an Application Security Group
+func ValidateApplicationSecurityGroupIDOrEmpty(i interface{}, k string) (warnings []string, errors []error) {
+	if v, ok := i.(string); ok && v == "" {
+		return
+	}
+
+	return ValidateApplicationSecurityGroupID(i, k)
+}
+
+const appServiceIDFormat = "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Web/sites/{name}"
+
+// AppServiceID is the Resource ID of an App Service
+type AppServiceID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewAppServiceID returns the Resource ID of the App Service
+func NewAppServiceID(subscriptionID, resourceGroup, name string) AppServiceID {
+	return AppServiceID{
+		SubscriptionID: subscriptionID,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the formatted Resource ID of the App Service
+func (id AppServiceID) ID() string {
+	return formatResourceID(appServiceIDFormat, id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+// ParseAppServiceID parses the Resource ID of an App Service, matching the segments of the ID case-insensitively
+func ParseAppServiceID(input string) (*AppServiceID, error) {
+	values, err := parseResourceIDWithFormat("App Service", appServiceIDFormat, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AppServiceID{
+		SubscriptionID: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// ValidateAppServiceID validates that the value is the Resource ID of an App Service
+func ValidateAppServiceID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDWithParser(i, k, func(input string) error {
+		_, err := ParseAppServiceID(input)
+		return err
+	})
+}
+
+// ValidateAppServiceIDOrEmpty validates that the value is either empty or the Resource ID of an App Service
+func ValidateAppServiceIDOrEmpty(i interface{}, k string) (warnings []string, errors []error) {
+	if v, ok := i.(string); ok && v == "" {
+		return
+	}
+
+	return ValidateAppServiceID(i, k)
+}
+
+const appServicePlanIDFormat = "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Web/serverfarms/{name}"
+
+// AppServicePlanID is the Resource ID of an App Service Plan
+type AppServicePlanID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewAppServicePlanID returns the Resource ID of the App Service Plan
+func NewAppServicePlanID(subscriptionID, resourceGroup, name string) AppServicePlanID {
+	return AppServicePlanID{
+		SubscriptionID: subscriptionID,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the formatted Resource ID of the App Service Plan
+func (id AppServicePlanID) ID() string {
+	return formatResourceID(appServicePlanIDFormat, id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+// ParseAppServicePlanID parses the Resource ID of an App Service Plan, matching the segments of the ID case-insensitively
+func ParseAppServicePlanID(input string) (*AppServicePlanID, error) {
+	values, err := parseResourceIDWithFormat("App Service Plan", appServicePlanIDFormat, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AppServicePlanID{
+		SubscriptionID: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// ValidateAppServicePlanID validates that the value is the Resource ID of an App Service Plan
+func ValidateAppServicePlanID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDWithParser(i, k, func(input string) error {
+		_, err := ParseAppServicePlanID(input)
+		return err
+	})
+}
+
+// ValidateAppServicePlanIDOrEmpty validates that the value is either empty or the Resource ID of an App Service Plan
+func ValidateAppServicePlanIDOrEmpty(i interface{}, k string) (warnings []string, errors []error) {
+	if v, ok := i.(string); ok && v == "" {
+		return
+	}
+
+	return ValidateAppServicePlanID(i, k)
+}
+
+const availabilitySetIDFormat = "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Compute/availabilitySets/{name}"
+
+// AvailabilitySetID is the Resource ID of an Availability Set
+type AvailabilitySetID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewAvailabilitySetID returns the Resource ID of the Availability Set
+func NewAvailabilitySetID(subscriptionID, resourceGroup, name string) AvailabilitySetID {
+	return AvailabilitySetID{
+		SubscriptionID: subscriptionID,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the formatted Resource ID of the Availability Set
+func (id AvailabilitySetID) ID() string {
+	return formatResourceID(availabilitySetIDFormat, id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+// ParseAvailabilitySetID parses the Resource ID of an Availability Set, matching the segments of the ID case-insensitively
+func ParseAvailabilitySetID(input string) (*AvailabilitySetID, error) {
+	values, err := parseResourceIDWithFormat("Availability Set", availabilitySetIDFormat, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AvailabilitySetID{
+		SubscriptionID: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// ValidateAvailabilitySetID validates that the value is the Resource ID of an Availability Set
+func ValidateAvailabilitySetID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDWithParser(i, k, func(input string) error {
+		_, err := ParseAvailabilitySetID(input)
+		return err
+	})
+}
+
+// ValidateAvailabilitySetIDOrEmpty validates that the value is either empty or the Resource ID of an Availability Set
+func ValidateAvailabilitySetIDOrEmpty(i interface{}, k string) (warnings []string, errors []error) {
+	if v, ok := i.(string); ok && v == "" {
+		return
+	}
+
+	return ValidateAvailabilitySetID(i, k)
+}
+
+const containerRegistryIDFormat = "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ContainerRegistry/registries/{name}"
+
+// ContainerRegistryID is the Resource ID of a Container Registry
+type ContainerRegistryID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewContainerRegistryID returns the Resource ID of the Container Registry
+func NewContainerRegistryID(subscriptionID, resourceGroup, name string) ContainerRegistryID {
+	return ContainerRegistryID{
+		SubscriptionID: subscriptionID,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the formatted Resource ID of the Container Registry
+func (id ContainerRegistryID) ID() string {
+	return formatResourceID(containerRegistryIDFormat, id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+// ParseContainerRegistryID parses the Resource ID of a Container Registry, matching the segments of the ID case-insensitively
+func ParseContainerRegistryID(input string) (*ContainerRegistryID, error) {
+	values, err := parseResourceIDWithFormat("Container Registry", containerRegistryIDFormat, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ContainerRegistryID{
+		SubscriptionID: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// ValidateContainerRegistryID validates that the value is the Resource ID of a Container Registry
+func ValidateContainerRegistryID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDWithParser(i, k, func(input string) error {
+		_, err := ParseContainerRegistryID(input)
+		return err
+	})
+}
+
+// ValidateContainerRegistryIDOrEmpty validates that the value is either empty or the Resource ID of a Container Registry
+func ValidateContainerRegistryIDOrEmpty(i interface{}, k string) (warnings []string, errors []error) {
+	if v, ok := i.(string); ok && v == "" {
+		return
+	}
+
+	return ValidateContainerRegistryID(i, k)
+}
+
+const cosmosDBAccountIDFormat = "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DocumentDB/databaseAccounts/{name}"
+
+// CosmosDBAccountID is the Resource ID of a Cosmos DB Account
+type CosmosDBAccountID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewCosmosDBAccountID returns the Resource ID of the Cosmos DB Account
+func NewCosmosDBAccountID(subscriptionID, resourceGroup, name string) CosmosDBAccountID {
+	return CosmosDBAccountID{
+		SubscriptionID: subscriptionID,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the formatted Resource ID of the Cosmos DB Account
+func (id CosmosDBAccountID) ID() string {
+	return formatResourceID(cosmosDBAccountIDFormat, id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+// ParseCosmosDBAccountID parses the Resource ID of a Cosmos DB Account, matching the segments of the ID case-insensitively
+func ParseCosmosDBAccountID(input string) (*CosmosDBAccountID, error) {
+	values, err := parseResourceIDWithFormat("Cosmos DB Account", cosmosDBAccountIDFormat, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CosmosDBAccountID{
+		SubscriptionID: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// ValidateCosmosDBAccountID validates that the value is the Resource ID of a Cosmos DB Account
+func ValidateCosmosDBAccountID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDWithParser(i, k, func(input string) error {
+		_, err := ParseCosmosDBAccountID(input)
+		return err
+	})
+}
+
+// ValidateCosmosDBAccountIDOrEmpty validates that the value is either empty or the Resource ID of a Cosmos DB Account
+func ValidateCosmosDBAccountIDOrEmpty(i interface{}, k string) (warnings []string, errors []error) {
+	if v, ok := i.(string); ok && v == "" {
+		return
+	}
+
+	return ValidateCosmosDBAccountID(i, k)
+}
+
+const cosmosDBCassandraKeyspaceIDFormat = "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DocumentDB/databaseAccounts/{accountName}/apis/cassandra/keyspaces/{name}"
+
+// CosmosDBCassandraKeyspaceID is the Resource ID of a Cosmos DB Cassandra Keyspace
+type CosmosDBCassandraKeyspaceID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	AccountName    string
+	Name           string
+}
+
+// NewCosmosDBCassandraKeyspaceID returns the Resource ID of the Cosmos DB Cassandra Keyspace
+func NewCosmosDBCassandraKeyspaceID(subscriptionID, resourceGroup, accountName, name string) CosmosDBCassandraKeyspaceID {
+	return CosmosDBCassandraKeyspaceID{
+		SubscriptionID: subscriptionID,
+		ResourceGroup:  resourceGroup,
+		AccountName:    accountName,
+		Name:           name,
+	}
+}
+
+// ID returns the formatted Resource ID of the Cosmos DB Cassandra Keyspace
+func (id CosmosDBCassandraKeyspaceID) ID() string {
+	return formatResourceID(cosmosDBCassandraKeyspaceIDFormat, id.SubscriptionID, id.ResourceGroup, id.AccountName, id.Name)
+}
+
+// ParseCosmosDBCassandraKeyspaceID parses the Resource ID of a Cosmos DB Cassandra Keyspace, matching the segments of the ID case-insensitively
+func ParseCosmosDBCassandraKeyspaceID(input string) (*CosmosDBCassandraKeyspaceID, error) {
+	values, err := parseResourceIDWithFormat("Cosmos DB Cassandra Keyspace", cosmosDBCassandraKeyspaceIDFormat, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CosmosDBCassandraKeyspaceID{
+		SubscriptionID: values[0],
+		ResourceGroup:  values[1],
+		AccountName:    values[2],
+		Name:           values[3],
+	}, nil
+}
+
+// ValidateCosmosDBCassandraKeyspaceID validates that the value is the Resource ID of a Cosmos DB Cassandra Keyspace
+func ValidateCosmosDBCassandraKeyspaceID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDWithParser(i, k, func(input string) error {
+		_, err := ParseCosmosDBCassandraKeyspaceID(input)
+		return err
+	})
+}
+
+// ValidateCosmosDBCassandraKeyspaceIDOrEmpty validates that the value is either empty or the Resource ID of a Cosmos DB Cassandra Keyspace
+func ValidateCosmosDBCassandraKeyspaceIDOrEmpty(i interface{}, k string) (warnings []string, errors []error) {
+	if v, ok := i.(string); ok && v == "" {
+		return
+	}
+
+	return ValidateCosmosDBCassandraKeyspaceID(i, k)
+}
+
+const cosmosDBCassandraTableIDFormat = "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DocumentDB/databaseAccounts/{accountName}/apis/cassandra/keyspaces/{keyspaceName}/tables/{name}"
+
+// CosmosDBCassandraTableID is the Resource ID of a Cosmos DB Cassandra Table
+type CosmosDBCassandraTableID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	AccountName    string
+	KeyspaceName   string
+	Name           string
+}
+
+// NewCosmosDBCassandraTableID returns the Resource ID of the Cosmos DB Cassandra Table
+func NewCosmosDBCassandraTableID(subscriptionID, resourceGroup, accountName, keyspaceName, name string) CosmosDBCassandraTableID {
+	return CosmosDBCassandraTableID{
+		SubscriptionID: subscriptionID,
+		ResourceGroup:  resourceGroup,
+		AccountName:    accountName,
+		KeyspaceName:   keyspaceName,
+		Name:           name,
+	}
+}
+
+// ID returns the formatted Resource ID of the Cosmos DB Cassandra Table
+func (id CosmosDBCassandraTableID) ID() string {
+	return formatResourceID(cosmosDBCassandraTableIDFormat, id.SubscriptionID, id.ResourceGroup, id.AccountName, id.KeyspaceName, id.Name)
+}
+
+// ParseCosmosDBCassandraTableID parses the Resource ID of a Cosmos DB Cassandra Table, matching the segments of the ID case-insensitively
+func ParseCosmosDBCassandraTableID(input string) (*CosmosDBCassandraTableID, error) {
+	values, err := parseResourceIDWithFormat("Cosmos DB Cassandra Table", cosmosDBCassandraTableIDFormat, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CosmosDBCassandraTableID{
+		SubscriptionID: values[0],
+		ResourceGroup:  values[1],
+		AccountName:    values[2],
+		KeyspaceName:   values[3],
+		Name:           values[4],
+	}, nil
+}
+
+// ValidateCosmosDBCassandraTableID validates that the value is the Resource ID of a Cosmos DB Cassandra Table
+func ValidateCosmosDBCassandraTableID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDWithParser(i, k, func(input string) error {
+		_, err := ParseCosmosDBCassandraTableID(input)
+		return err
+	})
+}
+
+// ValidateCosmosDBCassandraTableIDOrEmpty validates that the value is either empty or the Resource ID of a Cosmos DB Cassandra Table
+func ValidateCosmosDBCassandraTableIDOrEmpty(i interface{}, k string) (warnings []string, errors []error) {
+	if v, ok := i.(string); ok && v == "" {
+		return
+	}
+
+	return ValidateCosmosDBCassandraTableID(i, k)
+}
+
+const cosmosDBGremlinDatabaseIDFormat = "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DocumentDB/databaseAccounts/{accountName}/apis/gremlin/databases/{name}"
+
+// CosmosDBGremlinDatabaseID is the Resource ID of a Cosmos DB Gremlin Database
+type CosmosDBGremlinDatabaseID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	AccountName    string
+	Name           string
+}
+
+// NewCosmosDBGremlinDatabaseID returns the Resource ID of the Cosmos DB Gremlin Database
+func NewCosmosDBGremlinDatabaseID(subscriptionID, resourceGroup, accountName, name string) CosmosDBGremlinDatabaseID {
+	return CosmosDBGremlinDatabaseID{
+		SubscriptionID: subscriptionID,
+		ResourceGroup:  resourceGroup,
+		AccountName:    accountName,
+		Name:           name,
+	}
+}
+
+// ID returns the formatted Resource ID of the Cosmos DB Gremlin Database
+func (id CosmosDBGremlinDatabaseID) ID() string {
+	return formatResourceID(cosmosDBGremlinDatabaseIDFormat, id.SubscriptionID, id.ResourceGroup, id.AccountName, id.Name)
+}
+
+// ParseCosmosDBGremlinDatabaseID parses the Resource ID of a Cosmos DB Gremlin Database, matching the segments of the ID case-insensitively
+func ParseCosmosDBGremlinDatabaseID(input string) (*CosmosDBGremlinDatabaseID, error) {
+	values, err := parseResourceIDWithFormat("Cosmos DB Gremlin Database", cosmosDBGremlinDatabaseIDFormat, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CosmosDBGremlinDatabaseID{
+		SubscriptionID: values[0],
+		ResourceGroup:  values[1],
+		AccountName:    values[2],
+		Name:           values[3],
+	}, nil
+}
+
+// ValidateCosmosDBGremlinDatabaseID validates that the value is the Resource ID of a Cosmos DB Gremlin Database
+func ValidateCosmosDBGremlinDatabaseID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDWithParser(i, k, func(input string) error {
+		_, err := ParseCosmosDBGremlinDatabaseID(input)
+		return err
+	})
+}
+
+// ValidateCosmosDBGremlinDatabaseIDOrEmpty validates that the value is either empty or the Resource ID of a Cosmos DB Gremlin Database
+func ValidateCosmosDBGremlinDatabaseIDOrEmpty(i interface{}, k string) (warnings []string, errors []error) {
+	if v, ok := i.(string); ok && v == "" {
+		return
+	}
+
+	return ValidateCosmosDBGremlinDatabaseID(i, k)
+}
+
+const cosmosDBGremlinGraphIDFormat = "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DocumentDB/databaseAccounts/{accountName}/apis/gremlin/databases/{databaseName}/graphs/{name}"
+
+// CosmosDBGremlinGraphID is the Resource ID of a Cosmos DB Gremlin Graph
+type CosmosDBGremlinGraphID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	AccountName    string
+	DatabaseName   string
+	Name           string
+}
+
+// NewCosmosDBGremlinGraphID returns the Resource ID of the Cosmos DB Gremlin Graph
+func NewCosmosDBGremlinGraphID(subscriptionID, resourceGroup, accountName, databaseName, name string) CosmosDBGremlinGraphID {
+	return CosmosDBGremlinGraphID{
+		SubscriptionID: subscriptionID,
+		ResourceGroup:  resourceGroup,
+		AccountName:    accountName,
+		DatabaseName:   databaseName,
+		Name:           name,
+	}
+}
+
+// ID returns the formatted Resource ID of the Cosmos DB Gremlin Graph
+func (id CosmosDBGremlinGraphID) ID() string {
+	return formatResourceID(cosmosDBGremlinGraphIDFormat, id.SubscriptionID, id.ResourceGroup, id.AccountName, id.DatabaseName, id.Name)
+}
+
+// ParseCosmosDBGremlinGraphID parses the Resource ID of a Cosmos DB Gremlin Graph, matching the segments of the ID case-insensitively
+func ParseCosmosDBGremlinGraphID(input string) (*CosmosDBGremlinGraphID, error) {
+	values, err := parseResourceIDWithFormat("Cosmos DB Gremlin Graph", cosmosDBGremlinGraphIDFormat, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CosmosDBGremlinGraphID{
+		SubscriptionID: values[0],
+		ResourceGroup:  values[1],
+		AccountName:    values[2],
+		DatabaseName:   values[3],
+		Name:           values[4],
+	}, nil
+}
+
+// ValidateCosmosDBGremlinGraphID validates that the value is the Resource ID of a Cosmos DB Gremlin Graph
+func ValidateCosmosDBGremlinGraphID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDWithParser(i, k, func(input string) error {
+		_, err := ParseCosmosDBGremlinGraphID(input)
+		return err
+	})
+}
+
+// ValidateCosmosDBGremlinGraphIDOrEmpty validates that the value is either empty or the Resource ID of a Cosmos DB Gremlin Graph
+func ValidateCosmosDBGremlinGraphIDOrEmpty(i interface{}, k string) (warnings []string, errors []error) {
+	if v, ok := i.(string); ok && v == "" {
+		return
+	}
+
+	return ValidateCosmosDBGremlinGraphID(i, k)
+}
+
+const cosmosDBMongoCollectionIDFormat = "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DocumentDB/databaseAccounts/{accountName}/apis/mongodb/databases/{databaseName}/collections/{name}"
+
+// CosmosDBMongoCollectionID is the Resource ID of a Cosmos DB Mongo Collection
+type CosmosDBMongoCollectionID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	AccountName    string
+	DatabaseName   string
+	Name           string
+}
+
+// NewCosmosDBMongoCollectionID returns the Resource ID of the Cosmos DB Mongo Collection
+func NewCosmosDBMongoCollectionID(subscriptionID, resourceGroup, accountName, databaseName, name string) CosmosDBMongoCollectionID {
+	return CosmosDBMongoCollectionID{
+		SubscriptionID: subscriptionID,
+		ResourceGroup:  resourceGroup,
+		AccountName:    accountName,
+		DatabaseName:   databaseName,
+		Name:           name,
+	}
+}
+
+// ID returns the formatted Resource ID of the Cosmos DB Mongo Collection
+func (id CosmosDBMongoCollectionID) ID() string {
+	return formatResourceID(cosmosDBMongoCollectionIDFormat, id.SubscriptionID, id.ResourceGroup, id.AccountName, id.DatabaseName, id.Name)
+}
+
+// ParseCosmosDBMongoCollectionID parses the Resource ID of a Cosmos DB Mongo Collection, matching the segments of the ID case-insensitively
+func ParseCosmosDBMongoCollectionID(input string) (*CosmosDBMongoCollectionID, error) {
+	values, err := parseResourceIDWithFormat("Cosmos DB Mongo Collection", cosmosDBMongoCollectionIDFormat, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CosmosDBMongoCollectionID{
+		SubscriptionID: values[0],
+		ResourceGroup:  values[1],
+		AccountName:    values[2],
+		DatabaseName:   values[3],
+		Name:           values[4],
+	}, nil
+}
+
+// ValidateCosmosDBMongoCollectionID validates that the value is the Resource ID of a Cosmos DB Mongo Collection
+func ValidateCosmosDBMongoCollectionID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDWithParser(i, k, func(input string) error {
+		_, err := ParseCosmosDBMongoCollectionID(input)
+		return err
+	})
+}
+
+// ValidateCosmosDBMongoCollectionIDOrEmpty validates that the value is either empty or the Resource ID of a Cosmos DB Mongo Collection
+func ValidateCosmosDBMongoCollectionIDOrEmpty(i interface{}, k string) (warnings []string, errors []error) {
+	if v, ok := i.(string); ok && v == "" {
+		return
+	}
+
+	return ValidateCosmosDBMongoCollectionID(i, k)
+}
+
+const cosmosDBMongoDatabaseIDFormat = "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DocumentDB/databaseAccounts/{accountName}/apis/mongodb/databases/{name}"
+
+// CosmosDBMongoDatabaseID is the Resource ID of a Cosmos DB Mongo Database
+type CosmosDBMongoDatabaseID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	AccountName    string
+	Name           string
+}
+
+// NewCosmosDBMongoDatabaseID returns the Resource ID of the Cosmos DB Mongo Database
+func NewCosmosDBMongoDatabaseID(subscriptionID, resourceGroup, accountName, name string) CosmosDBMongoDatabaseID {
+	return CosmosDBMongoDatabaseID{
+		SubscriptionID: subscriptionID,
+		ResourceGroup:  resourceGroup,
+		AccountName:    accountName,
+		Name:           name,
+	}
+}
+
+// ID returns the formatted Resource ID of the Cosmos DB Mongo Database
+func (id CosmosDBMongoDatabaseID) ID() string {
+	return formatResourceID(cosmosDBMongoDatabaseIDFormat, id.SubscriptionID, id.ResourceGroup, id.AccountName, id.Name)
+}
+
+// ParseCosmosDBMongoDatabaseID parses the Resource ID of a Cosmos DB Mongo Database, matching the segments of the ID case-insensitively
+func ParseCosmosDBMongoDatabaseID(input string) (*CosmosDBMongoDatabaseID, error) {
+	values, err := parseResourceIDWithFormat("Cosmos DB Mongo Database", cosmosDBMongoDatabaseIDFormat, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CosmosDBMongoDatabaseID{
+		SubscriptionID: values[0],
+		ResourceGroup:  values[1],
+		AccountName:    values[2],
+		Name:           values[3],
+	}, nil
+}
+
+// ValidateCosmosDBMongoDatabaseID validates that the value is the Resource ID of a Cosmos DB Mongo Database
+func ValidateCosmosDBMongoDatabaseID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDWithParser(i, k, func(input string) error {
+		_, err := ParseCosmosDBMongoDatabaseID(input)
+		return err
+	})
+}
+
+// ValidateCosmosDBMongoDatabaseIDOrEmpty validates that the value is either empty or the Resource ID of a Cosmos DB Mongo Database
+func ValidateCosmosDBMongoDatabaseIDOrEmpty(i interface{}, k string) (warnings []string, errors []error) {
+	if v, ok := i.(string); ok && v == "" {
+		return
+	}
+
+	return ValidateCosmosDBMongoDatabaseID(i, k)
+}
+
+const cosmosDBSqlContainerIDFormat = "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DocumentDB/databaseAccounts/{accountName}/apis/sql/databases/{databaseName}/containers/{name}"
+
+// CosmosDBSqlContainerID is the Resource ID of a Cosmos DB Sql Container
+type CosmosDBSqlContainerID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	AccountName    string
+	DatabaseName   string
+	Name           string
+}
+
+// NewCosmosDBSqlContainerID returns the Resource ID of the Cosmos DB Sql Container
+func NewCosmosDBSqlContainerID(subscriptionID, resourceGroup, accountName, databaseName, name string) CosmosDBSqlContainerID {
+	return CosmosDBSqlContainerID{
+		SubscriptionID: subscriptionID,
+		ResourceGroup:  resourceGroup,
+		AccountName:    accountName,
+		DatabaseName:   databaseName,
+		Name:           name,
+	}
+}
+
+// ID returns the formatted Resource ID of the Cosmos DB Sql Container
+func (id CosmosDBSqlContainerID) ID() string {
+	return formatResourceID(cosmosDBSqlContainerIDFormat, id.SubscriptionID, id.ResourceGroup, id.AccountName, id.DatabaseName, id.Name)
+}
+
+// ParseCosmosDBSqlContainerID parses the Resource ID of a Cosmos DB Sql Container, matching the segments of the ID case-insensitively
+func ParseCosmosDBSqlContainerID(input string) (*CosmosDBSqlContainerID, error) {
+	values, err := parseResourceIDWithFormat("Cosmos DB Sql Container", cosmosDBSqlContainerIDFormat, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CosmosDBSqlContainerID{
+		SubscriptionID: values[0],
+		ResourceGroup:  values[1],
+		AccountName:    values[2],
+		DatabaseName:   values[3],
+		Name:           values[4],
+	}, nil
+}
+
+// ValidateCosmosDBSqlContainerID validates that the value is the Resource ID of a Cosmos DB Sql Container
+func ValidateCosmosDBSqlContainerID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDWithParser(i, k, func(input string) error {
+		_, err := ParseCosmosDBSqlContainerID(input)
+		return err
+	})
+}
+
+// ValidateCosmosDBSqlContainerIDOrEmpty validates that the value is either empty or the Resource ID of a Cosmos DB Sql Container
+func ValidateCosmosDBSqlContainerIDOrEmpty(i interface{}, k string) (warnings []string, errors []error) {
+	if v, ok := i.(string); ok && v == "" {
+		return
+	}
+
+	return ValidateCosmosDBSqlContainerID(i, k)
+}
+
+const cosmosDBSqlDatabaseIDFormat = "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DocumentDB/databaseAccounts/{accountName}/apis/sql/databases/{name}"
+
+// CosmosDBSqlDatabaseID is the Resource ID of a Cosmos DB Sql Database
+type CosmosDBSqlDatabaseID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	AccountName    string
+	Name           string
+}
+
+// NewCosmosDBSqlDatabaseID returns the Resource ID of the Cosmos DB Sql Database
+func NewCosmosDBSqlDatabaseID(subscriptionID, resourceGroup, accountName, name string) CosmosDBSqlDatabaseID {
+	return CosmosDBSqlDatabaseID{
+		SubscriptionID: subscriptionID,
+		ResourceGroup:  resourceGroup,
+		AccountName:    accountName,
+		Name:           name,
+	}
+}
+
+// ID returns the formatted Resource ID of the Cosmos DB Sql Database
+func (id CosmosDBSqlDatabaseID) ID() string {
+	return formatResourceID(cosmosDBSqlDatabaseIDFormat, id.SubscriptionID, id.ResourceGroup, id.AccountName, id.Name)
+}
+
+// ParseCosmosDBSqlDatabaseID parses the Resource ID of a Cosmos DB Sql Database, matching the segments of the ID case-insensitively
+func ParseCosmosDBSqlDatabaseID(input string) (*CosmosDBSqlDatabaseID, error) {
+	values, err := parseResourceIDWithFormat("Cosmos DB Sql Database", cosmosDBSqlDatabaseIDFormat, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CosmosDBSqlDatabaseID{
+		SubscriptionID: values[0],
+		ResourceGroup:  values[1],
+		AccountName:    values[2],
+		Name:           values[3],
+	}, nil
+}
+
+// ValidateCosmosDBSqlDatabaseID validates that the value is the Resource ID of a Cosmos DB Sql Database
+func ValidateCosmosDBSqlDatabaseID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDWithParser(i, k, func(input string) error {
+		_, err := ParseCosmosDBSqlDatabaseID(input)
+		return err
+	})
+}
+
+// ValidateCosmosDBSqlDatabaseIDOrEmpty validates that the value is either empty or the Resource ID of a Cosmos DB Sql Database
+func ValidateCosmosDBSqlDatabaseIDOrEmpty(i interface{}, k string) (warnings []string, errors []error) {
+	if v, ok := i.(string); ok && v == "" {
+		return
+	}
+
+	return ValidateCosmosDBSqlDatabaseID(i, k)
+}
+
+const cosmosDBTableIDFormat = "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.DocumentDB/databaseAccounts/{accountName}/apis/table/tables/{name}"
+
+// CosmosDBTableID is the Resource ID of a Cosmos DB Table
+type CosmosDBTableID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	AccountName    string
+	Name           string
+}
+
+// NewCosmosDBTableID returns the Resource ID of the Cosmos DB Table
+func NewCosmosDBTableID(subscriptionID, resourceGroup, accountName, name string) CosmosDBTableID {
+	return CosmosDBTableID{
+		SubscriptionID: subscriptionID,
+		ResourceGroup:  resourceGroup,
+		AccountName:    accountName,
+		Name:           name,
+	}
+}
+
+// ID returns the formatted Resource ID of the Cosmos DB Table
+func (id CosmosDBTableID) ID() string {
+	return formatResourceID(cosmosDBTableIDFormat, id.SubscriptionID, id.ResourceGroup, id.AccountName, id.Name)
+}
+
+// ParseCosmosDBTableID parses the Resource ID of a Cosmos DB Table, matching the segments of the ID case-insensitively
+func ParseCosmosDBTableID(input string) (*CosmosDBTableID, error) {
+	values, err := parseResourceIDWithFormat("Cosmos DB Table", cosmosDBTableIDFormat, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CosmosDBTableID{
+		SubscriptionID: values[0],
+		ResourceGroup:  values[1],
+		AccountName:    values[2],
+		Name:           values[3],
+	}, nil
+}
+
+// ValidateCosmosDBTableID validates that the value is the Resource ID of a Cosmos DB Table
+func ValidateCosmosDBTableID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDWithParser(i, k, func(input string) error {
+		_, err := ParseCosmosDBTableID(input)
+		return err
+	})
+}
+
+// ValidateCosmosDBTableIDOrEmpty validates that the value is either empty or the Resource ID of a Cosmos DB Table
+func ValidateCosmosDBTableIDOrEmpty(i interface{}, k string) (warnings []string, errors []error) {
+	if v, ok := i.(string); ok && v == "" {
+		return
+	}
+
+	return ValidateCosmosDBTableID(i, k)
+}
+
+const dnsZoneIDFormat = "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/dnszones/{name}"
+
+// DnsZoneID is the Resource ID of a Dns Zone
+type DnsZoneID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewDnsZoneID returns the Resource ID of the Dns Zone
+func NewDnsZoneID(subscriptionID, resourceGroup, name string) DnsZoneID {
+	return DnsZoneID{
+		SubscriptionID: subscriptionID,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the formatted Resource ID of the Dns Zone
+func (id DnsZoneID) ID() string {
+	return formatResourceID(dnsZoneIDFormat, id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+// ParseDnsZoneID parses the Resource ID of a Dns Zone, matching the segments of the ID case-insensitively
+func ParseDnsZoneID(input string) (*DnsZoneID, error) {
+	values, err := parseResourceIDWithFormat("Dns Zone", dnsZoneIDFormat, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DnsZoneID{
+		SubscriptionID: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// ValidateDnsZoneID validates that the value is the Resource ID of a Dns Zone
+func ValidateDnsZoneID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDWithParser(i, k, func(input string) error {
+		_, err := ParseDnsZoneID(input)
+		return err
+	})
+}
+
+// ValidateDnsZoneIDOrEmpty validates that the value is either empty or the Resource ID of a Dns Zone
+func ValidateDnsZoneIDOrEmpty(i interface{}, k string) (warnings []string, errors []error) {
+	if v, ok := i.(string); ok && v == "" {
+		return
+	}
+
+	return ValidateDnsZoneID(i, k)
+}
+
+const eventHubIDFormat = "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.EventHub/namespaces/{namespaceName}/eventhubs/{name}"
+
+// EventHubID is the Resource ID of an Event Hub
+type EventHubID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	NamespaceName  string
+	Name           string
+}
+
+// NewEventHubID returns the Resource ID of the Event Hub
+func NewEventHubID(subscriptionID, resourceGroup, namespaceName, name string) EventHubID {
+	return EventHubID{
+		SubscriptionID: subscriptionID,
+		ResourceGroup:  resourceGroup,
+		NamespaceName:  namespaceName,
+		Name:           name,
+	}
+}
+
+// ID returns the formatted Resource ID of the Event Hub
+func (id EventHubID) ID() string {
+	return formatResourceID(eventHubIDFormat, id.SubscriptionID, id.ResourceGroup, id.NamespaceName, id.Name)
+}
+
+// ParseEventHubID parses the Resource ID of an Event Hub, matching the segments of the ID case-insensitively
+func ParseEventHubID(input string) (*EventHubID, error) {
+	values, err := parseResourceIDWithFormat("Event Hub", eventHubIDFormat, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &EventHubID{
+		SubscriptionID: values[0],
+		ResourceGroup:  values[1],
+		NamespaceName:  values[2],
+		Name:           values[3],
+	}, nil
+}
+
+// ValidateEventHubID validates that the value is the Resource ID of an Event Hub
+func ValidateEventHubID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDWithParser(i, k, func(input string) error {
+		_, err := ParseEventHubID(input)
+		return err
+	})
+}
+
+// ValidateEventHubIDOrEmpty validates that the value is either empty or the Resource ID of an Event Hub
+func ValidateEventHubIDOrEmpty(i interface{}, k string) (warnings []string, errors []error) {
+	if v, ok := i.(string); ok && v == "" {
+		return
+	}
+
+	return ValidateEventHubID(i, k)
+}
+
+const eventHubNamespaceIDFormat = "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.EventHub/namespaces/{name}"
+
+// EventHubNamespaceID is the Resource ID of an Event Hub Namespace
+type EventHubNamespaceID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewEventHubNamespaceID returns the Resource ID of the Event Hub Namespace
+func NewEventHubNamespaceID(subscriptionID, resourceGroup, name string) EventHubNamespaceID {
+	return EventHubNamespaceID{
+		SubscriptionID: subscriptionID,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the formatted Resource ID of the Event Hub Namespace
+func (id EventHubNamespaceID) ID() string {
+	return formatResourceID(eventHubNamespaceIDFormat, id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+// ParseEventHubNamespaceID parses the Resource ID of an Event Hub Namespace, matching the segments of the ID case-insensitively
+func ParseEventHubNamespaceID(input string) (*EventHubNamespaceID, error) {
+	values, err := parseResourceIDWithFormat("Event Hub Namespace", eventHubNamespaceIDFormat, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &EventHubNamespaceID{
+		SubscriptionID: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// ValidateEventHubNamespaceID validates that the value is the Resource ID of an Event Hub Namespace
+func ValidateEventHubNamespaceID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDWithParser(i, k, func(input string) error {
+		_, err := ParseEventHubNamespaceID(input)
+		return err
+	})
+}
+
+// ValidateEventHubNamespaceIDOrEmpty validates that the value is either empty or the Resource ID of an Event Hub Namespace
+func ValidateEventHubNamespaceIDOrEmpty(i interface{}, k string) (warnings []string, errors []error) {
+	if v, ok := i.(string); ok && v == "" {
+		return
+	}
+
+	return ValidateEventHubNamespaceID(i, k)
+}
+
+const eventHubNamespaceAuthorizationRuleIDFormat = "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.EventHub/namespaces/{namespaceName}/authorizationRules/{name}"
+
+// EventHubNamespaceAuthorizationRuleID is the Resource ID of an Event Hub Namespace Authorization Rule
+type EventHubNamespaceAuthorizationRuleID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	NamespaceName  string
+	Name           string
+}
+
+// NewEventHubNamespaceAuthorizationRuleID returns the Resource ID of the Event Hub Namespace Authorization Rule
+func NewEventHubNamespaceAuthorizationRuleID(subscriptionID, resourceGroup, namespaceName, name string) EventHubNamespaceAuthorizationRuleID {
+	return EventHubNamespaceAuthorizationRuleID{
+		SubscriptionID: subscriptionID,
+		ResourceGroup:  resourceGroup,
+		NamespaceName:  namespaceName,
+		Name:           name,
+	}
+}
+
+// ID returns the formatted Resource ID of the Event Hub Namespace Authorization Rule
+func (id EventHubNamespaceAuthorizationRuleID) ID() string {
+	return formatResourceID(eventHubNamespaceAuthorizationRuleIDFormat, id.SubscriptionID, id.ResourceGroup, id.NamespaceName, id.Name)
+}
+
+// ParseEventHubNamespaceAuthorizationRuleID parses the Resource ID of an Event Hub Namespace Authorization Rule, matching the segments of the ID case-insensitively
+func ParseEventHubNamespaceAuthorizationRuleID(input string) (*EventHubNamespaceAuthorizationRuleID, error) {
+	values, err := parseResourceIDWithFormat("Event Hub Namespace Authorization Rule", eventHubNamespaceAuthorizationRuleIDFormat, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &EventHubNamespaceAuthorizationRuleID{
+		SubscriptionID: values[0],
+		ResourceGroup:  values[1],
+		NamespaceName:  values[2],
+		Name:           values[3],
+	}, nil
+}
+
+// ValidateEventHubNamespaceAuthorizationRuleID validates that the value is the Resource ID of an Event Hub Namespace Authorization Rule
+func ValidateEventHubNamespaceAuthorizationRuleID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDWithParser(i, k, func(input string) error {
+		_, err := ParseEventHubNamespaceAuthorizationRuleID(input)
+		return err
+	})
+}
+
+// ValidateEventHubNamespaceAuthorizationRuleIDOrEmpty validates that the value is either empty or the Resource ID of an Event Hub Namespace Authorization Rule
+func ValidateEventHubNamespaceAuthorizationRuleIDOrEmpty(i interface{}, k string) (warnings []string, errors []error) {
+	if v, ok := i.(string); ok && v == "" {
+		return
+	}
+
+	return ValidateEventHubNamespaceAuthorizationRuleID(i, k)
+}
+
+const expressRouteCircuitIDFormat = "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/expressRouteCircuits/{name}"
+
+// ExpressRouteCircuitID is the Resource ID of an Express Route Circuit
+type ExpressRouteCircuitID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewExpressRouteCircuitID returns the Resource ID of the Express Route Circuit
+func NewExpressRouteCircuitID(subscriptionID, resourceGroup, name string) ExpressRouteCircuitID {
+	return ExpressRouteCircuitID{
+		SubscriptionID: subscriptionID,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the formatted Resource ID of the Express Route Circuit
+func (id ExpressRouteCircuitID) ID() string {
+	return formatResourceID(expressRouteCircuitIDFormat, id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+// ParseExpressRouteCircuitID parses the Resource ID of an Express Route Circuit, matching the segments of the ID case-insensitively
+func ParseExpressRouteCircuitID(input string) (*ExpressRouteCircuitID, error) {
+	values, err := parseResourceIDWithFormat("Express Route Circuit", expressRouteCircuitIDFormat, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ExpressRouteCircuitID{
+		SubscriptionID: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// ValidateExpressRouteCircuitID validates that the value is the Resource ID of an Express Route Circuit
+func ValidateExpressRouteCircuitID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDWithParser(i, k, func(input string) error {
+		_, err := ParseExpressRouteCircuitID(input)
+		return err
+	})
+}
+
+// ValidateExpressRouteCircuitIDOrEmpty validates that the value is either empty or the Resource ID of an Express Route Circuit
+func ValidateExpressRouteCircuitIDOrEmpty(i interface{}, k string) (warnings []string, errors []error) {
+	if v, ok := i.(string); ok && v == "" {
+		return
+	}
+
+	return ValidateExpressRouteCircuitID(i, k)
+}
+
+const firewallIDFormat = "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/azureFirewalls/{name}"
+
+// FirewallID is the Resource ID of a Firewall
+type FirewallID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewFirewallID returns the Resource ID of the Firewall
+func NewFirewallID(subscriptionID, resourceGroup, name string) FirewallID {
+	return FirewallID{
+		SubscriptionID: subscriptionID,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the formatted Resource ID of the Firewall
+func (id FirewallID) ID() string {
+	return formatResourceID(firewallIDFormat, id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+// ParseFirewallID parses the Resource ID of a Firewall, matching the segments of the ID case-insensitively
+func ParseFirewallID(input string) (*FirewallID, error) {
+	values, err := parseResourceIDWithFormat("Firewall", firewallIDFormat, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &FirewallID{
+		SubscriptionID: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// ValidateFirewallID validates that the value is the Resource ID of a Firewall
+func ValidateFirewallID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDWithParser(i, k, func(input string) error {
+		_, err := ParseFirewallID(input)
+		return err
+	})
+}
+
+// ValidateFirewallIDOrEmpty validates that the value is either empty or the Resource ID of a Firewall
+func ValidateFirewallIDOrEmpty(i interface{}, k string) (warnings []string, errors []error) {
+	if v, ok := i.(string); ok && v == "" {
+		return
+	}
+
+	return ValidateFirewallID(i, k)
+}
+
+const imageIDFormat = "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Compute/images/{name}"
+
+// ImageID is the Resource ID of an Image
+type ImageID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewImageID returns the Resource ID of the Image
+func NewImageID(subscriptionID, resourceGroup, name string) ImageID {
+	return ImageID{
+		SubscriptionID: subscriptionID,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the formatted Resource ID of the Image
+func (id ImageID) ID() string {
+	return formatResourceID(imageIDFormat, id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+// ParseImageID parses the Resource ID of an Image, matching the segments of the ID case-insensitively
+func ParseImageID(input string) (*ImageID, error) {
+	values, err := parseResourceIDWithFormat("Image", imageIDFormat, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ImageID{
+		SubscriptionID: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// ValidateImageID validates that the value is the Resource ID of an Image
+func ValidateImageID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDWithParser(i, k, func(input string) error {
+		_, err := ParseImageID(input)
+		return err
+	})
+}
+
+// ValidateImageIDOrEmpty validates that the value is either empty or the Resource ID of an Image
+func ValidateImageIDOrEmpty(i interface{}, k string) (warnings []string, errors []error) {
+	if v, ok := i.(string); ok && v == "" {
+		return
+	}
+
+	return ValidateImageID(i, k)
+}
+
+const keyVaultIDFormat = "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.KeyVault/vaults/{name}"
+
+// KeyVaultID is the Resource ID of a Key Vault
+type KeyVaultID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewKeyVaultID returns the Resource ID of the Key Vault
+func NewKeyVaultID(subscriptionID, resourceGroup, name string) KeyVaultID {
+	return KeyVaultID{
+		SubscriptionID: subscriptionID,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the formatted Resource ID of the Key Vault
+func (id KeyVaultID) ID() string {
+	return formatResourceID(keyVaultIDFormat, id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+// ParseKeyVaultID parses the Resource ID of a Key Vault, matching the segments of the ID case-insensitively
+func ParseKeyVaultID(input string) (*KeyVaultID, error) {
+	values, err := parseResourceIDWithFormat("Key Vault", keyVaultIDFormat, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &KeyVaultID{
+		SubscriptionID: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// ValidateKeyVaultID validates that the value is the Resource ID of a Key Vault
+func ValidateKeyVaultID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDWithParser(i, k, func(input string) error {
+		_, err := ParseKeyVaultID(input)
+		return err
+	})
+}
+
+// ValidateKeyVaultIDOrEmpty validates that the value is either empty or the Resource ID of a Key Vault
+func ValidateKeyVaultIDOrEmpty(i interface{}, k string) (warnings []string, errors []error) {
+	if v, ok := i.(string); ok && v == "" {
+		return
+	}
+
+	return ValidateKeyVaultID(i, k)
+}
+
+const kubernetesClusterIDFormat = "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ContainerService/managedClusters/{name}"
+
+// KubernetesClusterID is the Resource ID of a Kubernetes Cluster
+type KubernetesClusterID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewKubernetesClusterID returns the Resource ID of the Kubernetes Cluster
+func NewKubernetesClusterID(subscriptionID, resourceGroup, name string) KubernetesClusterID {
+	return KubernetesClusterID{
+		SubscriptionID: subscriptionID,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the formatted Resource ID of the Kubernetes Cluster
+func (id KubernetesClusterID) ID() string {
+	return formatResourceID(kubernetesClusterIDFormat, id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+// ParseKubernetesClusterID parses the Resource ID of a Kubernetes Cluster, matching the segments of the ID case-insensitively
+func ParseKubernetesClusterID(input string) (*KubernetesClusterID, error) {
+	values, err := parseResourceIDWithFormat("Kubernetes Cluster", kubernetesClusterIDFormat, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &KubernetesClusterID{
+		SubscriptionID: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// ValidateKubernetesClusterID validates that the value is the Resource ID of a Kubernetes Cluster
+func ValidateKubernetesClusterID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDWithParser(i, k, func(input string) error {
+		_, err := ParseKubernetesClusterID(input)
+		return err
+	})
+}
+
+// ValidateKubernetesClusterIDOrEmpty validates that the value is either empty or the Resource ID of a Kubernetes Cluster
+func ValidateKubernetesClusterIDOrEmpty(i interface{}, k string) (warnings []string, errors []error) {
+	if v, ok := i.(string); ok && v == "" {
+		return
+	}
+
+	return ValidateKubernetesClusterID(i, k)
+}
+
+const kubernetesClusterNodePoolIDFormat = "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ContainerService/managedClusters/{clusterName}/agentPools/{name}"
+
+// KubernetesClusterNodePoolID is the Resource ID of a Kubernetes Cluster Node Pool
+type KubernetesClusterNodePoolID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	ClusterName    string
+	Name           string
+}
+
+// NewKubernetesClusterNodePoolID returns the Resource ID of the Kubernetes Cluster Node Pool
+func NewKubernetesClusterNodePoolID(subscriptionID, resourceGroup, clusterName, name string) KubernetesClusterNodePoolID {
+	return KubernetesClusterNodePoolID{
+		SubscriptionID: subscriptionID,
+		ResourceGroup:  resourceGroup,
+		ClusterName:    clusterName,
+		Name:           name,
+	}
+}
+
+// ID returns the formatted Resource ID of the Kubernetes Cluster Node Pool
+func (id KubernetesClusterNodePoolID) ID() string {
+	return formatResourceID(kubernetesClusterNodePoolIDFormat, id.SubscriptionID, id.ResourceGroup, id.ClusterName, id.Name)
+}
+
+// ParseKubernetesClusterNodePoolID parses the Resource ID of a Kubernetes Cluster Node Pool, matching the segments of the ID case-insensitively
+func ParseKubernetesClusterNodePoolID(input string) (*KubernetesClusterNodePoolID, error) {
+	values, err := parseResourceIDWithFormat("Kubernetes Cluster Node Pool", kubernetesClusterNodePoolIDFormat, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &KubernetesClusterNodePoolID{
+		SubscriptionID: values[0],
+		ResourceGroup:  values[1],
+		ClusterName:    values[2],
+		Name:           values[3],
+	}, nil
+}
+
+// ValidateKubernetesClusterNodePoolID validates that the value is the Resource ID of a Kubernetes Cluster Node Pool
+func ValidateKubernetesClusterNodePoolID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDWithParser(i, k, func(input string) error {
+		_, err := ParseKubernetesClusterNodePoolID(input)
+		return err
+	})
+}
+
+// ValidateKubernetesClusterNodePoolIDOrEmpty validates that the value is either empty or the Resource ID of a Kubernetes Cluster Node Pool
+func ValidateKubernetesClusterNodePoolIDOrEmpty(i interface{}, k string) (warnings []string, errors []error) {
+	if v, ok := i.(string); ok && v == "" {
+		return
+	}
+
+	return ValidateKubernetesClusterNodePoolID(i, k)
+}
+
+const loadBalancerIDFormat = "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/loadBalancers/{name}"
+
+// LoadBalancerID is the Resource ID of a Load Balancer
+type LoadBalancerID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewLoadBalancerID returns the Resource ID of the Load Balancer
+func NewLoadBalancerID(subscriptionID, resourceGroup, name string) LoadBalancerID {
+	return LoadBalancerID{
+		SubscriptionID: subscriptionID,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the formatted Resource ID of the Load Balancer
+func (id LoadBalancerID) ID() string {
+	return formatResourceID(loadBalancerIDFormat, id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+// ParseLoadBalancerID parses the Resource ID of a Load Balancer, matching the segments of the ID case-insensitively
+func ParseLoadBalancerID(input string) (*LoadBalancerID, error) {
+	values, err := parseResourceIDWithFormat("Load Balancer", loadBalancerIDFormat, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LoadBalancerID{
+		SubscriptionID: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// ValidateLoadBalancerID validates that the value is the Resource ID of a Load Balancer
+func ValidateLoadBalancerID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDWithParser(i, k, func(input string) error {
+		_, err := ParseLoadBalancerID(input)
+		return err
+	})
+}
+
+// ValidateLoadBalancerIDOrEmpty validates that the value is either empty or the Resource ID of a Load Balancer
+func ValidateLoadBalancerIDOrEmpty(i interface{}, k string) (warnings []string, errors []error) {
+	if v, ok := i.(string); ok && v == "" {
+		return
+	}
+
+	return ValidateLoadBalancerID(i, k)
+}
+
+const loadBalancerBackendAddressPoolIDFormat = "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/loadBalancers/{loadBalancerName}/backendAddressPools/{name}"
+
+// LoadBalancerBackendAddressPoolID is the Resource ID of a Load Balancer Backend Address Pool
+type LoadBalancerBackendAddressPoolID struct {
+	SubscriptionID   string
+	ResourceGroup    string
+	LoadBalancerName string
+	Name             string
+}
+
+// NewLoadBalancerBackendAddressPoolID returns the Resource ID of the Load Balancer Backend Address Pool
+func NewLoadBalancerBackendAddressPoolID(subscriptionID, resourceGroup, loadBalancerName, name string) LoadBalancerBackendAddressPoolID {
+	return LoadBalancerBackendAddressPoolID{
+		SubscriptionID:   subscriptionID,
+		ResourceGroup:    resourceGroup,
+		LoadBalancerName: loadBalancerName,
+		Name:             name,
+	}
+}
+
+// ID returns the formatted Resource ID of the Load Balancer Backend Address Pool
+func (id LoadBalancerBackendAddressPoolID) ID() string {
+	return formatResourceID(loadBalancerBackendAddressPoolIDFormat, id.SubscriptionID, id.ResourceGroup, id.LoadBalancerName, id.Name)
+}
+
+// ParseLoadBalancerBackendAddressPoolID parses the Resource ID of a Load Balancer Backend Address Pool, matching the segments of the ID case-insensitively
+func ParseLoadBalancerBackendAddressPoolID(input string) (*LoadBalancerBackendAddressPoolID, error) {
+	values, err := parseResourceIDWithFormat("Load Balancer Backend Address Pool", loadBalancerBackendAddressPoolIDFormat, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LoadBalancerBackendAddressPoolID{
+		SubscriptionID:   values[0],
+		ResourceGroup:    values[1],
+		LoadBalancerName: values[2],
+		Name:             values[3],
+	}, nil
+}
+
+// ValidateLoadBalancerBackendAddressPoolID validates that the value is the Resource ID of a Load Balancer Backend Address Pool
+func ValidateLoadBalancerBackendAddressPoolID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDWithParser(i, k, func(input string) error {
+		_, err := ParseLoadBalancerBackendAddressPoolID(input)
+		return err
+	})
+}
+
+// ValidateLoadBalancerBackendAddressPoolIDOrEmpty validates that the value is either empty or the Resource ID of a Load Balancer Backend Address Pool
+func ValidateLoadBalancerBackendAddressPoolIDOrEmpty(i interface{}, k string) (warnings []string, errors []error) {
+	if v, ok := i.(string); ok && v == "" {
+		return
+	}
+
+	return ValidateLoadBalancerBackendAddressPoolID(i, k)
+}
+
+const loadBalancerInboundNatRuleIDFormat = "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/loadBalancers/{loadBalancerName}/inboundNatRules/{name}"
+
+// LoadBalancerInboundNatRuleID is the Resource ID of a Load Balancer Inbound Nat Rule
+type LoadBalancerInboundNatRuleID struct {
+	SubscriptionID   string
+	ResourceGroup    string
+	LoadBalancerName string
+	Name             string
+}
+
+// NewLoadBalancerInboundNatRuleID returns the Resource ID of the Load Balancer Inbound Nat Rule
+func NewLoadBalancerInboundNatRuleID(subscriptionID, resourceGroup, loadBalancerName, name string) LoadBalancerInboundNatRuleID {
+	return LoadBalancerInboundNatRuleID{
+		SubscriptionID:   subscriptionID,
+		ResourceGroup:    resourceGroup,
+		LoadBalancerName: loadBalancerName,
+		Name:             name,
+	}
+}
+
+// ID returns the formatted Resource ID of the Load Balancer Inbound Nat Rule
+func (id LoadBalancerInboundNatRuleID) ID() string {
+	return formatResourceID(loadBalancerInboundNatRuleIDFormat, id.SubscriptionID, id.ResourceGroup, id.LoadBalancerName, id.Name)
+}
+
+// ParseLoadBalancerInboundNatRuleID parses the Resource ID of a Load Balancer Inbound Nat Rule, matching the segments of the ID case-insensitively
+func ParseLoadBalancerInboundNatRuleID(input string) (*LoadBalancerInboundNatRuleID, error) {
+	values, err := parseResourceIDWithFormat("Load Balancer Inbound Nat Rule", loadBalancerInboundNatRuleIDFormat, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LoadBalancerInboundNatRuleID{
+		SubscriptionID:   values[0],
+		ResourceGroup:    values[1],
+		LoadBalancerName: values[2],
+		Name:             values[3],
+	}, nil
+}
+
+// ValidateLoadBalancerInboundNatRuleID validates that the value is the Resource ID of a Load Balancer Inbound Nat Rule
+func ValidateLoadBalancerInboundNatRuleID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDWithParser(i, k, func(input string) error {
+		_, err := ParseLoadBalancerInboundNatRuleID(input)
+		return err
+	})
+}
+
+// ValidateLoadBalancerInboundNatRuleIDOrEmpty validates that the value is either empty or the Resource ID of a Load Balancer Inbound Nat Rule
+func ValidateLoadBalancerInboundNatRuleIDOrEmpty(i interface{}, k string) (warnings []string, errors []error) {
+	if v, ok := i.(string); ok && v == "" {
+		return
+	}
+
+	return ValidateLoadBalancerInboundNatRuleID(i, k)
+}
+
+const loadBalancerProbeIDFormat = "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/loadBalancers/{loadBalancerName}/probes/{name}"
+
+// LoadBalancerProbeID is the Resource ID of a Load Balancer Probe
+type LoadBalancerProbeID struct {
+	SubscriptionID   string
+	ResourceGroup    string
+	LoadBalancerName string
+	Name             string
+}
+
+// NewLoadBalancerProbeID returns the Resource ID of the Load Balancer Probe
+func NewLoadBalancerProbeID(subscriptionID, resourceGroup, loadBalancerName, name string) LoadBalancerProbeID {
+	return LoadBalancerProbeID{
+		SubscriptionID:   subscriptionID,
+		ResourceGroup:    resourceGroup,
+		LoadBalancerName: loadBalancerName,
+		Name:             name,
+	}
+}
+
+// ID returns the formatted Resource ID of the Load Balancer Probe
+func (id LoadBalancerProbeID) ID() string {
+	return formatResourceID(loadBalancerProbeIDFormat, id.SubscriptionID, id.ResourceGroup, id.LoadBalancerName, id.Name)
+}
+
+// ParseLoadBalancerProbeID parses the Resource ID of a Load Balancer Probe, matching the segments of the ID case-insensitively
+func ParseLoadBalancerProbeID(input string) (*LoadBalancerProbeID, error) {
+	values, err := parseResourceIDWithFormat("Load Balancer Probe", loadBalancerProbeIDFormat, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LoadBalancerProbeID{
+		SubscriptionID:   values[0],
+		ResourceGroup:    values[1],
+		LoadBalancerName: values[2],
+		Name:             values[3],
+	}, nil
+}
+
+// ValidateLoadBalancerProbeID validates that the value is the Resource ID of a Load Balancer Probe
+func ValidateLoadBalancerProbeID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDWithParser(i, k, func(input string) error {
+		_, err := ParseLoadBalancerProbeID(input)
+		return err
+	})
+}
+
+// ValidateLoadBalancerProbeIDOrEmpty validates that the value is either empty or the Resource ID of a Load Balancer Probe
+func ValidateLoadBalancerProbeIDOrEmpty(i interface{}, k string) (warnings []string, errors []error) {
+	if v, ok := i.(string); ok && v == "" {
+		return
+	}
+
+	return ValidateLoadBalancerProbeID(i, k)
+}
+
+const localNetworkGatewayIDFormat = "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/localNetworkGateways/{name}"
+
+// LocalNetworkGatewayID is the Resource ID of a Local Network Gateway
+type LocalNetworkGatewayID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewLocalNetworkGatewayID returns the Resource ID of the Local Network Gateway
+func NewLocalNetworkGatewayID(subscriptionID, resourceGroup, name string) LocalNetworkGatewayID {
+	return LocalNetworkGatewayID{
+		SubscriptionID: subscriptionID,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the formatted Resource ID of the Local Network Gateway
+func (id LocalNetworkGatewayID) ID() string {
+	return formatResourceID(localNetworkGatewayIDFormat, id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+// ParseLocalNetworkGatewayID parses the Resource ID of a Local Network Gateway, matching the segments of the ID case-insensitively
+func ParseLocalNetworkGatewayID(input string) (*LocalNetworkGatewayID, error) {
+	values, err := parseResourceIDWithFormat("Local Network Gateway", localNetworkGatewayIDFormat, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LocalNetworkGatewayID{
+		SubscriptionID: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// ValidateLocalNetworkGatewayID validates that the value is the Resource ID of a Local Network Gateway
+func ValidateLocalNetworkGatewayID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDWithParser(i, k, func(input string) error {
+		_, err := ParseLocalNetworkGatewayID(input)
+		return err
+	})
+}
+
+// ValidateLocalNetworkGatewayIDOrEmpty validates that the value is either empty or the Resource ID of a Local Network Gateway
+func ValidateLocalNetworkGatewayIDOrEmpty(i interface{}, k string) (warnings []string, errors []error) {
+	if v, ok := i.(string); ok && v == "" {
+		return
+	}
+
+	return ValidateLocalNetworkGatewayID(i, k)
+}
+
+const logAnalyticsWorkspaceIDFormat = "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.OperationalInsights/workspaces/{name}"
+
+// LogAnalyticsWorkspaceID is the Resource ID of a Log Analytics Workspace
+type LogAnalyticsWorkspaceID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewLogAnalyticsWorkspaceID returns the Resource ID of the Log Analytics Workspace
+func NewLogAnalyticsWorkspaceID(subscriptionID, resourceGroup, name string) LogAnalyticsWorkspaceID {
+	return LogAnalyticsWorkspaceID{
+		SubscriptionID: subscriptionID,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the formatted Resource ID of the Log Analytics Workspace
+func (id LogAnalyticsWorkspaceID) ID() string {
+	return formatResourceID(logAnalyticsWorkspaceIDFormat, id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+// ParseLogAnalyticsWorkspaceID parses the Resource ID of a Log Analytics Workspace, matching the segments of the ID case-insensitively
+func ParseLogAnalyticsWorkspaceID(input string) (*LogAnalyticsWorkspaceID, error) {
+	values, err := parseResourceIDWithFormat("Log Analytics Workspace", logAnalyticsWorkspaceIDFormat, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LogAnalyticsWorkspaceID{
+		SubscriptionID: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// ValidateLogAnalyticsWorkspaceID validates that the value is the Resource ID of a Log Analytics Workspace
+func ValidateLogAnalyticsWorkspaceID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDWithParser(i, k, func(input string) error {
+		_, err := ParseLogAnalyticsWorkspaceID(input)
+		return err
+	})
+}
+
+// ValidateLogAnalyticsWorkspaceIDOrEmpty validates that the value is either empty or the Resource ID of a Log Analytics Workspace
+func ValidateLogAnalyticsWorkspaceIDOrEmpty(i interface{}, k string) (warnings []string, errors []error) {
+	if v, ok := i.(string); ok && v == "" {
+		return
+	}
+
+	return ValidateLogAnalyticsWorkspaceID(i, k)
+}
+
+const logicAppIDFormat = "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Logic/workflows/{name}"
+
+// LogicAppID is the Resource ID of a Logic App
+type LogicAppID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewLogicAppID returns the Resource ID of the Logic App
+func NewLogicAppID(subscriptionID, resourceGroup, name string) LogicAppID {
+	return LogicAppID{
+		SubscriptionID: subscriptionID,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the formatted Resource ID of the Logic App
+func (id LogicAppID) ID() string {
+	return formatResourceID(logicAppIDFormat, id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+// ParseLogicAppID parses the Resource ID of a Logic App, matching the segments of the ID case-insensitively
+func ParseLogicAppID(input string) (*LogicAppID, error) {
+	values, err := parseResourceIDWithFormat("Logic App", logicAppIDFormat, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LogicAppID{
+		SubscriptionID: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// ValidateLogicAppID validates that the value is the Resource ID of a Logic App
+func ValidateLogicAppID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDWithParser(i, k, func(input string) error {
+		_, err := ParseLogicAppID(input)
+		return err
+	})
+}
+
+// ValidateLogicAppIDOrEmpty validates that the value is either empty or the Resource ID of a Logic App
+func ValidateLogicAppIDOrEmpty(i interface{}, k string) (warnings []string, errors []error) {
+	if v, ok := i.(string); ok && v == "" {
+		return
+	}
+
+	return ValidateLogicAppID(i, k)
+}
+
+const managedDiskIDFormat = "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Compute/disks/{name}"
+
+// ManagedDiskID is the Resource ID of a Managed Disk
+type ManagedDiskID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewManagedDiskID returns the Resource ID of the Managed Disk
+func NewManagedDiskID(subscriptionID, resourceGroup, name string) ManagedDiskID {
+	return ManagedDiskID{
+		SubscriptionID: subscriptionID,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the formatted Resource ID of the Managed Disk
+func (id ManagedDiskID) ID() string {
+	return formatResourceID(managedDiskIDFormat, id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+// ParseManagedDiskID parses the Resource ID of a Managed Disk, matching the segments of the ID case-insensitively
+func ParseManagedDiskID(input string) (*ManagedDiskID, error) {
+	values, err := parseResourceIDWithFormat("Managed Disk", managedDiskIDFormat, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ManagedDiskID{
+		SubscriptionID: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// ValidateManagedDiskID validates that the value is the Resource ID of a Managed Disk
+func ValidateManagedDiskID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDWithParser(i, k, func(input string) error {
+		_, err := ParseManagedDiskID(input)
+		return err
+	})
+}
+
+// ValidateManagedDiskIDOrEmpty validates that the value is either empty or the Resource ID of a Managed Disk
+func ValidateManagedDiskIDOrEmpty(i interface{}, k string) (warnings []string, errors []error) {
+	if v, ok := i.(string); ok && v == "" {
+		return
+	}
+
+	return ValidateManagedDiskID(i, k)
+}
+
+const managementGroupIDFormat = "/providers/Microsoft.Management/managementGroups/{name}"
+
+// ManagementGroupID is the Resource ID of a Management Group
+type ManagementGroupID struct {
+	Name string
+}
+
+// NewManagementGroupID returns the Resource ID of the Management Group
+func NewManagementGroupID(name string) ManagementGroupID {
+	return ManagementGroupID{
+		Name: name,
+	}
+}
+
+// ID returns the formatted Resource ID of the Management Group
+func (id ManagementGroupID) ID() string {
+	return formatResourceID(managementGroupIDFormat, id.Name)
+}
+
+// ParseManagementGroupID parses the Resource ID of a Management Group, matching the segments of the ID case-insensitively
+func ParseManagementGroupID(input string) (*ManagementGroupID, error) {
+	values, err := parseResourceIDWithFormat("Management Group", managementGroupIDFormat, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ManagementGroupID{
+		Name: values[0],
+	}, nil
+}
+
+// ValidateManagementGroupID validates that the value is the Resource ID of a Management Group
+func ValidateManagementGroupID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDWithParser(i, k, func(input string) error {
+		_, err := ParseManagementGroupID(input)
+		return err
+	})
+}
+
+// ValidateManagementGroupIDOrEmpty validates that the value is either empty or the Resource ID of a Management Group
+func ValidateManagementGroupIDOrEmpty(i interface{}, k string) (warnings []string, errors []error) {
+	if v, ok := i.(string); ok && v == "" {
+		return
+	}
+
+	return ValidateManagementGroupID(i, k)
+}
+
+const managementGroupTemplateDeploymentIDFormat = "/providers/Microsoft.Management/managementGroups/{managementGroupName}/providers/Microsoft.Resources/deployments/{name}"
+
+// ManagementGroupTemplateDeploymentID is the Resource ID of a Management Group Template Deployment
+type ManagementGroupTemplateDeploymentID struct {
+	ManagementGroupName string
+	Name                string
+}
+
+// NewManagementGroupTemplateDeploymentID returns the Resource ID of the Management Group Template Deployment
+func NewManagementGroupTemplateDeploymentID(managementGroupName, name string) ManagementGroupTemplateDeploymentID {
+	return ManagementGroupTemplateDeploymentID{
+		ManagementGroupName: managementGroupName,
+		Name:                name,
+	}
+}
+
+// ID returns the formatted Resource ID of the Management Group Template Deployment
+func (id ManagementGroupTemplateDeploymentID) ID() string {
+	return formatResourceID(managementGroupTemplateDeploymentIDFormat, id.ManagementGroupName, id.Name)
+}
+
+// ParseManagementGroupTemplateDeploymentID parses the Resource ID of a Management Group Template Deployment, matching the segments of the ID case-insensitively
+func ParseManagementGroupTemplateDeploymentID(input string) (*ManagementGroupTemplateDeploymentID, error) {
+	values, err := parseResourceIDWithFormat("Management Group Template Deployment", managementGroupTemplateDeploymentIDFormat, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ManagementGroupTemplateDeploymentID{
+		ManagementGroupName: values[0],
+		Name:                values[1],
+	}, nil
+}
+
+// ValidateManagementGroupTemplateDeploymentID validates that the value is the Resource ID of a Management Group Template Deployment
+func ValidateManagementGroupTemplateDeploymentID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDWithParser(i, k, func(input string) error {
+		_, err := ParseManagementGroupTemplateDeploymentID(input)
+		return err
+	})
+}
+
+// ValidateManagementGroupTemplateDeploymentIDOrEmpty validates that the value is either empty or the Resource ID of a Management Group Template Deployment
+func ValidateManagementGroupTemplateDeploymentIDOrEmpty(i interface{}, k string) (warnings []string, errors []error) {
+	if v, ok := i.(string); ok && v == "" {
+		return
+	}
+
+	return ValidateManagementGroupTemplateDeploymentID(i, k)
+}
+
+const monitorActionGroupIDFormat = "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/microsoft.insights/actionGroups/{name}"
+
+// MonitorActionGroupID is the Resource ID of a Monitor Action Group
+type MonitorActionGroupID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewMonitorActionGroupID returns the Resource ID of the Monitor Action Group
+func NewMonitorActionGroupID(subscriptionID, resourceGroup, name string) MonitorActionGroupID {
+	return MonitorActionGroupID{
+		SubscriptionID: subscriptionID,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the formatted Resource ID of the Monitor Action Group
+func (id MonitorActionGroupID) ID() string {
+	return formatResourceID(monitorActionGroupIDFormat, id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+// ParseMonitorActionGroupID parses the Resource ID of a Monitor Action Group, matching the segments of the ID case-insensitively
+func ParseMonitorActionGroupID(input string) (*MonitorActionGroupID, error) {
+	values, err := parseResourceIDWithFormat("Monitor Action Group", monitorActionGroupIDFormat, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MonitorActionGroupID{
+		SubscriptionID: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// ValidateMonitorActionGroupID validates that the value is the Resource ID of a Monitor Action Group
+func ValidateMonitorActionGroupID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDWithParser(i, k, func(input string) error {
+		_, err := ParseMonitorActionGroupID(input)
+		return err
+	})
+}
+
+// ValidateMonitorActionGroupIDOrEmpty validates that the value is either empty or the Resource ID of a Monitor Action Group
+func ValidateMonitorActionGroupIDOrEmpty(i interface{}, k string) (warnings []string, errors []error) {
+	if v, ok := i.(string); ok && v == "" {
+		return
+	}
+
+	return ValidateMonitorActionGroupID(i, k)
+}
+
+const networkInterfaceIDFormat = "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/networkInterfaces/{name}"
+
+// NetworkInterfaceID is the Resource ID of a Network Interface
+type NetworkInterfaceID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewNetworkInterfaceID returns the Resource ID of the Network Interface
+func NewNetworkInterfaceID(subscriptionID, resourceGroup, name string) NetworkInterfaceID {
+	return NetworkInterfaceID{
+		SubscriptionID: subscriptionID,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the formatted Resource ID of the Network Interface
+func (id NetworkInterfaceID) ID() string {
+	return formatResourceID(networkInterfaceIDFormat, id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+// ParseNetworkInterfaceID parses the Resource ID of a Network Interface, matching the segments of the ID case-insensitively
+func ParseNetworkInterfaceID(input string) (*NetworkInterfaceID, error) {
+	values, err := parseResourceIDWithFormat("Network Interface", networkInterfaceIDFormat, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &NetworkInterfaceID{
+		SubscriptionID: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// ValidateNetworkInterfaceID validates that the value is the Resource ID of a Network Interface
+func ValidateNetworkInterfaceID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDWithParser(i, k, func(input string) error {
+		_, err := ParseNetworkInterfaceID(input)
+		return err
+	})
+}
+
+// ValidateNetworkInterfaceIDOrEmpty validates that the value is either empty or the Resource ID of a Network Interface
+func ValidateNetworkInterfaceIDOrEmpty(i interface{}, k string) (warnings []string, errors []error) {
+	if v, ok := i.(string); ok && v == "" {
+		return
+	}
+
+	return ValidateNetworkInterfaceID(i, k)
+}
+
+const networkSecurityGroupIDFormat = "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/networkSecurityGroups/{name}"
+
+// NetworkSecurityGroupID is the Resource ID of a Network Security Group
+type NetworkSecurityGroupID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewNetworkSecurityGroupID returns the Resource ID of the Network Security Group
+func NewNetworkSecurityGroupID(subscriptionID, resourceGroup, name string) NetworkSecurityGroupID {
+	return NetworkSecurityGroupID{
+		SubscriptionID: subscriptionID,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the formatted Resource ID of the Network Security Group
+func (id NetworkSecurityGroupID) ID() string {
+	return formatResourceID(networkSecurityGroupIDFormat, id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+// ParseNetworkSecurityGroupID parses the Resource ID of a Network Security Group, matching the segments of the ID case-insensitively
+func ParseNetworkSecurityGroupID(input string) (*NetworkSecurityGroupID, error) {
+	values, err := parseResourceIDWithFormat("Network Security Group", networkSecurityGroupIDFormat, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &NetworkSecurityGroupID{
+		SubscriptionID: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// ValidateNetworkSecurityGroupID validates that the value is the Resource ID of a Network Security Group
+func ValidateNetworkSecurityGroupID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDWithParser(i, k, func(input string) error {
+		_, err := ParseNetworkSecurityGroupID(input)
+		return err
+	})
+}
+
+// ValidateNetworkSecurityGroupIDOrEmpty validates that the value is either empty or the Resource ID of a Network Security Group
+func ValidateNetworkSecurityGroupIDOrEmpty(i interface{}, k string) (warnings []string, errors []error) {
+	if v, ok := i.(string); ok && v == "" {
+		return
+	}
+
+	return ValidateNetworkSecurityGroupID(i, k)
+}
+
+const networkSecurityRuleIDFormat = "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/networkSecurityGroups/{networkSecurityGroupName}/securityRules/{name}"
+
+// NetworkSecurityRuleID is the Resource ID of a Network Security Rule
+type NetworkSecurityRuleID struct {
+	SubscriptionID           string
+	ResourceGroup            string
+	NetworkSecurityGroupName string
+	Name                     string
+}
+
+// NewNetworkSecurityRuleID returns the Resource ID of the Network Security Rule
+func NewNetworkSecurityRuleID(subscriptionID, resourceGroup, networkSecurityGroupName, name string) NetworkSecurityRuleID {
+	return NetworkSecurityRuleID{
+		SubscriptionID:           subscriptionID,
+		ResourceGroup:            resourceGroup,
+		NetworkSecurityGroupName: networkSecurityGroupName,
+		Name:                     name,
+	}
+}
+
+// ID returns the formatted Resource ID of the Network Security Rule
+func (id NetworkSecurityRuleID) ID() string {
+	return formatResourceID(networkSecurityRuleIDFormat, id.SubscriptionID, id.ResourceGroup, id.NetworkSecurityGroupName, id.Name)
+}
+
+// ParseNetworkSecurityRuleID parses the Resource ID of a Network Security Rule, matching the segments of the ID case-insensitively
+func ParseNetworkSecurityRuleID(input string) (*NetworkSecurityRuleID, error) {
+	values, err := parseResourceIDWithFormat("Network Security Rule", networkSecurityRuleIDFormat, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &NetworkSecurityRuleID{
+		SubscriptionID:           values[0],
+		ResourceGroup:            values[1],
+		NetworkSecurityGroupName: values[2],
+		Name:                     values[3],
+	}, nil
+}
+
+// ValidateNetworkSecurityRuleID validates that the value is the Resource ID of a Network Security Rule
+func ValidateNetworkSecurityRuleID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDWithParser(i, k, func(input string) error {
+		_, err := ParseNetworkSecurityRuleID(input)
+		return err
+	})
+}
+
+// ValidateNetworkSecurityRuleIDOrEmpty validates that the value is either empty or the Resource ID of a Network Security Rule
+func ValidateNetworkSecurityRuleIDOrEmpty(i interface{}, k string) (warnings []string, errors []error) {
+	if v, ok := i.(string); ok && v == "" {
+		return
+	}
+
+	return ValidateNetworkSecurityRuleID(i, k)
+}
+
+const networkWatcherIDFormat = "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/networkWatchers/{name}"
+
+// NetworkWatcherID is the Resource ID of a Network Watcher
+type NetworkWatcherID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewNetworkWatcherID returns the Resource ID of the Network Watcher
+func NewNetworkWatcherID(subscriptionID, resourceGroup, name string) NetworkWatcherID {
+	return NetworkWatcherID{
+		SubscriptionID: subscriptionID,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the formatted Resource ID of the Network Watcher
+func (id NetworkWatcherID) ID() string {
+	return formatResourceID(networkWatcherIDFormat, id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+// ParseNetworkWatcherID parses the Resource ID of a Network Watcher, matching the segments of the ID case-insensitively
+func ParseNetworkWatcherID(input string) (*NetworkWatcherID, error) {
+	values, err := parseResourceIDWithFormat("Network Watcher", networkWatcherIDFormat, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &NetworkWatcherID{
+		SubscriptionID: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// ValidateNetworkWatcherID validates that the value is the Resource ID of a Network Watcher
+func ValidateNetworkWatcherID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDWithParser(i, k, func(input string) error {
+		_, err := ParseNetworkWatcherID(input)
+		return err
+	})
+}
+
+// ValidateNetworkWatcherIDOrEmpty validates that the value is either empty or the Resource ID of a Network Watcher
+func ValidateNetworkWatcherIDOrEmpty(i interface{}, k string) (warnings []string, errors []error) {
+	if v, ok := i.(string); ok && v == "" {
+		return
+	}
+
+	return ValidateNetworkWatcherID(i, k)
+}
+
+const privateDnsARecordIDFormat = "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/privateDnsZones/{zoneName}/A/{name}"
+
+// PrivateDnsARecordID is the Resource ID of a Private Dns A Record
+type PrivateDnsARecordID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	ZoneName       string
+	Name           string
+}
+
+// NewPrivateDnsARecordID returns the Resource ID of the Private Dns A Record
+func NewPrivateDnsARecordID(subscriptionID, resourceGroup, zoneName, name string) PrivateDnsARecordID {
+	return PrivateDnsARecordID{
+		SubscriptionID: subscriptionID,
+		ResourceGroup:  resourceGroup,
+		ZoneName:       zoneName,
+		Name:           name,
+	}
+}
+
+// ID returns the formatted Resource ID of the Private Dns A Record
+func (id PrivateDnsARecordID) ID() string {
+	return formatResourceID(privateDnsARecordIDFormat, id.SubscriptionID, id.ResourceGroup, id.ZoneName, id.Name)
+}
+
+// ParsePrivateDnsARecordID parses the Resource ID of a Private Dns A Record, matching the segments of the ID case-insensitively
+func ParsePrivateDnsARecordID(input string) (*PrivateDnsARecordID, error) {
+	values, err := parseResourceIDWithFormat("Private Dns A Record", privateDnsARecordIDFormat, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PrivateDnsARecordID{
+		SubscriptionID: values[0],
+		ResourceGroup:  values[1],
+		ZoneName:       values[2],
+		Name:           values[3],
+	}, nil
+}
+
+// ValidatePrivateDnsARecordID validates that the value is the Resource ID of a Private Dns A Record
+func ValidatePrivateDnsARecordID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDWithParser(i, k, func(input string) error {
+		_, err := ParsePrivateDnsARecordID(input)
+		return err
+	})
+}
+
+// ValidatePrivateDnsARecordIDOrEmpty validates that the value is either empty or the Resource ID of a Private Dns A Record
+func ValidatePrivateDnsARecordIDOrEmpty(i interface{}, k string) (warnings []string, errors []error) {
+	if v, ok := i.(string); ok && v == "" {
+		return
+	}
+
+	return ValidatePrivateDnsARecordID(i, k)
+}
+
+const privateDnsAaaaRecordIDFormat = "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/privateDnsZones/{zoneName}/AAAA/{name}"
+
+// PrivateDnsAaaaRecordID is the Resource ID of a Private Dns Aaaa Record
+type PrivateDnsAaaaRecordID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	ZoneName       string
+	Name           string
+}
+
+// NewPrivateDnsAaaaRecordID returns the Resource ID of the Private Dns Aaaa Record
+func NewPrivateDnsAaaaRecordID(subscriptionID, resourceGroup, zoneName, name string) PrivateDnsAaaaRecordID {
+	return PrivateDnsAaaaRecordID{
+		SubscriptionID: subscriptionID,
+		ResourceGroup:  resourceGroup,
+		ZoneName:       zoneName,
+		Name:           name,
+	}
+}
+
+// ID returns the formatted Resource ID of the Private Dns Aaaa Record
+func (id PrivateDnsAaaaRecordID) ID() string {
+	return formatResourceID(privateDnsAaaaRecordIDFormat, id.SubscriptionID, id.ResourceGroup, id.ZoneName, id.Name)
+}
+
+// ParsePrivateDnsAaaaRecordID parses the Resource ID of a Private Dns Aaaa Record, matching the segments of the ID case-insensitively
+func ParsePrivateDnsAaaaRecordID(input string) (*PrivateDnsAaaaRecordID, error) {
+	values, err := parseResourceIDWithFormat("Private Dns Aaaa Record", privateDnsAaaaRecordIDFormat, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PrivateDnsAaaaRecordID{
+		SubscriptionID: values[0],
+		ResourceGroup:  values[1],
+		ZoneName:       values[2],
+		Name:           values[3],
+	}, nil
+}
+
+// ValidatePrivateDnsAaaaRecordID validates that the value is the Resource ID of a Private Dns Aaaa Record
+func ValidatePrivateDnsAaaaRecordID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDWithParser(i, k, func(input string) error {
+		_, err := ParsePrivateDnsAaaaRecordID(input)
+		return err
+	})
+}
+
+// ValidatePrivateDnsAaaaRecordIDOrEmpty validates that the value is either empty or the Resource ID of a Private Dns Aaaa Record
+func ValidatePrivateDnsAaaaRecordIDOrEmpty(i interface{}, k string) (warnings []string, errors []error) {
+	if v, ok := i.(string); ok && v == "" {
+		return
+	}
+
+	return ValidatePrivateDnsAaaaRecordID(i, k)
+}
+
+const privateDnsCNameRecordIDFormat = "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/privateDnsZones/{zoneName}/CNAME/{name}"
+
+// PrivateDnsCNameRecordID is the Resource ID of a Private Dns C Name Record
+type PrivateDnsCNameRecordID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	ZoneName       string
+	Name           string
+}
+
+// NewPrivateDnsCNameRecordID returns the Resource ID of the Private Dns C Name Record
+func NewPrivateDnsCNameRecordID(subscriptionID, resourceGroup, zoneName, name string) PrivateDnsCNameRecordID {
+	return PrivateDnsCNameRecordID{
+		SubscriptionID: subscriptionID,
+		ResourceGroup:  resourceGroup,
+		ZoneName:       zoneName,
+		Name:           name,
+	}
+}
+
+// ID returns the formatted Resource ID of the Private Dns C Name Record
+func (id PrivateDnsCNameRecordID) ID() string {
+	return formatResourceID(privateDnsCNameRecordIDFormat, id.SubscriptionID, id.ResourceGroup, id.ZoneName, id.Name)
+}
+
+// ParsePrivateDnsCNameRecordID parses the Resource ID of a Private Dns C Name Record, matching the segments of the ID case-insensitively
+func ParsePrivateDnsCNameRecordID(input string) (*PrivateDnsCNameRecordID, error) {
+	values, err := parseResourceIDWithFormat("Private Dns C Name Record", privateDnsCNameRecordIDFormat, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PrivateDnsCNameRecordID{
+		SubscriptionID: values[0],
+		ResourceGroup:  values[1],
+		ZoneName:       values[2],
+		Name:           values[3],
+	}, nil
+}
+
+// ValidatePrivateDnsCNameRecordID validates that the value is the Resource ID of a Private Dns C Name Record
+func ValidatePrivateDnsCNameRecordID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDWithParser(i, k, func(input string) error {
+		_, err := ParsePrivateDnsCNameRecordID(input)
+		return err
+	})
+}
+
+// ValidatePrivateDnsCNameRecordIDOrEmpty validates that the value is either empty or the Resource ID of a Private Dns C Name Record
+func ValidatePrivateDnsCNameRecordIDOrEmpty(i interface{}, k string) (warnings []string, errors []error) {
+	if v, ok := i.(string); ok && v == "" {
+		return
+	}
+
+	return ValidatePrivateDnsCNameRecordID(i, k)
+}
+
+const privateDnsMxRecordIDFormat = "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/privateDnsZones/{zoneName}/MX/{name}"
+
+// PrivateDnsMxRecordID is the Resource ID of a Private Dns Mx Record
+type PrivateDnsMxRecordID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	ZoneName       string
+	Name           string
+}
+
+// NewPrivateDnsMxRecordID returns the Resource ID of the Private Dns Mx Record
+func NewPrivateDnsMxRecordID(subscriptionID, resourceGroup, zoneName, name string) PrivateDnsMxRecordID {
+	return PrivateDnsMxRecordID{
+		SubscriptionID: subscriptionID,
+		ResourceGroup:  resourceGroup,
+		ZoneName:       zoneName,
+		Name:           name,
+	}
+}
+
+// ID returns the formatted Resource ID of the Private Dns Mx Record
+func (id PrivateDnsMxRecordID) ID() string {
+	return formatResourceID(privateDnsMxRecordIDFormat, id.SubscriptionID, id.ResourceGroup, id.ZoneName, id.Name)
+}
+
+// ParsePrivateDnsMxRecordID parses the Resource ID of a Private Dns Mx Record, matching the segments of the ID case-insensitively
+func ParsePrivateDnsMxRecordID(input string) (*PrivateDnsMxRecordID, error) {
+	values, err := parseResourceIDWithFormat("Private Dns Mx Record", privateDnsMxRecordIDFormat, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PrivateDnsMxRecordID{
+		SubscriptionID: values[0],
+		ResourceGroup:  values[1],
+		ZoneName:       values[2],
+		Name:           values[3],
+	}, nil
+}
+
+// ValidatePrivateDnsMxRecordID validates that the value is the Resource ID of a Private Dns Mx Record
+func ValidatePrivateDnsMxRecordID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDWithParser(i, k, func(input string) error {
+		_, err := ParsePrivateDnsMxRecordID(input)
+		return err
+	})
+}
+
+// ValidatePrivateDnsMxRecordIDOrEmpty validates that the value is either empty or the Resource ID of a Private Dns Mx Record
+func ValidatePrivateDnsMxRecordIDOrEmpty(i interface{}, k string) (warnings []string, errors []error) {
+	if v, ok := i.(string); ok && v == "" {
+		return
+	}
+
+	return ValidatePrivateDnsMxRecordID(i, k)
+}
+
+const privateDnsPtrRecordIDFormat = "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/privateDnsZones/{zoneName}/PTR/{name}"
+
+// PrivateDnsPtrRecordID is the Resource ID of a Private Dns Ptr Record
+type PrivateDnsPtrRecordID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	ZoneName       string
+	Name           string
+}
+
+// NewPrivateDnsPtrRecordID returns the Resource ID of the Private Dns Ptr Record
+func NewPrivateDnsPtrRecordID(subscriptionID, resourceGroup, zoneName, name string) PrivateDnsPtrRecordID {
+	return PrivateDnsPtrRecordID{
+		SubscriptionID: subscriptionID,
+		ResourceGroup:  resourceGroup,
+		ZoneName:       zoneName,
+		Name:           name,
+	}
+}
+
+// ID returns the formatted Resource ID of the Private Dns Ptr Record
+func (id PrivateDnsPtrRecordID) ID() string {
+	return formatResourceID(privateDnsPtrRecordIDFormat, id.SubscriptionID, id.ResourceGroup, id.ZoneName, id.Name)
+}
+
+// ParsePrivateDnsPtrRecordID parses the Resource ID of a Private Dns Ptr Record, matching the segments of the ID case-insensitively
+func ParsePrivateDnsPtrRecordID(input string) (*PrivateDnsPtrRecordID, error) {
+	values, err := parseResourceIDWithFormat("Private Dns Ptr Record", privateDnsPtrRecordIDFormat, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PrivateDnsPtrRecordID{
+		SubscriptionID: values[0],
+		ResourceGroup:  values[1],
+		ZoneName:       values[2],
+		Name:           values[3],
+	}, nil
+}
+
+// ValidatePrivateDnsPtrRecordID validates that the value is the Resource ID of a Private Dns Ptr Record
+func ValidatePrivateDnsPtrRecordID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDWithParser(i, k, func(input string) error {
+		_, err := ParsePrivateDnsPtrRecordID(input)
+		return err
+	})
+}
+
+// ValidatePrivateDnsPtrRecordIDOrEmpty validates that the value is either empty or the Resource ID of a Private Dns Ptr Record
+func ValidatePrivateDnsPtrRecordIDOrEmpty(i interface{}, k string) (warnings []string, errors []error) {
+	if v, ok := i.(string); ok && v == "" {
+		return
+	}
+
+	return ValidatePrivateDnsPtrRecordID(i, k)
+}
+
+const privateDnsSrvRecordIDFormat = "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/privateDnsZones/{zoneName}/SRV/{name}"
+
+// PrivateDnsSrvRecordID is the Resource ID of a Private Dns Srv Record
+type PrivateDnsSrvRecordID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	ZoneName       string
+	Name           string
+}
+
+// NewPrivateDnsSrvRecordID returns the Resource ID of the Private Dns Srv Record
+func NewPrivateDnsSrvRecordID(subscriptionID, resourceGroup, zoneName, name string) PrivateDnsSrvRecordID {
+	return PrivateDnsSrvRecordID{
+		SubscriptionID: subscriptionID,
+		ResourceGroup:  resourceGroup,
+		ZoneName:       zoneName,
+		Name:           name,
+	}
+}
+
+// ID returns the formatted Resource ID of the Private Dns Srv Record
+func (id PrivateDnsSrvRecordID) ID() string {
+	return formatResourceID(privateDnsSrvRecordIDFormat, id.SubscriptionID, id.ResourceGroup, id.ZoneName, id.Name)
+}
+
+// ParsePrivateDnsSrvRecordID parses the Resource ID of a Private Dns Srv Record, matching the segments of the ID case-insensitively
+func ParsePrivateDnsSrvRecordID(input string) (*PrivateDnsSrvRecordID, error) {
+	values, err := parseResourceIDWithFormat("Private Dns Srv Record", privateDnsSrvRecordIDFormat, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PrivateDnsSrvRecordID{
+		SubscriptionID: values[0],
+		ResourceGroup:  values[1],
+		ZoneName:       values[2],
+		Name:           values[3],
+	}, nil
+}
+
+// ValidatePrivateDnsSrvRecordID validates that the value is the Resource ID of a Private Dns Srv Record
+func ValidatePrivateDnsSrvRecordID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDWithParser(i, k, func(input string) error {
+		_, err := ParsePrivateDnsSrvRecordID(input)
+		return err
+	})
+}
+
+// ValidatePrivateDnsSrvRecordIDOrEmpty validates that the value is either empty or the Resource ID of a Private Dns Srv Record
+func ValidatePrivateDnsSrvRecordIDOrEmpty(i interface{}, k string) (warnings []string, errors []error) {
+	if v, ok := i.(string); ok && v == "" {
+		return
+	}
+
+	return ValidatePrivateDnsSrvRecordID(i, k)
+}
+
+const privateDnsTxtRecordIDFormat = "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/privateDnsZones/{zoneName}/TXT/{name}"
+
+// PrivateDnsTxtRecordID is the Resource ID of a Private Dns Txt Record
+type PrivateDnsTxtRecordID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	ZoneName       string
+	Name           string
+}
+
+// NewPrivateDnsTxtRecordID returns the Resource ID of the Private Dns Txt Record
+func NewPrivateDnsTxtRecordID(subscriptionID, resourceGroup, zoneName, name string) PrivateDnsTxtRecordID {
+	return PrivateDnsTxtRecordID{
+		SubscriptionID: subscriptionID,
+		ResourceGroup:  resourceGroup,
+		ZoneName:       zoneName,
+		Name:           name,
+	}
+}
+
+// ID returns the formatted Resource ID of the Private Dns Txt Record
+func (id PrivateDnsTxtRecordID) ID() string {
+	return formatResourceID(privateDnsTxtRecordIDFormat, id.SubscriptionID, id.ResourceGroup, id.ZoneName, id.Name)
+}
+
+// ParsePrivateDnsTxtRecordID parses the Resource ID of a Private Dns Txt Record, matching the segments of the ID case-insensitively
+func ParsePrivateDnsTxtRecordID(input string) (*PrivateDnsTxtRecordID, error) {
+	values, err := parseResourceIDWithFormat("Private Dns Txt Record", privateDnsTxtRecordIDFormat, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PrivateDnsTxtRecordID{
+		SubscriptionID: values[0],
+		ResourceGroup:  values[1],
+		ZoneName:       values[2],
+		Name:           values[3],
+	}, nil
+}
+
+// ValidatePrivateDnsTxtRecordID validates that the value is the Resource ID of a Private Dns Txt Record
+func ValidatePrivateDnsTxtRecordID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDWithParser(i, k, func(input string) error {
+		_, err := ParsePrivateDnsTxtRecordID(input)
+		return err
+	})
+}
+
+// ValidatePrivateDnsTxtRecordIDOrEmpty validates that the value is either empty or the Resource ID of a Private Dns Txt Record
+func ValidatePrivateDnsTxtRecordIDOrEmpty(i interface{}, k string) (warnings []string, errors []error) {
+	if v, ok := i.(string); ok && v == "" {
+		return
+	}
+
+	return ValidatePrivateDnsTxtRecordID(i, k)
+}
+
+const privateDnsZoneIDFormat = "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/privateDnsZones/{name}"
+
+// PrivateDnsZoneID is the Resource ID of a Private Dns Zone
+type PrivateDnsZoneID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewPrivateDnsZoneID returns the Resource ID of the Private Dns Zone
+func NewPrivateDnsZoneID(subscriptionID, resourceGroup, name string) PrivateDnsZoneID {
+	return PrivateDnsZoneID{
+		SubscriptionID: subscriptionID,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the formatted Resource ID of the Private Dns Zone
+func (id PrivateDnsZoneID) ID() string {
+	return formatResourceID(privateDnsZoneIDFormat, id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+// ParsePrivateDnsZoneID parses the Resource ID of a Private Dns Zone, matching the segments of the ID case-insensitively
+func ParsePrivateDnsZoneID(input string) (*PrivateDnsZoneID, error) {
+	values, err := parseResourceIDWithFormat("Private Dns Zone", privateDnsZoneIDFormat, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PrivateDnsZoneID{
+		SubscriptionID: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// ValidatePrivateDnsZoneID validates that the value is the Resource ID of a Private Dns Zone
+func ValidatePrivateDnsZoneID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDWithParser(i, k, func(input string) error {
+		_, err := ParsePrivateDnsZoneID(input)
+		return err
+	})
+}
+
+// ValidatePrivateDnsZoneIDOrEmpty validates that the value is either empty or the Resource ID of a Private Dns Zone
+func ValidatePrivateDnsZoneIDOrEmpty(i interface{}, k string) (warnings []string, errors []error) {
+	if v, ok := i.(string); ok && v == "" {
+		return
+	}
+
+	return ValidatePrivateDnsZoneID(i, k)
+}
+
+const privateDnsZoneVirtualNetworkLinkIDFormat = "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/privateDnsZones/{zoneName}/virtualNetworkLinks/{name}"
+
+// PrivateDnsZoneVirtualNetworkLinkID is the Resource ID of a Private Dns Zone Virtual Network Link
+type PrivateDnsZoneVirtualNetworkLinkID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	ZoneName       string
+	Name           string
+}
+
+// NewPrivateDnsZoneVirtualNetworkLinkID returns the Resource ID of the Private Dns Zone Virtual Network Link
+func NewPrivateDnsZoneVirtualNetworkLinkID(subscriptionID, resourceGroup, zoneName, name string) PrivateDnsZoneVirtualNetworkLinkID {
+	return PrivateDnsZoneVirtualNetworkLinkID{
+		SubscriptionID: subscriptionID,
+		ResourceGroup:  resourceGroup,
+		ZoneName:       zoneName,
+		Name:           name,
+	}
+}
+
+// ID returns the formatted Resource ID of the Private Dns Zone Virtual Network Link
+func (id PrivateDnsZoneVirtualNetworkLinkID) ID() string {
+	return formatResourceID(privateDnsZoneVirtualNetworkLinkIDFormat, id.SubscriptionID, id.ResourceGroup, id.ZoneName, id.Name)
+}
+
+// ParsePrivateDnsZoneVirtualNetworkLinkID parses the Resource ID of a Private Dns Zone Virtual Network Link, matching the segments of the ID case-insensitively
+func ParsePrivateDnsZoneVirtualNetworkLinkID(input string) (*PrivateDnsZoneVirtualNetworkLinkID, error) {
+	values, err := parseResourceIDWithFormat("Private Dns Zone Virtual Network Link", privateDnsZoneVirtualNetworkLinkIDFormat, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PrivateDnsZoneVirtualNetworkLinkID{
+		SubscriptionID: values[0],
+		ResourceGroup:  values[1],
+		ZoneName:       values[2],
+		Name:           values[3],
+	}, nil
+}
+
+// ValidatePrivateDnsZoneVirtualNetworkLinkID validates that the value is the Resource ID of a Private Dns Zone Virtual Network Link
+func ValidatePrivateDnsZoneVirtualNetworkLinkID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDWithParser(i, k, func(input string) error {
+		_, err := ParsePrivateDnsZoneVirtualNetworkLinkID(input)
+		return err
+	})
+}
+
+// ValidatePrivateDnsZoneVirtualNetworkLinkIDOrEmpty validates that the value is either empty or the Resource ID of a Private Dns Zone Virtual Network Link
+func ValidatePrivateDnsZoneVirtualNetworkLinkIDOrEmpty(i interface{}, k string) (warnings []string, errors []error) {
+	if v, ok := i.(string); ok && v == "" {
+		return
+	}
+
+	return ValidatePrivateDnsZoneVirtualNetworkLinkID(i, k)
+}
+
+const publicIPAddressIDFormat = "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/publicIPAddresses/{name}"
+
+// PublicIPAddressID is the Resource ID of a Public IP Address
+type PublicIPAddressID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewPublicIPAddressID returns the Resource ID of the Public IP Address
+func NewPublicIPAddressID(subscriptionID, resourceGroup, name string) PublicIPAddressID {
+	return PublicIPAddressID{
+		SubscriptionID: subscriptionID,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the formatted Resource ID of the Public IP Address
+func (id PublicIPAddressID) ID() string {
+	return formatResourceID(publicIPAddressIDFormat, id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+// ParsePublicIPAddressID parses the Resource ID of a Public IP Address, matching the segments of the ID case-insensitively
+func ParsePublicIPAddressID(input string) (*PublicIPAddressID, error) {
+	values, err := parseResourceIDWithFormat("Public IP Address", publicIPAddressIDFormat, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PublicIPAddressID{
+		SubscriptionID: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// ValidatePublicIPAddressID validates that the value is the Resource ID of a Public IP Address
+func ValidatePublicIPAddressID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDWithParser(i, k, func(input string) error {
+		_, err := ParsePublicIPAddressID(input)
+		return err
+	})
+}
+
+// ValidatePublicIPAddressIDOrEmpty validates that the value is either empty or the Resource ID of a Public IP Address
+func ValidatePublicIPAddressIDOrEmpty(i interface{}, k string) (warnings []string, errors []error) {
+	if v, ok := i.(string); ok && v == "" {
+		return
+	}
+
+	return ValidatePublicIPAddressID(i, k)
+}
+
+const recoveryServicesBackupPolicyIDFormat = "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.RecoveryServices/vaults/{vaultName}/backupPolicies/{name}"
+
+// RecoveryServicesBackupPolicyID is the Resource ID of a Recovery Services Backup Policy
+type RecoveryServicesBackupPolicyID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	VaultName      string
+	Name           string
+}
+
+// NewRecoveryServicesBackupPolicyID returns the Resource ID of the Recovery Services Backup Policy
+func NewRecoveryServicesBackupPolicyID(subscriptionID, resourceGroup, vaultName, name string) RecoveryServicesBackupPolicyID {
+	return RecoveryServicesBackupPolicyID{
+		SubscriptionID: subscriptionID,
+		ResourceGroup:  resourceGroup,
+		VaultName:      vaultName,
+		Name:           name,
+	}
+}
+
+// ID returns the formatted Resource ID of the Recovery Services Backup Policy
+func (id RecoveryServicesBackupPolicyID) ID() string {
+	return formatResourceID(recoveryServicesBackupPolicyIDFormat, id.SubscriptionID, id.ResourceGroup, id.VaultName, id.Name)
+}
+
+// ParseRecoveryServicesBackupPolicyID parses the Resource ID of a Recovery Services Backup Policy, matching the segments of the ID case-insensitively
+func ParseRecoveryServicesBackupPolicyID(input string) (*RecoveryServicesBackupPolicyID, error) {
+	values, err := parseResourceIDWithFormat("Recovery Services Backup Policy", recoveryServicesBackupPolicyIDFormat, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RecoveryServicesBackupPolicyID{
+		SubscriptionID: values[0],
+		ResourceGroup:  values[1],
+		VaultName:      values[2],
+		Name:           values[3],
+	}, nil
+}
+
+// ValidateRecoveryServicesBackupPolicyID validates that the value is the Resource ID of a Recovery Services Backup Policy
+func ValidateRecoveryServicesBackupPolicyID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDWithParser(i, k, func(input string) error {
+		_, err := ParseRecoveryServicesBackupPolicyID(input)
+		return err
+	})
+}
+
+// ValidateRecoveryServicesBackupPolicyIDOrEmpty validates that the value is either empty or the Resource ID of a Recovery Services Backup Policy
+func ValidateRecoveryServicesBackupPolicyIDOrEmpty(i interface{}, k string) (warnings []string, errors []error) {
+	if v, ok := i.(string); ok && v == "" {
+		return
+	}
+
+	return ValidateRecoveryServicesBackupPolicyID(i, k)
+}
+
+const recoveryServicesVaultIDFormat = "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.RecoveryServices/vaults/{name}"
+
+// RecoveryServicesVaultID is the Resource ID of a Recovery Services Vault
+type RecoveryServicesVaultID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewRecoveryServicesVaultID returns the Resource ID of the Recovery Services Vault
+func NewRecoveryServicesVaultID(subscriptionID, resourceGroup, name string) RecoveryServicesVaultID {
+	return RecoveryServicesVaultID{
+		SubscriptionID: subscriptionID,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the formatted Resource ID of the Recovery Services Vault
+func (id RecoveryServicesVaultID) ID() string {
+	return formatResourceID(recoveryServicesVaultIDFormat, id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+// ParseRecoveryServicesVaultID parses the Resource ID of a Recovery Services Vault, matching the segments of the ID case-insensitively
+func ParseRecoveryServicesVaultID(input string) (*RecoveryServicesVaultID, error) {
+	values, err := parseResourceIDWithFormat("Recovery Services Vault", recoveryServicesVaultIDFormat, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RecoveryServicesVaultID{
+		SubscriptionID: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// ValidateRecoveryServicesVaultID validates that the value is the Resource ID of a Recovery Services Vault
+func ValidateRecoveryServicesVaultID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDWithParser(i, k, func(input string) error {
+		_, err := ParseRecoveryServicesVaultID(input)
+		return err
+	})
+}
+
+// ValidateRecoveryServicesVaultIDOrEmpty validates that the value is either empty or the Resource ID of a Recovery Services Vault
+func ValidateRecoveryServicesVaultIDOrEmpty(i interface{}, k string) (warnings []string, errors []error) {
+	if v, ok := i.(string); ok && v == "" {
+		return
+	}
+
+	return ValidateRecoveryServicesVaultID(i, k)
+}
+
+const redisCacheIDFormat = "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Cache/Redis/{name}"
+
+// RedisCacheID is the Resource ID of a Redis Cache
+type RedisCacheID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewRedisCacheID returns the Resource ID of the Redis Cache
+func NewRedisCacheID(subscriptionID, resourceGroup, name string) RedisCacheID {
+	return RedisCacheID{
+		SubscriptionID: subscriptionID,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the formatted Resource ID of the Redis Cache
+func (id RedisCacheID) ID() string {
+	return formatResourceID(redisCacheIDFormat, id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+// ParseRedisCacheID parses the Resource ID of a Redis Cache, matching the segments of the ID case-insensitively
+func ParseRedisCacheID(input string) (*RedisCacheID, error) {
+	values, err := parseResourceIDWithFormat("Redis Cache", redisCacheIDFormat, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RedisCacheID{
+		SubscriptionID: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// ValidateRedisCacheID validates that the value is the Resource ID of a Redis Cache
+func ValidateRedisCacheID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDWithParser(i, k, func(input string) error {
+		_, err := ParseRedisCacheID(input)
+		return err
+	})
+}
+
+// ValidateRedisCacheIDOrEmpty validates that the value is either empty or the Resource ID of a Redis Cache
+func ValidateRedisCacheIDOrEmpty(i interface{}, k string) (warnings []string, errors []error) {
+	if v, ok := i.(string); ok && v == "" {
+		return
+	}
+
+	return ValidateRedisCacheID(i, k)
+}
+
+const relayHybridConnectionIDFormat = "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Relay/namespaces/{namespaceName}/hybridConnections/{name}"
+
+// RelayHybridConnectionID is the Resource ID of a Relay Hybrid Connection
+type RelayHybridConnectionID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	NamespaceName  string
+	Name           string
+}
+
+// NewRelayHybridConnectionID returns the Resource ID of the Relay Hybrid Connection
+func NewRelayHybridConnectionID(subscriptionID, resourceGroup, namespaceName, name string) RelayHybridConnectionID {
+	return RelayHybridConnectionID{
+		SubscriptionID: subscriptionID,
+		ResourceGroup:  resourceGroup,
+		NamespaceName:  namespaceName,
+		Name:           name,
+	}
+}
+
+// ID returns the formatted Resource ID of the Relay Hybrid Connection
+func (id RelayHybridConnectionID) ID() string {
+	return formatResourceID(relayHybridConnectionIDFormat, id.SubscriptionID, id.ResourceGroup, id.NamespaceName, id.Name)
+}
+
+// ParseRelayHybridConnectionID parses the Resource ID of a Relay Hybrid Connection, matching the segments of the ID case-insensitively
+func ParseRelayHybridConnectionID(input string) (*RelayHybridConnectionID, error) {
+	values, err := parseResourceIDWithFormat("Relay Hybrid Connection", relayHybridConnectionIDFormat, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RelayHybridConnectionID{
+		SubscriptionID: values[0],
+		ResourceGroup:  values[1],
+		NamespaceName:  values[2],
+		Name:           values[3],
+	}, nil
+}
+
+// ValidateRelayHybridConnectionID validates that the value is the Resource ID of a Relay Hybrid Connection
+func ValidateRelayHybridConnectionID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDWithParser(i, k, func(input string) error {
+		_, err := ParseRelayHybridConnectionID(input)
+		return err
+	})
+}
+
+// ValidateRelayHybridConnectionIDOrEmpty validates that the value is either empty or the Resource ID of a Relay Hybrid Connection
+func ValidateRelayHybridConnectionIDOrEmpty(i interface{}, k string) (warnings []string, errors []error) {
+	if v, ok := i.(string); ok && v == "" {
+		return
+	}
+
+	return ValidateRelayHybridConnectionID(i, k)
+}
+
+const resourceGroupIDFormat = "/subscriptions/{subscriptionId}/resourceGroups/{name}"
+
+// ResourceGroupID is the Resource ID of a Resource Group
+type ResourceGroupID struct {
+	SubscriptionID string
+	Name           string
+}
+
+// NewResourceGroupID returns the Resource ID of the Resource Group
+func NewResourceGroupID(subscriptionID, name string) ResourceGroupID {
+	return ResourceGroupID{
+		SubscriptionID: subscriptionID,
+		Name:           name,
+	}
+}
+
+// ID returns the formatted Resource ID of the Resource Group
+func (id ResourceGroupID) ID() string {
+	return formatResourceID(resourceGroupIDFormat, id.SubscriptionID, id.Name)
+}
+
+// ParseResourceGroupID parses the Resource ID of a Resource Group, matching the segments of the ID case-insensitively
+func ParseResourceGroupID(input string) (*ResourceGroupID, error) {
+	values, err := parseResourceIDWithFormat("Resource Group", resourceGroupIDFormat, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ResourceGroupID{
+		SubscriptionID: values[0],
+		Name:           values[1],
+	}, nil
+}
+
+// ValidateResourceGroupID validates that the value is the Resource ID of a Resource Group
+func ValidateResourceGroupID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDWithParser(i, k, func(input string) error {
+		_, err := ParseResourceGroupID(input)
+		return err
+	})
+}
+
+// ValidateResourceGroupIDOrEmpty validates that the value is either empty or the Resource ID of a Resource Group
+func ValidateResourceGroupIDOrEmpty(i interface{}, k string) (warnings []string, errors []error) {
+	if v, ok := i.(string); ok && v == "" {
+		return
+	}
+
+	return ValidateResourceGroupID(i, k)
+}
+
+const routeIDFormat = "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/routeTables/{routeTableName}/routes/{name}"
+
+// RouteID is the Resource ID of a Route
+type RouteID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	RouteTableName string
+	Name           string
+}
+
+// NewRouteID returns the Resource ID of the Route
+func NewRouteID(subscriptionID, resourceGroup, routeTableName, name string) RouteID {
+	return RouteID{
+		SubscriptionID: subscriptionID,
+		ResourceGroup:  resourceGroup,
+		RouteTableName: routeTableName,
+		Name:           name,
+	}
+}
+
+// ID returns the formatted Resource ID of the Route
+func (id RouteID) ID() string {
+	return formatResourceID(routeIDFormat, id.SubscriptionID, id.ResourceGroup, id.RouteTableName, id.Name)
+}
+
+// ParseRouteID parses the Resource ID of a Route, matching the segments of the ID case-insensitively
+func ParseRouteID(input string) (*RouteID, error) {
+	values, err := parseResourceIDWithFormat("Route", routeIDFormat, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RouteID{
+		SubscriptionID: values[0],
+		ResourceGroup:  values[1],
+		RouteTableName: values[2],
+		Name:           values[3],
+	}, nil
+}
+
+// ValidateRouteID validates that the value is the Resource ID of a Route
+func ValidateRouteID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDWithParser(i, k, func(input string) error {
+		_, err := ParseRouteID(input)
+		return err
+	})
+}
+
+// ValidateRouteIDOrEmpty validates that the value is either empty or the Resource ID of a Route
+func ValidateRouteIDOrEmpty(i interface{}, k string) (warnings []string, errors []error) {
+	if v, ok := i.(string); ok && v == "" {
+		return
+	}
+
+	return ValidateRouteID(i, k)
+}
+
+const routeTableIDFormat = "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/routeTables/{name}"
+
+// RouteTableID is the Resource ID of a Route Table
+type RouteTableID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewRouteTableID returns the Resource ID of the Route Table
+func NewRouteTableID(subscriptionID, resourceGroup, name string) RouteTableID {
+	return RouteTableID{
+		SubscriptionID: subscriptionID,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the formatted Resource ID of the Route Table
+func (id RouteTableID) ID() string {
+	return formatResourceID(routeTableIDFormat, id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+// ParseRouteTableID parses the Resource ID of a Route Table, matching the segments of the ID case-insensitively
+func ParseRouteTableID(input string) (*RouteTableID, error) {
+	values, err := parseResourceIDWithFormat("Route Table", routeTableIDFormat, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RouteTableID{
+		SubscriptionID: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// ValidateRouteTableID validates that the value is the Resource ID of a Route Table
+func ValidateRouteTableID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDWithParser(i, k, func(input string) error {
+		_, err := ParseRouteTableID(input)
+		return err
+	})
+}
+
+// ValidateRouteTableIDOrEmpty validates that the value is either empty or the Resource ID of a Route Table
+func ValidateRouteTableIDOrEmpty(i interface{}, k string) (warnings []string, errors []error) {
+	if v, ok := i.(string); ok && v == "" {
+		return
+	}
+
+	return ValidateRouteTableID(i, k)
+}
+
+const serviceBusNamespaceIDFormat = "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ServiceBus/namespaces/{name}"
+
+// ServiceBusNamespaceID is the Resource ID of a Service Bus Namespace
+type ServiceBusNamespaceID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewServiceBusNamespaceID returns the Resource ID of the Service Bus Namespace
+func NewServiceBusNamespaceID(subscriptionID, resourceGroup, name string) ServiceBusNamespaceID {
+	return ServiceBusNamespaceID{
+		SubscriptionID: subscriptionID,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the formatted Resource ID of the Service Bus Namespace
+func (id ServiceBusNamespaceID) ID() string {
+	return formatResourceID(serviceBusNamespaceIDFormat, id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+// ParseServiceBusNamespaceID parses the Resource ID of a Service Bus Namespace, matching the segments of the ID case-insensitively
+func ParseServiceBusNamespaceID(input string) (*ServiceBusNamespaceID, error) {
+	values, err := parseResourceIDWithFormat("Service Bus Namespace", serviceBusNamespaceIDFormat, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ServiceBusNamespaceID{
+		SubscriptionID: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// ValidateServiceBusNamespaceID validates that the value is the Resource ID of a Service Bus Namespace
+func ValidateServiceBusNamespaceID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDWithParser(i, k, func(input string) error {
+		_, err := ParseServiceBusNamespaceID(input)
+		return err
+	})
+}
+
+// ValidateServiceBusNamespaceIDOrEmpty validates that the value is either empty or the Resource ID of a Service Bus Namespace
+func ValidateServiceBusNamespaceIDOrEmpty(i interface{}, k string) (warnings []string, errors []error) {
+	if v, ok := i.(string); ok && v == "" {
+		return
+	}
+
+	return ValidateServiceBusNamespaceID(i, k)
+}
+
+const serviceBusQueueIDFormat = "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ServiceBus/namespaces/{namespaceName}/queues/{name}"
+
+// ServiceBusQueueID is the Resource ID of a Service Bus Queue
+type ServiceBusQueueID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	NamespaceName  string
+	Name           string
+}
+
+// NewServiceBusQueueID returns the Resource ID of the Service Bus Queue
+func NewServiceBusQueueID(subscriptionID, resourceGroup, namespaceName, name string) ServiceBusQueueID {
+	return ServiceBusQueueID{
+		SubscriptionID: subscriptionID,
+		ResourceGroup:  resourceGroup,
+		NamespaceName:  namespaceName,
+		Name:           name,
+	}
+}
+
+// ID returns the formatted Resource ID of the Service Bus Queue
+func (id ServiceBusQueueID) ID() string {
+	return formatResourceID(serviceBusQueueIDFormat, id.SubscriptionID, id.ResourceGroup, id.NamespaceName, id.Name)
+}
+
+// ParseServiceBusQueueID parses the Resource ID of a Service Bus Queue, matching the segments of the ID case-insensitively
+func ParseServiceBusQueueID(input string) (*ServiceBusQueueID, error) {
+	values, err := parseResourceIDWithFormat("Service Bus Queue", serviceBusQueueIDFormat, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ServiceBusQueueID{
+		SubscriptionID: values[0],
+		ResourceGroup:  values[1],
+		NamespaceName:  values[2],
+		Name:           values[3],
+	}, nil
+}
+
+// ValidateServiceBusQueueID validates that the value is the Resource ID of a Service Bus Queue
+func ValidateServiceBusQueueID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDWithParser(i, k, func(input string) error {
+		_, err := ParseServiceBusQueueID(input)
+		return err
+	})
+}
+
+// ValidateServiceBusQueueIDOrEmpty validates that the value is either empty or the Resource ID of a Service Bus Queue
+func ValidateServiceBusQueueIDOrEmpty(i interface{}, k string) (warnings []string, errors []error) {
+	if v, ok := i.(string); ok && v == "" {
+		return
+	}
+
+	return ValidateServiceBusQueueID(i, k)
+}
+
+const serviceBusTopicIDFormat = "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ServiceBus/namespaces/{namespaceName}/topics/{name}"
+
+// ServiceBusTopicID is the Resource ID of a Service Bus Topic
+type ServiceBusTopicID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	NamespaceName  string
+	Name           string
+}
+
+// NewServiceBusTopicID returns the Resource ID of the Service Bus Topic
+func NewServiceBusTopicID(subscriptionID, resourceGroup, namespaceName, name string) ServiceBusTopicID {
+	return ServiceBusTopicID{
+		SubscriptionID: subscriptionID,
+		ResourceGroup:  resourceGroup,
+		NamespaceName:  namespaceName,
+		Name:           name,
+	}
+}
+
+// ID returns the formatted Resource ID of the Service Bus Topic
+func (id ServiceBusTopicID) ID() string {
+	return formatResourceID(serviceBusTopicIDFormat, id.SubscriptionID, id.ResourceGroup, id.NamespaceName, id.Name)
+}
+
+// ParseServiceBusTopicID parses the Resource ID of a Service Bus Topic, matching the segments of the ID case-insensitively
+func ParseServiceBusTopicID(input string) (*ServiceBusTopicID, error) {
+	values, err := parseResourceIDWithFormat("Service Bus Topic", serviceBusTopicIDFormat, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ServiceBusTopicID{
+		SubscriptionID: values[0],
+		ResourceGroup:  values[1],
+		NamespaceName:  values[2],
+		Name:           values[3],
+	}, nil
+}
+
+// ValidateServiceBusTopicID validates that the value is the Resource ID of a Service Bus Topic
+func ValidateServiceBusTopicID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDWithParser(i, k, func(input string) error {
+		_, err := ParseServiceBusTopicID(input)
+		return err
+	})
+}
+
+// ValidateServiceBusTopicIDOrEmpty validates that the value is either empty or the Resource ID of a Service Bus Topic
+func ValidateServiceBusTopicIDOrEmpty(i interface{}, k string) (warnings []string, errors []error) {
+	if v, ok := i.(string); ok && v == "" {
+		return
+	}
+
+	return ValidateServiceBusTopicID(i, k)
+}
+
+const snapshotIDFormat = "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Compute/snapshots/{name}"
+
+// SnapshotID is the Resource ID of a Snapshot
+type SnapshotID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewSnapshotID returns the Resource ID of the Snapshot
+func NewSnapshotID(subscriptionID, resourceGroup, name string) SnapshotID {
+	return SnapshotID{
+		SubscriptionID: subscriptionID,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the formatted Resource ID of the Snapshot
+func (id SnapshotID) ID() string {
+	return formatResourceID(snapshotIDFormat, id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+// ParseSnapshotID parses the Resource ID of a Snapshot, matching the segments of the ID case-insensitively
+func ParseSnapshotID(input string) (*SnapshotID, error) {
+	values, err := parseResourceIDWithFormat("Snapshot", snapshotIDFormat, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SnapshotID{
+		SubscriptionID: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// ValidateSnapshotID validates that the value is the Resource ID of a Snapshot
+func ValidateSnapshotID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDWithParser(i, k, func(input string) error {
+		_, err := ParseSnapshotID(input)
+		return err
+	})
+}
+
+// ValidateSnapshotIDOrEmpty validates that the value is either empty or the Resource ID of a Snapshot
+func ValidateSnapshotIDOrEmpty(i interface{}, k string) (warnings []string, errors []error) {
+	if v, ok := i.(string); ok && v == "" {
+		return
+	}
+
+	return ValidateSnapshotID(i, k)
+}
+
+const sqlDatabaseIDFormat = "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Sql/servers/{serverName}/databases/{name}"
+
+// SqlDatabaseID is the Resource ID of a Sql Database
+type SqlDatabaseID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	ServerName     string
+	Name           string
+}
+
+// NewSqlDatabaseID returns the Resource ID of the Sql Database
+func NewSqlDatabaseID(subscriptionID, resourceGroup, serverName, name string) SqlDatabaseID {
+	return SqlDatabaseID{
+		SubscriptionID: subscriptionID,
+		ResourceGroup:  resourceGroup,
+		ServerName:     serverName,
+		Name:           name,
+	}
+}
+
+// ID returns the formatted Resource ID of the Sql Database
+func (id SqlDatabaseID) ID() string {
+	return formatResourceID(sqlDatabaseIDFormat, id.SubscriptionID, id.ResourceGroup, id.ServerName, id.Name)
+}
+
+// ParseSqlDatabaseID parses the Resource ID of a Sql Database, matching the segments of the ID case-insensitively
+func ParseSqlDatabaseID(input string) (*SqlDatabaseID, error) {
+	values, err := parseResourceIDWithFormat("Sql Database", sqlDatabaseIDFormat, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SqlDatabaseID{
+		SubscriptionID: values[0],
+		ResourceGroup:  values[1],
+		ServerName:     values[2],
+		Name:           values[3],
+	}, nil
+}
+
+// ValidateSqlDatabaseID validates that the value is the Resource ID of a Sql Database
+func ValidateSqlDatabaseID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDWithParser(i, k, func(input string) error {
+		_, err := ParseSqlDatabaseID(input)
+		return err
+	})
+}
+
+// ValidateSqlDatabaseIDOrEmpty validates that the value is either empty or the Resource ID of a Sql Database
+func ValidateSqlDatabaseIDOrEmpty(i interface{}, k string) (warnings []string, errors []error) {
+	if v, ok := i.(string); ok && v == "" {
+		return
+	}
+
+	return ValidateSqlDatabaseID(i, k)
+}
+
+const sqlServerIDFormat = "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Sql/servers/{name}"
+
+// SqlServerID is the Resource ID of a Sql Server
+type SqlServerID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewSqlServerID returns the Resource ID of the Sql Server
+func NewSqlServerID(subscriptionID, resourceGroup, name string) SqlServerID {
+	return SqlServerID{
+		SubscriptionID: subscriptionID,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the formatted Resource ID of the Sql Server
+func (id SqlServerID) ID() string {
+	return formatResourceID(sqlServerIDFormat, id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+// ParseSqlServerID parses the Resource ID of a Sql Server, matching the segments of the ID case-insensitively
+func ParseSqlServerID(input string) (*SqlServerID, error) {
+	values, err := parseResourceIDWithFormat("Sql Server", sqlServerIDFormat, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SqlServerID{
+		SubscriptionID: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// ValidateSqlServerID validates that the value is the Resource ID of a Sql Server
+func ValidateSqlServerID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDWithParser(i, k, func(input string) error {
+		_, err := ParseSqlServerID(input)
+		return err
+	})
+}
+
+// ValidateSqlServerIDOrEmpty validates that the value is either empty or the Resource ID of a Sql Server
+func ValidateSqlServerIDOrEmpty(i interface{}, k string) (warnings []string, errors []error) {
+	if v, ok := i.(string); ok && v == "" {
+		return
+	}
+
+	return ValidateSqlServerID(i, k)
+}
+
+const storageAccountIDFormat = "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Storage/storageAccounts/{name}"
+
+// StorageAccountID is the Resource ID of a Storage Account
+type StorageAccountID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewStorageAccountID returns the Resource ID of the Storage Account
+func NewStorageAccountID(subscriptionID, resourceGroup, name string) StorageAccountID {
+	return StorageAccountID{
+		SubscriptionID: subscriptionID,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the formatted Resource ID of the Storage Account
+func (id StorageAccountID) ID() string {
+	return formatResourceID(storageAccountIDFormat, id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+// ParseStorageAccountID parses the Resource ID of a Storage Account, matching the segments of the ID case-insensitively
+func ParseStorageAccountID(input string) (*StorageAccountID, error) {
+	values, err := parseResourceIDWithFormat("Storage Account", storageAccountIDFormat, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &StorageAccountID{
+		SubscriptionID: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// ValidateStorageAccountID validates that the value is the Resource ID of a Storage Account
+func ValidateStorageAccountID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDWithParser(i, k, func(input string) error {
+		_, err := ParseStorageAccountID(input)
+		return err
+	})
+}
+
+// ValidateStorageAccountIDOrEmpty validates that the value is either empty or the Resource ID of a Storage Account
+func ValidateStorageAccountIDOrEmpty(i interface{}, k string) (warnings []string, errors []error) {
+	if v, ok := i.(string); ok && v == "" {
+		return
+	}
+
+	return ValidateStorageAccountID(i, k)
+}
+
+const storageManagementPolicyIDFormat = "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Storage/storageAccounts/{accountName}/managementPolicies/{name}"
+
+// StorageManagementPolicyID is the Resource ID of a Storage Management Policy
+type StorageManagementPolicyID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	AccountName    string
+	Name           string
+}
+
+// NewStorageManagementPolicyID returns the Resource ID of the Storage Management Policy
+func NewStorageManagementPolicyID(subscriptionID, resourceGroup, accountName, name string) StorageManagementPolicyID {
+	return StorageManagementPolicyID{
+		SubscriptionID: subscriptionID,
+		ResourceGroup:  resourceGroup,
+		AccountName:    accountName,
+		Name:           name,
+	}
+}
+
+// ID returns the formatted Resource ID of the Storage Management Policy
+func (id StorageManagementPolicyID) ID() string {
+	return formatResourceID(storageManagementPolicyIDFormat, id.SubscriptionID, id.ResourceGroup, id.AccountName, id.Name)
+}
+
+// ParseStorageManagementPolicyID parses the Resource ID of a Storage Management Policy, matching the segments of the ID case-insensitively
+func ParseStorageManagementPolicyID(input string) (*StorageManagementPolicyID, error) {
+	values, err := parseResourceIDWithFormat("Storage Management Policy", storageManagementPolicyIDFormat, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &StorageManagementPolicyID{
+		SubscriptionID: values[0],
+		ResourceGroup:  values[1],
+		AccountName:    values[2],
+		Name:           values[3],
+	}, nil
+}
+
+// ValidateStorageManagementPolicyID validates that the value is the Resource ID of a Storage Management Policy
+func ValidateStorageManagementPolicyID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDWithParser(i, k, func(input string) error {
+		_, err := ParseStorageManagementPolicyID(input)
+		return err
+	})
+}
+
+// ValidateStorageManagementPolicyIDOrEmpty validates that the value is either empty or the Resource ID of a Storage Management Policy
+func ValidateStorageManagementPolicyIDOrEmpty(i interface{}, k string) (warnings []string, errors []error) {
+	if v, ok := i.(string); ok && v == "" {
+		return
+	}
+
+	return ValidateStorageManagementPolicyID(i, k)
+}
+
+const subnetIDFormat = "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/virtualNetworks/{virtualNetworkName}/subnets/{name}"
+
+// SubnetID is the Resource ID of a Subnet
+type SubnetID struct {
+	SubscriptionID     string
+	ResourceGroup      string
+	VirtualNetworkName string
+	Name               string
+}
+
+// NewSubnetID returns the Resource ID of the Subnet
+func NewSubnetID(subscriptionID, resourceGroup, virtualNetworkName, name string) SubnetID {
+	return SubnetID{
+		SubscriptionID:     subscriptionID,
+		ResourceGroup:      resourceGroup,
+		VirtualNetworkName: virtualNetworkName,
+		Name:               name,
+	}
+}
+
+// ID returns the formatted Resource ID of the Subnet
+func (id SubnetID) ID() string {
+	return formatResourceID(subnetIDFormat, id.SubscriptionID, id.ResourceGroup, id.VirtualNetworkName, id.Name)
+}
+
+// ParseSubnetID parses the Resource ID of a Subnet, matching the segments of the ID case-insensitively
+func ParseSubnetID(input string) (*SubnetID, error) {
+	values, err := parseResourceIDWithFormat("Subnet", subnetIDFormat, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SubnetID{
+		SubscriptionID:     values[0],
+		ResourceGroup:      values[1],
+		VirtualNetworkName: values[2],
+		Name:               values[3],
+	}, nil
+}
+
+// ValidateSubnetID validates that the value is the Resource ID of a Subnet
+func ValidateSubnetID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDWithParser(i, k, func(input string) error {
+		_, err := ParseSubnetID(input)
+		return err
+	})
+}
+
+// ValidateSubnetIDOrEmpty validates that the value is either empty or the Resource ID of a Subnet
+func ValidateSubnetIDOrEmpty(i interface{}, k string) (warnings []string, errors []error) {
+	if v, ok := i.(string); ok && v == "" {
+		return
+	}
+
+	return ValidateSubnetID(i, k)
+}
+
+const subscriptionTemplateDeploymentIDFormat = "/subscriptions/{subscriptionId}/providers/Microsoft.Resources/deployments/{name}"
+
+// SubscriptionTemplateDeploymentID is the Resource ID of a Subscription Template Deployment
+type SubscriptionTemplateDeploymentID struct {
+	SubscriptionID string
+	Name           string
+}
+
+// NewSubscriptionTemplateDeploymentID returns the Resource ID of the Subscription Template Deployment
+func NewSubscriptionTemplateDeploymentID(subscriptionID, name string) SubscriptionTemplateDeploymentID {
+	return SubscriptionTemplateDeploymentID{
+		SubscriptionID: subscriptionID,
+		Name:           name,
+	}
+}
+
+// ID returns the formatted Resource ID of the Subscription Template Deployment
+func (id SubscriptionTemplateDeploymentID) ID() string {
+	return formatResourceID(subscriptionTemplateDeploymentIDFormat, id.SubscriptionID, id.Name)
+}
+
+// ParseSubscriptionTemplateDeploymentID parses the Resource ID of a Subscription Template Deployment, matching the segments of the ID case-insensitively
+func ParseSubscriptionTemplateDeploymentID(input string) (*SubscriptionTemplateDeploymentID, error) {
+	values, err := parseResourceIDWithFormat("Subscription Template Deployment", subscriptionTemplateDeploymentIDFormat, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SubscriptionTemplateDeploymentID{
+		SubscriptionID: values[0],
+		Name:           values[1],
+	}, nil
+}
+
+// ValidateSubscriptionTemplateDeploymentID validates that the value is the Resource ID of a Subscription Template Deployment
+func ValidateSubscriptionTemplateDeploymentID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDWithParser(i, k, func(input string) error {
+		_, err := ParseSubscriptionTemplateDeploymentID(input)
+		return err
+	})
+}
+
+// ValidateSubscriptionTemplateDeploymentIDOrEmpty validates that the value is either empty or the Resource ID of a Subscription Template Deployment
+func ValidateSubscriptionTemplateDeploymentIDOrEmpty(i interface{}, k string) (warnings []string, errors []error) {
+	if v, ok := i.(string); ok && v == "" {
+		return
+	}
+
+	return ValidateSubscriptionTemplateDeploymentID(i, k)
+}
+
+const templateDeploymentIDFormat = "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Resources/deployments/{name}"
+
+// TemplateDeploymentID is the Resource ID of a Template Deployment
+type TemplateDeploymentID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewTemplateDeploymentID returns the Resource ID of the Template Deployment
+func NewTemplateDeploymentID(subscriptionID, resourceGroup, name string) TemplateDeploymentID {
+	return TemplateDeploymentID{
+		SubscriptionID: subscriptionID,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the formatted Resource ID of the Template Deployment
+func (id TemplateDeploymentID) ID() string {
+	return formatResourceID(templateDeploymentIDFormat, id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+// ParseTemplateDeploymentID parses the Resource ID of a Template Deployment, matching the segments of the ID case-insensitively
+func ParseTemplateDeploymentID(input string) (*TemplateDeploymentID, error) {
+	values, err := parseResourceIDWithFormat("Template Deployment", templateDeploymentIDFormat, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TemplateDeploymentID{
+		SubscriptionID: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// ValidateTemplateDeploymentID validates that the value is the Resource ID of a Template Deployment
+func ValidateTemplateDeploymentID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDWithParser(i, k, func(input string) error {
+		_, err := ParseTemplateDeploymentID(input)
+		return err
+	})
+}
+
+// ValidateTemplateDeploymentIDOrEmpty validates that the value is either empty or the Resource ID of a Template Deployment
+func ValidateTemplateDeploymentIDOrEmpty(i interface{}, k string) (warnings []string, errors []error) {
+	if v, ok := i.(string); ok && v == "" {
+		return
+	}
+
+	return ValidateTemplateDeploymentID(i, k)
+}
+
+const userAssignedIdentityIDFormat = "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.ManagedIdentity/userAssignedIdentities/{name}"
+
+// UserAssignedIdentityID is the Resource ID of an User Assigned Identity
+type UserAssignedIdentityID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewUserAssignedIdentityID returns the Resource ID of the User Assigned Identity
+func NewUserAssignedIdentityID(subscriptionID, resourceGroup, name string) UserAssignedIdentityID {
+	return UserAssignedIdentityID{
+		SubscriptionID: subscriptionID,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the formatted Resource ID of the User Assigned Identity
+func (id UserAssignedIdentityID) ID() string {
+	return formatResourceID(userAssignedIdentityIDFormat, id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+// ParseUserAssignedIdentityID parses the Resource ID of an User Assigned Identity, matching the segments of the ID case-insensitively
+func ParseUserAssignedIdentityID(input string) (*UserAssignedIdentityID, error) {
+	values, err := parseResourceIDWithFormat("User Assigned Identity", userAssignedIdentityIDFormat, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &UserAssignedIdentityID{
+		SubscriptionID: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// ValidateUserAssignedIdentityID validates that the value is the Resource ID of an User Assigned Identity
+func ValidateUserAssignedIdentityID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDWithParser(i, k, func(input string) error {
+		_, err := ParseUserAssignedIdentityID(input)
+		return err
+	})
+}
+
+// ValidateUserAssignedIdentityIDOrEmpty validates that the value is either empty or the Resource ID of an User Assigned Identity
+func ValidateUserAssignedIdentityIDOrEmpty(i interface{}, k string) (warnings []string, errors []error) {
+	if v, ok := i.(string); ok && v == "" {
+		return
+	}
+
+	return ValidateUserAssignedIdentityID(i, k)
+}
+
+const virtualMachineIDFormat = "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Compute/virtualMachines/{name}"
+
+// VirtualMachineID is the Resource ID of a Virtual Machine
+type VirtualMachineID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewVirtualMachineID returns the Resource ID of the Virtual Machine
+func NewVirtualMachineID(subscriptionID, resourceGroup, name string) VirtualMachineID {
+	return VirtualMachineID{
+		SubscriptionID: subscriptionID,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the formatted Resource ID of the Virtual Machine
+func (id VirtualMachineID) ID() string {
+	return formatResourceID(virtualMachineIDFormat, id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+// ParseVirtualMachineID parses the Resource ID of a Virtual Machine, matching the segments of the ID case-insensitively
+func ParseVirtualMachineID(input string) (*VirtualMachineID, error) {
+	values, err := parseResourceIDWithFormat("Virtual Machine", virtualMachineIDFormat, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &VirtualMachineID{
+		SubscriptionID: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// ValidateVirtualMachineID validates that the value is the Resource ID of a Virtual Machine
+func ValidateVirtualMachineID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDWithParser(i, k, func(input string) error {
+		_, err := ParseVirtualMachineID(input)
+		return err
+	})
+}
+
+// ValidateVirtualMachineIDOrEmpty validates that the value is either empty or the Resource ID of a Virtual Machine
+func ValidateVirtualMachineIDOrEmpty(i interface{}, k string) (warnings []string, errors []error) {
+	if v, ok := i.(string); ok && v == "" {
+		return
+	}
+
+	return ValidateVirtualMachineID(i, k)
+}
+
+const virtualMachineScaleSetIDFormat = "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Compute/virtualMachineScaleSets/{name}"
+
+// VirtualMachineScaleSetID is the Resource ID of a Virtual Machine Scale Set
+type VirtualMachineScaleSetID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewVirtualMachineScaleSetID returns the Resource ID of the Virtual Machine Scale Set
+func NewVirtualMachineScaleSetID(subscriptionID, resourceGroup, name string) VirtualMachineScaleSetID {
+	return VirtualMachineScaleSetID{
+		SubscriptionID: subscriptionID,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the formatted Resource ID of the Virtual Machine Scale Set
+func (id VirtualMachineScaleSetID) ID() string {
+	return formatResourceID(virtualMachineScaleSetIDFormat, id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+// ParseVirtualMachineScaleSetID parses the Resource ID of a Virtual Machine Scale Set, matching the segments of the ID case-insensitively
+func ParseVirtualMachineScaleSetID(input string) (*VirtualMachineScaleSetID, error) {
+	values, err := parseResourceIDWithFormat("Virtual Machine Scale Set", virtualMachineScaleSetIDFormat, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &VirtualMachineScaleSetID{
+		SubscriptionID: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// ValidateVirtualMachineScaleSetID validates that the value is the Resource ID of a Virtual Machine Scale Set
+func ValidateVirtualMachineScaleSetID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDWithParser(i, k, func(input string) error {
+		_, err := ParseVirtualMachineScaleSetID(input)
+		return err
+	})
+}
+
+// ValidateVirtualMachineScaleSetIDOrEmpty validates that the value is either empty or the Resource ID of a Virtual Machine Scale Set
+func ValidateVirtualMachineScaleSetIDOrEmpty(i interface{}, k string) (warnings []string, errors []error) {
+	if v, ok := i.(string); ok && v == "" {
+		return
+	}
+
+	return ValidateVirtualMachineScaleSetID(i, k)
+}
+
+const virtualMachineScaleSetInstanceIDFormat = "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Compute/virtualMachineScaleSets/{scaleSetName}/virtualMachines/{instanceId}"
+
+// VirtualMachineScaleSetInstanceID is the Resource ID of a Virtual Machine Scale Set Instance
+type VirtualMachineScaleSetInstanceID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	ScaleSetName   string
+	InstanceId     string
+}
+
+// NewVirtualMachineScaleSetInstanceID returns the Resource ID of the Virtual Machine Scale Set Instance
+func NewVirtualMachineScaleSetInstanceID(subscriptionID, resourceGroup, scaleSetName, instanceId string) VirtualMachineScaleSetInstanceID {
+	return VirtualMachineScaleSetInstanceID{
+		SubscriptionID: subscriptionID,
+		ResourceGroup:  resourceGroup,
+		ScaleSetName:   scaleSetName,
+		InstanceId:     instanceId,
+	}
+}
+
+// ID returns the formatted Resource ID of the Virtual Machine Scale Set Instance
+func (id VirtualMachineScaleSetInstanceID) ID() string {
+	return formatResourceID(virtualMachineScaleSetInstanceIDFormat, id.SubscriptionID, id.ResourceGroup, id.ScaleSetName, id.InstanceId)
+}
+
+// ParseVirtualMachineScaleSetInstanceID parses the Resource ID of a Virtual Machine Scale Set Instance, matching the segments of the ID case-insensitively
+func ParseVirtualMachineScaleSetInstanceID(input string) (*VirtualMachineScaleSetInstanceID, error) {
+	values, err := parseResourceIDWithFormat("Virtual Machine Scale Set Instance", virtualMachineScaleSetInstanceIDFormat, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &VirtualMachineScaleSetInstanceID{
+		SubscriptionID: values[0],
+		ResourceGroup:  values[1],
+		ScaleSetName:   values[2],
+		InstanceId:     values[3],
+	}, nil
+}
+
+// ValidateVirtualMachineScaleSetInstanceID validates that the value is the Resource ID of a Virtual Machine Scale Set Instance
+func ValidateVirtualMachineScaleSetInstanceID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDWithParser(i, k, func(input string) error {
+		_, err := ParseVirtualMachineScaleSetInstanceID(input)
+		return err
+	})
+}
+
+// ValidateVirtualMachineScaleSetInstanceIDOrEmpty validates that the value is either empty or the Resource ID of a Virtual Machine Scale Set Instance
+func ValidateVirtualMachineScaleSetInstanceIDOrEmpty(i interface{}, k string) (warnings []string, errors []error) {
+	if v, ok := i.(string); ok && v == "" {
+		return
+	}
+
+	return ValidateVirtualMachineScaleSetInstanceID(i, k)
+}
+
+const virtualNetworkIDFormat = "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/virtualNetworks/{name}"
+
+// VirtualNetworkID is the Resource ID of a Virtual Network
+type VirtualNetworkID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewVirtualNetworkID returns the Resource ID of the Virtual Network
+func NewVirtualNetworkID(subscriptionID, resourceGroup, name string) VirtualNetworkID {
+	return VirtualNetworkID{
+		SubscriptionID: subscriptionID,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the formatted Resource ID of the Virtual Network
+func (id VirtualNetworkID) ID() string {
+	return formatResourceID(virtualNetworkIDFormat, id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+// ParseVirtualNetworkID parses the Resource ID of a Virtual Network, matching the segments of the ID case-insensitively
+func ParseVirtualNetworkID(input string) (*VirtualNetworkID, error) {
+	values, err := parseResourceIDWithFormat("Virtual Network", virtualNetworkIDFormat, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &VirtualNetworkID{
+		SubscriptionID: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// ValidateVirtualNetworkID validates that the value is the Resource ID of a Virtual Network
+func ValidateVirtualNetworkID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDWithParser(i, k, func(input string) error {
+		_, err := ParseVirtualNetworkID(input)
+		return err
+	})
+}
+
+// ValidateVirtualNetworkIDOrEmpty validates that the value is either empty or the Resource ID of a Virtual Network
+func ValidateVirtualNetworkIDOrEmpty(i interface{}, k string) (warnings []string, errors []error) {
+	if v, ok := i.(string); ok && v == "" {
+		return
+	}
+
+	return ValidateVirtualNetworkID(i, k)
+}
+
+const virtualNetworkGatewayIDFormat = "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/virtualNetworkGateways/{name}"
+
+// VirtualNetworkGatewayID is the Resource ID of a Virtual Network Gateway
+type VirtualNetworkGatewayID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewVirtualNetworkGatewayID returns the Resource ID of the Virtual Network Gateway
+func NewVirtualNetworkGatewayID(subscriptionID, resourceGroup, name string) VirtualNetworkGatewayID {
+	return VirtualNetworkGatewayID{
+		SubscriptionID: subscriptionID,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the formatted Resource ID of the Virtual Network Gateway
+func (id VirtualNetworkGatewayID) ID() string {
+	return formatResourceID(virtualNetworkGatewayIDFormat, id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+// ParseVirtualNetworkGatewayID parses the Resource ID of a Virtual Network Gateway, matching the segments of the ID case-insensitively
+func ParseVirtualNetworkGatewayID(input string) (*VirtualNetworkGatewayID, error) {
+	values, err := parseResourceIDWithFormat("Virtual Network Gateway", virtualNetworkGatewayIDFormat, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &VirtualNetworkGatewayID{
+		SubscriptionID: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// ValidateVirtualNetworkGatewayID validates that the value is the Resource ID of a Virtual Network Gateway
+func ValidateVirtualNetworkGatewayID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDWithParser(i, k, func(input string) error {
+		_, err := ParseVirtualNetworkGatewayID(input)
+		return err
+	})
+}
+
+// ValidateVirtualNetworkGatewayIDOrEmpty validates that the value is either empty or the Resource ID of a Virtual Network Gateway
+func ValidateVirtualNetworkGatewayIDOrEmpty(i interface{}, k string) (warnings []string, errors []error) {
+	if v, ok := i.(string); ok && v == "" {
+		return
+	}
+
+	return ValidateVirtualNetworkGatewayID(i, k)
+}
+
+const virtualNetworkGatewayConnectionIDFormat = "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/connections/{name}"
+
+// VirtualNetworkGatewayConnectionID is the Resource ID of a Virtual Network Gateway Connection
+type VirtualNetworkGatewayConnectionID struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+// NewVirtualNetworkGatewayConnectionID returns the Resource ID of the Virtual Network Gateway Connection
+func NewVirtualNetworkGatewayConnectionID(subscriptionID, resourceGroup, name string) VirtualNetworkGatewayConnectionID {
+	return VirtualNetworkGatewayConnectionID{
+		SubscriptionID: subscriptionID,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+// ID returns the formatted Resource ID of the Virtual Network Gateway Connection
+func (id VirtualNetworkGatewayConnectionID) ID() string {
+	return formatResourceID(virtualNetworkGatewayConnectionIDFormat, id.SubscriptionID, id.ResourceGroup, id.Name)
+}
+
+// ParseVirtualNetworkGatewayConnectionID parses the Resource ID of a Virtual Network Gateway Connection, matching the segments of the ID case-insensitively
+func ParseVirtualNetworkGatewayConnectionID(input string) (*VirtualNetworkGatewayConnectionID, error) {
+	values, err := parseResourceIDWithFormat("Virtual Network Gateway Connection", virtualNetworkGatewayConnectionIDFormat, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &VirtualNetworkGatewayConnectionID{
+		SubscriptionID: values[0],
+		ResourceGroup:  values[1],
+		Name:           values[2],
+	}, nil
+}
+
+// ValidateVirtualNetworkGatewayConnectionID validates that the value is the Resource ID of a Virtual Network Gateway Connection
+func ValidateVirtualNetworkGatewayConnectionID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDWithParser(i, k, func(input string) error {
+		_, err := ParseVirtualNetworkGatewayConnectionID(input)
+		return err
+	})
+}
+
+// ValidateVirtualNetworkGatewayConnectionIDOrEmpty validates that the value is either empty or the Resource ID of a Virtual Network Gateway Connection
+func ValidateVirtualNetworkGatewayConnectionIDOrEmpty(i interface{}, k string) (warnings []string, errors []error) {
+	if v, ok := i.(string); ok && v == "" {
+		return
+	}
+
+	return ValidateVirtualNetworkGatewayConnectionID(i, k)
+}
+
+const virtualNetworkPeeringIDFormat = "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/virtualNetworks/{virtualNetworkName}/virtualNetworkPeerings/{name}"
+
+// VirtualNetworkPeeringID is the Resource ID of a Virtual Network Peering
+type VirtualNetworkPeeringID struct {
+	SubscriptionID     string
+	ResourceGroup      string
+	VirtualNetworkName string
+	Name               string
+}
+
+// NewVirtualNetworkPeeringID returns the Resource ID of the Virtual Network Peering
+func NewVirtualNetworkPeeringID(subscriptionID, resourceGroup, virtualNetworkName, name string) VirtualNetworkPeeringID {
+	return VirtualNetworkPeeringID{
+		SubscriptionID:     subscriptionID,
+		ResourceGroup:      resourceGroup,
+		VirtualNetworkName: virtualNetworkName,
+		Name:               name,
+	}
+}
+
+// ID returns the formatted Resource ID of the Virtual Network Peering
+func (id VirtualNetworkPeeringID) ID() string {
+	return formatResourceID(virtualNetworkPeeringIDFormat, id.SubscriptionID, id.ResourceGroup, id.VirtualNetworkName, id.Name)
+}
+
+// ParseVirtualNetworkPeeringID parses the Resource ID of a Virtual Network Peering, matching the segments of the ID case-insensitively
+func ParseVirtualNetworkPeeringID(input string) (*VirtualNetworkPeeringID, error) {
+	values, err := parseResourceIDWithFormat("Virtual Network Peering", virtualNetworkPeeringIDFormat, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &VirtualNetworkPeeringID{
+		SubscriptionID:     values[0],
+		ResourceGroup:      values[1],
+		VirtualNetworkName: values[2],
+		Name:               values[3],
+	}, nil
+}
+
+// ValidateVirtualNetworkPeeringID validates that the value is the Resource ID of a Virtual Network Peering
+func ValidateVirtualNetworkPeeringID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateResourceIDWithParser(i, k, func(input string) error {
+		_, err := ParseVirtualNetworkPeeringID(input)
+		return err
+	})
+}
+
+// ValidateVirtualNetworkPeeringIDOrEmpty validates that the value is either empty or the Resource ID of a Virtual Network Peering
+func ValidateVirtualNetworkPeeringIDOrEmpty(i interface{}, k string) (warnings []string, errors []error) {
+	if v, ok := i.(string); ok && v == "" {
+		return
+	}
+
+	return ValidateVirtualNetworkPeeringID(i, k)
+}
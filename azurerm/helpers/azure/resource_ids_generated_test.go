@@ -0,0 +1,3617 @@
+// Code generated by scripts/generate-resource-ids; DO NOT EDIT.
+
+package azure
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestApplicationGatewayID(t *testing.T) {
+	id := NewApplicationGatewayID("00000000-0000-0000-0000-000000000000", "resourceGroup1", "name1").ID()
+
+	// the ID should round-trip, regardless of the casing of the segments
+	for _, input := range []string{id, strings.ToLower(id), strings.ToUpper(id)} {
+		parsed, err := ParseApplicationGatewayID(input)
+		if err != nil {
+			t.Fatalf("Expected %q to parse but got: %+v", input, err)
+		}
+		if !strings.EqualFold(parsed.ID(), id) {
+			t.Fatalf("Expected the ID to be %q but got %q", id, parsed.ID())
+		}
+	}
+
+	parsed, err := ParseApplicationGatewayID(id)
+	if err != nil {
+		t.Fatalf("Expected %q to parse but got: %+v", id, err)
+	}
+	if parsed.SubscriptionID != "00000000-0000-0000-0000-000000000000" {
+		t.Fatalf("Expected SubscriptionID to be %q but got %q", "00000000-0000-0000-0000-000000000000", parsed.SubscriptionID)
+	}
+	if parsed.ResourceGroup != "resourceGroup1" {
+		t.Fatalf("Expected ResourceGroup to be %q but got %q", "resourceGroup1", parsed.ResourceGroup)
+	}
+	if parsed.Name != "name1" {
+		t.Fatalf("Expected Name to be %q but got %q", "name1", parsed.Name)
+	}
+
+	for _, input := range []string{"", "random", id + "/extra/segment", id[:strings.LastIndex(id, "/")]} {
+		if _, err := ParseApplicationGatewayID(input); err == nil {
+			t.Fatalf("Expected %q not to parse", input)
+		}
+		if _, errors := ValidateApplicationGatewayID(input, "id"); len(errors) == 0 {
+			t.Fatalf("Expected %q not to be valid", input)
+		}
+	}
+
+	if _, errors := ValidateApplicationGatewayIDOrEmpty("", "id"); len(errors) > 0 {
+		t.Fatalf("Expected an empty value to be valid but got: %+v", errors)
+	}
+	if _, errors := ValidateApplicationGatewayIDOrEmpty("random", "id"); len(errors) == 0 {
+		t.Fatalf("Expected %q not to be valid", "random")
+	}
+}
+
+func TestApplicationInsightsID(t *testing.T) {
+	id := NewApplicationInsightsID("00000000-0000-0000-0000-000000000000", "resourceGroup1", "name1").ID()
+
+	// the ID should round-trip, regardless of the casing of the segments
+	for _, input := range []string{id, strings.ToLower(id), strings.ToUpper(id)} {
+		parsed, err := ParseApplicationInsightsID(input)
+		if err != nil {
+			t.Fatalf("Expected %q to parse but got: %+v", input, err)
+		}
+		if !strings.EqualFold(parsed.ID(), id) {
+			t.Fatalf("Expected the ID to be %q but got %q", id, parsed.ID())
+		}
+	}
+
+	parsed, err := ParseApplicationInsightsID(id)
+	if err != nil {
+		t.Fatalf("Expected %q to parse but got: %+v", id, err)
+	}
+	if parsed.SubscriptionID != "00000000-0000-0000-0000-000000000000" {
+		t.Fatalf("Expected SubscriptionID to be %q but got %q", "00000000-0000-0000-0000-000000000000", parsed.SubscriptionID)
+	}
+	if parsed.ResourceGroup != "resourceGroup1" {
+		t.Fatalf("Expected ResourceGroup to be %q but got %q", "resourceGroup1", parsed.ResourceGroup)
+	}
+	if parsed.Name != "name1" {
+		t.Fatalf("Expected Name to be %q but got %q", "name1", parsed.Name)
+	}
+
+	for _, input := range []string{"", "random", id + "/extra/segment", id[:strings.LastIndex(id, "/")]} {
+		if _, err := ParseApplicationInsightsID(input); err == nil {
+			t.Fatalf("Expected %q not to parse", input)
+		}
+		if _, errors := ValidateApplicationInsightsID(input, "id"); len(errors) == 0 {
+			t.Fatalf("Expected %q not to be valid", input)
+		}
+	}
+
+	if _, errors := ValidateApplicationInsightsIDOrEmpty("", "id"); len(errors) > 0 {
+		t.Fatalf("Expected an empty value to be valid but got: %+v", errors)
+	}
+	if _, errors := ValidateApplicationInsightsIDOrEmpty("random", "id"); len(errors) == 0 {
+		t.Fatalf("Expected %q not to be valid", "random")
+	}
+}
+
+func TestApplicationSecurityGroupID(t *testing.T) {
+	id := NewApplicationSecurityGroupID("00000000-0000-0000-0000-000000000000", "resourceGroup1", "name1").ID()
+
+	// the ID should round-trip, regardless of the casing of the segments
+	for _, input := range []string{id, strings.ToLower(id), strings.ToUpper(id)} {
+		parsed, err := ParseApplicationSecurityGroupID(input)
+		if err != nil {
+			t.Fatalf("Expected %q to parse but got: %+v", input, err)
+		}
+		if !strings.EqualFold(parsed.ID(), id) {
+			t.Fatalf("Expected the ID to be %q but got %q", id, parsed.ID())
+		}
+	}
+
+	parsed, err := ParseApplicationSecurityGroupID(id)
+	if err != nil {
+		t.Fatalf("Expected %q to parse but got: %+v", id, err)
+	}
+	if parsed.SubscriptionID != "00000000-0000-0000-0000-000000000000" {
+		t.Fatalf("Expected SubscriptionID to be %q but got %q", "00000000-0000-0000-0000-000000000000", parsed.SubscriptionID)
+	}
+	if parsed.ResourceGroup != "resourceGroup1" {
+		t.Fatalf("Expected ResourceGroup to be %q but got %q", "resourceGroup1", parsed.ResourceGroup)
+	}
+	if parsed.Name != "name1" {
+		t.Fatalf("Expected Name to be %q but got %q", "name1", parsed.Name)
+	}
+
+	for _, input := range []string{"", "random", id + "/extra/segment", id[:strings.LastIndex(id, "/")]} {
+		if _, err := ParseApplicationSecurityGroupID(input); err == nil {
+			t.Fatalf("Expected %q not to parse", input)
+		}
+		if _, errors := ValidateApplicationSecurityGroupID(input, "id"); len(errors) == 0 {
+			t.Fatalf("Expected %q not to be valid", input)
+		}
+	}
+
+	if _, errors := ValidateApplicationSecurityGroupIDOrEmpty("", "id"); len(errors) > 0 {
+		t.Fatalf("Expected an empty value to be valid but got: %+v", errors)
+	}
+	if _, errors := ValidateApplicationSecurityGroupIDOrEmpty("random", "id"); len(errors) == 0 {
+		t.Fatalf("Expected %q not to be valid", "random")
+	}
+}
+
+func TestAppServiceID(t *testing.T) {
+	id := NewAppServiceID("00000000-0000-0000-0000-000000000000", "resourceGroup1", "name1").ID()
+
+	// the ID should round-trip, regardless of the casing of the segments
+	for _, input := range []string{id, strings.ToLower(id), strings.ToUpper(id)} {
+		parsed, err := ParseAppServiceID(input)
+		if err != nil {
+			t.Fatalf("Expected %q to parse but got: %+v", input, err)
+		}
+		if !strings.EqualFold(parsed.ID(), id) {
+			t.Fatalf("Expected the ID to be %q but got %q", id, parsed.ID())
+		}
+	}
+
+	parsed, err := ParseAppServiceID(id)
+	if err != nil {
+		t.Fatalf("Expected %q to parse but got: %+v", id, err)
+	}
+	if parsed.SubscriptionID != "00000000-0000-0000-0000-000000000000" {
+		t.Fatalf("Expected SubscriptionID to be %q but got %q", "00000000-0000-0000-0000-000000000000", parsed.SubscriptionID)
+	}
+	if parsed.ResourceGroup != "resourceGroup1" {
+		t.Fatalf("Expected ResourceGroup to be %q but got %q", "resourceGroup1", parsed.ResourceGroup)
+	}
+	if parsed.Name != "name1" {
+		t.Fatalf("Expected Name to be %q but got %q", "name1", parsed.Name)
+	}
+
+	for _, input := range []string{"", "random", id + "/extra/segment", id[:strings.LastIndex(id, "/")]} {
+		if _, err := ParseAppServiceID(input); err == nil {
+			t.Fatalf("Expected %q not to parse", input)
+		}
+		if _, errors := ValidateAppServiceID(input, "id"); len(errors) == 0 {
+			t.Fatalf("Expected %q not to be valid", input)
+		}
+	}
+
+	if _, errors := ValidateAppServiceIDOrEmpty("", "id"); len(errors) > 0 {
+		t.Fatalf("Expected an empty value to be valid but got: %+v", errors)
+	}
+	if _, errors := ValidateAppServiceIDOrEmpty("random", "id"); len(errors) == 0 {
+		t.Fatalf("Expected %q not to be valid", "random")
+	}
+}
+
+func TestAppServicePlanID(t *testing.T) {
+	id := NewAppServicePlanID("00000000-0000-0000-0000-000000000000", "resourceGroup1", "name1").ID()
+
+	// the ID should round-trip, regardless of the casing of the segments
+	for _, input := range []string{id, strings.ToLower(id), strings.ToUpper(id)} {
+		parsed, err := ParseAppServicePlanID(input)
+		if err != nil {
+			t.Fatalf("Expected %q to parse but got: %+v", input, err)
+		}
+		if !strings.EqualFold(parsed.ID(), id) {
+			t.Fatalf("Expected the ID to be %q but got %q", id, parsed.ID())
+		}
+	}
+
+	parsed, err := ParseAppServicePlanID(id)
+	if err != nil {
+		t.Fatalf("Expected %q to parse but got: %+v", id, err)
+	}
+	if parsed.SubscriptionID != "00000000-0000-0000-0000-000000000000" {
+		t.Fatalf("Expected SubscriptionID to be %q but got %q", "00000000-0000-0000-0000-000000000000", parsed.SubscriptionID)
+	}
+	if parsed.ResourceGroup != "resourceGroup1" {
+		t.Fatalf("Expected ResourceGroup to be %q but got %q", "resourceGroup1", parsed.ResourceGroup)
+	}
+	if parsed.Name != "name1" {
+		t.Fatalf("Expected Name to be %q but got %q", "name1", parsed.Name)
+	}
+
+	for _, input := range []string{"", "random", id + "/extra/segment", id[:strings.LastIndex(id, "/")]} {
+		if _, err := ParseAppServicePlanID(input); err == nil {
+			t.Fatalf("Expected %q not to parse", input)
+		}
+		if _, errors := ValidateAppServicePlanID(input, "id"); len(errors) == 0 {
+			t.Fatalf("Expected %q not to be valid", input)
+		}
+	}
+
+	if _, errors := ValidateAppServicePlanIDOrEmpty("", "id"); len(errors) > 0 {
+		t.Fatalf("Expected an empty value to be valid but got: %+v", errors)
+	}
+	if _, errors := ValidateAppServicePlanIDOrEmpty("random", "id"); len(errors) == 0 {
+		t.Fatalf("Expected %q not to be valid", "random")
+	}
+}
+
+func TestAvailabilitySetID(t *testing.T) {
+	id := NewAvailabilitySetID("00000000-0000-0000-0000-000000000000", "resourceGroup1", "name1").ID()
+
+	// the ID should round-trip, regardless of the casing of the segments
+	for _, input := range []string{id, strings.ToLower(id), strings.ToUpper(id)} {
+		parsed, err := ParseAvailabilitySetID(input)
+		if err != nil {
+			t.Fatalf("Expected %q to parse but got: %+v", input, err)
+		}
+		if !strings.EqualFold(parsed.ID(), id) {
+			t.Fatalf("Expected the ID to be %q but got %q", id, parsed.ID())
+		}
+	}
+
+	parsed, err := ParseAvailabilitySetID(id)
+	if err != nil {
+		t.Fatalf("Expected %q to parse but got: %+v", id, err)
+	}
+	if parsed.SubscriptionID != "00000000-0000-0000-0000-000000000000" {
+		t.Fatalf("Expected SubscriptionID to be %q but got %q", "00000000-0000-0000-0000-000000000000", parsed.SubscriptionID)
+	}
+	if parsed.ResourceGroup != "resourceGroup1" {
+		t.Fatalf("Expected ResourceGroup to be %q but got %q", "resourceGroup1", parsed.ResourceGroup)
+	}
+	if parsed.Name != "name1" {
+		t.Fatalf("Expected Name to be %q but got %q", "name1", parsed.Name)
+	}
+
+	for _, input := range []string{"", "random", id + "/extra/segment", id[:strings.LastIndex(id, "/")]} {
+		if _, err := ParseAvailabilitySetID(input); err == nil {
+			t.Fatalf("Expected %q not to parse", input)
+		}
+		if _, errors := ValidateAvailabilitySetID(input, "id"); len(errors) == 0 {
+			t.Fatalf("Expected %q not to be valid", input)
+		}
+	}
+
+	if _, errors := ValidateAvailabilitySetIDOrEmpty("", "id"); len(errors) > 0 {
+		t.Fatalf("Expected an empty value to be valid but got: %+v", errors)
+	}
+	if _, errors := ValidateAvailabilitySetIDOrEmpty("random", "id"); len(errors) == 0 {
+		t.Fatalf("Expected %q not to be valid", "random")
+	}
+}
+
+func TestContainerRegistryID(t *testing.T) {
+	id := NewContainerRegistryID("00000000-0000-0000-0000-000000000000", "resourceGroup1", "name1").ID()
+
+	// the ID should round-trip, regardless of the casing of the segments
+	for _, input := range []string{id, strings.ToLower(id), strings.ToUpper(id)} {
+		parsed, err := ParseContainerRegistryID(input)
+		if err != nil {
+			t.Fatalf("Expected %q to parse but got: %+v", input, err)
+		}
+		if !strings.EqualFold(parsed.ID(), id) {
+			t.Fatalf("Expected the ID to be %q but got %q", id, parsed.ID())
+		}
+	}
+
+	parsed, err := ParseContainerRegistryID(id)
+	if err != nil {
+		t.Fatalf("Expected %q to parse but got: %+v", id, err)
+	}
+	if parsed.SubscriptionID != "00000000-0000-0000-0000-000000000000" {
+		t.Fatalf("Expected SubscriptionID to be %q but got %q", "00000000-0000-0000-0000-000000000000", parsed.SubscriptionID)
+	}
+	if parsed.ResourceGroup != "resourceGroup1" {
+		t.Fatalf("Expected ResourceGroup to be %q but got %q", "resourceGroup1", parsed.ResourceGroup)
+	}
+	if parsed.Name != "name1" {
+		t.Fatalf("Expected Name to be %q but got %q", "name1", parsed.Name)
+	}
+
+	for _, input := range []string{"", "random", id + "/extra/segment", id[:strings.LastIndex(id, "/")]} {
+		if _, err := ParseContainerRegistryID(input); err == nil {
+			t.Fatalf("Expected %q not to parse", input)
+		}
+		if _, errors := ValidateContainerRegistryID(input, "id"); len(errors) == 0 {
+			t.Fatalf("Expected %q not to be valid", input)
+		}
+	}
+
+	if _, errors := ValidateContainerRegistryIDOrEmpty("", "id"); len(errors) > 0 {
+		t.Fatalf("Expected an empty value to be valid but got: %+v", errors)
+	}
+	if _, errors := ValidateContainerRegistryIDOrEmpty("random", "id"); len(errors) == 0 {
+		t.Fatalf("Expected %q not to be valid", "random")
+	}
+}
+
+func TestCosmosDBAccountID(t *testing.T) {
+	id := NewCosmosDBAccountID("00000000-0000-0000-0000-000000000000", "resourceGroup1", "name1").ID()
+
+	// the ID should round-trip, regardless of the casing of the segments
+	for _, input := range []string{id, strings.ToLower(id), strings.ToUpper(id)} {
+		parsed, err := ParseCosmosDBAccountID(input)
+		if err != nil {
+			t.Fatalf("Expected %q to parse but got: %+v", input, err)
+		}
+		if !strings.EqualFold(parsed.ID(), id) {
+			t.Fatalf("Expected the ID to be %q but got %q", id, parsed.ID())
+		}
+	}
+
+	parsed, err := ParseCosmosDBAccountID(id)
+	if err != nil {
+		t.Fatalf("Expected %q to parse but got: %+v", id, err)
+	}
+	if parsed.SubscriptionID != "00000000-0000-0000-0000-000000000000" {
+		t.Fatalf("Expected SubscriptionID to be %q but got %q", "00000000-0000-0000-0000-000000000000", parsed.SubscriptionID)
+	}
+	if parsed.ResourceGroup != "resourceGroup1" {
+		t.Fatalf("Expected ResourceGroup to be %q but got %q", "resourceGroup1", parsed.ResourceGroup)
+	}
+	if parsed.Name != "name1" {
+		t.Fatalf("Expected Name to be %q but got %q", "name1", parsed.Name)
+	}
+
+	for _, input := range []string{"", "random", id + "/extra/segment", id[:strings.LastIndex(id, "/")]} {
+		if _, err := ParseCosmosDBAccountID(input); err == nil {
+			t.Fatalf("Expected %q not to parse", input)
+		}
+		if _, errors := ValidateCosmosDBAccountID(input, "id"); len(errors) == 0 {
+			t.Fatalf("Expected %q not to be valid", input)
+		}
+	}
+
+	if _, errors := ValidateCosmosDBAccountIDOrEmpty("", "id"); len(errors) > 0 {
+		t.Fatalf("Expected an empty value to be valid but got: %+v", errors)
+	}
+	if _, errors := ValidateCosmosDBAccountIDOrEmpty("random", "id"); len(errors) == 0 {
+		t.Fatalf("Expected %q not to be valid", "random")
+	}
+}
+
+func TestCosmosDBCassandraKeyspaceID(t *testing.T) {
+	id := NewCosmosDBCassandraKeyspaceID("00000000-0000-0000-0000-000000000000", "resourceGroup1", "accountName1", "name1").ID()
+
+	// the ID should round-trip, regardless of the casing of the segments
+	for _, input := range []string{id, strings.ToLower(id), strings.ToUpper(id)} {
+		parsed, err := ParseCosmosDBCassandraKeyspaceID(input)
+		if err != nil {
+			t.Fatalf("Expected %q to parse but got: %+v", input, err)
+		}
+		if !strings.EqualFold(parsed.ID(), id) {
+			t.Fatalf("Expected the ID to be %q but got %q", id, parsed.ID())
+		}
+	}
+
+	parsed, err := ParseCosmosDBCassandraKeyspaceID(id)
+	if err != nil {
+		t.Fatalf("Expected %q to parse but got: %+v", id, err)
+	}
+	if parsed.SubscriptionID != "00000000-0000-0000-0000-000000000000" {
+		t.Fatalf("Expected SubscriptionID to be %q but got %q", "00000000-0000-0000-0000-000000000000", parsed.SubscriptionID)
+	}
+	if parsed.ResourceGroup != "resourceGroup1" {
+		t.Fatalf("Expected ResourceGroup to be %q but got %q", "resourceGroup1", parsed.ResourceGroup)
+	}
+	if parsed.AccountName != "accountName1" {
+		t.Fatalf("Expected AccountName to be %q but got %q", "accountName1", parsed.AccountName)
+	}
+	if parsed.Name != "name1" {
+		t.Fatalf("Expected Name to be %q but got %q", "name1", parsed.Name)
+	}
+
+	for _, input := range []string{"", "random", id + "/extra/segment", id[:strings.LastIndex(id, "/")]} {
+		if _, err := ParseCosmosDBCassandraKeyspaceID(input); err == nil {
+			t.Fatalf("Expected %q not to parse", input)
+		}
+		if _, errors := ValidateCosmosDBCassandraKeyspaceID(input, "id"); len(errors) == 0 {
+			t.Fatalf("Expected %q not to be valid", input)
+		}
+	}
+
+	if _, errors := ValidateCosmosDBCassandraKeyspaceIDOrEmpty("", "id"); len(errors) > 0 {
+		t.Fatalf("Expected an empty value to be valid but got: %+v", errors)
+	}
+	if _, errors := ValidateCosmosDBCassandraKeyspaceIDOrEmpty("random", "id"); len(errors) == 0 {
+		t.Fatalf("Expected %q not to be valid", "random")
+	}
+}
+
+func TestCosmosDBCassandraTableID(t *testing.T) {
+	id := NewCosmosDBCassandraTableID("00000000-0000-0000-0000-000000000000", "resourceGroup1", "accountName1", "keyspaceName1", "name1").ID()
+
+	// the ID should round-trip, regardless of the casing of the segments
+	for _, input := range []string{id, strings.ToLower(id), strings.ToUpper(id)} {
+		parsed, err := ParseCosmosDBCassandraTableID(input)
+		if err != nil {
+			t.Fatalf("Expected %q to parse but got: %+v", input, err)
+		}
+		if !strings.EqualFold(parsed.ID(), id) {
+			t.Fatalf("Expected the ID to be %q but got %q", id, parsed.ID())
+		}
+	}
+
+	parsed, err := ParseCosmosDBCassandraTableID(id)
+	if err != nil {
+		t.Fatalf("Expected %q to parse but got: %+v", id, err)
+	}
+	if parsed.SubscriptionID != "00000000-0000-0000-0000-000000000000" {
+		t.Fatalf("Expected SubscriptionID to be %q but got %q", "00000000-0000-0000-0000-000000000000", parsed.SubscriptionID)
+	}
+	if parsed.ResourceGroup != "resourceGroup1" {
+		t.Fatalf("Expected ResourceGroup to be %q but got %q", "resourceGroup1", parsed.ResourceGroup)
+	}
+	if parsed.AccountName != "accountName1" {
+		t.Fatalf("Expected AccountName to be %q but got %q", "accountName1", parsed.AccountName)
+	}
+	if parsed.KeyspaceName != "keyspaceName1" {
+		t.Fatalf("Expected KeyspaceName to be %q but got %q", "keyspaceName1", parsed.KeyspaceName)
+	}
+	if parsed.Name != "name1" {
+		t.Fatalf("Expected Name to be %q but got %q", "name1", parsed.Name)
+	}
+
+	for _, input := range []string{"", "random", id + "/extra/segment", id[:strings.LastIndex(id, "/")]} {
+		if _, err := ParseCosmosDBCassandraTableID(input); err == nil {
+			t.Fatalf("Expected %q not to parse", input)
+		}
+		if _, errors := ValidateCosmosDBCassandraTableID(input, "id"); len(errors) == 0 {
+			t.Fatalf("Expected %q not to be valid", input)
+		}
+	}
+
+	if _, errors := ValidateCosmosDBCassandraTableIDOrEmpty("", "id"); len(errors) > 0 {
+		t.Fatalf("Expected an empty value to be valid but got: %+v", errors)
+	}
+	if _, errors := ValidateCosmosDBCassandraTableIDOrEmpty("random", "id"); len(errors) == 0 {
+		t.Fatalf("Expected %q not to be valid", "random")
+	}
+}
+
+func TestCosmosDBGremlinDatabaseID(t *testing.T) {
+	id := NewCosmosDBGremlinDatabaseID("00000000-0000-0000-0000-000000000000", "resourceGroup1", "accountName1", "name1").ID()
+
+	// the ID should round-trip, regardless of the casing of the segments
+	for _, input := range []string{id, strings.ToLower(id), strings.ToUpper(id)} {
+		parsed, err := ParseCosmosDBGremlinDatabaseID(input)
+		if err != nil {
+			t.Fatalf("Expected %q to parse but got: %+v", input, err)
+		}
+		if !strings.EqualFold(parsed.ID(), id) {
+			t.Fatalf("Expected the ID to be %q but got %q", id, parsed.ID())
+		}
+	}
+
+	parsed, err := ParseCosmosDBGremlinDatabaseID(id)
+	if err != nil {
+		t.Fatalf("Expected %q to parse but got: %+v", id, err)
+	}
+	if parsed.SubscriptionID != "00000000-0000-0000-0000-000000000000" {
+		t.Fatalf("Expected SubscriptionID to be %q but got %q", "00000000-0000-0000-0000-000000000000", parsed.SubscriptionID)
+	}
+	if parsed.ResourceGroup != "resourceGroup1" {
+		t.Fatalf("Expected ResourceGroup to be %q but got %q", "resourceGroup1", parsed.ResourceGroup)
+	}
+	if parsed.AccountName != "accountName1" {
+		t.Fatalf("Expected AccountName to be %q but got %q", "accountName1", parsed.AccountName)
+	}
+	if parsed.Name != "name1" {
+		t.Fatalf("Expected Name to be %q but got %q", "name1", parsed.Name)
+	}
+
+	for _, input := range []string{"", "random", id + "/extra/segment", id[:strings.LastIndex(id, "/")]} {
+		if _, err := ParseCosmosDBGremlinDatabaseID(input); err == nil {
+			t.Fatalf("Expected %q not to parse", input)
+		}
+		if _, errors := ValidateCosmosDBGremlinDatabaseID(input, "id"); len(errors) == 0 {
+			t.Fatalf("Expected %q not to be valid", input)
+		}
+	}
+
+	if _, errors := ValidateCosmosDBGremlinDatabaseIDOrEmpty("", "id"); len(errors) > 0 {
+		t.Fatalf("Expected an empty value to be valid but got: %+v", errors)
+	}
+	if _, errors := ValidateCosmosDBGremlinDatabaseIDOrEmpty("random", "id"); len(errors) == 0 {
+		t.Fatalf("Expected %q not to be valid", "random")
+	}
+}
+
+func TestCosmosDBGremlinGraphID(t *testing.T) {
+	id := NewCosmosDBGremlinGraphID("00000000-0000-0000-0000-000000000000", "resourceGroup1", "accountName1", "databaseName1", "name1").ID()
+
+	// the ID should round-trip, regardless of the casing of the segments
+	for _, input := range []string{id, strings.ToLower(id), strings.ToUpper(id)} {
+		parsed, err := ParseCosmosDBGremlinGraphID(input)
+		if err != nil {
+			t.Fatalf("Expected %q to parse but got: %+v", input, err)
+		}
+		if !strings.EqualFold(parsed.ID(), id) {
+			t.Fatalf("Expected the ID to be %q but got %q", id, parsed.ID())
+		}
+	}
+
+	parsed, err := ParseCosmosDBGremlinGraphID(id)
+	if err != nil {
+		t.Fatalf("Expected %q to parse but got: %+v", id, err)
+	}
+	if parsed.SubscriptionID != "00000000-0000-0000-0000-000000000000" {
+		t.Fatalf("Expected SubscriptionID to be %q but got %q", "00000000-0000-0000-0000-000000000000", parsed.SubscriptionID)
+	}
+	if parsed.ResourceGroup != "resourceGroup1" {
+		t.Fatalf("Expected ResourceGroup to be %q but got %q", "resourceGroup1", parsed.ResourceGroup)
+	}
+	if parsed.AccountName != "accountName1" {
+		t.Fatalf("Expected AccountName to be %q but got %q", "accountName1", parsed.AccountName)
+	}
+	if parsed.DatabaseName != "databaseName1" {
+		t.Fatalf("Expected DatabaseName to be %q but got %q", "databaseName1", parsed.DatabaseName)
+	}
+	if parsed.Name != "name1" {
+		t.Fatalf("Expected Name to be %q but got %q", "name1", parsed.Name)
+	}
+
+	for _, input := range []string{"", "random", id + "/extra/segment", id[:strings.LastIndex(id, "/")]} {
+		if _, err := ParseCosmosDBGremlinGraphID(input); err == nil {
+			t.Fatalf("Expected %q not to parse", input)
+		}
+		if _, errors := ValidateCosmosDBGremlinGraphID(input, "id"); len(errors) == 0 {
+			t.Fatalf("Expected %q not to be valid", input)
+		}
+	}
+
+	if _, errors := ValidateCosmosDBGremlinGraphIDOrEmpty("", "id"); len(errors) > 0 {
+		t.Fatalf("Expected an empty value to be valid but got: %+v", errors)
+	}
+	if _, errors := ValidateCosmosDBGremlinGraphIDOrEmpty("random", "id"); len(errors) == 0 {
+		t.Fatalf("Expected %q not to be valid", "random")
+	}
+}
+
+func TestCosmosDBMongoCollectionID(t *testing.T) {
+	id := NewCosmosDBMongoCollectionID("00000000-0000-0000-0000-000000000000", "resourceGroup1", "accountName1", "databaseName1", "name1").ID()
+
+	// the ID should round-trip, regardless of the casing of the segments
+	for _, input := range []string{id, strings.ToLower(id), strings.ToUpper(id)} {
+		parsed, err := ParseCosmosDBMongoCollectionID(input)
+		if err != nil {
+			t.Fatalf("Expected %q to parse but got: %+v", input, err)
+		}
+		if !strings.EqualFold(parsed.ID(), id) {
+			t.Fatalf("Expected the ID to be %q but got %q", id, parsed.ID())
+		}
+	}
+
+	parsed, err := ParseCosmosDBMongoCollectionID(id)
+	if err != nil {
+		t.Fatalf("Expected %q to parse but got: %+v", id, err)
+	}
+	if parsed.SubscriptionID != "00000000-0000-0000-0000-000000000000" {
+		t.Fatalf("Expected SubscriptionID to be %q but got %q", "00000000-0000-0000-0000-000000000000", parsed.SubscriptionID)
+	}
+	if parsed.ResourceGroup != "resourceGroup1" {
+		t.Fatalf("Expected ResourceGroup to be %q but got %q", "resourceGroup1", parsed.ResourceGroup)
+	}
+	if parsed.AccountName != "accountName1" {
+		t.Fatalf("Expected AccountName to be %q but got %q", "accountName1", parsed.AccountName)
+	}
+	if parsed.DatabaseName != "databaseName1" {
+		t.Fatalf("Expected DatabaseName to be %q but got %q", "databaseName1", parsed.DatabaseName)
+	}
+	if parsed.Name != "name1" {
+		t.Fatalf("Expected Name to be %q but got %q", "name1", parsed.Name)
+	}
+
+	for _, input := range []string{"", "random", id + "/extra/segment", id[:strings.LastIndex(id, "/")]} {
+		if _, err := ParseCosmosDBMongoCollectionID(input); err == nil {
+			t.Fatalf("Expected %q not to parse", input)
+		}
+		if _, errors := ValidateCosmosDBMongoCollectionID(input, "id"); len(errors) == 0 {
+			t.Fatalf("Expected %q not to be valid", input)
+		}
+	}
+
+	if _, errors := ValidateCosmosDBMongoCollectionIDOrEmpty("", "id"); len(errors) > 0 {
+		t.Fatalf("Expected an empty value to be valid but got: %+v", errors)
+	}
+	if _, errors := ValidateCosmosDBMongoCollectionIDOrEmpty("random", "id"); len(errors) == 0 {
+		t.Fatalf("Expected %q not to be valid", "random")
+	}
+}
+
+func TestCosmosDBMongoDatabaseID(t *testing.T) {
+	id := NewCosmosDBMongoDatabaseID("00000000-0000-0000-0000-000000000000", "resourceGroup1", "accountName1", "name1").ID()
+
+	// the ID should round-trip, regardless of the casing of the segments
+	for _, input := range []string{id, strings.ToLower(id), strings.ToUpper(id)} {
+		parsed, err := ParseCosmosDBMongoDatabaseID(input)
+		if err != nil {
+			t.Fatalf("Expected %q to parse but got: %+v", input, err)
+		}
+		if !strings.EqualFold(parsed.ID(), id) {
+			t.Fatalf("Expected the ID to be %q but got %q", id, parsed.ID())
+		}
+	}
+
+	parsed, err := ParseCosmosDBMongoDatabaseID(id)
+	if err != nil {
+		t.Fatalf("Expected %q to parse but got: %+v", id, err)
+	}
+	if parsed.SubscriptionID != "00000000-0000-0000-0000-000000000000" {
+		t.Fatalf("Expected SubscriptionID to be %q but got %q", "00000000-0000-0000-0000-000000000000", parsed.SubscriptionID)
+	}
+	if parsed.ResourceGroup != "resourceGroup1" {
+		t.Fatalf("Expected ResourceGroup to be %q but got %q", "resourceGroup1", parsed.ResourceGroup)
+	}
+	if parsed.AccountName != "accountName1" {
+		t.Fatalf("Expected AccountName to be %q but got %q", "accountName1", parsed.AccountName)
+	}
+	if parsed.Name != "name1" {
+		t.Fatalf("Expected Name to be %q but got %q", "name1", parsed.Name)
+	}
+
+	for _, input := range []string{"", "random", id + "/extra/segment", id[:strings.LastIndex(id, "/")]} {
+		if _, err := ParseCosmosDBMongoDatabaseID(input); err == nil {
+			t.Fatalf("Expected %q not to parse", input)
+		}
+		if _, errors := ValidateCosmosDBMongoDatabaseID(input, "id"); len(errors) == 0 {
+			t.Fatalf("Expected %q not to be valid", input)
+		}
+	}
+
+	if _, errors := ValidateCosmosDBMongoDatabaseIDOrEmpty("", "id"); len(errors) > 0 {
+		t.Fatalf("Expected an empty value to be valid but got: %+v", errors)
+	}
+	if _, errors := ValidateCosmosDBMongoDatabaseIDOrEmpty("random", "id"); len(errors) == 0 {
+		t.Fatalf("Expected %q not to be valid", "random")
+	}
+}
+
+func TestCosmosDBSqlContainerID(t *testing.T) {
+	id := NewCosmosDBSqlContainerID("00000000-0000-0000-0000-000000000000", "resourceGroup1", "accountName1", "databaseName1", "name1").ID()
+
+	// the ID should round-trip, regardless of the casing of the segments
+	for _, input := range []string{id, strings.ToLower(id), strings.ToUpper(id)} {
+		parsed, err := ParseCosmosDBSqlContainerID(input)
+		if err != nil {
+			t.Fatalf("Expected %q to parse but got: %+v", input, err)
+		}
+		if !strings.EqualFold(parsed.ID(), id) {
+			t.Fatalf("Expected the ID to be %q but got %q", id, parsed.ID())
+		}
+	}
+
+	parsed, err := ParseCosmosDBSqlContainerID(id)
+	if err != nil {
+		t.Fatalf("Expected %q to parse but got: %+v", id, err)
+	}
+	if parsed.SubscriptionID != "00000000-0000-0000-0000-000000000000" {
+		t.Fatalf("Expected SubscriptionID to be %q but got %q", "00000000-0000-0000-0000-000000000000", parsed.SubscriptionID)
+	}
+	if parsed.ResourceGroup != "resourceGroup1" {
+		t.Fatalf("Expected ResourceGroup to be %q but got %q", "resourceGroup1", parsed.ResourceGroup)
+	}
+	if parsed.AccountName != "accountName1" {
+		t.Fatalf("Expected AccountName to be %q but got %q", "accountName1", parsed.AccountName)
+	}
+	if parsed.DatabaseName != "databaseName1" {
+		t.Fatalf("Expected DatabaseName to be %q but got %q", "databaseName1", parsed.DatabaseName)
+	}
+	if parsed.Name != "name1" {
+		t.Fatalf("Expected Name to be %q but got %q", "name1", parsed.Name)
+	}
+
+	for _, input := range []string{"", "random", id + "/extra/segment", id[:strings.LastIndex(id, "/")]} {
+		if _, err := ParseCosmosDBSqlContainerID(input); err == nil {
+			t.Fatalf("Expected %q not to parse", input)
+		}
+		if _, errors := ValidateCosmosDBSqlContainerID(input, "id"); len(errors) == 0 {
+			t.Fatalf("Expected %q not to be valid", input)
+		}
+	}
+
+	if _, errors := ValidateCosmosDBSqlContainerIDOrEmpty("", "id"); len(errors) > 0 {
+		t.Fatalf("Expected an empty value to be valid but got: %+v", errors)
+	}
+	if _, errors := ValidateCosmosDBSqlContainerIDOrEmpty("random", "id"); len(errors) == 0 {
+		t.Fatalf("Expected %q not to be valid", "random")
+	}
+}
+
+func TestCosmosDBSqlDatabaseID(t *testing.T) {
+	id := NewCosmosDBSqlDatabaseID("00000000-0000-0000-0000-000000000000", "resourceGroup1", "accountName1", "name1").ID()
+
+	// the ID should round-trip, regardless of the casing of the segments
+	for _, input := range []string{id, strings.ToLower(id), strings.ToUpper(id)} {
+		parsed, err := ParseCosmosDBSqlDatabaseID(input)
+		if err != nil {
+			t.Fatalf("Expected %q to parse but got: %+v", input, err)
+		}
+		if !strings.EqualFold(parsed.ID(), id) {
+			t.Fatalf("Expected the ID to be %q but got %q", id, parsed.ID())
+		}
+	}
+
+	parsed, err := ParseCosmosDBSqlDatabaseID(id)
+	if err != nil {
+		t.Fatalf("Expected %q to parse but got: %+v", id, err)
+	}
+	if parsed.SubscriptionID != "00000000-0000-0000-0000-000000000000" {
+		t.Fatalf("Expected SubscriptionID to be %q but got %q", "00000000-0000-0000-0000-000000000000", parsed.SubscriptionID)
+	}
+	if parsed.ResourceGroup != "resourceGroup1" {
+		t.Fatalf("Expected ResourceGroup to be %q but got %q", "resourceGroup1", parsed.ResourceGroup)
+	}
+	if parsed.AccountName != "accountName1" {
+		t.Fatalf("Expected AccountName to be %q but got %q", "accountName1", parsed.AccountName)
+	}
+	if parsed.Name != "name1" {
+		t.Fatalf("Expected Name to be %q but got %q", "name1", parsed.Name)
+	}
+
+	for _, input := range []string{"", "random", id + "/extra/segment", id[:strings.LastIndex(id, "/")]} {
+		if _, err := ParseCosmosDBSqlDatabaseID(input); err == nil {
+			t.Fatalf("Expected %q not to parse", input)
+		}
+		if _, errors := ValidateCosmosDBSqlDatabaseID(input, "id"); len(errors) == 0 {
+			t.Fatalf("Expected %q not to be valid", input)
+		}
+	}
+
+	if _, errors := ValidateCosmosDBSqlDatabaseIDOrEmpty("", "id"); len(errors) > 0 {
+		t.Fatalf("Expected an empty value to be valid but got: %+v", errors)
+	}
+	if _, errors := ValidateCosmosDBSqlDatabaseIDOrEmpty("random", "id"); len(errors) == 0 {
+		t.Fatalf("Expected %q not to be valid", "random")
+	}
+}
+
+func TestCosmosDBTableID(t *testing.T) {
+	id := NewCosmosDBTableID("00000000-0000-0000-0000-000000000000", "resourceGroup1", "accountName1", "name1").ID()
+
+	// the ID should round-trip, regardless of the casing of the segments
+	for _, input := range []string{id, strings.ToLower(id), strings.ToUpper(id)} {
+		parsed, err := ParseCosmosDBTableID(input)
+		if err != nil {
+			t.Fatalf("Expected %q to parse but got: %+v", input, err)
+		}
+		if !strings.EqualFold(parsed.ID(), id) {
+			t.Fatalf("Expected the ID to be %q but got %q", id, parsed.ID())
+		}
+	}
+
+	parsed, err := ParseCosmosDBTableID(id)
+	if err != nil {
+		t.Fatalf("Expected %q to parse but got: %+v", id, err)
+	}
+	if parsed.SubscriptionID != "00000000-0000-0000-0000-000000000000" {
+		t.Fatalf("Expected SubscriptionID to be %q but got %q", "00000000-0000-0000-0000-000000000000", parsed.SubscriptionID)
+	}
+	if parsed.ResourceGroup != "resourceGroup1" {
+		t.Fatalf("Expected ResourceGroup to be %q but got %q", "resourceGroup1", parsed.ResourceGroup)
+	}
+	if parsed.AccountName != "accountName1" {
+		t.Fatalf("Expected AccountName to be %q but got %q", "accountName1", parsed.AccountName)
+	}
+	if parsed.Name != "name1" {
+		t.Fatalf("Expected Name to be %q but got %q", "name1", parsed.Name)
+	}
+
+	for _, input := range []string{"", "random", id + "/extra/segment", id[:strings.LastIndex(id, "/")]} {
+		if _, err := ParseCosmosDBTableID(input); err == nil {
+			t.Fatalf("Expected %q not to parse", input)
+		}
+		if _, errors := ValidateCosmosDBTableID(input, "id"); len(errors) == 0 {
+			t.Fatalf("Expected %q not to be valid", input)
+		}
+	}
+
+	if _, errors := ValidateCosmosDBTableIDOrEmpty("", "id"); len(errors) > 0 {
+		t.Fatalf("Expected an empty value to be valid but got: %+v", errors)
+	}
+	if _, errors := ValidateCosmosDBTableIDOrEmpty("random", "id"); len(errors) == 0 {
+		t.Fatalf("Expected %q not to be valid", "random")
+	}
+}
+
+func TestDnsZoneID(t *testing.T) {
+	id := NewDnsZoneID("00000000-0000-0000-0000-000000000000", "resourceGroup1", "name1").ID()
+
+	// the ID should round-trip, regardless of the casing of the segments
+	for _, input := range []string{id, strings.ToLower(id), strings.ToUpper(id)} {
+		parsed, err := ParseDnsZoneID(input)
+		if err != nil {
+			t.Fatalf("Expected %q to parse but got: %+v", input, err)
+		}
+		if !strings.EqualFold(parsed.ID(), id) {
+			t.Fatalf("Expected the ID to be %q but got %q", id, parsed.ID())
+		}
+	}
+
+	parsed, err := ParseDnsZoneID(id)
+	if err != nil {
+		t.Fatalf("Expected %q to parse but got: %+v", id, err)
+	}
+	if parsed.SubscriptionID != "00000000-0000-0000-0000-000000000000" {
+		t.Fatalf("Expected SubscriptionID to be %q but got %q", "00000000-0000-0000-0000-000000000000", parsed.SubscriptionID)
+	}
+	if parsed.ResourceGroup != "resourceGroup1" {
+		t.Fatalf("Expected ResourceGroup to be %q but got %q", "resourceGroup1", parsed.ResourceGroup)
+	}
+	if parsed.Name != "name1" {
+		t.Fatalf("Expected Name to be %q but got %q", "name1", parsed.Name)
+	}
+
+	for _, input := range []string{"", "random", id + "/extra/segment", id[:strings.LastIndex(id, "/")]} {
+		if _, err := ParseDnsZoneID(input); err == nil {
+			t.Fatalf("Expected %q not to parse", input)
+		}
+		if _, errors := ValidateDnsZoneID(input, "id"); len(errors) == 0 {
+			t.Fatalf("Expected %q not to be valid", input)
+		}
+	}
+
+	if _, errors := ValidateDnsZoneIDOrEmpty("", "id"); len(errors) > 0 {
+		t.Fatalf("Expected an empty value to be valid but got: %+v", errors)
+	}
+	if _, errors := ValidateDnsZoneIDOrEmpty("random", "id"); len(errors) == 0 {
+		t.Fatalf("Expected %q not to be valid", "random")
+	}
+}
+
+func TestEventHubID(t *testing.T) {
+	id := NewEventHubID("00000000-0000-0000-0000-000000000000", "resourceGroup1", "namespaceName1", "name1").ID()
+
+	// the ID should round-trip, regardless of the casing of the segments
+	for _, input := range []string{id, strings.ToLower(id), strings.ToUpper(id)} {
+		parsed, err := ParseEventHubID(input)
+		if err != nil {
+			t.Fatalf("Expected %q to parse but got: %+v", input, err)
+		}
+		if !strings.EqualFold(parsed.ID(), id) {
+			t.Fatalf("Expected the ID to be %q but got %q", id, parsed.ID())
+		}
+	}
+
+	parsed, err := ParseEventHubID(id)
+	if err != nil {
+		t.Fatalf("Expected %q to parse but got: %+v", id, err)
+	}
+	if parsed.SubscriptionID != "00000000-0000-0000-0000-000000000000" {
+		t.Fatalf("Expected SubscriptionID to be %q but got %q", "00000000-0000-0000-0000-000000000000", parsed.SubscriptionID)
+	}
+	if parsed.ResourceGroup != "resourceGroup1" {
+		t.Fatalf("Expected ResourceGroup to be %q but got %q", "resourceGroup1", parsed.ResourceGroup)
+	}
+	if parsed.NamespaceName != "namespaceName1" {
+		t.Fatalf("Expected NamespaceName to be %q but got %q", "namespaceName1", parsed.NamespaceName)
+	}
+	if parsed.Name != "name1" {
+		t.Fatalf("Expected Name to be %q but got %q", "name1", parsed.Name)
+	}
+
+	for _, input := range []string{"", "random", id + "/extra/segment", id[:strings.LastIndex(id, "/")]} {
+		if _, err := ParseEventHubID(input); err == nil {
+			t.Fatalf("Expected %q not to parse", input)
+		}
+		if _, errors := ValidateEventHubID(input, "id"); len(errors) == 0 {
+			t.Fatalf("Expected %q not to be valid", input)
+		}
+	}
+
+	if _, errors := ValidateEventHubIDOrEmpty("", "id"); len(errors) > 0 {
+		t.Fatalf("Expected an empty value to be valid but got: %+v", errors)
+	}
+	if _, errors := ValidateEventHubIDOrEmpty("random", "id"); len(errors) == 0 {
+		t.Fatalf("Expected %q not to be valid", "random")
+	}
+}
+
+func TestEventHubNamespaceID(t *testing.T) {
+	id := NewEventHubNamespaceID("00000000-0000-0000-0000-000000000000", "resourceGroup1", "name1").ID()
+
+	// the ID should round-trip, regardless of the casing of the segments
+	for _, input := range []string{id, strings.ToLower(id), strings.ToUpper(id)} {
+		parsed, err := ParseEventHubNamespaceID(input)
+		if err != nil {
+			t.Fatalf("Expected %q to parse but got: %+v", input, err)
+		}
+		if !strings.EqualFold(parsed.ID(), id) {
+			t.Fatalf("Expected the ID to be %q but got %q", id, parsed.ID())
+		}
+	}
+
+	parsed, err := ParseEventHubNamespaceID(id)
+	if err != nil {
+		t.Fatalf("Expected %q to parse but got: %+v", id, err)
+	}
+	if parsed.SubscriptionID != "00000000-0000-0000-0000-000000000000" {
+		t.Fatalf("Expected SubscriptionID to be %q but got %q", "00000000-0000-0000-0000-000000000000", parsed.SubscriptionID)
+	}
+	if parsed.ResourceGroup != "resourceGroup1" {
+		t.Fatalf("Expected ResourceGroup to be %q but got %q", "resourceGroup1", parsed.ResourceGroup)
+	}
+	if parsed.Name != "name1" {
+		t.Fatalf("Expected Name to be %q but got %q", "name1", parsed.Name)
+	}
+
+	for _, input := range []string{"", "random", id + "/extra/segment", id[:strings.LastIndex(id, "/")]} {
+		if _, err := ParseEventHubNamespaceID(input); err == nil {
+			t.Fatalf("Expected %q not to parse", input)
+		}
+		if _, errors := ValidateEventHubNamespaceID(input, "id"); len(errors) == 0 {
+			t.Fatalf("Expected %q not to be valid", input)
+		}
+	}
+
+	if _, errors := ValidateEventHubNamespaceIDOrEmpty("", "id"); len(errors) > 0 {
+		t.Fatalf("Expected an empty value to be valid but got: %+v", errors)
+	}
+	if _, errors := ValidateEventHubNamespaceIDOrEmpty("random", "id"); len(errors) == 0 {
+		t.Fatalf("Expected %q not to be valid", "random")
+	}
+}
+
+func TestEventHubNamespaceAuthorizationRuleID(t *testing.T) {
+	id := NewEventHubNamespaceAuthorizationRuleID("00000000-0000-0000-0000-000000000000", "resourceGroup1", "namespaceName1", "name1").ID()
+
+	// the ID should round-trip, regardless of the casing of the segments
+	for _, input := range []string{id, strings.ToLower(id), strings.ToUpper(id)} {
+		parsed, err := ParseEventHubNamespaceAuthorizationRuleID(input)
+		if err != nil {
+			t.Fatalf("Expected %q to parse but got: %+v", input, err)
+		}
+		if !strings.EqualFold(parsed.ID(), id) {
+			t.Fatalf("Expected the ID to be %q but got %q", id, parsed.ID())
+		}
+	}
+
+	parsed, err := ParseEventHubNamespaceAuthorizationRuleID(id)
+	if err != nil {
+		t.Fatalf("Expected %q to parse but got: %+v", id, err)
+	}
+	if parsed.SubscriptionID != "00000000-0000-0000-0000-000000000000" {
+		t.Fatalf("Expected SubscriptionID to be %q but got %q", "00000000-0000-0000-0000-000000000000", parsed.SubscriptionID)
+	}
+	if parsed.ResourceGroup != "resourceGroup1" {
+		t.Fatalf("Expected ResourceGroup to be %q but got %q", "resourceGroup1", parsed.ResourceGroup)
+	}
+	if parsed.NamespaceName != "namespaceName1" {
+		t.Fatalf("Expected NamespaceName to be %q but got %q", "namespaceName1", parsed.NamespaceName)
+	}
+	if parsed.Name != "name1" {
+		t.Fatalf("Expected Name to be %q but got %q", "name1", parsed.Name)
+	}
+
+	for _, input := range []string{"", "random", id + "/extra/segment", id[:strings.LastIndex(id, "/")]} {
+		if _, err := ParseEventHubNamespaceAuthorizationRuleID(input); err == nil {
+			t.Fatalf("Expected %q not to parse", input)
+		}
+		if _, errors := ValidateEventHubNamespaceAuthorizationRuleID(input, "id"); len(errors) == 0 {
+			t.Fatalf("Expected %q not to be valid", input)
+		}
+	}
+
+	if _, errors := ValidateEventHubNamespaceAuthorizationRuleIDOrEmpty("", "id"); len(errors) > 0 {
+		t.Fatalf("Expected an empty value to be valid but got: %+v", errors)
+	}
+	if _, errors := ValidateEventHubNamespaceAuthorizationRuleIDOrEmpty("random", "id"); len(errors) == 0 {
+		t.Fatalf("Expected %q not to be valid", "random")
+	}
+}
+
+func TestExpressRouteCircuitID(t *testing.T) {
+	id := NewExpressRouteCircuitID("00000000-0000-0000-0000-000000000000", "resourceGroup1", "name1").ID()
+
+	// the ID should round-trip, regardless of the casing of the segments
+	for _, input := range []string{id, strings.ToLower(id), strings.ToUpper(id)} {
+		parsed, err := ParseExpressRouteCircuitID(input)
+		if err != nil {
+			t.Fatalf("Expected %q to parse but got: %+v", input, err)
+		}
+		if !strings.EqualFold(parsed.ID(), id) {
+			t.Fatalf("Expected the ID to be %q but got %q", id, parsed.ID())
+		}
+	}
+
+	parsed, err := ParseExpressRouteCircuitID(id)
+	if err != nil {
+		t.Fatalf("Expected %q to parse but got: %+v", id, err)
+	}
+	if parsed.SubscriptionID != "00000000-0000-0000-0000-000000000000" {
+		t.Fatalf("Expected SubscriptionID to be %q but got %q", "00000000-0000-0000-0000-000000000000", parsed.SubscriptionID)
+	}
+	if parsed.ResourceGroup != "resourceGroup1" {
+		t.Fatalf("Expected ResourceGroup to be %q but got %q", "resourceGroup1", parsed.ResourceGroup)
+	}
+	if parsed.Name != "name1" {
+		t.Fatalf("Expected Name to be %q but got %q", "name1", parsed.Name)
+	}
+
+	for _, input := range []string{"", "random", id + "/extra/segment", id[:strings.LastIndex(id, "/")]} {
+		if _, err := ParseExpressRouteCircuitID(input); err == nil {
+			t.Fatalf("Expected %q not to parse", input)
+		}
+		if _, errors := ValidateExpressRouteCircuitID(input, "id"); len(errors) == 0 {
+			t.Fatalf("Expected %q not to be valid", input)
+		}
+	}
+
+	if _, errors := ValidateExpressRouteCircuitIDOrEmpty("", "id"); len(errors) > 0 {
+		t.Fatalf("Expected an empty value to be valid but got: %+v", errors)
+	}
+	if _, errors := ValidateExpressRouteCircuitIDOrEmpty("random", "id"); len(errors) == 0 {
+		t.Fatalf("Expected %q not to be valid", "random")
+	}
+}
+
+func TestFirewallID(t *testing.T) {
+	id := NewFirewallID("00000000-0000-0000-0000-000000000000", "resourceGroup1", "name1").ID()
+
+	// the ID should round-trip, regardless of the casing of the segments
+	for _, input := range []string{id, strings.ToLower(id), strings.ToUpper(id)} {
+		parsed, err := ParseFirewallID(input)
+		if err != nil {
+			t.Fatalf("Expected %q to parse but got: %+v", input, err)
+		}
+		if !strings.EqualFold(parsed.ID(), id) {
+			t.Fatalf("Expected the ID to be %q but got %q", id, parsed.ID())
+		}
+	}
+
+	parsed, err := ParseFirewallID(id)
+	if err != nil {
+		t.Fatalf("Expected %q to parse but got: %+v", id, err)
+	}
+	if parsed.SubscriptionID != "00000000-0000-0000-0000-000000000000" {
+		t.Fatalf("Expected SubscriptionID to be %q but got %q", "00000000-0000-0000-0000-000000000000", parsed.SubscriptionID)
+	}
+	if parsed.ResourceGroup != "resourceGroup1" {
+		t.Fatalf("Expected ResourceGroup to be %q but got %q", "resourceGroup1", parsed.ResourceGroup)
+	}
+	if parsed.Name != "name1" {
+		t.Fatalf("Expected Name to be %q but got %q", "name1", parsed.Name)
+	}
+
+	for _, input := range []string{"", "random", id + "/extra/segment", id[:strings.LastIndex(id, "/")]} {
+		if _, err := ParseFirewallID(input); err == nil {
+			t.Fatalf("Expected %q not to parse", input)
+		}
+		if _, errors := ValidateFirewallID(input, "id"); len(errors) == 0 {
+			t.Fatalf("Expected %q not to be valid", input)
+		}
+	}
+
+	if _, errors := ValidateFirewallIDOrEmpty("", "id"); len(errors) > 0 {
+		t.Fatalf("Expected an empty value to be valid but got: %+v", errors)
+	}
+	if _, errors := ValidateFirewallIDOrEmpty("random", "id"); len(errors) == 0 {
+		t.Fatalf("Expected %q not to be valid", "random")
+	}
+}
+
+func TestImageID(t *testing.T) {
+	id := NewImageID("00000000-0000-0000-0000-000000000000", "resourceGroup1", "name1").ID()
+
+	// the ID should round-trip, regardless of the casing of the segments
+	for _, input := range []string{id, strings.ToLower(id), strings.ToUpper(id)} {
+		parsed, err := ParseImageID(input)
+		if err != nil {
+			t.Fatalf("Expected %q to parse but got: %+v", input, err)
+		}
+		if !strings.EqualFold(parsed.ID(), id) {
+			t.Fatalf("Expected the ID to be %q but got %q", id, parsed.ID())
+		}
+	}
+
+	parsed, err := ParseImageID(id)
+	if err != nil {
+		t.Fatalf("Expected %q to parse but got: %+v", id, err)
+	}
+	if parsed.SubscriptionID != "00000000-0000-0000-0000-000000000000" {
+		t.Fatalf("Expected SubscriptionID to be %q but got %q", "00000000-0000-0000-0000-000000000000", parsed.SubscriptionID)
+	}
+	if parsed.ResourceGroup != "resourceGroup1" {
+		t.Fatalf("Expected ResourceGroup to be %q but got %q", "resourceGroup1", parsed.ResourceGroup)
+	}
+	if parsed.Name != "name1" {
+		t.Fatalf("Expected Name to be %q but got %q", "name1", parsed.Name)
+	}
+
+	for _, input := range []string{"", "random", id + "/extra/segment", id[:strings.LastIndex(id, "/")]} {
+		if _, err := ParseImageID(input); err == nil {
+			t.Fatalf("Expected %q not to parse", input)
+		}
+		if _, errors := ValidateImageID(input, "id"); len(errors) == 0 {
+			t.Fatalf("Expected %q not to be valid", input)
+		}
+	}
+
+	if _, errors := ValidateImageIDOrEmpty("", "id"); len(errors) > 0 {
+		t.Fatalf("Expected an empty value to be valid but got: %+v", errors)
+	}
+	if _, errors := ValidateImageIDOrEmpty("random", "id"); len(errors) == 0 {
+		t.Fatalf("Expected %q not to be valid", "random")
+	}
+}
+
+func TestKeyVaultID(t *testing.T) {
+	id := NewKeyVaultID("00000000-0000-0000-0000-000000000000", "resourceGroup1", "name1").ID()
+
+	// the ID should round-trip, regardless of the casing of the segments
+	for _, input := range []string{id, strings.ToLower(id), strings.ToUpper(id)} {
+		parsed, err := ParseKeyVaultID(input)
+		if err != nil {
+			t.Fatalf("Expected %q to parse but got: %+v", input, err)
+		}
+		if !strings.EqualFold(parsed.ID(), id) {
+			t.Fatalf("Expected the ID to be %q but got %q", id, parsed.ID())
+		}
+	}
+
+	parsed, err := ParseKeyVaultID(id)
+	if err != nil {
+		t.Fatalf("Expected %q to parse but got: %+v", id, err)
+	}
+	if parsed.SubscriptionID != "00000000-0000-0000-0000-000000000000" {
+		t.Fatalf("Expected SubscriptionID to be %q but got %q", "00000000-0000-0000-0000-000000000000", parsed.SubscriptionID)
+	}
+	if parsed.ResourceGroup != "resourceGroup1" {
+		t.Fatalf("Expected ResourceGroup to be %q but got %q", "resourceGroup1", parsed.ResourceGroup)
+	}
+	if parsed.Name != "name1" {
+		t.Fatalf("Expected Name to be %q but got %q", "name1", parsed.Name)
+	}
+
+	for _, input := range []string{"", "random", id + "/extra/segment", id[:strings.LastIndex(id, "/")]} {
+		if _, err := ParseKeyVaultID(input); err == nil {
+			t.Fatalf("Expected %q not to parse", input)
+		}
+		if _, errors := ValidateKeyVaultID(input, "id"); len(errors) == 0 {
+			t.Fatalf("Expected %q not to be valid", input)
+		}
+	}
+
+	if _, errors := ValidateKeyVaultIDOrEmpty("", "id"); len(errors) > 0 {
+		t.Fatalf("Expected an empty value to be valid but got: %+v", errors)
+	}
+	if _, errors := ValidateKeyVaultIDOrEmpty("random", "id"); len(errors) == 0 {
+		t.Fatalf("Expected %q not to be valid", "random")
+	}
+}
+
+func TestKubernetesClusterID(t *testing.T) {
+	id := NewKubernetesClusterID("00000000-0000-0000-0000-000000000000", "resourceGroup1", "name1").ID()
+
+	// the ID should round-trip, regardless of the casing of the segments
+	for _, input := range []string{id, strings.ToLower(id), strings.ToUpper(id)} {
+		parsed, err := ParseKubernetesClusterID(input)
+		if err != nil {
+			t.Fatalf("Expected %q to parse but got: %+v", input, err)
+		}
+		if !strings.EqualFold(parsed.ID(), id) {
+			t.Fatalf("Expected the ID to be %q but got %q", id, parsed.ID())
+		}
+	}
+
+	parsed, err := ParseKubernetesClusterID(id)
+	if err != nil {
+		t.Fatalf("Expected %q to parse but got: %+v", id, err)
+	}
+	if parsed.SubscriptionID != "00000000-0000-0000-0000-000000000000" {
+		t.Fatalf("Expected SubscriptionID to be %q but got %q", "00000000-0000-0000-0000-000000000000", parsed.SubscriptionID)
+	}
+	if parsed.ResourceGroup != "resourceGroup1" {
+		t.Fatalf("Expected ResourceGroup to be %q but got %q", "resourceGroup1", parsed.ResourceGroup)
+	}
+	if parsed.Name != "name1" {
+		t.Fatalf("Expected Name to be %q but got %q", "name1", parsed.Name)
+	}
+
+	for _, input := range []string{"", "random", id + "/extra/segment", id[:strings.LastIndex(id, "/")]} {
+		if _, err := ParseKubernetesClusterID(input); err == nil {
+			t.Fatalf("Expected %q not to parse", input)
+		}
+		if _, errors := ValidateKubernetesClusterID(input, "id"); len(errors) == 0 {
+			t.Fatalf("Expected %q not to be valid", input)
+		}
+	}
+
+	if _, errors := ValidateKubernetesClusterIDOrEmpty("", "id"); len(errors) > 0 {
+		t.Fatalf("Expected an empty value to be valid but got: %+v", errors)
+	}
+	if _, errors := ValidateKubernetesClusterIDOrEmpty("random", "id"); len(errors) == 0 {
+		t.Fatalf("Expected %q not to be valid", "random")
+	}
+}
+
+func TestKubernetesClusterNodePoolID(t *testing.T) {
+	id := NewKubernetesClusterNodePoolID("00000000-0000-0000-0000-000000000000", "resourceGroup1", "clusterName1", "name1").ID()
+
+	// the ID should round-trip, regardless of the casing of the segments
+	for _, input := range []string{id, strings.ToLower(id), strings.ToUpper(id)} {
+		parsed, err := ParseKubernetesClusterNodePoolID(input)
+		if err != nil {
+			t.Fatalf("Expected %q to parse but got: %+v", input, err)
+		}
+		if !strings.EqualFold(parsed.ID(), id) {
+			t.Fatalf("Expected the ID to be %q but got %q", id, parsed.ID())
+		}
+	}
+
+	parsed, err := ParseKubernetesClusterNodePoolID(id)
+	if err != nil {
+		t.Fatalf("Expected %q to parse but got: %+v", id, err)
+	}
+	if parsed.SubscriptionID != "00000000-0000-0000-0000-000000000000" {
+		t.Fatalf("Expected SubscriptionID to be %q but got %q", "00000000-0000-0000-0000-000000000000", parsed.SubscriptionID)
+	}
+	if parsed.ResourceGroup != "resourceGroup1" {
+		t.Fatalf("Expected ResourceGroup to be %q but got %q", "resourceGroup1", parsed.ResourceGroup)
+	}
+	if parsed.ClusterName != "clusterName1" {
+		t.Fatalf("Expected ClusterName to be %q but got %q", "clusterName1", parsed.ClusterName)
+	}
+	if parsed.Name != "name1" {
+		t.Fatalf("Expected Name to be %q but got %q", "name1", parsed.Name)
+	}
+
+	for _, input := range []string{"", "random", id + "/extra/segment", id[:strings.LastIndex(id, "/")]} {
+		if _, err := ParseKubernetesClusterNodePoolID(input); err == nil {
+			t.Fatalf("Expected %q not to parse", input)
+		}
+		if _, errors := ValidateKubernetesClusterNodePoolID(input, "id"); len(errors) == 0 {
+			t.Fatalf("Expected %q not to be valid", input)
+		}
+	}
+
+	if _, errors := ValidateKubernetesClusterNodePoolIDOrEmpty("", "id"); len(errors) > 0 {
+		t.Fatalf("Expected an empty value to be valid but got: %+v", errors)
+	}
+	if _, errors := ValidateKubernetesClusterNodePoolIDOrEmpty("random", "id"); len(errors) == 0 {
+		t.Fatalf("Expected %q not to be valid", "random")
+	}
+}
+
+func TestLoadBalancerID(t *testing.T) {
+	id := NewLoadBalancerID("00000000-0000-0000-0000-000000000000", "resourceGroup1", "name1").ID()
+
+	// the ID should round-trip, regardless of the casing of the segments
+	for _, input := range []string{id, strings.ToLower(id), strings.ToUpper(id)} {
+		parsed, err := ParseLoadBalancerID(input)
+		if err != nil {
+			t.Fatalf("Expected %q to parse but got: %+v", input, err)
+		}
+		if !strings.EqualFold(parsed.ID(), id) {
+			t.Fatalf("Expected the ID to be %q but got %q", id, parsed.ID())
+		}
+	}
+
+	parsed, err := ParseLoadBalancerID(id)
+	if err != nil {
+		t.Fatalf("Expected %q to parse but got: %+v", id, err)
+	}
+	if parsed.SubscriptionID != "00000000-0000-0000-0000-000000000000" {
+		t.Fatalf("Expected SubscriptionID to be %q but got %q", "00000000-0000-0000-0000-000000000000", parsed.SubscriptionID)
+	}
+	if parsed.ResourceGroup != "resourceGroup1" {
+		t.Fatalf("Expected ResourceGroup to be %q but got %q", "resourceGroup1", parsed.ResourceGroup)
+	}
+	if parsed.Name != "name1" {
+		t.Fatalf("Expected Name to be %q but got %q", "name1", parsed.Name)
+	}
+
+	for _, input := range []string{"", "random", id + "/extra/segment", id[:strings.LastIndex(id, "/")]} {
+		if _, err := ParseLoadBalancerID(input); err == nil {
+			t.Fatalf("Expected %q not to parse", input)
+		}
+		if _, errors := ValidateLoadBalancerID(input, "id"); len(errors) == 0 {
+			t.Fatalf("Expected %q not to be valid", input)
+		}
+	}
+
+	if _, errors := ValidateLoadBalancerIDOrEmpty("", "id"); len(errors) > 0 {
+		t.Fatalf("Expected an empty value to be valid but got: %+v", errors)
+	}
+	if _, errors := ValidateLoadBalancerIDOrEmpty("random", "id"); len(errors) == 0 {
+		t.Fatalf("Expected %q not to be valid", "random")
+	}
+}
+
+func TestLoadBalancerBackendAddressPoolID(t *testing.T) {
+	id := NewLoadBalancerBackendAddressPoolID("00000000-0000-0000-0000-000000000000", "resourceGroup1", "loadBalancerName1", "name1").ID()
+
+	// the ID should round-trip, regardless of the casing of the segments
+	for _, input := range []string{id, strings.ToLower(id), strings.ToUpper(id)} {
+		parsed, err := ParseLoadBalancerBackendAddressPoolID(input)
+		if err != nil {
+			t.Fatalf("Expected %q to parse but got: %+v", input, err)
+		}
+		if !strings.EqualFold(parsed.ID(), id) {
+			t.Fatalf("Expected the ID to be %q but got %q", id, parsed.ID())
+		}
+	}
+
+	parsed, err := ParseLoadBalancerBackendAddressPoolID(id)
+	if err != nil {
+		t.Fatalf("Expected %q to parse but got: %+v", id, err)
+	}
+	if parsed.SubscriptionID != "00000000-0000-0000-0000-000000000000" {
+		t.Fatalf("Expected SubscriptionID to be %q but got %q", "00000000-0000-0000-0000-000000000000", parsed.SubscriptionID)
+	}
+	if parsed.ResourceGroup != "resourceGroup1" {
+		t.Fatalf("Expected ResourceGroup to be %q but got %q", "resourceGroup1", parsed.ResourceGroup)
+	}
+	if parsed.LoadBalancerName != "loadBalancerName1" {
+		t.Fatalf("Expected LoadBalancerName to be %q but got %q", "loadBalancerName1", parsed.LoadBalancerName)
+	}
+	if parsed.Name != "name1" {
+		t.Fatalf("Expected Name to be %q but got %q", "name1", parsed.Name)
+	}
+
+	for _, input := range []string{"", "random", id + "/extra/segment", id[:strings.LastIndex(id, "/")]} {
+		if _, err := ParseLoadBalancerBackendAddressPoolID(input); err == nil {
+			t.Fatalf("Expected %q not to parse", input)
+		}
+		if _, errors := ValidateLoadBalancerBackendAddressPoolID(input, "id"); len(errors) == 0 {
+			t.Fatalf("Expected %q not to be valid", input)
+		}
+	}
+
+	if _, errors := ValidateLoadBalancerBackendAddressPoolIDOrEmpty("", "id"); len(errors) > 0 {
+		t.Fatalf("Expected an empty value to be valid but got: %+v", errors)
+	}
+	if _, errors := ValidateLoadBalancerBackendAddressPoolIDOrEmpty("random", "id"); len(errors) == 0 {
+		t.Fatalf("Expected %q not to be valid", "random")
+	}
+}
+
+func TestLoadBalancerInboundNatRuleID(t *testing.T) {
+	id := NewLoadBalancerInboundNatRuleID("00000000-0000-0000-0000-000000000000", "resourceGroup1", "loadBalancerName1", "name1").ID()
+
+	// the ID should round-trip, regardless of the casing of the segments
+	for _, input := range []string{id, strings.ToLower(id), strings.ToUpper(id)} {
+		parsed, err := ParseLoadBalancerInboundNatRuleID(input)
+		if err != nil {
+			t.Fatalf("Expected %q to parse but got: %+v", input, err)
+		}
+		if !strings.EqualFold(parsed.ID(), id) {
+			t.Fatalf("Expected the ID to be %q but got %q", id, parsed.ID())
+		}
+	}
+
+	parsed, err := ParseLoadBalancerInboundNatRuleID(id)
+	if err != nil {
+		t.Fatalf("Expected %q to parse but got: %+v", id, err)
+	}
+	if parsed.SubscriptionID != "00000000-0000-0000-0000-000000000000" {
+		t.Fatalf("Expected SubscriptionID to be %q but got %q", "00000000-0000-0000-0000-000000000000", parsed.SubscriptionID)
+	}
+	if parsed.ResourceGroup != "resourceGroup1" {
+		t.Fatalf("Expected ResourceGroup to be %q but got %q", "resourceGroup1", parsed.ResourceGroup)
+	}
+	if parsed.LoadBalancerName != "loadBalancerName1" {
+		t.Fatalf("Expected LoadBalancerName to be %q but got %q", "loadBalancerName1", parsed.LoadBalancerName)
+	}
+	if parsed.Name != "name1" {
+		t.Fatalf("Expected Name to be %q but got %q", "name1", parsed.Name)
+	}
+
+	for _, input := range []string{"", "random", id + "/extra/segment", id[:strings.LastIndex(id, "/")]} {
+		if _, err := ParseLoadBalancerInboundNatRuleID(input); err == nil {
+			t.Fatalf("Expected %q not to parse", input)
+		}
+		if _, errors := ValidateLoadBalancerInboundNatRuleID(input, "id"); len(errors) == 0 {
+			t.Fatalf("Expected %q not to be valid", input)
+		}
+	}
+
+	if _, errors := ValidateLoadBalancerInboundNatRuleIDOrEmpty("", "id"); len(errors) > 0 {
+		t.Fatalf("Expected an empty value to be valid but got: %+v", errors)
+	}
+	if _, errors := ValidateLoadBalancerInboundNatRuleIDOrEmpty("random", "id"); len(errors) == 0 {
+		t.Fatalf("Expected %q not to be valid", "random")
+	}
+}
+
+func TestLoadBalancerProbeID(t *testing.T) {
+	id := NewLoadBalancerProbeID("00000000-0000-0000-0000-000000000000", "resourceGroup1", "loadBalancerName1", "name1").ID()
+
+	// the ID should round-trip, regardless of the casing of the segments
+	for _, input := range []string{id, strings.ToLower(id), strings.ToUpper(id)} {
+		parsed, err := ParseLoadBalancerProbeID(input)
+		if err != nil {
+			t.Fatalf("Expected %q to parse but got: %+v", input, err)
+		}
+		if !strings.EqualFold(parsed.ID(), id) {
+			t.Fatalf("Expected the ID to be %q but got %q", id, parsed.ID())
+		}
+	}
+
+	parsed, err := ParseLoadBalancerProbeID(id)
+	if err != nil {
+		t.Fatalf("Expected %q to parse but got: %+v", id, err)
+	}
+	if parsed.SubscriptionID != "00000000-0000-0000-0000-000000000000" {
+		t.Fatalf("Expected SubscriptionID to be %q but got %q", "00000000-0000-0000-0000-000000000000", parsed.SubscriptionID)
+	}
+	if parsed.ResourceGroup != "resourceGroup1" {
+		t.Fatalf("Expected ResourceGroup to be %q but got %q", "resourceGroup1", parsed.ResourceGroup)
+	}
+	if parsed.LoadBalancerName != "loadBalancerName1" {
+		t.Fatalf("Expected LoadBalancerName to be %q but got %q", "loadBalancerName1", parsed.LoadBalancerName)
+	}
+	if parsed.Name != "name1" {
+		t.Fatalf("Expected Name to be %q but got %q", "name1", parsed.Name)
+	}
+
+	for _, input := range []string{"", "random", id + "/extra/segment", id[:strings.LastIndex(id, "/")]} {
+		if _, err := ParseLoadBalancerProbeID(input); err == nil {
+			t.Fatalf("Expected %q not to parse", input)
+		}
+		if _, errors := ValidateLoadBalancerProbeID(input, "id"); len(errors) == 0 {
+			t.Fatalf("Expected %q not to be valid", input)
+		}
+	}
+
+	if _, errors := ValidateLoadBalancerProbeIDOrEmpty("", "id"); len(errors) > 0 {
+		t.Fatalf("Expected an empty value to be valid but got: %+v", errors)
+	}
+	if _, errors := ValidateLoadBalancerProbeIDOrEmpty("random", "id"); len(errors) == 0 {
+		t.Fatalf("Expected %q not to be valid", "random")
+	}
+}
+
+func TestLocalNetworkGatewayID(t *testing.T) {
+	id := NewLocalNetworkGatewayID("00000000-0000-0000-0000-000000000000", "resourceGroup1", "name1").ID()
+
+	// the ID should round-trip, regardless of the casing of the segments
+	for _, input := range []string{id, strings.ToLower(id), strings.ToUpper(id)} {
+		parsed, err := ParseLocalNetworkGatewayID(input)
+		if err != nil {
+			t.Fatalf("Expected %q to parse but got: %+v", input, err)
+		}
+		if !strings.EqualFold(parsed.ID(), id) {
+			t.Fatalf("Expected the ID to be %q but got %q", id, parsed.ID())
+		}
+	}
+
+	parsed, err := ParseLocalNetworkGatewayID(id)
+	if err != nil {
+		t.Fatalf("Expected %q to parse but got: %+v", id, err)
+	}
+	if parsed.SubscriptionID != "00000000-0000-0000-0000-000000000000" {
+		t.Fatalf("Expected SubscriptionID to be %q but got %q", "00000000-0000-0000-0000-000000000000", parsed.SubscriptionID)
+	}
+	if parsed.ResourceGroup != "resourceGroup1" {
+		t.Fatalf("Expected ResourceGroup to be %q but got %q", "resourceGroup1", parsed.ResourceGroup)
+	}
+	if parsed.Name != "name1" {
+		t.Fatalf("Expected Name to be %q but got %q", "name1", parsed.Name)
+	}
+
+	for _, input := range []string{"", "random", id + "/extra/segment", id[:strings.LastIndex(id, "/")]} {
+		if _, err := ParseLocalNetworkGatewayID(input); err == nil {
+			t.Fatalf("Expected %q not to parse", input)
+		}
+		if _, errors := ValidateLocalNetworkGatewayID(input, "id"); len(errors) == 0 {
+			t.Fatalf("Expected %q not to be valid", input)
+		}
+	}
+
+	if _, errors := ValidateLocalNetworkGatewayIDOrEmpty("", "id"); len(errors) > 0 {
+		t.Fatalf("Expected an empty value to be valid but got: %+v", errors)
+	}
+	if _, errors := ValidateLocalNetworkGatewayIDOrEmpty("random", "id"); len(errors) == 0 {
+		t.Fatalf("Expected %q not to be valid", "random")
+	}
+}
+
+func TestLogAnalyticsWorkspaceID(t *testing.T) {
+	id := NewLogAnalyticsWorkspaceID("00000000-0000-0000-0000-000000000000", "resourceGroup1", "name1").ID()
+
+	// the ID should round-trip, regardless of the casing of the segments
+	for _, input := range []string{id, strings.ToLower(id), strings.ToUpper(id)} {
+		parsed, err := ParseLogAnalyticsWorkspaceID(input)
+		if err != nil {
+			t.Fatalf("Expected %q to parse but got: %+v", input, err)
+		}
+		if !strings.EqualFold(parsed.ID(), id) {
+			t.Fatalf("Expected the ID to be %q but got %q", id, parsed.ID())
+		}
+	}
+
+	parsed, err := ParseLogAnalyticsWorkspaceID(id)
+	if err != nil {
+		t.Fatalf("Expected %q to parse but got: %+v", id, err)
+	}
+	if parsed.SubscriptionID != "00000000-0000-0000-0000-000000000000" {
+		t.Fatalf("Expected SubscriptionID to be %q but got %q", "00000000-0000-0000-0000-000000000000", parsed.SubscriptionID)
+	}
+	if parsed.ResourceGroup != "resourceGroup1" {
+		t.Fatalf("Expected ResourceGroup to be %q but got %q", "resourceGroup1", parsed.ResourceGroup)
+	}
+	if parsed.Name != "name1" {
+		t.Fatalf("Expected Name to be %q but got %q", "name1", parsed.Name)
+	}
+
+	for _, input := range []string{"", "random", id + "/extra/segment", id[:strings.LastIndex(id, "/")]} {
+		if _, err := ParseLogAnalyticsWorkspaceID(input); err == nil {
+			t.Fatalf("Expected %q not to parse", input)
+		}
+		if _, errors := ValidateLogAnalyticsWorkspaceID(input, "id"); len(errors) == 0 {
+			t.Fatalf("Expected %q not to be valid", input)
+		}
+	}
+
+	if _, errors := ValidateLogAnalyticsWorkspaceIDOrEmpty("", "id"); len(errors) > 0 {
+		t.Fatalf("Expected an empty value to be valid but got: %+v", errors)
+	}
+	if _, errors := ValidateLogAnalyticsWorkspaceIDOrEmpty("random", "id"); len(errors) == 0 {
+		t.Fatalf("Expected %q not to be valid", "random")
+	}
+}
+
+func TestLogicAppID(t *testing.T) {
+	id := NewLogicAppID("00000000-0000-0000-0000-000000000000", "resourceGroup1", "name1").ID()
+
+	// the ID should round-trip, regardless of the casing of the segments
+	for _, input := range []string{id, strings.ToLower(id), strings.ToUpper(id)} {
+		parsed, err := ParseLogicAppID(input)
+		if err != nil {
+			t.Fatalf("Expected %q to parse but got: %+v", input, err)
+		}
+		if !strings.EqualFold(parsed.ID(), id) {
+			t.Fatalf("Expected the ID to be %q but got %q", id, parsed.ID())
+		}
+	}
+
+	parsed, err := ParseLogicAppID(id)
+	if err != nil {
+		t.Fatalf("Expected %q to parse but got: %+v", id, err)
+	}
+	if parsed.SubscriptionID != "00000000-0000-0000-0000-000000000000" {
+		t.Fatalf("Expected SubscriptionID to be %q but got %q", "00000000-0000-0000-0000-000000000000", parsed.SubscriptionID)
+	}
+	if parsed.ResourceGroup != "resourceGroup1" {
+		t.Fatalf("Expected ResourceGroup to be %q but got %q", "resourceGroup1", parsed.ResourceGroup)
+	}
+	if parsed.Name != "name1" {
+		t.Fatalf("Expected Name to be %q but got %q", "name1", parsed.Name)
+	}
+
+	for _, input := range []string{"", "random", id + "/extra/segment", id[:strings.LastIndex(id, "/")]} {
+		if _, err := ParseLogicAppID(input); err == nil {
+			t.Fatalf("Expected %q not to parse", input)
+		}
+		if _, errors := ValidateLogicAppID(input, "id"); len(errors) == 0 {
+			t.Fatalf("Expected %q not to be valid", input)
+		}
+	}
+
+	if _, errors := ValidateLogicAppIDOrEmpty("", "id"); len(errors) > 0 {
+		t.Fatalf("Expected an empty value to be valid but got: %+v", errors)
+	}
+	if _, errors := ValidateLogicAppIDOrEmpty("random", "id"); len(errors) == 0 {
+		t.Fatalf("Expected %q not to be valid", "random")
+	}
+}
+
+func TestManagedDiskID(t *testing.T) {
+	id := NewManagedDiskID("00000000-0000-0000-0000-000000000000", "resourceGroup1", "name1").ID()
+
+	// the ID should round-trip, regardless of the casing of the segments
+	for _, input := range []string{id, strings.ToLower(id), strings.ToUpper(id)} {
+		parsed, err := ParseManagedDiskID(input)
+		if err != nil {
+			t.Fatalf("Expected %q to parse but got: %+v", input, err)
+		}
+		if !strings.EqualFold(parsed.ID(), id) {
+			t.Fatalf("Expected the ID to be %q but got %q", id, parsed.ID())
+		}
+	}
+
+	parsed, err := ParseManagedDiskID(id)
+	if err != nil {
+		t.Fatalf("Expected %q to parse but got: %+v", id, err)
+	}
+	if parsed.SubscriptionID != "00000000-0000-0000-0000-000000000000" {
+		t.Fatalf("Expected SubscriptionID to be %q but got %q", "00000000-0000-0000-0000-000000000000", parsed.SubscriptionID)
+	}
+	if parsed.ResourceGroup != "resourceGroup1" {
+		t.Fatalf("Expected ResourceGroup to be %q but got %q", "resourceGroup1", parsed.ResourceGroup)
+	}
+	if parsed.Name != "name1" {
+		t.Fatalf("Expected Name to be %q but got %q", "name1", parsed.Name)
+	}
+
+	for _, input := range []string{"", "random", id + "/extra/segment", id[:strings.LastIndex(id, "/")]} {
+		if _, err := ParseManagedDiskID(input); err == nil {
+			t.Fatalf("Expected %q not to parse", input)
+		}
+		if _, errors := ValidateManagedDiskID(input, "id"); len(errors) == 0 {
+			t.Fatalf("Expected %q not to be valid", input)
+		}
+	}
+
+	if _, errors := ValidateManagedDiskIDOrEmpty("", "id"); len(errors) > 0 {
+		t.Fatalf("Expected an empty value to be valid but got: %+v", errors)
+	}
+	if _, errors := ValidateManagedDiskIDOrEmpty("random", "id"); len(errors) == 0 {
+		t.Fatalf("Expected %q not to be valid", "random")
+	}
+}
+
+func TestManagementGroupID(t *testing.T) {
+	id := NewManagementGroupID("name1").ID()
+
+	// the ID should round-trip, regardless of the casing of the segments
+	for _, input := range []string{id, strings.ToLower(id), strings.ToUpper(id)} {
+		parsed, err := ParseManagementGroupID(input)
+		if err != nil {
+			t.Fatalf("Expected %q to parse but got: %+v", input, err)
+		}
+		if !strings.EqualFold(parsed.ID(), id) {
+			t.Fatalf("Expected the ID to be %q but got %q", id, parsed.ID())
+		}
+	}
+
+	parsed, err := ParseManagementGroupID(id)
+	if err != nil {
+		t.Fatalf("Expected %q to parse but got: %+v", id, err)
+	}
+	if parsed.Name != "name1" {
+		t.Fatalf("Expected Name to be %q but got %q", "name1", parsed.Name)
+	}
+
+	for _, input := range []string{"", "random", id + "/extra/segment", id[:strings.LastIndex(id, "/")]} {
+		if _, err := ParseManagementGroupID(input); err == nil {
+			t.Fatalf("Expected %q not to parse", input)
+		}
+		if _, errors := ValidateManagementGroupID(input, "id"); len(errors) == 0 {
+			t.Fatalf("Expected %q not to be valid", input)
+		}
+	}
+
+	if _, errors := ValidateManagementGroupIDOrEmpty("", "id"); len(errors) > 0 {
+		t.Fatalf("Expected an empty value to be valid but got: %+v", errors)
+	}
+	if _, errors := ValidateManagementGroupIDOrEmpty("random", "id"); len(errors) == 0 {
+		t.Fatalf("Expected %q not to be valid", "random")
+	}
+}
+
+func TestManagementGroupTemplateDeploymentID(t *testing.T) {
+	id := NewManagementGroupTemplateDeploymentID("managementGroupName1", "name1").ID()
+
+	// the ID should round-trip, regardless of the casing of the segments
+	for _, input := range []string{id, strings.ToLower(id), strings.ToUpper(id)} {
+		parsed, err := ParseManagementGroupTemplateDeploymentID(input)
+		if err != nil {
+			t.Fatalf("Expected %q to parse but got: %+v", input, err)
+		}
+		if !strings.EqualFold(parsed.ID(), id) {
+			t.Fatalf("Expected the ID to be %q but got %q", id, parsed.ID())
+		}
+	}
+
+	parsed, err := ParseManagementGroupTemplateDeploymentID(id)
+	if err != nil {
+		t.Fatalf("Expected %q to parse but got: %+v", id, err)
+	}
+	if parsed.ManagementGroupName != "managementGroupName1" {
+		t.Fatalf("Expected ManagementGroupName to be %q but got %q", "managementGroupName1", parsed.ManagementGroupName)
+	}
+	if parsed.Name != "name1" {
+		t.Fatalf("Expected Name to be %q but got %q", "name1", parsed.Name)
+	}
+
+	for _, input := range []string{"", "random", id + "/extra/segment", id[:strings.LastIndex(id, "/")]} {
+		if _, err := ParseManagementGroupTemplateDeploymentID(input); err == nil {
+			t.Fatalf("Expected %q not to parse", input)
+		}
+		if _, errors := ValidateManagementGroupTemplateDeploymentID(input, "id"); len(errors) == 0 {
+			t.Fatalf("Expected %q not to be valid", input)
+		}
+	}
+
+	if _, errors := ValidateManagementGroupTemplateDeploymentIDOrEmpty("", "id"); len(errors) > 0 {
+		t.Fatalf("Expected an empty value to be valid but got: %+v", errors)
+	}
+	if _, errors := ValidateManagementGroupTemplateDeploymentIDOrEmpty("random", "id"); len(errors) == 0 {
+		t.Fatalf("Expected %q not to be valid", "random")
+	}
+}
+
+func TestMonitorActionGroupID(t *testing.T) {
+	id := NewMonitorActionGroupID("00000000-0000-0000-0000-000000000000", "resourceGroup1", "name1").ID()
+
+	// the ID should round-trip, regardless of the casing of the segments
+	for _, input := range []string{id, strings.ToLower(id), strings.ToUpper(id)} {
+		parsed, err := ParseMonitorActionGroupID(input)
+		if err != nil {
+			t.Fatalf("Expected %q to parse but got: %+v", input, err)
+		}
+		if !strings.EqualFold(parsed.ID(), id) {
+			t.Fatalf("Expected the ID to be %q but got %q", id, parsed.ID())
+		}
+	}
+
+	parsed, err := ParseMonitorActionGroupID(id)
+	if err != nil {
+		t.Fatalf("Expected %q to parse but got: %+v", id, err)
+	}
+	if parsed.SubscriptionID != "00000000-0000-0000-0000-000000000000" {
+		t.Fatalf("Expected SubscriptionID to be %q but got %q", "00000000-0000-0000-0000-000000000000", parsed.SubscriptionID)
+	}
+	if parsed.ResourceGroup != "resourceGroup1" {
+		t.Fatalf("Expected ResourceGroup to be %q but got %q", "resourceGroup1", parsed.ResourceGroup)
+	}
+	if parsed.Name != "name1" {
+		t.Fatalf("Expected Name to be %q but got %q", "name1", parsed.Name)
+	}
+
+	for _, input := range []string{"", "random", id + "/extra/segment", id[:strings.LastIndex(id, "/")]} {
+		if _, err := ParseMonitorActionGroupID(input); err == nil {
+			t.Fatalf("Expected %q not to parse", input)
+		}
+		if _, errors := ValidateMonitorActionGroupID(input, "id"); len(errors) == 0 {
+			t.Fatalf("Expected %q not to be valid", input)
+		}
+	}
+
+	if _, errors := ValidateMonitorActionGroupIDOrEmpty("", "id"); len(errors) > 0 {
+		t.Fatalf("Expected an empty value to be valid but got: %+v", errors)
+	}
+	if _, errors := ValidateMonitorActionGroupIDOrEmpty("random", "id"); len(errors) == 0 {
+		t.Fatalf("Expected %q not to be valid", "random")
+	}
+}
+
+func TestNetworkInterfaceID(t *testing.T) {
+	id := NewNetworkInterfaceID("00000000-0000-0000-0000-000000000000", "resourceGroup1", "name1").ID()
+
+	// the ID should round-trip, regardless of the casing of the segments
+	for _, input := range []string{id, strings.ToLower(id), strings.ToUpper(id)} {
+		parsed, err := ParseNetworkInterfaceID(input)
+		if err != nil {
+			t.Fatalf("Expected %q to parse but got: %+v", input, err)
+		}
+		if !strings.EqualFold(parsed.ID(), id) {
+			t.Fatalf("Expected the ID to be %q but got %q", id, parsed.ID())
+		}
+	}
+
+	parsed, err := ParseNetworkInterfaceID(id)
+	if err != nil {
+		t.Fatalf("Expected %q to parse but got: %+v", id, err)
+	}
+	if parsed.SubscriptionID != "00000000-0000-0000-0000-000000000000" {
+		t.Fatalf("Expected SubscriptionID to be %q but got %q", "00000000-0000-0000-0000-000000000000", parsed.SubscriptionID)
+	}
+	if parsed.ResourceGroup != "resourceGroup1" {
+		t.Fatalf("Expected ResourceGroup to be %q but got %q", "resourceGroup1", parsed.ResourceGroup)
+	}
+	if parsed.Name != "name1" {
+		t.Fatalf("Expected Name to be %q but got %q", "name1", parsed.Name)
+	}
+
+	for _, input := range []string{"", "random", id + "/extra/segment", id[:strings.LastIndex(id, "/")]} {
+		if _, err := ParseNetworkInterfaceID(input); err == nil {
+			t.Fatalf("Expected %q not to parse", input)
+		}
+		if _, errors := ValidateNetworkInterfaceID(input, "id"); len(errors) == 0 {
+			t.Fatalf("Expected %q not to be valid", input)
+		}
+	}
+
+	if _, errors := ValidateNetworkInterfaceIDOrEmpty("", "id"); len(errors) > 0 {
+		t.Fatalf("Expected an empty value to be valid but got: %+v", errors)
+	}
+	if _, errors := ValidateNetworkInterfaceIDOrEmpty("random", "id"); len(errors) == 0 {
+		t.Fatalf("Expected %q not to be valid", "random")
+	}
+}
+
+func TestNetworkSecurityGroupID(t *testing.T) {
+	id := NewNetworkSecurityGroupID("00000000-0000-0000-0000-000000000000", "resourceGroup1", "name1").ID()
+
+	// the ID should round-trip, regardless of the casing of the segments
+	for _, input := range []string{id, strings.ToLower(id), strings.ToUpper(id)} {
+		parsed, err := ParseNetworkSecurityGroupID(input)
+		if err != nil {
+			t.Fatalf("Expected %q to parse but got: %+v", input, err)
+		}
+		if !strings.EqualFold(parsed.ID(), id) {
+			t.Fatalf("Expected the ID to be %q but got %q", id, parsed.ID())
+		}
+	}
+
+	parsed, err := ParseNetworkSecurityGroupID(id)
+	if err != nil {
+		t.Fatalf("Expected %q to parse but got: %+v", id, err)
+	}
+	if parsed.SubscriptionID != "00000000-0000-0000-0000-000000000000" {
+		t.Fatalf("Expected SubscriptionID to be %q but got %q", "00000000-0000-0000-0000-000000000000", parsed.SubscriptionID)
+	}
+	if parsed.ResourceGroup != "resourceGroup1" {
+		t.Fatalf("Expected ResourceGroup to be %q but got %q", "resourceGroup1", parsed.ResourceGroup)
+	}
+	if parsed.Name != "name1" {
+		t.Fatalf("Expected Name to be %q but got %q", "name1", parsed.Name)
+	}
+
+	for _, input := range []string{"", "random", id + "/extra/segment", id[:strings.LastIndex(id, "/")]} {
+		if _, err := ParseNetworkSecurityGroupID(input); err == nil {
+			t.Fatalf("Expected %q not to parse", input)
+		}
+		if _, errors := ValidateNetworkSecurityGroupID(input, "id"); len(errors) == 0 {
+			t.Fatalf("Expected %q not to be valid", input)
+		}
+	}
+
+	if _, errors := ValidateNetworkSecurityGroupIDOrEmpty("", "id"); len(errors) > 0 {
+		t.Fatalf("Expected an empty value to be valid but got: %+v", errors)
+	}
+	if _, errors := ValidateNetworkSecurityGroupIDOrEmpty("random", "id"); len(errors) == 0 {
+		t.Fatalf("Expected %q not to be valid", "random")
+	}
+}
+
+func TestNetworkSecurityRuleID(t *testing.T) {
+	id := NewNetworkSecurityRuleID("00000000-0000-0000-0000-000000000000", "resourceGroup1", "networkSecurityGroupName1", "name1").ID()
+
+	// the ID should round-trip, regardless of the casing of the segments
+	for _, input := range []string{id, strings.ToLower(id), strings.ToUpper(id)} {
+		parsed, err := ParseNetworkSecurityRuleID(input)
+		if err != nil {
+			t.Fatalf("Expected %q to parse but got: %+v", input, err)
+		}
+		if !strings.EqualFold(parsed.ID(), id) {
+			t.Fatalf("Expected the ID to be %q but got %q", id, parsed.ID())
+		}
+	}
+
+	parsed, err := ParseNetworkSecurityRuleID(id)
+	if err != nil {
+		t.Fatalf("Expected %q to parse but got: %+v", id, err)
+	}
+	if parsed.SubscriptionID != "00000000-0000-0000-0000-000000000000" {
+		t.Fatalf("Expected SubscriptionID to be %q but got %q", "00000000-0000-0000-0000-000000000000", parsed.SubscriptionID)
+	}
+	if parsed.ResourceGroup != "resourceGroup1" {
+		t.Fatalf("Expected ResourceGroup to be %q but got %q", "resourceGroup1", parsed.ResourceGroup)
+	}
+	if parsed.NetworkSecurityGroupName != "networkSecurityGroupName1" {
+		t.Fatalf("Expected NetworkSecurityGroupName to be %q but got %q", "networkSecurityGroupName1", parsed.NetworkSecurityGroupName)
+	}
+	if parsed.Name != "name1" {
+		t.Fatalf("Expected Name to be %q but got %q", "name1", parsed.Name)
+	}
+
+	for _, input := range []string{"", "random", id + "/extra/segment", id[:strings.LastIndex(id, "/")]} {
+		if _, err := ParseNetworkSecurityRuleID(input); err == nil {
+			t.Fatalf("Expected %q not to parse", input)
+		}
+		if _, errors := ValidateNetworkSecurityRuleID(input, "id"); len(errors) == 0 {
+			t.Fatalf("Expected %q not to be valid", input)
+		}
+	}
+
+	if _, errors := ValidateNetworkSecurityRuleIDOrEmpty("", "id"); len(errors) > 0 {
+		t.Fatalf("Expected an empty value to be valid but got: %+v", errors)
+	}
+	if _, errors := ValidateNetworkSecurityRuleIDOrEmpty("random", "id"); len(errors) == 0 {
+		t.Fatalf("Expected %q not to be valid", "random")
+	}
+}
+
+func TestNetworkWatcherID(t *testing.T) {
+	id := NewNetworkWatcherID("00000000-0000-0000-0000-000000000000", "resourceGroup1", "name1").ID()
+
+	// the ID should round-trip, regardless of the casing of the segments
+	for _, input := range []string{id, strings.ToLower(id), strings.ToUpper(id)} {
+		parsed, err := ParseNetworkWatcherID(input)
+		if err != nil {
+			t.Fatalf("Expected %q to parse but got: %+v", input, err)
+		}
+		if !strings.EqualFold(parsed.ID(), id) {
+			t.Fatalf("Expected the ID to be %q but got %q", id, parsed.ID())
+		}
+	}
+
+	parsed, err := ParseNetworkWatcherID(id)
+	if err != nil {
+		t.Fatalf("Expected %q to parse but got: %+v", id, err)
+	}
+	if parsed.SubscriptionID != "00000000-0000-0000-0000-000000000000" {
+		t.Fatalf("Expected SubscriptionID to be %q but got %q", "00000000-0000-0000-0000-000000000000", parsed.SubscriptionID)
+	}
+	if parsed.ResourceGroup != "resourceGroup1" {
+		t.Fatalf("Expected ResourceGroup to be %q but got %q", "resourceGroup1", parsed.ResourceGroup)
+	}
+	if parsed.Name != "name1" {
+		t.Fatalf("Expected Name to be %q but got %q", "name1", parsed.Name)
+	}
+
+	for _, input := range []string{"", "random", id + "/extra/segment", id[:strings.LastIndex(id, "/")]} {
+		if _, err := ParseNetworkWatcherID(input); err == nil {
+			t.Fatalf("Expected %q not to parse", input)
+		}
+		if _, errors := ValidateNetworkWatcherID(input, "id"); len(errors) == 0 {
+			t.Fatalf("Expected %q not to be valid", input)
+		}
+	}
+
+	if _, errors := ValidateNetworkWatcherIDOrEmpty("", "id"); len(errors) > 0 {
+		t.Fatalf("Expected an empty value to be valid but got: %+v", errors)
+	}
+	if _, errors := ValidateNetworkWatcherIDOrEmpty("random", "id"); len(errors) == 0 {
+		t.Fatalf("Expected %q not to be valid", "random")
+	}
+}
+
+func TestPrivateDnsARecordID(t *testing.T) {
+	id := NewPrivateDnsARecordID("00000000-0000-0000-0000-000000000000", "resourceGroup1", "zoneName1", "name1").ID()
+
+	// the ID should round-trip, regardless of the casing of the segments
+	for _, input := range []string{id, strings.ToLower(id), strings.ToUpper(id)} {
+		parsed, err := ParsePrivateDnsARecordID(input)
+		if err != nil {
+			t.Fatalf("Expected %q to parse but got: %+v", input, err)
+		}
+		if !strings.EqualFold(parsed.ID(), id) {
+			t.Fatalf("Expected the ID to be %q but got %q", id, parsed.ID())
+		}
+	}
+
+	parsed, err := ParsePrivateDnsARecordID(id)
+	if err != nil {
+		t.Fatalf("Expected %q to parse but got: %+v", id, err)
+	}
+	if parsed.SubscriptionID != "00000000-0000-0000-0000-000000000000" {
+		t.Fatalf("Expected SubscriptionID to be %q but got %q", "00000000-0000-0000-0000-000000000000", parsed.SubscriptionID)
+	}
+	if parsed.ResourceGroup != "resourceGroup1" {
+		t.Fatalf("Expected ResourceGroup to be %q but got %q", "resourceGroup1", parsed.ResourceGroup)
+	}
+	if parsed.ZoneName != "zoneName1" {
+		t.Fatalf("Expected ZoneName to be %q but got %q", "zoneName1", parsed.ZoneName)
+	}
+	if parsed.Name != "name1" {
+		t.Fatalf("Expected Name to be %q but got %q", "name1", parsed.Name)
+	}
+
+	for _, input := range []string{"", "random", id + "/extra/segment", id[:strings.LastIndex(id, "/")]} {
+		if _, err := ParsePrivateDnsARecordID(input); err == nil {
+			t.Fatalf("Expected %q not to parse", input)
+		}
+		if _, errors := ValidatePrivateDnsARecordID(input, "id"); len(errors) == 0 {
+			t.Fatalf("Expected %q not to be valid", input)
+		}
+	}
+
+	if _, errors := ValidatePrivateDnsARecordIDOrEmpty("", "id"); len(errors) > 0 {
+		t.Fatalf("Expected an empty value to be valid but got: %+v", errors)
+	}
+	if _, errors := ValidatePrivateDnsARecordIDOrEmpty("random", "id"); len(errors) == 0 {
+		t.Fatalf("Expected %q not to be valid", "random")
+	}
+}
+
+func TestPrivateDnsAaaaRecordID(t *testing.T) {
+	id := NewPrivateDnsAaaaRecordID("00000000-0000-0000-0000-000000000000", "resourceGroup1", "zoneName1", "name1").ID()
+
+	// the ID should round-trip, regardless of the casing of the segments
+	for _, input := range []string{id, strings.ToLower(id), strings.ToUpper(id)} {
+		parsed, err := ParsePrivateDnsAaaaRecordID(input)
+		if err != nil {
+			t.Fatalf("Expected %q to parse but got: %+v", input, err)
+		}
+		if !strings.EqualFold(parsed.ID(), id) {
+			t.Fatalf("Expected the ID to be %q but got %q", id, parsed.ID())
+		}
+	}
+
+	parsed, err := ParsePrivateDnsAaaaRecordID(id)
+	if err != nil {
+		t.Fatalf("Expected %q to parse but got: %+v", id, err)
+	}
+	if parsed.SubscriptionID != "00000000-0000-0000-0000-000000000000" {
+		t.Fatalf("Expected SubscriptionID to be %q but got %q", "00000000-0000-0000-0000-000000000000", parsed.SubscriptionID)
+	}
+	if parsed.ResourceGroup != "resourceGroup1" {
+		t.Fatalf("Expected ResourceGroup to be %q but got %q", "resourceGroup1", parsed.ResourceGroup)
+	}
+	if parsed.ZoneName != "zoneName1" {
+		t.Fatalf("Expected ZoneName to be %q but got %q", "zoneName1", parsed.ZoneName)
+	}
+	if parsed.Name != "name1" {
+		t.Fatalf("Expected Name to be %q but got %q", "name1", parsed.Name)
+	}
+
+	for _, input := range []string{"", "random", id + "/extra/segment", id[:strings.LastIndex(id, "/")]} {
+		if _, err := ParsePrivateDnsAaaaRecordID(input); err == nil {
+			t.Fatalf("Expected %q not to parse", input)
+		}
+		if _, errors := ValidatePrivateDnsAaaaRecordID(input, "id"); len(errors) == 0 {
+			t.Fatalf("Expected %q not to be valid", input)
+		}
+	}
+
+	if _, errors := ValidatePrivateDnsAaaaRecordIDOrEmpty("", "id"); len(errors) > 0 {
+		t.Fatalf("Expected an empty value to be valid but got: %+v", errors)
+	}
+	if _, errors := ValidatePrivateDnsAaaaRecordIDOrEmpty("random", "id"); len(errors) == 0 {
+		t.Fatalf("Expected %q not to be valid", "random")
+	}
+}
+
+func TestPrivateDnsCNameRecordID(t *testing.T) {
+	id := NewPrivateDnsCNameRecordID("00000000-0000-0000-0000-000000000000", "resourceGroup1", "zoneName1", "name1").ID()
+
+	// the ID should round-trip, regardless of the casing of the segments
+	for _, input := range []string{id, strings.ToLower(id), strings.ToUpper(id)} {
+		parsed, err := ParsePrivateDnsCNameRecordID(input)
+		if err != nil {
+			t.Fatalf("Expected %q to parse but got: %+v", input, err)
+		}
+		if !strings.EqualFold(parsed.ID(), id) {
+			t.Fatalf("Expected the ID to be %q but got %q", id, parsed.ID())
+		}
+	}
+
+	parsed, err := ParsePrivateDnsCNameRecordID(id)
+	if err != nil {
+		t.Fatalf("Expected %q to parse but got: %+v", id, err)
+	}
+	if parsed.SubscriptionID != "00000000-0000-0000-0000-000000000000" {
+		t.Fatalf("Expected SubscriptionID to be %q but got %q", "00000000-0000-0000-0000-000000000000", parsed.SubscriptionID)
+	}
+	if parsed.ResourceGroup != "resourceGroup1" {
+		t.Fatalf("Expected ResourceGroup to be %q but got %q", "resourceGroup1", parsed.ResourceGroup)
+	}
+	if parsed.ZoneName != "zoneName1" {
+		t.Fatalf("Expected ZoneName to be %q but got %q", "zoneName1", parsed.ZoneName)
+	}
+	if parsed.Name != "name1" {
+		t.Fatalf("Expected Name to be %q but got %q", "name1", parsed.Name)
+	}
+
+	for _, input := range []string{"", "random", id + "/extra/segment", id[:strings.LastIndex(id, "/")]} {
+		if _, err := ParsePrivateDnsCNameRecordID(input); err == nil {
+			t.Fatalf("Expected %q not to parse", input)
+		}
+		if _, errors := ValidatePrivateDnsCNameRecordID(input, "id"); len(errors) == 0 {
+			t.Fatalf("Expected %q not to be valid", input)
+		}
+	}
+
+	if _, errors := ValidatePrivateDnsCNameRecordIDOrEmpty("", "id"); len(errors) > 0 {
+		t.Fatalf("Expected an empty value to be valid but got: %+v", errors)
+	}
+	if _, errors := ValidatePrivateDnsCNameRecordIDOrEmpty("random", "id"); len(errors) == 0 {
+		t.Fatalf("Expected %q not to be valid", "random")
+	}
+}
+
+func TestPrivateDnsMxRecordID(t *testing.T) {
+	id := NewPrivateDnsMxRecordID("00000000-0000-0000-0000-000000000000", "resourceGroup1", "zoneName1", "name1").ID()
+
+	// the ID should round-trip, regardless of the casing of the segments
+	for _, input := range []string{id, strings.ToLower(id), strings.ToUpper(id)} {
+		parsed, err := ParsePrivateDnsMxRecordID(input)
+		if err != nil {
+			t.Fatalf("Expected %q to parse but got: %+v", input, err)
+		}
+		if !strings.EqualFold(parsed.ID(), id) {
+			t.Fatalf("Expected the ID to be %q but got %q", id, parsed.ID())
+		}
+	}
+
+	parsed, err := ParsePrivateDnsMxRecordID(id)
+	if err != nil {
+		t.Fatalf("Expected %q to parse but got: %+v", id, err)
+	}
+	if parsed.SubscriptionID != "00000000-0000-0000-0000-000000000000" {
+		t.Fatalf("Expected SubscriptionID to be %q but got %q", "00000000-0000-0000-0000-000000000000", parsed.SubscriptionID)
+	}
+	if parsed.ResourceGroup != "resourceGroup1" {
+		t.Fatalf("Expected ResourceGroup to be %q but got %q", "resourceGroup1", parsed.ResourceGroup)
+	}
+	if parsed.ZoneName != "zoneName1" {
+		t.Fatalf("Expected ZoneName to be %q but got %q", "zoneName1", parsed.ZoneName)
+	}
+	if parsed.Name != "name1" {
+		t.Fatalf("Expected Name to be %q but got %q", "name1", parsed.Name)
+	}
+
+	for _, input := range []string{"", "random", id + "/extra/segment", id[:strings.LastIndex(id, "/")]} {
+		if _, err := ParsePrivateDnsMxRecordID(input); err == nil {
+			t.Fatalf("Expected %q not to parse", input)
+		}
+		if _, errors := ValidatePrivateDnsMxRecordID(input, "id"); len(errors) == 0 {
+			t.Fatalf("Expected %q not to be valid", input)
+		}
+	}
+
+	if _, errors := ValidatePrivateDnsMxRecordIDOrEmpty("", "id"); len(errors) > 0 {
+		t.Fatalf("Expected an empty value to be valid but got: %+v", errors)
+	}
+	if _, errors := ValidatePrivateDnsMxRecordIDOrEmpty("random", "id"); len(errors) == 0 {
+		t.Fatalf("Expected %q not to be valid", "random")
+	}
+}
+
+func TestPrivateDnsPtrRecordID(t *testing.T) {
+	id := NewPrivateDnsPtrRecordID("00000000-0000-0000-0000-000000000000", "resourceGroup1", "zoneName1", "name1").ID()
+
+	// the ID should round-trip, regardless of the casing of the segments
+	for _, input := range []string{id, strings.ToLower(id), strings.ToUpper(id)} {
+		parsed, err := ParsePrivateDnsPtrRecordID(input)
+		if err != nil {
+			t.Fatalf("Expected %q to parse but got: %+v", input, err)
+		}
+		if !strings.EqualFold(parsed.ID(), id) {
+			t.Fatalf("Expected the ID to be %q but got %q", id, parsed.ID())
+		}
+	}
+
+	parsed, err := ParsePrivateDnsPtrRecordID(id)
+	if err != nil {
+		t.Fatalf("Expected %q to parse but got: %+v", id, err)
+	}
+	if parsed.SubscriptionID != "00000000-0000-0000-0000-000000000000" {
+		t.Fatalf("Expected SubscriptionID to be %q but got %q", "00000000-0000-0000-0000-000000000000", parsed.SubscriptionID)
+	}
+	if parsed.ResourceGroup != "resourceGroup1" {
+		t.Fatalf("Expected ResourceGroup to be %q but got %q", "resourceGroup1", parsed.ResourceGroup)
+	}
+	if parsed.ZoneName != "zoneName1" {
+		t.Fatalf("Expected ZoneName to be %q but got %q", "zoneName1", parsed.ZoneName)
+	}
+	if parsed.Name != "name1" {
+		t.Fatalf("Expected Name to be %q but got %q", "name1", parsed.Name)
+	}
+
+	for _, input := range []string{"", "random", id + "/extra/segment", id[:strings.LastIndex(id, "/")]} {
+		if _, err := ParsePrivateDnsPtrRecordID(input); err == nil {
+			t.Fatalf("Expected %q not to parse", input)
+		}
+		if _, errors := ValidatePrivateDnsPtrRecordID(input, "id"); len(errors) == 0 {
+			t.Fatalf("Expected %q not to be valid", input)
+		}
+	}
+
+	if _, errors := ValidatePrivateDnsPtrRecordIDOrEmpty("", "id"); len(errors) > 0 {
+		t.Fatalf("Expected an empty value to be valid but got: %+v", errors)
+	}
+	if _, errors := ValidatePrivateDnsPtrRecordIDOrEmpty("random", "id"); len(errors) == 0 {
+		t.Fatalf("Expected %q not to be valid", "random")
+	}
+}
+
+func TestPrivateDnsSrvRecordID(t *testing.T) {
+	id := NewPrivateDnsSrvRecordID("00000000-0000-0000-0000-000000000000", "resourceGroup1", "zoneName1", "name1").ID()
+
+	// the ID should round-trip, regardless of the casing of the segments
+	for _, input := range []string{id, strings.ToLower(id), strings.ToUpper(id)} {
+		parsed, err := ParsePrivateDnsSrvRecordID(input)
+		if err != nil {
+			t.Fatalf("Expected %q to parse but got: %+v", input, err)
+		}
+		if !strings.EqualFold(parsed.ID(), id) {
+			t.Fatalf("Expected the ID to be %q but got %q", id, parsed.ID())
+		}
+	}
+
+	parsed, err := ParsePrivateDnsSrvRecordID(id)
+	if err != nil {
+		t.Fatalf("Expected %q to parse but got: %+v", id, err)
+	}
+	if parsed.SubscriptionID != "00000000-0000-0000-0000-000000000000" {
+		t.Fatalf("Expected SubscriptionID to be %q but got %q", "00000000-0000-0000-0000-000000000000", parsed.SubscriptionID)
+	}
+	if parsed.ResourceGroup != "resourceGroup1" {
+		t.Fatalf("Expected ResourceGroup to be %q but got %q", "resourceGroup1", parsed.ResourceGroup)
+	}
+	if parsed.ZoneName != "zoneName1" {
+		t.Fatalf("Expected ZoneName to be %q but got %q", "zoneName1", parsed.ZoneName)
+	}
+	if parsed.Name != "name1" {
+		t.Fatalf("Expected Name to be %q but got %q", "name1", parsed.Name)
+	}
+
+	for _, input := range []string{"", "random", id + "/extra/segment", id[:strings.LastIndex(id, "/")]} {
+		if _, err := ParsePrivateDnsSrvRecordID(input); err == nil {
+			t.Fatalf("Expected %q not to parse", input)
+		}
+		if _, errors := ValidatePrivateDnsSrvRecordID(input, "id"); len(errors) == 0 {
+			t.Fatalf("Expected %q not to be valid", input)
+		}
+	}
+
+	if _, errors := ValidatePrivateDnsSrvRecordIDOrEmpty("", "id"); len(errors) > 0 {
+		t.Fatalf("Expected an empty value to be valid but got: %+v", errors)
+	}
+	if _, errors := ValidatePrivateDnsSrvRecordIDOrEmpty("random", "id"); len(errors) == 0 {
+		t.Fatalf("Expected %q not to be valid", "random")
+	}
+}
+
+func TestPrivateDnsTxtRecordID(t *testing.T) {
+	id := NewPrivateDnsTxtRecordID("00000000-0000-0000-0000-000000000000", "resourceGroup1", "zoneName1", "name1").ID()
+
+	// the ID should round-trip, regardless of the casing of the segments
+	for _, input := range []string{id, strings.ToLower(id), strings.ToUpper(id)} {
+		parsed, err := ParsePrivateDnsTxtRecordID(input)
+		if err != nil {
+			t.Fatalf("Expected %q to parse but got: %+v", input, err)
+		}
+		if !strings.EqualFold(parsed.ID(), id) {
+			t.Fatalf("Expected the ID to be %q but got %q", id, parsed.ID())
+		}
+	}
+
+	parsed, err := ParsePrivateDnsTxtRecordID(id)
+	if err != nil {
+		t.Fatalf("Expected %q to parse but got: %+v", id, err)
+	}
+	if parsed.SubscriptionID != "00000000-0000-0000-0000-000000000000" {
+		t.Fatalf("Expected SubscriptionID to be %q but got %q", "00000000-0000-0000-0000-000000000000", parsed.SubscriptionID)
+	}
+	if parsed.ResourceGroup != "resourceGroup1" {
+		t.Fatalf("Expected ResourceGroup to be %q but got %q", "resourceGroup1", parsed.ResourceGroup)
+	}
+	if parsed.ZoneName != "zoneName1" {
+		t.Fatalf("Expected ZoneName to be %q but got %q", "zoneName1", parsed.ZoneName)
+	}
+	if parsed.Name != "name1" {
+		t.Fatalf("Expected Name to be %q but got %q", "name1", parsed.Name)
+	}
+
+	for _, input := range []string{"", "random", id + "/extra/segment", id[:strings.LastIndex(id, "/")]} {
+		if _, err := ParsePrivateDnsTxtRecordID(input); err == nil {
+			t.Fatalf("Expected %q not to parse", input)
+		}
+		if _, errors := ValidatePrivateDnsTxtRecordID(input, "id"); len(errors) == 0 {
+			t.Fatalf("Expected %q not to be valid", input)
+		}
+	}
+
+	if _, errors := ValidatePrivateDnsTxtRecordIDOrEmpty("", "id"); len(errors) > 0 {
+		t.Fatalf("Expected an empty value to be valid but got: %+v", errors)
+	}
+	if _, errors := ValidatePrivateDnsTxtRecordIDOrEmpty("random", "id"); len(errors) == 0 {
+		t.Fatalf("Expected %q not to be valid", "random")
+	}
+}
+
+func TestPrivateDnsZoneID(t *testing.T) {
+	id := NewPrivateDnsZoneID("00000000-0000-0000-0000-000000000000", "resourceGroup1", "name1").ID()
+
+	// the ID should round-trip, regardless of the casing of the segments
+	for _, input := range []string{id, strings.ToLower(id), strings.ToUpper(id)} {
+		parsed, err := ParsePrivateDnsZoneID(input)
+		if err != nil {
+			t.Fatalf("Expected %q to parse but got: %+v", input, err)
+		}
+		if !strings.EqualFold(parsed.ID(), id) {
+			t.Fatalf("Expected the ID to be %q but got %q", id, parsed.ID())
+		}
+	}
+
+	parsed, err := ParsePrivateDnsZoneID(id)
+	if err != nil {
+		t.Fatalf("Expected %q to parse but got: %+v", id, err)
+	}
+	if parsed.SubscriptionID != "00000000-0000-0000-0000-000000000000" {
+		t.Fatalf("Expected SubscriptionID to be %q but got %q", "00000000-0000-0000-0000-000000000000", parsed.SubscriptionID)
+	}
+	if parsed.ResourceGroup != "resourceGroup1" {
+		t.Fatalf("Expected ResourceGroup to be %q but got %q", "resourceGroup1", parsed.ResourceGroup)
+	}
+	if parsed.Name != "name1" {
+		t.Fatalf("Expected Name to be %q but got %q", "name1", parsed.Name)
+	}
+
+	for _, input := range []string{"", "random", id + "/extra/segment", id[:strings.LastIndex(id, "/")]} {
+		if _, err := ParsePrivateDnsZoneID(input); err == nil {
+			t.Fatalf("Expected %q not to parse", input)
+		}
+		if _, errors := ValidatePrivateDnsZoneID(input, "id"); len(errors) == 0 {
+			t.Fatalf("Expected %q not to be valid", input)
+		}
+	}
+
+	if _, errors := ValidatePrivateDnsZoneIDOrEmpty("", "id"); len(errors) > 0 {
+		t.Fatalf("Expected an empty value to be valid but got: %+v", errors)
+	}
+	if _, errors := ValidatePrivateDnsZoneIDOrEmpty("random", "id"); len(errors) == 0 {
+		t.Fatalf("Expected %q not to be valid", "random")
+	}
+}
+
+func TestPrivateDnsZoneVirtualNetworkLinkID(t *testing.T) {
+	id := NewPrivateDnsZoneVirtualNetworkLinkID("00000000-0000-0000-0000-000000000000", "resourceGroup1", "zoneName1", "name1").ID()
+
+	// the ID should round-trip, regardless of the casing of the segments
+	for _, input := range []string{id, strings.ToLower(id), strings.ToUpper(id)} {
+		parsed, err := ParsePrivateDnsZoneVirtualNetworkLinkID(input)
+		if err != nil {
+			t.Fatalf("Expected %q to parse but got: %+v", input, err)
+		}
+		if !strings.EqualFold(parsed.ID(), id) {
+			t.Fatalf("Expected the ID to be %q but got %q", id, parsed.ID())
+		}
+	}
+
+	parsed, err := ParsePrivateDnsZoneVirtualNetworkLinkID(id)
+	if err != nil {
+		t.Fatalf("Expected %q to parse but got: %+v", id, err)
+	}
+	if parsed.SubscriptionID != "00000000-0000-0000-0000-000000000000" {
+		t.Fatalf("Expected SubscriptionID to be %q but got %q", "00000000-0000-0000-0000-000000000000", parsed.SubscriptionID)
+	}
+	if parsed.ResourceGroup != "resourceGroup1" {
+		t.Fatalf("Expected ResourceGroup to be %q but got %q", "resourceGroup1", parsed.ResourceGroup)
+	}
+	if parsed.ZoneName != "zoneName1" {
+		t.Fatalf("Expected ZoneName to be %q but got %q", "zoneName1", parsed.ZoneName)
+	}
+	if parsed.Name != "name1" {
+		t.Fatalf("Expected Name to be %q but got %q", "name1", parsed.Name)
+	}
+
+	for _, input := range []string{"", "random", id + "/extra/segment", id[:strings.LastIndex(id, "/")]} {
+		if _, err := ParsePrivateDnsZoneVirtualNetworkLinkID(input); err == nil {
+			t.Fatalf("Expected %q not to parse", input)
+		}
+		if _, errors := ValidatePrivateDnsZoneVirtualNetworkLinkID(input, "id"); len(errors) == 0 {
+			t.Fatalf("Expected %q not to be valid", input)
+		}
+	}
+
+	if _, errors := ValidatePrivateDnsZoneVirtualNetworkLinkIDOrEmpty("", "id"); len(errors) > 0 {
+		t.Fatalf("Expected an empty value to be valid but got: %+v", errors)
+	}
+	if _, errors := ValidatePrivateDnsZoneVirtualNetworkLinkIDOrEmpty("random", "id"); len(errors) == 0 {
+		t.Fatalf("Expected %q not to be valid", "random")
+	}
+}
+
+func TestPublicIPAddressID(t *testing.T) {
+	id := NewPublicIPAddressID("00000000-0000-0000-0000-000000000000", "resourceGroup1", "name1").ID()
+
+	// the ID should round-trip, regardless of the casing of the segments
+	for _, input := range []string{id, strings.ToLower(id), strings.ToUpper(id)} {
+		parsed, err := ParsePublicIPAddressID(input)
+		if err != nil {
+			t.Fatalf("Expected %q to parse but got: %+v", input, err)
+		}
+		if !strings.EqualFold(parsed.ID(), id) {
+			t.Fatalf("Expected the ID to be %q but got %q", id, parsed.ID())
+		}
+	}
+
+	parsed, err := ParsePublicIPAddressID(id)
+	if err != nil {
+		t.Fatalf("Expected %q to parse but got: %+v", id, err)
+	}
+	if parsed.SubscriptionID != "00000000-0000-0000-0000-000000000000" {
+		t.Fatalf("Expected SubscriptionID to be %q but got %q", "00000000-0000-0000-0000-000000000000", parsed.SubscriptionID)
+	}
+	if parsed.ResourceGroup != "resourceGroup1" {
+		t.Fatalf("Expected ResourceGroup to be %q but got %q", "resourceGroup1", parsed.ResourceGroup)
+	}
+	if parsed.Name != "name1" {
+		t.Fatalf("Expected Name to be %q but got %q", "name1", parsed.Name)
+	}
+
+	for _, input := range []string{"", "random", id + "/extra/segment", id[:strings.LastIndex(id, "/")]} {
+		if _, err := ParsePublicIPAddressID(input); err == nil {
+			t.Fatalf("Expected %q not to parse", input)
+		}
+		if _, errors := ValidatePublicIPAddressID(input, "id"); len(errors) == 0 {
+			t.Fatalf("Expected %q not to be valid", input)
+		}
+	}
+
+	if _, errors := ValidatePublicIPAddressIDOrEmpty("", "id"); len(errors) > 0 {
+		t.Fatalf("Expected an empty value to be valid but got: %+v", errors)
+	}
+	if _, errors := ValidatePublicIPAddressIDOrEmpty("random", "id"); len(errors) == 0 {
+		t.Fatalf("Expected %q not to be valid", "random")
+	}
+}
+
+func TestRecoveryServicesBackupPolicyID(t *testing.T) {
+	id := NewRecoveryServicesBackupPolicyID("00000000-0000-0000-0000-000000000000", "resourceGroup1", "vaultName1", "name1").ID()
+
+	// the ID should round-trip, regardless of the casing of the segments
+	for _, input := range []string{id, strings.ToLower(id), strings.ToUpper(id)} {
+		parsed, err := ParseRecoveryServicesBackupPolicyID(input)
+		if err != nil {
+			t.Fatalf("Expected %q to parse but got: %+v", input, err)
+		}
+		if !strings.EqualFold(parsed.ID(), id) {
+			t.Fatalf("Expected the ID to be %q but got %q", id, parsed.ID())
+		}
+	}
+
+	parsed, err := ParseRecoveryServicesBackupPolicyID(id)
+	if err != nil {
+		t.Fatalf("Expected %q to parse but got: %+v", id, err)
+	}
+	if parsed.SubscriptionID != "00000000-0000-0000-0000-000000000000" {
+		t.Fatalf("Expected SubscriptionID to be %q but got %q", "00000000-0000-0000-0000-000000000000", parsed.SubscriptionID)
+	}
+	if parsed.ResourceGroup != "resourceGroup1" {
+		t.Fatalf("Expected ResourceGroup to be %q but got %q", "resourceGroup1", parsed.ResourceGroup)
+	}
+	if parsed.VaultName != "vaultName1" {
+		t.Fatalf("Expected VaultName to be %q but got %q", "vaultName1", parsed.VaultName)
+	}
+	if parsed.Name != "name1" {
+		t.Fatalf("Expected Name to be %q but got %q", "name1", parsed.Name)
+	}
+
+	for _, input := range []string{"", "random", id + "/extra/segment", id[:strings.LastIndex(id, "/")]} {
+		if _, err := ParseRecoveryServicesBackupPolicyID(input); err == nil {
+			t.Fatalf("Expected %q not to parse", input)
+		}
+		if _, errors := ValidateRecoveryServicesBackupPolicyID(input, "id"); len(errors) == 0 {
+			t.Fatalf("Expected %q not to be valid", input)
+		}
+	}
+
+	if _, errors := ValidateRecoveryServicesBackupPolicyIDOrEmpty("", "id"); len(errors) > 0 {
+		t.Fatalf("Expected an empty value to be valid but got: %+v", errors)
+	}
+	if _, errors := ValidateRecoveryServicesBackupPolicyIDOrEmpty("random", "id"); len(errors) == 0 {
+		t.Fatalf("Expected %q not to be valid", "random")
+	}
+}
+
+func TestRecoveryServicesVaultID(t *testing.T) {
+	id := NewRecoveryServicesVaultID("00000000-0000-0000-0000-000000000000", "resourceGroup1", "name1").ID()
+
+	// the ID should round-trip, regardless of the casing of the segments
+	for _, input := range []string{id, strings.ToLower(id), strings.ToUpper(id)} {
+		parsed, err := ParseRecoveryServicesVaultID(input)
+		if err != nil {
+			t.Fatalf("Expected %q to parse but got: %+v", input, err)
+		}
+		if !strings.EqualFold(parsed.ID(), id) {
+			t.Fatalf("Expected the ID to be %q but got %q", id, parsed.ID())
+		}
+	}
+
+	parsed, err := ParseRecoveryServicesVaultID(id)
+	if err != nil {
+		t.Fatalf("Expected %q to parse but got: %+v", id, err)
+	}
+	if parsed.SubscriptionID != "00000000-0000-0000-0000-000000000000" {
+		t.Fatalf("Expected SubscriptionID to be %q but got %q", "00000000-0000-0000-0000-000000000000", parsed.SubscriptionID)
+	}
+	if parsed.ResourceGroup != "resourceGroup1" {
+		t.Fatalf("Expected ResourceGroup to be %q but got %q", "resourceGroup1", parsed.ResourceGroup)
+	}
+	if parsed.Name != "name1" {
+		t.Fatalf("Expected Name to be %q but got %q", "name1", parsed.Name)
+	}
+
+	for _, input := range []string{"", "random", id + "/extra/segment", id[:strings.LastIndex(id, "/")]} {
+		if _, err := ParseRecoveryServicesVaultID(input); err == nil {
+			t.Fatalf("Expected %q not to parse", input)
+		}
+		if _, errors := ValidateRecoveryServicesVaultID(input, "id"); len(errors) == 0 {
+			t.Fatalf("Expected %q not to be valid", input)
+		}
+	}
+
+	if _, errors := ValidateRecoveryServicesVaultIDOrEmpty("", "id"); len(errors) > 0 {
+		t.Fatalf("Expected an empty value to be valid but got: %+v", errors)
+	}
+	if _, errors := ValidateRecoveryServicesVaultIDOrEmpty("random", "id"); len(errors) == 0 {
+		t.Fatalf("Expected %q not to be valid", "random")
+	}
+}
+
+func TestRedisCacheID(t *testing.T) {
+	id := NewRedisCacheID("00000000-0000-0000-0000-000000000000", "resourceGroup1", "name1").ID()
+
+	// the ID should round-trip, regardless of the casing of the segments
+	for _, input := range []string{id, strings.ToLower(id), strings.ToUpper(id)} {
+		parsed, err := ParseRedisCacheID(input)
+		if err != nil {
+			t.Fatalf("Expected %q to parse but got: %+v", input, err)
+		}
+		if !strings.EqualFold(parsed.ID(), id) {
+			t.Fatalf("Expected the ID to be %q but got %q", id, parsed.ID())
+		}
+	}
+
+	parsed, err := ParseRedisCacheID(id)
+	if err != nil {
+		t.Fatalf("Expected %q to parse but got: %+v", id, err)
+	}
+	if parsed.SubscriptionID != "00000000-0000-0000-0000-000000000000" {
+		t.Fatalf("Expected SubscriptionID to be %q but got %q", "00000000-0000-0000-0000-000000000000", parsed.SubscriptionID)
+	}
+	if parsed.ResourceGroup != "resourceGroup1" {
+		t.Fatalf("Expected ResourceGroup to be %q but got %q", "resourceGroup1", parsed.ResourceGroup)
+	}
+	if parsed.Name != "name1" {
+		t.Fatalf("Expected Name to be %q but got %q", "name1", parsed.Name)
+	}
+
+	for _, input := range []string{"", "random", id + "/extra/segment", id[:strings.LastIndex(id, "/")]} {
+		if _, err := ParseRedisCacheID(input); err == nil {
+			t.Fatalf("Expected %q not to parse", input)
+		}
+		if _, errors := ValidateRedisCacheID(input, "id"); len(errors) == 0 {
+			t.Fatalf("Expected %q not to be valid", input)
+		}
+	}
+
+	if _, errors := ValidateRedisCacheIDOrEmpty("", "id"); len(errors) > 0 {
+		t.Fatalf("Expected an empty value to be valid but got: %+v", errors)
+	}
+	if _, errors := ValidateRedisCacheIDOrEmpty("random", "id"); len(errors) == 0 {
+		t.Fatalf("Expected %q not to be valid", "random")
+	}
+}
+
+func TestRelayHybridConnectionID(t *testing.T) {
+	id := NewRelayHybridConnectionID("00000000-0000-0000-0000-000000000000", "resourceGroup1", "namespaceName1", "name1").ID()
+
+	// the ID should round-trip, regardless of the casing of the segments
+	for _, input := range []string{id, strings.ToLower(id), strings.ToUpper(id)} {
+		parsed, err := ParseRelayHybridConnectionID(input)
+		if err != nil {
+			t.Fatalf("Expected %q to parse but got: %+v", input, err)
+		}
+		if !strings.EqualFold(parsed.ID(), id) {
+			t.Fatalf("Expected the ID to be %q but got %q", id, parsed.ID())
+		}
+	}
+
+	parsed, err := ParseRelayHybridConnectionID(id)
+	if err != nil {
+		t.Fatalf("Expected %q to parse but got: %+v", id, err)
+	}
+	if parsed.SubscriptionID != "00000000-0000-0000-0000-000000000000" {
+		t.Fatalf("Expected SubscriptionID to be %q but got %q", "00000000-0000-0000-0000-000000000000", parsed.SubscriptionID)
+	}
+	if parsed.ResourceGroup != "resourceGroup1" {
+		t.Fatalf("Expected ResourceGroup to be %q but got %q", "resourceGroup1", parsed.ResourceGroup)
+	}
+	if parsed.NamespaceName != "namespaceName1" {
+		t.Fatalf("Expected NamespaceName to be %q but got %q", "namespaceName1", parsed.NamespaceName)
+	}
+	if parsed.Name != "name1" {
+		t.Fatalf("Expected Name to be %q but got %q", "name1", parsed.Name)
+	}
+
+	for _, input := range []string{"", "random", id + "/extra/segment", id[:strings.LastIndex(id, "/")]} {
+		if _, err := ParseRelayHybridConnectionID(input); err == nil {
+			t.Fatalf("Expected %q not to parse", input)
+		}
+		if _, errors := ValidateRelayHybridConnectionID(input, "id"); len(errors) == 0 {
+			t.Fatalf("Expected %q not to be valid", input)
+		}
+	}
+
+	if _, errors := ValidateRelayHybridConnectionIDOrEmpty("", "id"); len(errors) > 0 {
+		t.Fatalf("Expected an empty value to be valid but got: %+v", errors)
+	}
+	if _, errors := ValidateRelayHybridConnectionIDOrEmpty("random", "id"); len(errors) == 0 {
+		t.Fatalf("Expected %q not to be valid", "random")
+	}
+}
+
+func TestResourceGroupID(t *testing.T) {
+	id := NewResourceGroupID("00000000-0000-0000-0000-000000000000", "name1").ID()
+
+	// the ID should round-trip, regardless of the casing of the segments
+	for _, input := range []string{id, strings.ToLower(id), strings.ToUpper(id)} {
+		parsed, err := ParseResourceGroupID(input)
+		if err != nil {
+			t.Fatalf("Expected %q to parse but got: %+v", input, err)
+		}
+		if !strings.EqualFold(parsed.ID(), id) {
+			t.Fatalf("Expected the ID to be %q but got %q", id, parsed.ID())
+		}
+	}
+
+	parsed, err := ParseResourceGroupID(id)
+	if err != nil {
+		t.Fatalf("Expected %q to parse but got: %+v", id, err)
+	}
+	if parsed.SubscriptionID != "00000000-0000-0000-0000-000000000000" {
+		t.Fatalf("Expected SubscriptionID to be %q but got %q", "00000000-0000-0000-0000-000000000000", parsed.SubscriptionID)
+	}
+	if parsed.Name != "name1" {
+		t.Fatalf("Expected Name to be %q but got %q", "name1", parsed.Name)
+	}
+
+	for _, input := range []string{"", "random", id + "/extra/segment", id[:strings.LastIndex(id, "/")]} {
+		if _, err := ParseResourceGroupID(input); err == nil {
+			t.Fatalf("Expected %q not to parse", input)
+		}
+		if _, errors := ValidateResourceGroupID(input, "id"); len(errors) == 0 {
+			t.Fatalf("Expected %q not to be valid", input)
+		}
+	}
+
+	if _, errors := ValidateResourceGroupIDOrEmpty("", "id"); len(errors) > 0 {
+		t.Fatalf("Expected an empty value to be valid but got: %+v", errors)
+	}
+	if _, errors := ValidateResourceGroupIDOrEmpty("random", "id"); len(errors) == 0 {
+		t.Fatalf("Expected %q not to be valid", "random")
+	}
+}
+
+func TestRouteID(t *testing.T) {
+	id := NewRouteID("00000000-0000-0000-0000-000000000000", "resourceGroup1", "routeTableName1", "name1").ID()
+
+	// the ID should round-trip, regardless of the casing of the segments
+	for _, input := range []string{id, strings.ToLower(id), strings.ToUpper(id)} {
+		parsed, err := ParseRouteID(input)
+		if err != nil {
+			t.Fatalf("Expected %q to parse but got: %+v", input, err)
+		}
+		if !strings.EqualFold(parsed.ID(), id) {
+			t.Fatalf("Expected the ID to be %q but got %q", id, parsed.ID())
+		}
+	}
+
+	parsed, err := ParseRouteID(id)
+	if err != nil {
+		t.Fatalf("Expected %q to parse but got: %+v", id, err)
+	}
+	if parsed.SubscriptionID != "00000000-0000-0000-0000-000000000000" {
+		t.Fatalf("Expected SubscriptionID to be %q but got %q", "00000000-0000-0000-0000-000000000000", parsed.SubscriptionID)
+	}
+	if parsed.ResourceGroup != "resourceGroup1" {
+		t.Fatalf("Expected ResourceGroup to be %q but got %q", "resourceGroup1", parsed.ResourceGroup)
+	}
+	if parsed.RouteTableName != "routeTableName1" {
+		t.Fatalf("Expected RouteTableName to be %q but got %q", "routeTableName1", parsed.RouteTableName)
+	}
+	if parsed.Name != "name1" {
+		t.Fatalf("Expected Name to be %q but got %q", "name1", parsed.Name)
+	}
+
+	for _, input := range []string{"", "random", id + "/extra/segment", id[:strings.LastIndex(id, "/")]} {
+		if _, err := ParseRouteID(input); err == nil {
+			t.Fatalf("Expected %q not to parse", input)
+		}
+		if _, errors := ValidateRouteID(input, "id"); len(errors) == 0 {
+			t.Fatalf("Expected %q not to be valid", input)
+		}
+	}
+
+	if _, errors := ValidateRouteIDOrEmpty("", "id"); len(errors) > 0 {
+		t.Fatalf("Expected an empty value to be valid but got: %+v", errors)
+	}
+	if _, errors := ValidateRouteIDOrEmpty("random", "id"); len(errors) == 0 {
+		t.Fatalf("Expected %q not to be valid", "random")
+	}
+}
+
+func TestRouteTableID(t *testing.T) {
+	id := NewRouteTableID("00000000-0000-0000-0000-000000000000", "resourceGroup1", "name1").ID()
+
+	// the ID should round-trip, regardless of the casing of the segments
+	for _, input := range []string{id, strings.ToLower(id), strings.ToUpper(id)} {
+		parsed, err := ParseRouteTableID(input)
+		if err != nil {
+			t.Fatalf("Expected %q to parse but got: %+v", input, err)
+		}
+		if !strings.EqualFold(parsed.ID(), id) {
+			t.Fatalf("Expected the ID to be %q but got %q", id, parsed.ID())
+		}
+	}
+
+	parsed, err := ParseRouteTableID(id)
+	if err != nil {
+		t.Fatalf("Expected %q to parse but got: %+v", id, err)
+	}
+	if parsed.SubscriptionID != "00000000-0000-0000-0000-000000000000" {
+		t.Fatalf("Expected SubscriptionID to be %q but got %q", "00000000-0000-0000-0000-000000000000", parsed.SubscriptionID)
+	}
+	if parsed.ResourceGroup != "resourceGroup1" {
+		t.Fatalf("Expected ResourceGroup to be %q but got %q", "resourceGroup1", parsed.ResourceGroup)
+	}
+	if parsed.Name != "name1" {
+		t.Fatalf("Expected Name to be %q but got %q", "name1", parsed.Name)
+	}
+
+	for _, input := range []string{"", "random", id + "/extra/segment", id[:strings.LastIndex(id, "/")]} {
+		if _, err := ParseRouteTableID(input); err == nil {
+			t.Fatalf("Expected %q not to parse", input)
+		}
+		if _, errors := ValidateRouteTableID(input, "id"); len(errors) == 0 {
+			t.Fatalf("Expected %q not to be valid", input)
+		}
+	}
+
+	if _, errors := ValidateRouteTableIDOrEmpty("", "id"); len(errors) > 0 {
+		t.Fatalf("Expected an empty value to be valid but got: %+v", errors)
+	}
+	if _, errors := ValidateRouteTableIDOrEmpty("random", "id"); len(errors) == 0 {
+		t.Fatalf("Expected %q not to be valid", "random")
+	}
+}
+
+func TestServiceBusNamespaceID(t *testing.T) {
+	id := NewServiceBusNamespaceID("00000000-0000-0000-0000-000000000000", "resourceGroup1", "name1").ID()
+
+	// the ID should round-trip, regardless of the casing of the segments
+	for _, input := range []string{id, strings.ToLower(id), strings.ToUpper(id)} {
+		parsed, err := ParseServiceBusNamespaceID(input)
+		if err != nil {
+			t.Fatalf("Expected %q to parse but got: %+v", input, err)
+		}
+		if !strings.EqualFold(parsed.ID(), id) {
+			t.Fatalf("Expected the ID to be %q but got %q", id, parsed.ID())
+		}
+	}
+
+	parsed, err := ParseServiceBusNamespaceID(id)
+	if err != nil {
+		t.Fatalf("Expected %q to parse but got: %+v", id, err)
+	}
+	if parsed.SubscriptionID != "00000000-0000-0000-0000-000000000000" {
+		t.Fatalf("Expected SubscriptionID to be %q but got %q", "00000000-0000-0000-0000-000000000000", parsed.SubscriptionID)
+	}
+	if parsed.ResourceGroup != "resourceGroup1" {
+		t.Fatalf("Expected ResourceGroup to be %q but got %q", "resourceGroup1", parsed.ResourceGroup)
+	}
+	if parsed.Name != "name1" {
+		t.Fatalf("Expected Name to be %q but got %q", "name1", parsed.Name)
+	}
+
+	for _, input := range []string{"", "random", id + "/extra/segment", id[:strings.LastIndex(id, "/")]} {
+		if _, err := ParseServiceBusNamespaceID(input); err == nil {
+			t.Fatalf("Expected %q not to parse", input)
+		}
+		if _, errors := ValidateServiceBusNamespaceID(input, "id"); len(errors) == 0 {
+			t.Fatalf("Expected %q not to be valid", input)
+		}
+	}
+
+	if _, errors := ValidateServiceBusNamespaceIDOrEmpty("", "id"); len(errors) > 0 {
+		t.Fatalf("Expected an empty value to be valid but got: %+v", errors)
+	}
+	if _, errors := ValidateServiceBusNamespaceIDOrEmpty("random", "id"); len(errors) == 0 {
+		t.Fatalf("Expected %q not to be valid", "random")
+	}
+}
+
+func TestServiceBusQueueID(t *testing.T) {
+	id := NewServiceBusQueueID("00000000-0000-0000-0000-000000000000", "resourceGroup1", "namespaceName1", "name1").ID()
+
+	// the ID should round-trip, regardless of the casing of the segments
+	for _, input := range []string{id, strings.ToLower(id), strings.ToUpper(id)} {
+		parsed, err := ParseServiceBusQueueID(input)
+		if err != nil {
+			t.Fatalf("Expected %q to parse but got: %+v", input, err)
+		}
+		if !strings.EqualFold(parsed.ID(), id) {
+			t.Fatalf("Expected the ID to be %q but got %q", id, parsed.ID())
+		}
+	}
+
+	parsed, err := ParseServiceBusQueueID(id)
+	if err != nil {
+		t.Fatalf("Expected %q to parse but got: %+v", id, err)
+	}
+	if parsed.SubscriptionID != "00000000-0000-0000-0000-000000000000" {
+		t.Fatalf("Expected SubscriptionID to be %q but got %q", "00000000-0000-0000-0000-000000000000", parsed.SubscriptionID)
+	}
+	if parsed.ResourceGroup != "resourceGroup1" {
+		t.Fatalf("Expected ResourceGroup to be %q but got %q", "resourceGroup1", parsed.ResourceGroup)
+	}
+	if parsed.NamespaceName != "namespaceName1" {
+		t.Fatalf("Expected NamespaceName to be %q but got %q", "namespaceName1", parsed.NamespaceName)
+	}
+	if parsed.Name != "name1" {
+		t.Fatalf("Expected Name to be %q but got %q", "name1", parsed.Name)
+	}
+
+	for _, input := range []string{"", "random", id + "/extra/segment", id[:strings.LastIndex(id, "/")]} {
+		if _, err := ParseServiceBusQueueID(input); err == nil {
+			t.Fatalf("Expected %q not to parse", input)
+		}
+		if _, errors := ValidateServiceBusQueueID(input, "id"); len(errors) == 0 {
+			t.Fatalf("Expected %q not to be valid", input)
+		}
+	}
+
+	if _, errors := ValidateServiceBusQueueIDOrEmpty("", "id"); len(errors) > 0 {
+		t.Fatalf("Expected an empty value to be valid but got: %+v", errors)
+	}
+	if _, errors := ValidateServiceBusQueueIDOrEmpty("random", "id"); len(errors) == 0 {
+		t.Fatalf("Expected %q not to be valid", "random")
+	}
+}
+
+func TestServiceBusTopicID(t *testing.T) {
+	id := NewServiceBusTopicID("00000000-0000-0000-0000-000000000000", "resourceGroup1", "namespaceName1", "name1").ID()
+
+	// the ID should round-trip, regardless of the casing of the segments
+	for _, input := range []string{id, strings.ToLower(id), strings.ToUpper(id)} {
+		parsed, err := ParseServiceBusTopicID(input)
+		if err != nil {
+			t.Fatalf("Expected %q to parse but got: %+v", input, err)
+		}
+		if !strings.EqualFold(parsed.ID(), id) {
+			t.Fatalf("Expected the ID to be %q but got %q", id, parsed.ID())
+		}
+	}
+
+	parsed, err := ParseServiceBusTopicID(id)
+	if err != nil {
+		t.Fatalf("Expected %q to parse but got: %+v", id, err)
+	}
+	if parsed.SubscriptionID != "00000000-0000-0000-0000-000000000000" {
+		t.Fatalf("Expected SubscriptionID to be %q but got %q", "00000000-0000-0000-0000-000000000000", parsed.SubscriptionID)
+	}
+	if parsed.ResourceGroup != "resourceGroup1" {
+		t.Fatalf("Expected ResourceGroup to be %q but got %q", "resourceGroup1", parsed.ResourceGroup)
+	}
+	if parsed.NamespaceName != "namespaceName1" {
+		t.Fatalf("Expected NamespaceName to be %q but got %q", "namespaceName1", parsed.NamespaceName)
+	}
+	if parsed.Name != "name1" {
+		t.Fatalf("Expected Name to be %q but got %q", "name1", parsed.Name)
+	}
+
+	for _, input := range []string{"", "random", id + "/extra/segment", id[:strings.LastIndex(id, "/")]} {
+		if _, err := ParseServiceBusTopicID(input); err == nil {
+			t.Fatalf("Expected %q not to parse", input)
+		}
+		if _, errors := ValidateServiceBusTopicID(input, "id"); len(errors) == 0 {
+			t.Fatalf("Expected %q not to be valid", input)
+		}
+	}
+
+	if _, errors := ValidateServiceBusTopicIDOrEmpty("", "id"); len(errors) > 0 {
+		t.Fatalf("Expected an empty value to be valid but got: %+v", errors)
+	}
+	if _, errors := ValidateServiceBusTopicIDOrEmpty("random", "id"); len(errors) == 0 {
+		t.Fatalf("Expected %q not to be valid", "random")
+	}
+}
+
+func TestSnapshotID(t *testing.T) {
+	id := NewSnapshotID("00000000-0000-0000-0000-000000000000", "resourceGroup1", "name1").ID()
+
+	// the ID should round-trip, regardless of the casing of the segments
+	for _, input := range []string{id, strings.ToLower(id), strings.ToUpper(id)} {
+		parsed, err := ParseSnapshotID(input)
+		if err != nil {
+			t.Fatalf("Expected %q to parse but got: %+v", input, err)
+		}
+		if !strings.EqualFold(parsed.ID(), id) {
+			t.Fatalf("Expected the ID to be %q but got %q", id, parsed.ID())
+		}
+	}
+
+	parsed, err := ParseSnapshotID(id)
+	if err != nil {
+		t.Fatalf("Expected %q to parse but got: %+v", id, err)
+	}
+	if parsed.SubscriptionID != "00000000-0000-0000-0000-000000000000" {
+		t.Fatalf("Expected SubscriptionID to be %q but got %q", "00000000-0000-0000-0000-000000000000", parsed.SubscriptionID)
+	}
+	if parsed.ResourceGroup != "resourceGroup1" {
+		t.Fatalf("Expected ResourceGroup to be %q but got %q", "resourceGroup1", parsed.ResourceGroup)
+	}
+	if parsed.Name != "name1" {
+		t.Fatalf("Expected Name to be %q but got %q", "name1", parsed.Name)
+	}
+
+	for _, input := range []string{"", "random", id + "/extra/segment", id[:strings.LastIndex(id, "/")]} {
+		if _, err := ParseSnapshotID(input); err == nil {
+			t.Fatalf("Expected %q not to parse", input)
+		}
+		if _, errors := ValidateSnapshotID(input, "id"); len(errors) == 0 {
+			t.Fatalf("Expected %q not to be valid", input)
+		}
+	}
+
+	if _, errors := ValidateSnapshotIDOrEmpty("", "id"); len(errors) > 0 {
+		t.Fatalf("Expected an empty value to be valid but got: %+v", errors)
+	}
+	if _, errors := ValidateSnapshotIDOrEmpty("random", "id"); len(errors) == 0 {
+		t.Fatalf("Expected %q not to be valid", "random")
+	}
+}
+
+func TestSqlDatabaseID(t *testing.T) {
+	id := NewSqlDatabaseID("00000000-0000-0000-0000-000000000000", "resourceGroup1", "serverName1", "name1").ID()
+
+	// the ID should round-trip, regardless of the casing of the segments
+	for _, input := range []string{id, strings.ToLower(id), strings.ToUpper(id)} {
+		parsed, err := ParseSqlDatabaseID(input)
+		if err != nil {
+			t.Fatalf("Expected %q to parse but got: %+v", input, err)
+		}
+		if !strings.EqualFold(parsed.ID(), id) {
+			t.Fatalf("Expected the ID to be %q but got %q", id, parsed.ID())
+		}
+	}
+
+	parsed, err := ParseSqlDatabaseID(id)
+	if err != nil {
+		t.Fatalf("Expected %q to parse but got: %+v", id, err)
+	}
+	if parsed.SubscriptionID != "00000000-0000-0000-0000-000000000000" {
+		t.Fatalf("Expected SubscriptionID to be %q but got %q", "00000000-0000-0000-0000-000000000000", parsed.SubscriptionID)
+	}
+	if parsed.ResourceGroup != "resourceGroup1" {
+		t.Fatalf("Expected ResourceGroup to be %q but got %q", "resourceGroup1", parsed.ResourceGroup)
+	}
+	if parsed.ServerName != "serverName1" {
+		t.Fatalf("Expected ServerName to be %q but got %q", "serverName1", parsed.ServerName)
+	}
+	if parsed.Name != "name1" {
+		t.Fatalf("Expected Name to be %q but got %q", "name1", parsed.Name)
+	}
+
+	for _, input := range []string{"", "random", id + "/extra/segment", id[:strings.LastIndex(id, "/")]} {
+		if _, err := ParseSqlDatabaseID(input); err == nil {
+			t.Fatalf("Expected %q not to parse", input)
+		}
+		if _, errors := ValidateSqlDatabaseID(input, "id"); len(errors) == 0 {
+			t.Fatalf("Expected %q not to be valid", input)
+		}
+	}
+
+	if _, errors := ValidateSqlDatabaseIDOrEmpty("", "id"); len(errors) > 0 {
+		t.Fatalf("Expected an empty value to be valid but got: %+v", errors)
+	}
+	if _, errors := ValidateSqlDatabaseIDOrEmpty("random", "id"); len(errors) == 0 {
+		t.Fatalf("Expected %q not to be valid", "random")
+	}
+}
+
+func TestSqlServerID(t *testing.T) {
+	id := NewSqlServerID("00000000-0000-0000-0000-000000000000", "resourceGroup1", "name1").ID()
+
+	// the ID should round-trip, regardless of the casing of the segments
+	for _, input := range []string{id, strings.ToLower(id), strings.ToUpper(id)} {
+		parsed, err := ParseSqlServerID(input)
+		if err != nil {
+			t.Fatalf("Expected %q to parse but got: %+v", input, err)
+		}
+		if !strings.EqualFold(parsed.ID(), id) {
+			t.Fatalf("Expected the ID to be %q but got %q", id, parsed.ID())
+		}
+	}
+
+	parsed, err := ParseSqlServerID(id)
+	if err != nil {
+		t.Fatalf("Expected %q to parse but got: %+v", id, err)
+	}
+	if parsed.SubscriptionID != "00000000-0000-0000-0000-000000000000" {
+		t.Fatalf("Expected SubscriptionID to be %q but got %q", "00000000-0000-0000-0000-000000000000", parsed.SubscriptionID)
+	}
+	if parsed.ResourceGroup != "resourceGroup1" {
+		t.Fatalf("Expected ResourceGroup to be %q but got %q", "resourceGroup1", parsed.ResourceGroup)
+	}
+	if parsed.Name != "name1" {
+		t.Fatalf("Expected Name to be %q but got %q", "name1", parsed.Name)
+	}
+
+	for _, input := range []string{"", "random", id + "/extra/segment", id[:strings.LastIndex(id, "/")]} {
+		if _, err := ParseSqlServerID(input); err == nil {
+			t.Fatalf("Expected %q not to parse", input)
+		}
+		if _, errors := ValidateSqlServerID(input, "id"); len(errors) == 0 {
+			t.Fatalf("Expected %q not to be valid", input)
+		}
+	}
+
+	if _, errors := ValidateSqlServerIDOrEmpty("", "id"); len(errors) > 0 {
+		t.Fatalf("Expected an empty value to be valid but got: %+v", errors)
+	}
+	if _, errors := ValidateSqlServerIDOrEmpty("random", "id"); len(errors) == 0 {
+		t.Fatalf("Expected %q not to be valid", "random")
+	}
+}
+
+func TestStorageAccountID(t *testing.T) {
+	id := NewStorageAccountID("00000000-0000-0000-0000-000000000000", "resourceGroup1", "name1").ID()
+
+	// the ID should round-trip, regardless of the casing of the segments
+	for _, input := range []string{id, strings.ToLower(id), strings.ToUpper(id)} {
+		parsed, err := ParseStorageAccountID(input)
+		if err != nil {
+			t.Fatalf("Expected %q to parse but got: %+v", input, err)
+		}
+		if !strings.EqualFold(parsed.ID(), id) {
+			t.Fatalf("Expected the ID to be %q but got %q", id, parsed.ID())
+		}
+	}
+
+	parsed, err := ParseStorageAccountID(id)
+	if err != nil {
+		t.Fatalf("Expected %q to parse but got: %+v", id, err)
+	}
+	if parsed.SubscriptionID != "00000000-0000-0000-0000-000000000000" {
+		t.Fatalf("Expected SubscriptionID to be %q but got %q", "00000000-0000-0000-0000-000000000000", parsed.SubscriptionID)
+	}
+	if parsed.ResourceGroup != "resourceGroup1" {
+		t.Fatalf("Expected ResourceGroup to be %q but got %q", "resourceGroup1", parsed.ResourceGroup)
+	}
+	if parsed.Name != "name1" {
+		t.Fatalf("Expected Name to be %q but got %q", "name1", parsed.Name)
+	}
+
+	for _, input := range []string{"", "random", id + "/extra/segment", id[:strings.LastIndex(id, "/")]} {
+		if _, err := ParseStorageAccountID(input); err == nil {
+			t.Fatalf("Expected %q not to parse", input)
+		}
+		if _, errors := ValidateStorageAccountID(input, "id"); len(errors) == 0 {
+			t.Fatalf("Expected %q not to be valid", input)
+		}
+	}
+
+	if _, errors := ValidateStorageAccountIDOrEmpty("", "id"); len(errors) > 0 {
+		t.Fatalf("Expected an empty value to be valid but got: %+v", errors)
+	}
+	if _, errors := ValidateStorageAccountIDOrEmpty("random", "id"); len(errors) == 0 {
+		t.Fatalf("Expected %q not to be valid", "random")
+	}
+}
+
+func TestStorageManagementPolicyID(t *testing.T) {
+	id := NewStorageManagementPolicyID("00000000-0000-0000-0000-000000000000", "resourceGroup1", "accountName1", "name1").ID()
+
+	// the ID should round-trip, regardless of the casing of the segments
+	for _, input := range []string{id, strings.ToLower(id), strings.ToUpper(id)} {
+		parsed, err := ParseStorageManagementPolicyID(input)
+		if err != nil {
+			t.Fatalf("Expected %q to parse but got: %+v", input, err)
+		}
+		if !strings.EqualFold(parsed.ID(), id) {
+			t.Fatalf("Expected the ID to be %q but got %q", id, parsed.ID())
+		}
+	}
+
+	parsed, err := ParseStorageManagementPolicyID(id)
+	if err != nil {
+		t.Fatalf("Expected %q to parse but got: %+v", id, err)
+	}
+	if parsed.SubscriptionID != "00000000-0000-0000-0000-000000000000" {
+		t.Fatalf("Expected SubscriptionID to be %q but got %q", "00000000-0000-0000-0000-000000000000", parsed.SubscriptionID)
+	}
+	if parsed.ResourceGroup != "resourceGroup1" {
+		t.Fatalf("Expected ResourceGroup to be %q but got %q", "resourceGroup1", parsed.ResourceGroup)
+	}
+	if parsed.AccountName != "accountName1" {
+		t.Fatalf("Expected AccountName to be %q but got %q", "accountName1", parsed.AccountName)
+	}
+	if parsed.Name != "name1" {
+		t.Fatalf("Expected Name to be %q but got %q", "name1", parsed.Name)
+	}
+
+	for _, input := range []string{"", "random", id + "/extra/segment", id[:strings.LastIndex(id, "/")]} {
+		if _, err := ParseStorageManagementPolicyID(input); err == nil {
+			t.Fatalf("Expected %q not to parse", input)
+		}
+		if _, errors := ValidateStorageManagementPolicyID(input, "id"); len(errors) == 0 {
+			t.Fatalf("Expected %q not to be valid", input)
+		}
+	}
+
+	if _, errors := ValidateStorageManagementPolicyIDOrEmpty("", "id"); len(errors) > 0 {
+		t.Fatalf("Expected an empty value to be valid but got: %+v", errors)
+	}
+	if _, errors := ValidateStorageManagementPolicyIDOrEmpty("random", "id"); len(errors) == 0 {
+		t.Fatalf("Expected %q not to be valid", "random")
+	}
+}
+
+func TestSubnetID(t *testing.T) {
+	id := NewSubnetID("00000000-0000-0000-0000-000000000000", "resourceGroup1", "virtualNetworkName1", "name1").ID()
+
+	// the ID should round-trip, regardless of the casing of the segments
+	for _, input := range []string{id, strings.ToLower(id), strings.ToUpper(id)} {
+		parsed, err := ParseSubnetID(input)
+		if err != nil {
+			t.Fatalf("Expected %q to parse but got: %+v", input, err)
+		}
+		if !strings.EqualFold(parsed.ID(), id) {
+			t.Fatalf("Expected the ID to be %q but got %q", id, parsed.ID())
+		}
+	}
+
+	parsed, err := ParseSubnetID(id)
+	if err != nil {
+		t.Fatalf("Expected %q to parse but got: %+v", id, err)
+	}
+	if parsed.SubscriptionID != "00000000-0000-0000-0000-000000000000" {
+		t.Fatalf("Expected SubscriptionID to be %q but got %q", "00000000-0000-0000-0000-000000000000", parsed.SubscriptionID)
+	}
+	if parsed.ResourceGroup != "resourceGroup1" {
+		t.Fatalf("Expected ResourceGroup to be %q but got %q", "resourceGroup1", parsed.ResourceGroup)
+	}
+	if parsed.VirtualNetworkName != "virtualNetworkName1" {
+		t.Fatalf("Expected VirtualNetworkName to be %q but got %q", "virtualNetworkName1", parsed.VirtualNetworkName)
+	}
+	if parsed.Name != "name1" {
+		t.Fatalf("Expected Name to be %q but got %q", "name1", parsed.Name)
+	}
+
+	for _, input := range []string{"", "random", id + "/extra/segment", id[:strings.LastIndex(id, "/")]} {
+		if _, err := ParseSubnetID(input); err == nil {
+			t.Fatalf("Expected %q not to parse", input)
+		}
+		if _, errors := ValidateSubnetID(input, "id"); len(errors) == 0 {
+			t.Fatalf("Expected %q not to be valid", input)
+		}
+	}
+
+	if _, errors := ValidateSubnetIDOrEmpty("", "id"); len(errors) > 0 {
+		t.Fatalf("Expected an empty value to be valid but got: %+v", errors)
+	}
+	if _, errors := ValidateSubnetIDOrEmpty("random", "id"); len(errors) == 0 {
+		t.Fatalf("Expected %q not to be valid", "random")
+	}
+}
+
+func TestSubscriptionTemplateDeploymentID(t *testing.T) {
+	id := NewSubscriptionTemplateDeploymentID("00000000-0000-0000-0000-000000000000", "name1").ID()
+
+	// the ID should round-trip, regardless of the casing of the segments
+	for _, input := range []string{id, strings.ToLower(id), strings.ToUpper(id)} {
+		parsed, err := ParseSubscriptionTemplateDeploymentID(input)
+		if err != nil {
+			t.Fatalf("Expected %q to parse but got: %+v", input, err)
+		}
+		if !strings.EqualFold(parsed.ID(), id) {
+			t.Fatalf("Expected the ID to be %q but got %q", id, parsed.ID())
+		}
+	}
+
+	parsed, err := ParseSubscriptionTemplateDeploymentID(id)
+	if err != nil {
+		t.Fatalf("Expected %q to parse but got: %+v", id, err)
+	}
+	if parsed.SubscriptionID != "00000000-0000-0000-0000-000000000000" {
+		t.Fatalf("Expected SubscriptionID to be %q but got %q", "00000000-0000-0000-0000-000000000000", parsed.SubscriptionID)
+	}
+	if parsed.Name != "name1" {
+		t.Fatalf("Expected Name to be %q but got %q", "name1", parsed.Name)
+	}
+
+	for _, input := range []string{"", "random", id + "/extra/segment", id[:strings.LastIndex(id, "/")]} {
+		if _, err := ParseSubscriptionTemplateDeploymentID(input); err == nil {
+			t.Fatalf("Expected %q not to parse", input)
+		}
+		if _, errors := ValidateSubscriptionTemplateDeploymentID(input, "id"); len(errors) == 0 {
+			t.Fatalf("Expected %q not to be valid", input)
+		}
+	}
+
+	if _, errors := ValidateSubscriptionTemplateDeploymentIDOrEmpty("", "id"); len(errors) > 0 {
+		t.Fatalf("Expected an empty value to be valid but got: %+v", errors)
+	}
+	if _, errors := ValidateSubscriptionTemplateDeploymentIDOrEmpty("random", "id"); len(errors) == 0 {
+		t.Fatalf("Expected %q not to be valid", "random")
+	}
+}
+
+func TestTemplateDeploymentID(t *testing.T) {
+	id := NewTemplateDeploymentID("00000000-0000-0000-0000-000000000000", "resourceGroup1", "name1").ID()
+
+	// the ID should round-trip, regardless of the casing of the segments
+	for _, input := range []string{id, strings.ToLower(id), strings.ToUpper(id)} {
+		parsed, err := ParseTemplateDeploymentID(input)
+		if err != nil {
+			t.Fatalf("Expected %q to parse but got: %+v", input, err)
+		}
+		if !strings.EqualFold(parsed.ID(), id) {
+			t.Fatalf("Expected the ID to be %q but got %q", id, parsed.ID())
+		}
+	}
+
+	parsed, err := ParseTemplateDeploymentID(id)
+	if err != nil {
+		t.Fatalf("Expected %q to parse but got: %+v", id, err)
+	}
+	if parsed.SubscriptionID != "00000000-0000-0000-0000-000000000000" {
+		t.Fatalf("Expected SubscriptionID to be %q but got %q", "00000000-0000-0000-0000-000000000000", parsed.SubscriptionID)
+	}
+	if parsed.ResourceGroup != "resourceGroup1" {
+		t.Fatalf("Expected ResourceGroup to be %q but got %q", "resourceGroup1", parsed.ResourceGroup)
+	}
+	if parsed.Name != "name1" {
+		t.Fatalf("Expected Name to be %q but got %q", "name1", parsed.Name)
+	}
+
+	for _, input := range []string{"", "random", id + "/extra/segment", id[:strings.LastIndex(id, "/")]} {
+		if _, err := ParseTemplateDeploymentID(input); err == nil {
+			t.Fatalf("Expected %q not to parse", input)
+		}
+		if _, errors := ValidateTemplateDeploymentID(input, "id"); len(errors) == 0 {
+			t.Fatalf("Expected %q not to be valid", input)
+		}
+	}
+
+	if _, errors := ValidateTemplateDeploymentIDOrEmpty("", "id"); len(errors) > 0 {
+		t.Fatalf("Expected an empty value to be valid but got: %+v", errors)
+	}
+	if _, errors := ValidateTemplateDeploymentIDOrEmpty("random", "id"); len(errors) == 0 {
+		t.Fatalf("Expected %q not to be valid", "random")
+	}
+}
+
+func TestUserAssignedIdentityID(t *testing.T) {
+	id := NewUserAssignedIdentityID("00000000-0000-0000-0000-000000000000", "resourceGroup1", "name1").ID()
+
+	// the ID should round-trip, regardless of the casing of the segments
+	for _, input := range []string{id, strings.ToLower(id), strings.ToUpper(id)} {
+		parsed, err := ParseUserAssignedIdentityID(input)
+		if err != nil {
+			t.Fatalf("Expected %q to parse but got: %+v", input, err)
+		}
+		if !strings.EqualFold(parsed.ID(), id) {
+			t.Fatalf("Expected the ID to be %q but got %q", id, parsed.ID())
+		}
+	}
+
+	parsed, err := ParseUserAssignedIdentityID(id)
+	if err != nil {
+		t.Fatalf("Expected %q to parse but got: %+v", id, err)
+	}
+	if parsed.SubscriptionID != "00000000-0000-0000-0000-000000000000" {
+		t.Fatalf("Expected SubscriptionID to be %q but got %q", "00000000-0000-0000-0000-000000000000", parsed.SubscriptionID)
+	}
+	if parsed.ResourceGroup != "resourceGroup1" {
+		t.Fatalf("Expected ResourceGroup to be %q but got %q", "resourceGroup1", parsed.ResourceGroup)
+	}
+	if parsed.Name != "name1" {
+		t.Fatalf("Expected Name to be %q but got %q", "name1", parsed.Name)
+	}
+
+	for _, input := range []string{"", "random", id + "/extra/segment", id[:strings.LastIndex(id, "/")]} {
+		if _, err := ParseUserAssignedIdentityID(input); err == nil {
+			t.Fatalf("Expected %q not to parse", input)
+		}
+		if _, errors := ValidateUserAssignedIdentityID(input, "id"); len(errors) == 0 {
+			t.Fatalf("Expected %q not to be valid", input)
+		}
+	}
+
+	if _, errors := ValidateUserAssignedIdentityIDOrEmpty("", "id"); len(errors) > 0 {
+		t.Fatalf("Expected an empty value to be valid but got: %+v", errors)
+	}
+	if _, errors := ValidateUserAssignedIdentityIDOrEmpty("random", "id"); len(errors) == 0 {
+		t.Fatalf("Expected %q not to be valid", "random")
+	}
+}
+
+func TestVirtualMachineID(t *testing.T) {
+	id := NewVirtualMachineID("00000000-0000-0000-0000-000000000000", "resourceGroup1", "name1").ID()
+
+	// the ID should round-trip, regardless of the casing of the segments
+	for _, input := range []string{id, strings.ToLower(id), strings.ToUpper(id)} {
+		parsed, err := ParseVirtualMachineID(input)
+		if err != nil {
+			t.Fatalf("Expected %q to parse but got: %+v", input, err)
+		}
+		if !strings.EqualFold(parsed.ID(), id) {
+			t.Fatalf("Expected the ID to be %q but got %q", id, parsed.ID())
+		}
+	}
+
+	parsed, err := ParseVirtualMachineID(id)
+	if err != nil {
+		t.Fatalf("Expected %q to parse but got: %+v", id, err)
+	}
+	if parsed.SubscriptionID != "00000000-0000-0000-0000-000000000000" {
+		t.Fatalf("Expected SubscriptionID to be %q but got %q", "00000000-0000-0000-0000-000000000000", parsed.SubscriptionID)
+	}
+	if parsed.ResourceGroup != "resourceGroup1" {
+		t.Fatalf("Expected ResourceGroup to be %q but got %q", "resourceGroup1", parsed.ResourceGroup)
+	}
+	if parsed.Name != "name1" {
+		t.Fatalf("Expected Name to be %q but got %q", "name1", parsed.Name)
+	}
+
+	for _, input := range []string{"", "random", id + "/extra/segment", id[:strings.LastIndex(id, "/")]} {
+		if _, err := ParseVirtualMachineID(input); err == nil {
+			t.Fatalf("Expected %q not to parse", input)
+		}
+		if _, errors := ValidateVirtualMachineID(input, "id"); len(errors) == 0 {
+			t.Fatalf("Expected %q not to be valid", input)
+		}
+	}
+
+	if _, errors := ValidateVirtualMachineIDOrEmpty("", "id"); len(errors) > 0 {
+		t.Fatalf("Expected an empty value to be valid but got: %+v", errors)
+	}
+	if _, errors := ValidateVirtualMachineIDOrEmpty("random", "id"); len(errors) == 0 {
+		t.Fatalf("Expected %q not to be valid", "random")
+	}
+}
+
+func TestVirtualMachineScaleSetID(t *testing.T) {
+	id := NewVirtualMachineScaleSetID("00000000-0000-0000-0000-000000000000", "resourceGroup1", "name1").ID()
+
+	// the ID should round-trip, regardless of the casing of the segments
+	for _, input := range []string{id, strings.ToLower(id), strings.ToUpper(id)} {
+		parsed, err := ParseVirtualMachineScaleSetID(input)
+		if err != nil {
+			t.Fatalf("Expected %q to parse but got: %+v", input, err)
+		}
+		if !strings.EqualFold(parsed.ID(), id) {
+			t.Fatalf("Expected the ID to be %q but got %q", id, parsed.ID())
+		}
+	}
+
+	parsed, err := ParseVirtualMachineScaleSetID(id)
+	if err != nil {
+		t.Fatalf("Expected %q to parse but got: %+v", id, err)
+	}
+	if parsed.SubscriptionID != "00000000-0000-0000-0000-000000000000" {
+		t.Fatalf("Expected SubscriptionID to be %q but got %q", "00000000-0000-0000-0000-000000000000", parsed.SubscriptionID)
+	}
+	if parsed.ResourceGroup != "resourceGroup1" {
+		t.Fatalf("Expected ResourceGroup to be %q but got %q", "resourceGroup1", parsed.ResourceGroup)
+	}
+	if parsed.Name != "name1" {
+		t.Fatalf("Expected Name to be %q but got %q", "name1", parsed.Name)
+	}
+
+	for _, input := range []string{"", "random", id + "/extra/segment", id[:strings.LastIndex(id, "/")]} {
+		if _, err := ParseVirtualMachineScaleSetID(input); err == nil {
+			t.Fatalf("Expected %q not to parse", input)
+		}
+		if _, errors := ValidateVirtualMachineScaleSetID(input, "id"); len(errors) == 0 {
+			t.Fatalf("Expected %q not to be valid", input)
+		}
+	}
+
+	if _, errors := ValidateVirtualMachineScaleSetIDOrEmpty("", "id"); len(errors) > 0 {
+		t.Fatalf("Expected an empty value to be valid but got: %+v", errors)
+	}
+	if _, errors := ValidateVirtualMachineScaleSetIDOrEmpty("random", "id"); len(errors) == 0 {
+		t.Fatalf("Expected %q not to be valid", "random")
+	}
+}
+
+func TestVirtualMachineScaleSetInstanceID(t *testing.T) {
+	id := NewVirtualMachineScaleSetInstanceID("00000000-0000-0000-0000-000000000000", "resourceGroup1", "scaleSetName1", "instanceId1").ID()
+
+	// the ID should round-trip, regardless of the casing of the segments
+	for _, input := range []string{id, strings.ToLower(id), strings.ToUpper(id)} {
+		parsed, err := ParseVirtualMachineScaleSetInstanceID(input)
+		if err != nil {
+			t.Fatalf("Expected %q to parse but got: %+v", input, err)
+		}
+		if !strings.EqualFold(parsed.ID(), id) {
+			t.Fatalf("Expected the ID to be %q but got %q", id, parsed.ID())
+		}
+	}
+
+	parsed, err := ParseVirtualMachineScaleSetInstanceID(id)
+	if err != nil {
+		t.Fatalf("Expected %q to parse but got: %+v", id, err)
+	}
+	if parsed.SubscriptionID != "00000000-0000-0000-0000-000000000000" {
+		t.Fatalf("Expected SubscriptionID to be %q but got %q", "00000000-0000-0000-0000-000000000000", parsed.SubscriptionID)
+	}
+	if parsed.ResourceGroup != "resourceGroup1" {
+		t.Fatalf("Expected ResourceGroup to be %q but got %q", "resourceGroup1", parsed.ResourceGroup)
+	}
+	if parsed.ScaleSetName != "scaleSetName1" {
+		t.Fatalf("Expected ScaleSetName to be %q but got %q", "scaleSetName1", parsed.ScaleSetName)
+	}
+	if parsed.InstanceId != "instanceId1" {
+		t.Fatalf("Expected InstanceId to be %q but got %q", "instanceId1", parsed.InstanceId)
+	}
+
+	for _, input := range []string{"", "random", id + "/extra/segment", id[:strings.LastIndex(id, "/")]} {
+		if _, err := ParseVirtualMachineScaleSetInstanceID(input); err == nil {
+			t.Fatalf("Expected %q not to parse", input)
+		}
+		if _, errors := ValidateVirtualMachineScaleSetInstanceID(input, "id"); len(errors) == 0 {
+			t.Fatalf("Expected %q not to be valid", input)
+		}
+	}
+
+	if _, errors := ValidateVirtualMachineScaleSetInstanceIDOrEmpty("", "id"); len(errors) > 0 {
+		t.Fatalf("Expected an empty value to be valid but got: %+v", errors)
+	}
+	if _, errors := ValidateVirtualMachineScaleSetInstanceIDOrEmpty("random", "id"); len(errors) == 0 {
+		t.Fatalf("Expected %q not to be valid", "random")
+	}
+}
+
+func TestVirtualNetworkID(t *testing.T) {
+	id := NewVirtualNetworkID("00000000-0000-0000-0000-000000000000", "resourceGroup1", "name1").ID()
+
+	// the ID should round-trip, regardless of the casing of the segments
+	for _, input := range []string{id, strings.ToLower(id), strings.ToUpper(id)} {
+		parsed, err := ParseVirtualNetworkID(input)
+		if err != nil {
+			t.Fatalf("Expected %q to parse but got: %+v", input, err)
+		}
+		if !strings.EqualFold(parsed.ID(), id) {
+			t.Fatalf("Expected the ID to be %q but got %q", id, parsed.ID())
+		}
+	}
+
+	parsed, err := ParseVirtualNetworkID(id)
+	if err != nil {
+		t.Fatalf("Expected %q to parse but got: %+v", id, err)
+	}
+	if parsed.SubscriptionID != "00000000-0000-0000-0000-000000000000" {
+		t.Fatalf("Expected SubscriptionID to be %q but got %q", "00000000-0000-0000-0000-000000000000", parsed.SubscriptionID)
+	}
+	if parsed.ResourceGroup != "resourceGroup1" {
+		t.Fatalf("Expected ResourceGroup to be %q but got %q", "resourceGroup1", parsed.ResourceGroup)
+	}
+	if parsed.Name != "name1" {
+		t.Fatalf("Expected Name to be %q but got %q", "name1", parsed.Name)
+	}
+
+	for _, input := range []string{"", "random", id + "/extra/segment", id[:strings.LastIndex(id, "/")]} {
+		if _, err := ParseVirtualNetworkID(input); err == nil {
+			t.Fatalf("Expected %q not to parse", input)
+		}
+		if _, errors := ValidateVirtualNetworkID(input, "id"); len(errors) == 0 {
+			t.Fatalf("Expected %q not to be valid", input)
+		}
+	}
+
+	if _, errors := ValidateVirtualNetworkIDOrEmpty("", "id"); len(errors) > 0 {
+		t.Fatalf("Expected an empty value to be valid but got: %+v", errors)
+	}
+	if _, errors := ValidateVirtualNetworkIDOrEmpty("random", "id"); len(errors) == 0 {
+		t.Fatalf("Expected %q not to be valid", "random")
+	}
+}
+
+func TestVirtualNetworkGatewayID(t *testing.T) {
+	id := NewVirtualNetworkGatewayID("00000000-0000-0000-0000-000000000000", "resourceGroup1", "name1").ID()
+
+	// the ID should round-trip, regardless of the casing of the segments
+	for _, input := range []string{id, strings.ToLower(id), strings.ToUpper(id)} {
+		parsed, err := ParseVirtualNetworkGatewayID(input)
+		if err != nil {
+			t.Fatalf("Expected %q to parse but got: %+v", input, err)
+		}
+		if !strings.EqualFold(parsed.ID(), id) {
+			t.Fatalf("Expected the ID to be %q but got %q", id, parsed.ID())
+		}
+	}
+
+	parsed, err := ParseVirtualNetworkGatewayID(id)
+	if err != nil {
+		t.Fatalf("Expected %q to parse but got: %+v", id, err)
+	}
+	if parsed.SubscriptionID != "00000000-0000-0000-0000-000000000000" {
+		t.Fatalf("Expected SubscriptionID to be %q but got %q", "00000000-0000-0000-0000-000000000000", parsed.SubscriptionID)
+	}
+	if parsed.ResourceGroup != "resourceGroup1" {
+		t.Fatalf("Expected ResourceGroup to be %q but got %q", "resourceGroup1", parsed.ResourceGroup)
+	}
+	if parsed.Name != "name1" {
+		t.Fatalf("Expected Name to be %q but got %q", "name1", parsed.Name)
+	}
+
+	for _, input := range []string{"", "random", id + "/extra/segment", id[:strings.LastIndex(id, "/")]} {
+		if _, err := ParseVirtualNetworkGatewayID(input); err == nil {
+			t.Fatalf("Expected %q not to parse", input)
+		}
+		if _, errors := ValidateVirtualNetworkGatewayID(input, "id"); len(errors) == 0 {
+			t.Fatalf("Expected %q not to be valid", input)
+		}
+	}
+
+	if _, errors := ValidateVirtualNetworkGatewayIDOrEmpty("", "id"); len(errors) > 0 {
+		t.Fatalf("Expected an empty value to be valid but got: %+v", errors)
+	}
+	if _, errors := ValidateVirtualNetworkGatewayIDOrEmpty("random", "id"); len(errors) == 0 {
+		t.Fatalf("Expected %q not to be valid", "random")
+	}
+}
+
+func TestVirtualNetworkGatewayConnectionID(t *testing.T) {
+	id := NewVirtualNetworkGatewayConnectionID("00000000-0000-0000-0000-000000000000", "resourceGroup1", "name1").ID()
+
+	// the ID should round-trip, regardless of the casing of the segments
+	for _, input := range []string{id, strings.ToLower(id), strings.ToUpper(id)} {
+		parsed, err := ParseVirtualNetworkGatewayConnectionID(input)
+		if err != nil {
+			t.Fatalf("Expected %q to parse but got: %+v", input, err)
+		}
+		if !strings.EqualFold(parsed.ID(), id) {
+			t.Fatalf("Expected the ID to be %q but got %q", id, parsed.ID())
+		}
+	}
+
+	parsed, err := ParseVirtualNetworkGatewayConnectionID(id)
+	if err != nil {
+		t.Fatalf("Expected %q to parse but got: %+v", id, err)
+	}
+	if parsed.SubscriptionID != "00000000-0000-0000-0000-000000000000" {
+		t.Fatalf("Expected SubscriptionID to be %q but got %q", "00000000-0000-0000-0000-000000000000", parsed.SubscriptionID)
+	}
+	if parsed.ResourceGroup != "resourceGroup1" {
+		t.Fatalf("Expected ResourceGroup to be %q but got %q", "resourceGroup1", parsed.ResourceGroup)
+	}
+	if parsed.Name != "name1" {
+		t.Fatalf("Expected Name to be %q but got %q", "name1", parsed.Name)
+	}
+
+	for _, input := range []string{"", "random", id + "/extra/segment", id[:strings.LastIndex(id, "/")]} {
+		if _, err := ParseVirtualNetworkGatewayConnectionID(input); err == nil {
+			t.Fatalf("Expected %q not to parse", input)
+		}
+		if _, errors := ValidateVirtualNetworkGatewayConnectionID(input, "id"); len(errors) == 0 {
+			t.Fatalf("Expected %q not to be valid", input)
+		}
+	}
+
+	if _, errors := ValidateVirtualNetworkGatewayConnectionIDOrEmpty("", "id"); len(errors) > 0 {
+		t.Fatalf("Expected an empty value to be valid but got: %+v", errors)
+	}
+	if _, errors := ValidateVirtualNetworkGatewayConnectionIDOrEmpty("random", "id"); len(errors) == 0 {
+		t.Fatalf("Expected %q not to be valid", "random")
+	}
+}
+
+func TestVirtualNetworkPeeringID(t *testing.T) {
+	id := NewVirtualNetworkPeeringID("00000000-0000-0000-0000-000000000000", "resourceGroup1", "virtualNetworkName1", "name1").ID()
+
+	// the ID should round-trip, regardless of the casing of the segments
+	for _, input := range []string{id, strings.ToLower(id), strings.ToUpper(id)} {
+		parsed, err := ParseVirtualNetworkPeeringID(input)
+		if err != nil {
+			t.Fatalf("Expected %q to parse but got: %+v", input, err)
+		}
+		if !strings.EqualFold(parsed.ID(), id) {
+			t.Fatalf("Expected the ID to be %q but got %q", id, parsed.ID())
+		}
+	}
+
+	parsed, err := ParseVirtualNetworkPeeringID(id)
+	if err != nil {
+		t.Fatalf("Expected %q to parse but got: %+v", id, err)
+	}
+	if parsed.SubscriptionID != "00000000-0000-0000-0000-000000000000" {
+		t.Fatalf("Expected SubscriptionID to be %q but got %q", "00000000-0000-0000-0000-000000000000", parsed.SubscriptionID)
+	}
+	if parsed.ResourceGroup != "resourceGroup1" {
+		t.Fatalf("Expected ResourceGroup to be %q but got %q", "resourceGroup1", parsed.ResourceGroup)
+	}
+	if parsed.VirtualNetworkName != "virtualNetworkName1" {
+		t.Fatalf("Expected VirtualNetworkName to be %q but got %q", "virtualNetworkName1", parsed.VirtualNetworkName)
+	}
+	if parsed.Name != "name1" {
+		t.Fatalf("Expected Name to be %q but got %q", "name1", parsed.Name)
+	}
+
+	for _, input := range []string{"", "random", id + "/extra/segment", id[:strings.LastIndex(id, "/")]} {
+		if _, err := ParseVirtualNetworkPeeringID(input); err == nil {
+			t.Fatalf("Expected %q not to parse", input)
+		}
+		if _, errors := ValidateVirtualNetworkPeeringID(input, "id"); len(errors) == 0 {
+			t.Fatalf("Expected %q not to be valid", input)
+		}
+	}
+
+	if _, errors := ValidateVirtualNetworkPeeringIDOrEmpty("", "id"); len(errors) > 0 {
+		t.Fatalf("Expected an empty value to be valid but got: %+v", errors)
+	}
+	if _, errors := ValidateVirtualNetworkPeeringIDOrEmpty("random", "id"); len(errors) == 0 {
+		t.Fatalf("Expected %q not to be valid", "random")
+	}
+}
@@ -0,0 +1,22 @@
+package azure
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// ValidateResourceIDPriorToImport returns a ResourceImporter which validates the ID being imported using the
+// ValidateFunc for the typed Resource ID (for example `ValidateSubnetID`) - so that an ID for a different type
+// of resource is rejected up-front, rather than being imported and failing (or drifting) on the next Read
+func ValidateResourceIDPriorToImport(validateFunc schema.SchemaValidateFunc) *schema.ResourceImporter {
+	return &schema.ResourceImporter{
+		State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+			if _, errors := validateFunc(d.Id(), "id"); len(errors) > 0 {
+				return nil, fmt.Errorf("Error importing %q: %+v", d.Id(), errors[0])
+			}
+
+			return []*schema.ResourceData{d}, nil
+		},
+	}
+}
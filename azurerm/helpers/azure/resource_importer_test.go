@@ -0,0 +1,50 @@
+package azure
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestValidateResourceIDPriorToImport(t *testing.T) {
+	cases := []struct {
+		ID    string
+		Valid bool
+	}{
+		{
+			ID:    "",
+			Valid: false,
+		},
+		{
+			ID:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1",
+			Valid: false,
+		},
+		{
+			ID:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1",
+			Valid: true,
+		},
+		{
+			ID:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/group1/providers/microsoft.network/virtualnetworks/network1/subnets/subnet1",
+			Valid: true,
+		},
+	}
+
+	importer := ValidateResourceIDPriorToImport(ValidateSubnetID)
+	for _, v := range cases {
+		t.Logf("[DEBUG] Testing %q", v.ID)
+
+		d := (&schema.Resource{Schema: map[string]*schema.Schema{}}).TestResourceData()
+		d.SetId(v.ID)
+
+		result, err := importer.State(d, nil)
+		if v.Valid && err != nil {
+			t.Fatalf("Expected %q to be importable but got: %+v", v.ID, err)
+		}
+		if !v.Valid && err == nil {
+			t.Fatalf("Expected %q not to be importable", v.ID)
+		}
+		if v.Valid && (len(result) != 1 || result[0].Id() != v.ID) {
+			t.Fatalf("Expected %q to be imported as-is", v.ID)
+		}
+	}
+}
@@ -0,0 +1,23 @@
+package azure
+
+import (
+	"regexp"
+	"strings"
+)
+
+var unregisteredNamespaceRegex = regexp.MustCompile(`namespace '([^']+)'`)
+
+// UnregisteredResourceProvider determines whether the error was caused by a Resource Provider which isn't registered
+// in the Subscription (the `MissingSubscriptionRegistration` error) - returning the namespace of the Resource Provider
+// when it's available. Since errors are generally wrapped before being returned, this is determined from the message.
+func UnregisteredResourceProvider(err error) (string, bool) {
+	if err == nil || !strings.Contains(err.Error(), "MissingSubscriptionRegistration") {
+		return "", false
+	}
+
+	if matches := unregisteredNamespaceRegex.FindStringSubmatch(err.Error()); len(matches) == 2 {
+		return matches[1], true
+	}
+
+	return "", true
+}
@@ -0,0 +1,51 @@
+package azure
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestUnregisteredResourceProvider(t *testing.T) {
+	cases := []struct {
+		Name         string
+		Error        error
+		Unregistered bool
+		Namespace    string
+	}{
+		{
+			Name:         "no error",
+			Error:        nil,
+			Unregistered: false,
+		},
+		{
+			Name:         "unrelated error",
+			Error:        errors.New("StatusCode=404 Code=\"ResourceGroupNotFound\""),
+			Unregistered: false,
+		},
+		{
+			Name:         "wrapped error with namespace",
+			Error:        fmt.Errorf("Error creating Redis Cache %q: %+v", "example", errors.New(`Code="MissingSubscriptionRegistration" Message="The subscription is not registered to use namespace 'Microsoft.Cache'. See https://aka.ms/rps-not-found for how to register subscriptions."`)),
+			Unregistered: true,
+			Namespace:    "Microsoft.Cache",
+		},
+		{
+			Name:         "error without namespace",
+			Error:        errors.New(`Code="MissingSubscriptionRegistration" Message="The subscription is not registered."`),
+			Unregistered: true,
+			Namespace:    "",
+		},
+	}
+
+	for _, v := range cases {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		namespace, unregistered := UnregisteredResourceProvider(v.Error)
+		if unregistered != v.Unregistered {
+			t.Fatalf("Expected unregistered to be %t but got %t", v.Unregistered, unregistered)
+		}
+		if namespace != v.Namespace {
+			t.Fatalf("Expected the namespace to be %q but got %q", v.Namespace, namespace)
+		}
+	}
+}
@@ -0,0 +1,279 @@
+package azure
+
+import (
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// RetryPolicy defines how requests to Azure which are throttled or fail with a transient error are retried
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of times a request is sent, including the initial attempt
+	MaxAttempts int
+
+	// MinBackoff is the delay before the first retry, which is doubled for each subsequent retry
+	MinBackoff time.Duration
+
+	// MaxBackoff is the upper bound for the delay between two attempts when Azure doesn't specify one
+	MaxBackoff time.Duration
+}
+
+// DefaultRetryPolicy returns the RetryPolicy used when one isn't configured in the Provider block
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts: 4,
+		MinBackoff:  2 * time.Second,
+		MaxBackoff:  60 * time.Second,
+	}
+}
+
+var retryableStatusCodes = map[int]bool{
+	http.StatusRequestTimeout:      true,
+	http.StatusTooManyRequests:     true,
+	http.StatusInternalServerError: true,
+	http.StatusBadGateway:          true,
+	http.StatusServiceUnavailable:  true,
+	http.StatusGatewayTimeout:      true,
+}
+
+// the headers ARM returns to indicate how many requests remain in the current throttling window
+var rateLimitRemainingHeaders = []string{
+	"x-ms-ratelimit-remaining-subscription-reads",
+	"x-ms-ratelimit-remaining-subscription-writes",
+	"x-ms-ratelimit-remaining-subscription-deletes",
+	"x-ms-ratelimit-remaining-tenant-reads",
+	"x-ms-ratelimit-remaining-tenant-writes",
+	"x-ms-ratelimit-remaining-tenant-deletes",
+}
+
+// the status codes which indicate the request wasn't processed, meaning it's safe to retry non-idempotent requests
+var unprocessedStatusCodes = map[int]bool{
+	http.StatusRequestTimeout:     true,
+	http.StatusTooManyRequests:    true,
+	http.StatusServiceUnavailable: true,
+}
+
+func (p RetryPolicy) shouldRetry(req *http.Request, resp *http.Response, err error) bool {
+	// a POST (such as an action like `listKeys` or `restart`) or a PATCH may have been processed before the request
+	// failed, so these are only retried when Azure has indicated the request wasn't processed
+	idempotent := req.Method != http.MethodPost && req.Method != http.MethodPatch
+
+	if err != nil {
+		return idempotent && utils.ResponseErrorIsRetryable(err)
+	}
+
+	if resp == nil {
+		return false
+	}
+
+	if !idempotent {
+		return unprocessedStatusCodes[resp.StatusCode]
+	}
+
+	return retryableStatusCodes[resp.StatusCode]
+}
+
+// delay returns how long to wait before sending the next attempt, where attempt is the number of
+// attempts which have been sent so far
+func (p RetryPolicy) delay(resp *http.Response, attempt int) time.Duration {
+	if resp != nil {
+		if retryAfter, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+			return retryAfter
+		}
+
+		// when the throttling window has been exhausted there's no point retrying until it resets
+		if remaining, ok := rateLimitRemaining(resp); ok && remaining == 0 {
+			return p.MaxBackoff
+		}
+	}
+
+	backoff := p.MinBackoff
+	for i := 1; i < attempt; i++ {
+		backoff *= 2
+		if backoff >= p.MaxBackoff {
+			return p.MaxBackoff
+		}
+	}
+
+	return backoff
+}
+
+// parseRetryAfter parses the value of a `Retry-After` header, which can either be a number of seconds or a HTTP Date
+func parseRetryAfter(value string) (time.Duration, bool) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		delay := time.Until(date)
+		if delay < 0 {
+			delay = 0
+		}
+		return delay, true
+	}
+
+	return 0, false
+}
+
+// rateLimitRemaining returns the lowest number of requests remaining across the rate limit headers in the response
+func rateLimitRemaining(resp *http.Response) (int, bool) {
+	found := false
+	lowest := 0
+
+	for _, header := range rateLimitRemainingHeaders {
+		v := resp.Header.Get(header)
+		if v == "" {
+			continue
+		}
+
+		remaining, err := strconv.Atoi(v)
+		if err != nil {
+			continue
+		}
+
+		if !found || remaining < lowest {
+			lowest = remaining
+			found = true
+		}
+	}
+
+	return lowest, found
+}
+
+// throttledError is returned when a request is still being throttled once the Retry Policy's attempts are exhausted.
+// This implements net.Error (and isn't temporary) since the SDK otherwise considers the error worth retrying
+type throttledError struct {
+	method   string
+	url      string
+	attempts int
+}
+
+func (e throttledError) Error() string {
+	return fmt.Sprintf("Request %s to %s was throttled by Azure (HTTP 429) on all %d attempts", e.method, e.url, e.attempts)
+}
+
+func (e throttledError) Timeout() bool {
+	return false
+}
+
+func (e throttledError) Temporary() bool {
+	return false
+}
+
+// exhaustedError wraps the error returned once the Retry Policy has stopped retrying a request. This isn't temporary,
+// since the SDK otherwise retries the request again (including requests which aren't safe to repeat)
+type exhaustedError struct {
+	err error
+}
+
+func (e exhaustedError) Error() string {
+	return e.err.Error()
+}
+
+func (e exhaustedError) Timeout() bool {
+	if netErr, ok := e.err.(net.Error); ok {
+		return netErr.Timeout()
+	}
+
+	return false
+}
+
+func (e exhaustedError) Temporary() bool {
+	return false
+}
+
+var disableSDKRetriesOnce sync.Once
+
+// DisableSDKRetries leaves retrying requests to the Sender's Retry Policy, since the SDK's retries would otherwise
+// multiply the number of attempts (and never give up on requests which are throttled). The SDK's retry attempts are
+// left in place since these also drive the loop which registers Resource Providers and then re-sends the request.
+func DisableSDKRetries(client *autorest.Client) {
+	// the status codes the SDK retries are global, rather than configured per client
+	disableSDKRetriesOnce.Do(func() {
+		autorest.StatusCodesForRetry = []int{}
+	})
+
+	if client.RetryAttempts < autorest.DefaultRetryAttempts {
+		client.RetryAttempts = autorest.DefaultRetryAttempts
+	}
+}
+
+func withRetryPolicy(policy RetryPolicy) autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			rr := autorest.NewRetriableRequest(r)
+
+			var resp *http.Response
+			var err error
+			for attempt := 1; ; attempt++ {
+				if err = rr.Prepare(); err != nil {
+					return resp, err
+				}
+
+				resp, err = s.Do(rr.Request())
+
+				if resp != nil {
+					if remaining, ok := rateLimitRemaining(resp); ok && remaining < 10 {
+						log.Printf("[WARN] Only %d requests remain in the current ARM throttling window", remaining)
+					}
+				}
+
+				if !policy.shouldRetry(r, resp, err) {
+					if err != nil {
+						return resp, exhaustedError{err: err}
+					}
+					return resp, nil
+				}
+
+				if attempt >= policy.MaxAttempts {
+					if resp != nil && resp.StatusCode == http.StatusTooManyRequests {
+						// the SDK retries throttled requests indefinitely, so an error is returned to stop it doing so
+						autorest.Respond(resp, autorest.ByDiscardingBody(), autorest.ByClosing()) // nolint: errcheck
+						return resp, throttledError{
+							method:   r.Method,
+							url:      r.URL.String(),
+							attempts: attempt,
+						}
+					}
+
+					if err != nil {
+						return resp, exhaustedError{err: err}
+					}
+					return resp, nil
+				}
+
+				delay := policy.delay(resp, attempt)
+				if resp != nil {
+					log.Printf("[DEBUG] AzureRM Request %s to %s returned %s - retrying in %s (attempt %d of %d)", r.Method, r.URL, resp.Status, delay, attempt, policy.MaxAttempts)
+
+					// the response is discarded in favour of the next attempt, so the connection can be reused
+					autorest.Respond(resp, autorest.ByDiscardingBody(), autorest.ByClosing()) // nolint: errcheck
+				} else {
+					log.Printf("[DEBUG] AzureRM Request %s to %s failed with %+v - retrying in %s (attempt %d of %d)", r.Method, r.URL, err, delay, attempt, policy.MaxAttempts)
+				}
+
+				select {
+				case <-time.After(delay):
+				case <-r.Context().Done():
+					return nil, r.Context().Err()
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,351 @@
+package azure
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	az "github.com/Azure/go-autorest/autorest/azure"
+)
+
+func testRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts: 3,
+		MinBackoff:  time.Millisecond,
+		MaxBackoff:  10 * time.Millisecond,
+	}
+}
+
+// testRetryServer returns a server which replies with each of the status codes in turn, repeating the last one
+func testRetryServer(headers map[string]string, statusCodes ...int) (*httptest.Server, *int32) {
+	var count int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		i := int(atomic.AddInt32(&count, 1)) - 1
+		if i >= len(statusCodes) {
+			i = len(statusCodes) - 1
+		}
+
+		body, _ := ioutil.ReadAll(r.Body)
+		for k, v := range headers {
+			w.Header().Set(k, v)
+		}
+		w.WriteHeader(statusCodes[i])
+		w.Write(body) // nolint: errcheck
+	}))
+	return server, &count
+}
+
+func TestBuildSender_RetriesTransientErrors(t *testing.T) {
+	cases := []struct {
+		Name             string
+		Method           string
+		StatusCodes      []int
+		ExpectedStatus   int
+		ExpectedRequests int32
+	}{
+		{
+			Name:             "Success",
+			StatusCodes:      []int{http.StatusOK},
+			ExpectedStatus:   http.StatusOK,
+			ExpectedRequests: 1,
+		},
+		{
+			Name:             "Not Retryable",
+			StatusCodes:      []int{http.StatusBadRequest},
+			ExpectedStatus:   http.StatusBadRequest,
+			ExpectedRequests: 1,
+		},
+		{
+			Name:             "Transient Failure then Success",
+			StatusCodes:      []int{http.StatusServiceUnavailable, http.StatusOK},
+			ExpectedStatus:   http.StatusOK,
+			ExpectedRequests: 2,
+		},
+		{
+			Name:             "Throttled then Success",
+			StatusCodes:      []int{http.StatusTooManyRequests, http.StatusTooManyRequests, http.StatusOK},
+			ExpectedStatus:   http.StatusOK,
+			ExpectedRequests: 3,
+		},
+		{
+			Name:             "Attempts Exhausted",
+			StatusCodes:      []int{http.StatusInternalServerError},
+			ExpectedStatus:   http.StatusInternalServerError,
+			ExpectedRequests: 3,
+		},
+		{
+			Name:             "Action Failed",
+			Method:           http.MethodPost,
+			StatusCodes:      []int{http.StatusInternalServerError, http.StatusOK},
+			ExpectedStatus:   http.StatusInternalServerError,
+			ExpectedRequests: 1,
+		},
+		{
+			Name:             "Action Unavailable then Success",
+			Method:           http.MethodPost,
+			StatusCodes:      []int{http.StatusServiceUnavailable, http.StatusOK},
+			ExpectedStatus:   http.StatusOK,
+			ExpectedRequests: 2,
+		},
+		{
+			Name:             "Update Failed",
+			Method:           http.MethodPatch,
+			StatusCodes:      []int{http.StatusBadGateway, http.StatusOK},
+			ExpectedStatus:   http.StatusBadGateway,
+			ExpectedRequests: 1,
+		},
+	}
+
+	for _, v := range cases {
+		t.Run(v.Name, func(t *testing.T) {
+			server, count := testRetryServer(nil, v.StatusCodes...)
+			defer server.Close()
+
+			method := v.Method
+			if method == "" {
+				method = http.MethodPut
+			}
+
+			req, _ := http.NewRequest(method, server.URL, strings.NewReader("hello"))
+			resp, err := BuildSender(testRetryPolicy()).Do(req)
+			if err != nil {
+				t.Fatalf("Expected no error but got: %+v", err)
+			}
+			defer resp.Body.Close()
+
+			if resp.StatusCode != v.ExpectedStatus {
+				t.Fatalf("Expected the status code %d but got %d", v.ExpectedStatus, resp.StatusCode)
+			}
+
+			// the request body should be sent with every attempt
+			body, _ := ioutil.ReadAll(resp.Body)
+			if string(body) != "hello" {
+				t.Fatalf("Expected the request body to be replayed but got %q", string(body))
+			}
+
+			if actual := atomic.LoadInt32(count); actual != v.ExpectedRequests {
+				t.Fatalf("Expected %d requests but got %d", v.ExpectedRequests, actual)
+			}
+		})
+	}
+}
+
+func TestBuildSender_ThrottledAttemptsExhausted(t *testing.T) {
+	server, count := testRetryServer(nil, http.StatusTooManyRequests)
+	defer server.Close()
+
+	req, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+	resp, err := BuildSender(testRetryPolicy()).Do(req)
+	if err == nil {
+		t.Fatalf("Expected an error once the attempts were exhausted but didn't get one")
+	}
+
+	if resp == nil || resp.StatusCode != http.StatusTooManyRequests {
+		t.Fatalf("Expected the throttled response to be returned but got %+v", resp)
+	}
+
+	if actual := atomic.LoadInt32(count); actual != 3 {
+		t.Fatalf("Expected 3 requests but got %d", actual)
+	}
+}
+
+// TestBuildSender_SDKRetriesDisabled ensures requests sent the way the generated SDK clients send them are only
+// retried by the Retry Policy once the SDK's retries have been disabled, as they are by configureClient
+func TestBuildSender_SDKRetriesDisabled(t *testing.T) {
+	cases := []struct {
+		Name             string
+		Method           string
+		StatusCode       int
+		ExpectedRequests int32
+		ExpectError      bool
+	}{
+		{
+			Name:             "Success",
+			Method:           http.MethodGet,
+			StatusCode:       http.StatusOK,
+			ExpectedRequests: 1,
+		},
+		{
+			Name:             "Server Error",
+			Method:           http.MethodGet,
+			StatusCode:       http.StatusInternalServerError,
+			ExpectedRequests: 3,
+		},
+		{
+			Name:             "Throttled",
+			Method:           http.MethodGet,
+			StatusCode:       http.StatusTooManyRequests,
+			ExpectedRequests: 3,
+			ExpectError:      true,
+		},
+		{
+			Name:             "Server Error for an Action",
+			Method:           http.MethodPost,
+			StatusCode:       http.StatusInternalServerError,
+			ExpectedRequests: 1,
+		},
+	}
+
+	for _, v := range cases {
+		t.Run(v.Name, func(t *testing.T) {
+			server, count := testRetryServer(nil, v.StatusCode)
+			defer server.Close()
+
+			client := autorest.NewClientWithUserAgent("")
+			client.Sender = BuildSender(testRetryPolicy())
+			DisableSDKRetries(&client)
+
+			req, _ := http.NewRequest(v.Method, server.URL, nil)
+			resp, err := autorest.SendWithSender(client, req, az.DoRetryWithRegistration(client))
+			if resp != nil {
+				defer resp.Body.Close()
+			}
+
+			if v.ExpectError {
+				if err == nil {
+					t.Fatalf("Expected an error but didn't get one")
+				}
+			} else {
+				if err != nil {
+					t.Fatalf("Expected no error but got: %+v", err)
+				}
+				if resp == nil {
+					t.Fatalf("Expected a response but didn't get one")
+				}
+				if resp.StatusCode != v.StatusCode {
+					t.Fatalf("Expected a %d but got a %d", v.StatusCode, resp.StatusCode)
+				}
+			}
+
+			if actual := atomic.LoadInt32(count); actual != v.ExpectedRequests {
+				t.Fatalf("Expected %d requests but got %d", v.ExpectedRequests, actual)
+			}
+		})
+	}
+}
+
+func TestBuildSender_HonoursRetryAfter(t *testing.T) {
+	server, count := testRetryServer(map[string]string{"Retry-After": "1"}, http.StatusTooManyRequests, http.StatusOK)
+	defer server.Close()
+
+	// the backoff would otherwise be far shorter than the Retry-After header
+	policy := testRetryPolicy()
+
+	start := time.Now()
+	req, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+	resp, err := BuildSender(policy).Do(req)
+	if err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+	resp.Body.Close()
+
+	if elapsed := time.Since(start); elapsed < time.Second {
+		t.Fatalf("Expected the request to be delayed for at least 1s but it took %s", elapsed)
+	}
+
+	if actual := atomic.LoadInt32(count); actual != 2 {
+		t.Fatalf("Expected 2 requests but got %d", actual)
+	}
+}
+
+func TestBuildSender_StopsWhenContextIsCancelled(t *testing.T) {
+	server, count := testRetryServer(nil, http.StatusServiceUnavailable)
+	defer server.Close()
+
+	policy := RetryPolicy{
+		MaxAttempts: 5,
+		MinBackoff:  time.Hour,
+		MaxBackoff:  time.Hour,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	req, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+	_, err := BuildSender(policy).Do(req.WithContext(ctx))
+	if err != context.DeadlineExceeded {
+		t.Fatalf("Expected the context deadline to be exceeded but got: %+v", err)
+	}
+
+	if actual := atomic.LoadInt32(count); actual != 1 {
+		t.Fatalf("Expected 1 request but got %d", actual)
+	}
+}
+
+func TestRetryPolicy_Delay(t *testing.T) {
+	policy := RetryPolicy{
+		MaxAttempts: 10,
+		MinBackoff:  2 * time.Second,
+		MaxBackoff:  10 * time.Second,
+	}
+
+	cases := []struct {
+		Name     string
+		Attempt  int
+		Headers  map[string]string
+		Expected time.Duration
+	}{
+		{
+			Name:     "First Retry",
+			Attempt:  1,
+			Expected: 2 * time.Second,
+		},
+		{
+			Name:     "Exponential Backoff",
+			Attempt:  3,
+			Expected: 8 * time.Second,
+		},
+		{
+			Name:     "Capped Backoff",
+			Attempt:  4,
+			Expected: 10 * time.Second,
+		},
+		{
+			Name:     "Retry-After Seconds",
+			Attempt:  1,
+			Headers:  map[string]string{"Retry-After": "30"},
+			Expected: 30 * time.Second,
+		},
+		{
+			Name:     "Invalid Retry-After",
+			Attempt:  1,
+			Headers:  map[string]string{"Retry-After": "soon"},
+			Expected: 2 * time.Second,
+		},
+		{
+			Name:     "Throttling Window Exhausted",
+			Attempt:  1,
+			Headers:  map[string]string{"x-ms-ratelimit-remaining-subscription-writes": "0"},
+			Expected: 10 * time.Second,
+		},
+		{
+			Name:     "Throttling Window Remaining",
+			Attempt:  1,
+			Headers:  map[string]string{"x-ms-ratelimit-remaining-subscription-reads": "1199"},
+			Expected: 2 * time.Second,
+		},
+	}
+
+	for _, v := range cases {
+		t.Run(v.Name, func(t *testing.T) {
+			resp := &http.Response{
+				StatusCode: http.StatusTooManyRequests,
+				Header:     http.Header{},
+			}
+			for k, val := range v.Headers {
+				resp.Header.Set(k, val)
+			}
+
+			actual := policy.delay(resp, v.Attempt)
+			if actual != v.Expected {
+				t.Fatalf("Expected a delay of %s but got %s", v.Expected, actual)
+			}
+		})
+	}
+}
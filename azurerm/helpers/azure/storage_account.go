@@ -0,0 +1,218 @@
+package azure
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/storage"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+)
+
+// storageAccountReplicationTypes are the `account_replication_type`s supported by each
+// combination of `account_kind` and `account_tier`
+var storageAccountReplicationTypes = map[string]map[string][]string{
+	"storage": {
+		"standard": {"LRS", "ZRS", "GRS", "RAGRS"},
+		"premium":  {"LRS"},
+	},
+	"storagev2": {
+		"standard": {"LRS", "ZRS", "GRS", "RAGRS"},
+		"premium":  {"LRS"},
+	},
+	"blobstorage": {
+		"standard": {"LRS", "GRS", "RAGRS"},
+	},
+}
+
+// StorageAccountCustomizeDiff validates at plan time that the `account_replication_type`, `access_tier`,
+// `is_hns_enabled` and Service Properties of a Storage Account are supported by its `account_kind` and `account_tier`
+func StorageAccountCustomizeDiff(d *schema.ResourceDiff, _ interface{}) error {
+	if !valuesKnown(d, "account_kind", "account_tier", "account_replication_type") {
+		return nil
+	}
+
+	kind := d.Get("account_kind").(string)
+	tier := d.Get("account_tier").(string)
+	replicationType := d.Get("account_replication_type").(string)
+
+	tiers, ok := storageAccountReplicationTypes[strings.ToLower(kind)]
+	if !ok {
+		// this is caught by the ValidateFunc
+		return nil
+	}
+
+	replicationTypes, ok := tiers[strings.ToLower(tier)]
+	if !ok {
+		return fmt.Errorf("An `account_tier` of %q isn't supported for %q Storage Accounts", tier, kind)
+	}
+
+	supported := false
+	for _, v := range replicationTypes {
+		if strings.EqualFold(v, replicationType) {
+			supported = true
+			break
+		}
+	}
+	if !supported {
+		return fmt.Errorf("An `account_replication_type` of %q isn't supported for %q Storage Accounts with an `account_tier` of %q - supported values are: %s", replicationType, kind, tier, strings.Join(replicationTypes, ", "))
+	}
+
+	// the Blob and Queue Service Properties are only available for Standard accounts - and the Queue Service isn't
+	// available at all for BlobStorage accounts, nor Static Websites for anything other than StorageV2 accounts
+	for _, key := range []string{"blob_properties", "queue_properties", "static_website"} {
+		if len(d.Get(key).([]interface{})) > 0 && !strings.EqualFold(tier, "Standard") {
+			return fmt.Errorf("`%s` can only be used with an `account_tier` of `Standard`", key)
+		}
+	}
+
+	if len(d.Get("queue_properties").([]interface{})) > 0 && strings.EqualFold(kind, "BlobStorage") {
+		return fmt.Errorf("`queue_properties` cannot be used with account kind `BlobStorage`")
+	}
+
+	if len(d.Get("static_website").([]interface{})) > 0 && !strings.EqualFold(kind, "StorageV2") {
+		return fmt.Errorf("`static_website` can only be used with account kind `StorageV2`")
+	}
+
+	supportsAccessTier := strings.EqualFold(kind, "BlobStorage") || strings.EqualFold(kind, "StorageV2")
+	if supportsAccessTier {
+		return nil
+	}
+
+	if valuesKnown(d, "access_tier") && d.Get("access_tier").(string) != "" {
+		return fmt.Errorf("`access_tier` can only be used with account kinds `StorageV2` and `BlobStorage`")
+	}
+
+	if valuesKnown(d, "is_hns_enabled") && d.Get("is_hns_enabled").(bool) {
+		return fmt.Errorf("`is_hns_enabled` can only be used with account kinds `StorageV2` and `BlobStorage`")
+	}
+
+	return nil
+}
+
+// SchemaStorageAccountCorsRule returns the schema for the CORS Rules of a Service (e.g. Blob or Queue) within a Storage Account
+func SchemaStorageAccountCorsRule() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 5,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"allowed_origins": {
+					Type:     schema.TypeList,
+					Required: true,
+					MaxItems: 64,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validate.NoEmptyStrings,
+					},
+				},
+
+				"exposed_headers": {
+					Type:     schema.TypeList,
+					Required: true,
+					MaxItems: 64,
+					MinItems: 1,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+
+				"allowed_headers": {
+					Type:     schema.TypeList,
+					Required: true,
+					MaxItems: 64,
+					MinItems: 1,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+
+				"allowed_methods": {
+					Type:     schema.TypeList,
+					Required: true,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+						ValidateFunc: validation.StringInSlice([]string{
+							"DELETE",
+							"GET",
+							"HEAD",
+							"MERGE",
+							"POST",
+							"OPTIONS",
+							"PUT",
+						}, false),
+					},
+				},
+
+				"max_age_in_seconds": {
+					Type:         schema.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IntBetween(1, 2000000000),
+				},
+			},
+		},
+	}
+}
+
+// ExpandStorageAccountCorsRules expands the `cors_rule` blocks of a Service within a Storage Account - where an
+// empty list of rules removes any existing CORS Rules from the Service
+func ExpandStorageAccountCorsRules(input []interface{}) *storage.Cors {
+	rules := make([]storage.CorsRule, 0)
+
+	for _, raw := range input {
+		v := raw.(map[string]interface{})
+
+		rules = append(rules, storage.CorsRule{
+			AllowedOrigins:  joinStorageAccountCorsValues(v["allowed_origins"].([]interface{})),
+			AllowedMethods:  joinStorageAccountCorsValues(v["allowed_methods"].([]interface{})),
+			AllowedHeaders:  joinStorageAccountCorsValues(v["allowed_headers"].([]interface{})),
+			ExposedHeaders:  joinStorageAccountCorsValues(v["exposed_headers"].([]interface{})),
+			MaxAgeInSeconds: v["max_age_in_seconds"].(int),
+		})
+	}
+
+	return &storage.Cors{
+		CorsRule: rules,
+	}
+}
+
+// FlattenStorageAccountCorsRules flattens the CORS Rules of a Service within a Storage Account
+func FlattenStorageAccountCorsRules(input *storage.Cors) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, rule := range input.CorsRule {
+		results = append(results, map[string]interface{}{
+			"allowed_origins":    splitStorageAccountCorsValues(rule.AllowedOrigins),
+			"allowed_methods":    splitStorageAccountCorsValues(rule.AllowedMethods),
+			"allowed_headers":    splitStorageAccountCorsValues(rule.AllowedHeaders),
+			"exposed_headers":    splitStorageAccountCorsValues(rule.ExposedHeaders),
+			"max_age_in_seconds": rule.MaxAgeInSeconds,
+		})
+	}
+
+	return results
+}
+
+// the API represents each list of values within a CORS Rule as a comma-separated string
+func joinStorageAccountCorsValues(input []interface{}) string {
+	values := make([]string, 0)
+	for _, v := range input {
+		values = append(values, v.(string))
+	}
+	return strings.Join(values, ",")
+}
+
+func splitStorageAccountCorsValues(input string) []interface{} {
+	values := make([]interface{}, 0)
+	for _, v := range strings.Split(input, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			values = append(values, v)
+		}
+	}
+	return values
+}
@@ -0,0 +1,102 @@
+package azure
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/storage"
+	"github.com/Azure/go-autorest/autorest"
+	az "github.com/Azure/go-autorest/autorest/azure"
+)
+
+const (
+	// StorageAzureADResource is the resource which tokens for the Storage data-plane APIs are issued for
+	StorageAzureADResource = "https://storage.azure.com/"
+
+	// storageAzureADAPIVersion is the earliest version of the Storage data-plane APIs which supports Azure AD tokens
+	storageAzureADAPIVersion = "2017-11-09"
+
+	// storageTableAzureADAPIVersion is the earliest version of the Table Service which supports Azure AD tokens
+	storageTableAzureADAPIVersion = "2019-02-02"
+
+	storageVersionHeader = "x-ms-version"
+)
+
+// NewStorageClientUsingAzureAD returns a client for the Storage data-plane APIs of the Storage Account which
+// authenticates using an Azure AD token (obtained from the Authorizer) rather than the Storage Account's Access Key,
+// such that principals with only data-plane role assignments (e.g. `Storage Blob Data Contributor`) can be used
+func NewStorageClientUsingAzureAD(accountName string, env az.Environment, authorizer autorest.Authorizer) storage.Client {
+	// the storage SDK only supports Shared Key and SAS authentication - so we build a SAS client (which doesn't sign
+	// requests using an Access Key) and then swap the SAS parameters for a bearer token before each request is sent
+	token := url.Values{
+		"sv":  []string{storageAzureADAPIVersion},
+		"spr": []string{"https"},
+	}
+	client := storage.NewAccountSASClient(accountName, token, env)
+	client.Sender = storageAzureADSender{
+		authorizer: authorizer,
+		sender:     client.Sender,
+	}
+	return client
+}
+
+type storageAzureADSender struct {
+	authorizer autorest.Authorizer
+	sender     storage.Sender
+}
+
+func (s storageAzureADSender) Send(c *storage.Client, req *http.Request) (*http.Response, error) {
+	query := req.URL.Query()
+	query.Del("sv")
+	query.Del("spr")
+	req.URL.RawQuery = query.Encode()
+
+	// the File service doesn't accept Azure AD tokens for managing File Shares, and the other services only accept them
+	// from a given version onwards - so requests using an earlier version (such as the storage SDK's default) are upgraded
+	var minimumVersion string
+	switch service := storageServiceForHost(req.URL.Host); service {
+	case "blob", "queue":
+		minimumVersion = storageAzureADAPIVersion
+	case "table":
+		minimumVersion = storageTableAzureADAPIVersion
+	default:
+		return nil, fmt.Errorf("Azure AD authentication isn't supported for the %q Storage service - the Storage Account's Access Key must be used instead", service)
+	}
+
+	if v := storageAPIVersion(req.Header); v < minimumVersion {
+		// the storage SDK sets this header without canonicalising the key for some services (and canonicalised for
+		// others), so both are removed before it's overwritten in the form used by the storage SDK
+		req.Header.Del(storageVersionHeader)
+		req.Header[storageVersionHeader] = []string{minimumVersion}
+	}
+
+	req, err := autorest.Prepare(req, s.authorizer.WithAuthorization())
+	if err != nil {
+		return nil, err
+	}
+
+	return s.sender.Send(c, req)
+}
+
+// storageAPIVersion returns the version of the Storage data-plane APIs requested, regardless of whether the header's
+// key has been canonicalised
+func storageAPIVersion(header http.Header) string {
+	if v := header[storageVersionHeader]; len(v) > 0 {
+		return v[0]
+	}
+
+	return header.Get(storageVersionHeader)
+}
+
+// storageServiceForHost returns the Storage service (such as `blob` or `table`) for the host of a Storage endpoint,
+// which is in the format `{accountName}.{service}.{storageEndpointSuffix}`
+func storageServiceForHost(host string) string {
+	segments := strings.SplitN(host, ".", 3)
+	if len(segments) < 3 {
+		return ""
+	}
+
+	return strings.ToLower(segments[1])
+}
@@ -0,0 +1,142 @@
+package azure
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/storage"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/adal"
+	az "github.com/Azure/go-autorest/autorest/azure"
+)
+
+type testStorageSender struct {
+	request *http.Request
+}
+
+func (s *testStorageSender) Send(_ *storage.Client, req *http.Request) (*http.Response, error) {
+	s.request = req
+	return &http.Response{StatusCode: http.StatusOK, Request: req}, nil
+}
+
+func TestNewStorageClientUsingAzureAD(t *testing.T) {
+	client := NewStorageClientUsingAzureAD("example", az.PublicCloud, autorest.NewBearerAuthorizer(&adal.Token{AccessToken: "primary"}))
+
+	sender, ok := client.Sender.(storageAzureADSender)
+	if !ok {
+		t.Fatalf("Expected the Sender to be a storageAzureADSender but got %T", client.Sender)
+	}
+	inner := &testStorageSender{}
+	sender.sender = inner
+
+	req, err := http.NewRequest(http.MethodPut, "https://example.blob.core.windows.net/container?restype=container&spr=https&sv=2017-11-09", nil)
+	if err != nil {
+		t.Fatalf("Error building the request: %+v", err)
+	}
+	req.Header["x-ms-version"] = []string{""}
+
+	if _, err := sender.Send(&client, req); err != nil {
+		t.Fatalf("Error sending the request: %+v", err)
+	}
+
+	if inner.request == nil {
+		t.Fatalf("Expected the request to be sent")
+	}
+	if v := inner.request.Header.Get("Authorization"); v != "Bearer primary" {
+		t.Fatalf("Expected the Authorization header to be %q but got %q", "Bearer primary", v)
+	}
+	if v := inner.request.Header["x-ms-version"]; len(v) != 1 || v[0] != storageAzureADAPIVersion {
+		t.Fatalf("Expected the API Version to be %q but got %+v", storageAzureADAPIVersion, v)
+	}
+	if v := inner.request.URL.RawQuery; v != "restype=container" {
+		t.Fatalf("Expected the SAS parameters to be removed from the query but got %q", v)
+	}
+}
+
+func TestNewStorageClientUsingAzureAD_APIVersion(t *testing.T) {
+	cases := []struct {
+		URL      string
+		Version  string
+		Expected string
+		Error    bool
+	}{
+		{
+			// the version used by the storage SDK doesn't support Azure AD, so it's upgraded
+			URL:      "https://example.queue.core.windows.net/queue",
+			Version:  "2016-05-31",
+			Expected: storageAzureADAPIVersion,
+		},
+		{
+			URL:      "https://example.blob.core.windows.net/container/blob",
+			Version:  "2018-03-28",
+			Expected: "2018-03-28",
+		},
+		{
+			// the Table Service only supports Azure AD from a later version than the Blob and Queue services
+			URL:      "https://example.table.core.windows.net/Tables",
+			Version:  "2017-11-09",
+			Expected: storageTableAzureADAPIVersion,
+		},
+		{
+			URL:     "https://example.file.core.windows.net/share",
+			Version: "2016-05-31",
+			Error:   true,
+		},
+	}
+
+	for _, v := range cases {
+		client := NewStorageClientUsingAzureAD("example", az.PublicCloud, autorest.NewBearerAuthorizer(&adal.Token{AccessToken: "primary"}))
+		sender := client.Sender.(storageAzureADSender)
+		inner := &testStorageSender{}
+		sender.sender = inner
+
+		req, err := http.NewRequest(http.MethodGet, v.URL, nil)
+		if err != nil {
+			t.Fatalf("Error building the request: %+v", err)
+		}
+		req.Header["x-ms-version"] = []string{v.Version}
+
+		_, err = sender.Send(&client, req)
+		if v.Error {
+			if err == nil {
+				t.Fatalf("Expected an error sending a request to %q but didn't get one", v.URL)
+			}
+			if inner.request != nil {
+				t.Fatalf("Expected the request to %q not to be sent", v.URL)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("Error sending the request to %q: %+v", v.URL, err)
+		}
+
+		if actual := inner.request.Header["x-ms-version"]; len(actual) != 1 || actual[0] != v.Expected {
+			t.Fatalf("Expected the API Version for %q to be %q but got %+v", v.URL, v.Expected, actual)
+		}
+	}
+}
+
+func TestNewStorageClientUsingAzureAD_CanonicalAPIVersion(t *testing.T) {
+	client := NewStorageClientUsingAzureAD("example", az.PublicCloud, autorest.NewBearerAuthorizer(&adal.Token{AccessToken: "primary"}))
+	sender := client.Sender.(storageAzureADSender)
+	inner := &testStorageSender{}
+	sender.sender = inner
+
+	// the storage SDK canonicalises the key of this header for the Table Service
+	req, err := http.NewRequest(http.MethodPost, "https://example.table.core.windows.net/Tables", nil)
+	if err != nil {
+		t.Fatalf("Error building the request: %+v", err)
+	}
+	req.Header.Set("x-ms-version", storageAzureADAPIVersion)
+
+	if _, err := sender.Send(&client, req); err != nil {
+		t.Fatalf("Error sending the request: %+v", err)
+	}
+
+	if v := inner.request.Header.Get("x-ms-version"); v != "" {
+		t.Fatalf("Expected the canonicalised API Version to be removed but got %q", v)
+	}
+	if v := inner.request.Header["x-ms-version"]; len(v) != 1 || v[0] != storageTableAzureADAPIVersion {
+		t.Fatalf("Expected the API Version to be %q but got %+v", storageTableAzureADAPIVersion, v)
+	}
+}
@@ -0,0 +1,277 @@
+package azure
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2018-02-01/storage"
+	"github.com/Azure/go-autorest/autorest"
+	az "github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// storageManagementPolicyAPIVersion is the version of the Storage API which supports Management Policies
+const storageManagementPolicyAPIVersion = "2019-04-01"
+
+// StorageManagementPolicyName is the name of the (only) Management Policy within a Storage Account
+const StorageManagementPolicyName = "default"
+
+// StorageManagementPoliciesClient is a client for the Management (Lifecycle) Policy of a Storage Account - which
+// isn't available in the version of the Storage SDK we're using, so these requests are sent using the (configured)
+// client for Storage Accounts
+type StorageManagementPoliciesClient struct {
+	storage.BaseClient
+}
+
+// NewStorageManagementPoliciesClient returns a StorageManagementPoliciesClient built on the client for Storage Accounts
+func NewStorageManagementPoliciesClient(accountsClient storage.AccountsClient) StorageManagementPoliciesClient {
+	return StorageManagementPoliciesClient{
+		BaseClient: accountsClient.BaseClient,
+	}
+}
+
+// StorageManagementPolicy is the Management Policy of a Storage Account
+type StorageManagementPolicy struct {
+	autorest.Response `json:"-"`
+	ID                *string                            `json:"id,omitempty"`
+	Name              *string                            `json:"name,omitempty"`
+	Properties        *StorageManagementPolicyProperties `json:"properties,omitempty"`
+}
+
+// StorageManagementPolicyProperties are the properties of a Management Policy
+type StorageManagementPolicyProperties struct {
+	Policy *StorageManagementPolicySchema `json:"policy,omitempty"`
+}
+
+// StorageManagementPolicySchema is the set of Rules within a Management Policy
+type StorageManagementPolicySchema struct {
+	Rules *[]StorageManagementPolicyRule `json:"rules,omitempty"`
+}
+
+// StorageManagementPolicyRule is a Lifecycle Rule within a Management Policy
+type StorageManagementPolicyRule struct {
+	Enabled    *bool                                  `json:"enabled,omitempty"`
+	Name       *string                                `json:"name,omitempty"`
+	Type       string                                 `json:"type,omitempty"`
+	Definition *StorageManagementPolicyRuleDefinition `json:"definition,omitempty"`
+}
+
+// StorageManagementPolicyRuleDefinition defines the Blobs a Rule applies to, and the Actions taken on them
+type StorageManagementPolicyRuleDefinition struct {
+	Filters *StorageManagementPolicyFilter `json:"filters,omitempty"`
+	Actions *StorageManagementPolicyAction `json:"actions,omitempty"`
+}
+
+// StorageManagementPolicyFilter limits the Blobs a Rule applies to, by their prefix and type
+type StorageManagementPolicyFilter struct {
+	PrefixMatch *[]string `json:"prefixMatch,omitempty"`
+	BlobTypes   *[]string `json:"blobTypes,omitempty"`
+}
+
+// StorageManagementPolicyAction are the Actions taken on the Base Blobs and Snapshots a Rule applies to
+type StorageManagementPolicyAction struct {
+	BaseBlob *StorageManagementPolicyBaseBlob `json:"baseBlob,omitempty"`
+	Snapshot *StorageManagementPolicySnapshot `json:"snapshot,omitempty"`
+}
+
+// StorageManagementPolicyBaseBlob are the Actions taken on Base Blobs, based on when these were last modified
+type StorageManagementPolicyBaseBlob struct {
+	TierToCool    *StorageManagementPolicyDaysAfterModification `json:"tierToCool,omitempty"`
+	TierToArchive *StorageManagementPolicyDaysAfterModification `json:"tierToArchive,omitempty"`
+	Delete        *StorageManagementPolicyDaysAfterModification `json:"delete,omitempty"`
+}
+
+// StorageManagementPolicySnapshot are the Actions taken on Snapshots, based on when these were created
+type StorageManagementPolicySnapshot struct {
+	Delete *StorageManagementPolicyDaysAfterCreation `json:"delete,omitempty"`
+}
+
+// StorageManagementPolicyDaysAfterModification is the number of days after a Blob was last modified an Action is taken
+type StorageManagementPolicyDaysAfterModification struct {
+	DaysAfterModificationGreaterThan *int32 `json:"daysAfterModificationGreaterThan,omitempty"`
+}
+
+// StorageManagementPolicyDaysAfterCreation is the number of days after a Snapshot was created an Action is taken
+type StorageManagementPolicyDaysAfterCreation struct {
+	DaysAfterCreationGreaterThan *int32 `json:"daysAfterCreationGreaterThan,omitempty"`
+}
+
+// CreateOrUpdate creates or replaces the Management Policy of the Storage Account
+func (client StorageManagementPoliciesClient) CreateOrUpdate(ctx context.Context, id StorageManagementPolicyID, policy StorageManagementPolicy) error {
+	req, err := client.preparer(ctx, id,
+		autorest.AsPut(),
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.WithJSON(policy))
+	if err != nil {
+		return autorest.NewErrorWithError(err, "azure.StorageManagementPoliciesClient", "CreateOrUpdate", nil, "Failure preparing request")
+	}
+
+	resp, err := client.send(req)
+	if err != nil {
+		return autorest.NewErrorWithError(err, "azure.StorageManagementPoliciesClient", "CreateOrUpdate", resp, "Failure sending request")
+	}
+
+	err = autorest.Respond(
+		resp,
+		client.ByInspecting(),
+		az.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByClosing())
+	if err != nil {
+		return autorest.NewErrorWithError(err, "azure.StorageManagementPoliciesClient", "CreateOrUpdate", resp, "Failure responding to request")
+	}
+
+	return nil
+}
+
+// Get retrieves the Management Policy of the Storage Account
+func (client StorageManagementPoliciesClient) Get(ctx context.Context, id StorageManagementPolicyID) (result StorageManagementPolicy, err error) {
+	req, err := client.preparer(ctx, id, autorest.AsGet())
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "azure.StorageManagementPoliciesClient", "Get", nil, "Failure preparing request")
+	}
+
+	resp, err := client.send(req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		return result, autorest.NewErrorWithError(err, "azure.StorageManagementPoliciesClient", "Get", resp, "Failure sending request")
+	}
+
+	err = autorest.Respond(
+		resp,
+		client.ByInspecting(),
+		az.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "azure.StorageManagementPoliciesClient", "Get", resp, "Failure responding to request")
+	}
+
+	return result, nil
+}
+
+// Delete deletes the Management Policy of the Storage Account
+func (client StorageManagementPoliciesClient) Delete(ctx context.Context, id StorageManagementPolicyID) (autorest.Response, error) {
+	req, err := client.preparer(ctx, id, autorest.AsDelete())
+	if err != nil {
+		return autorest.Response{}, autorest.NewErrorWithError(err, "azure.StorageManagementPoliciesClient", "Delete", nil, "Failure preparing request")
+	}
+
+	resp, err := client.send(req)
+	if err != nil {
+		return autorest.Response{Response: resp}, autorest.NewErrorWithError(err, "azure.StorageManagementPoliciesClient", "Delete", resp, "Failure sending request")
+	}
+
+	err = autorest.Respond(
+		resp,
+		client.ByInspecting(),
+		az.WithErrorUnlessStatusCode(http.StatusOK, http.StatusNoContent),
+		autorest.ByClosing())
+	if err != nil {
+		return autorest.Response{Response: resp}, autorest.NewErrorWithError(err, "azure.StorageManagementPoliciesClient", "Delete", resp, "Failure responding to request")
+	}
+
+	return autorest.Response{Response: resp}, nil
+}
+
+func (client StorageManagementPoliciesClient) preparer(ctx context.Context, id StorageManagementPolicyID, decorators ...autorest.PrepareDecorator) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": storageManagementPolicyAPIVersion,
+	}
+
+	decorators = append(decorators,
+		autorest.WithBaseURL(client.BaseURI),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return autorest.CreatePreparer(decorators...).Prepare((&http.Request{}).WithContext(ctx))
+}
+
+func (client StorageManagementPoliciesClient) send(req *http.Request) (*http.Response, error) {
+	return autorest.SendWithSender(client, req)
+}
+
+// ImportStorageManagementPolicy returns a ResourceImporter for the Management Policy of a Storage Account, which
+// accepts either the Resource ID of the Management Policy or the Resource ID of the Storage Account itself
+func ImportStorageManagementPolicy() *schema.ResourceImporter {
+	return &schema.ResourceImporter{
+		State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+			if account, err := ParseStorageAccountID(d.Id()); err == nil {
+				d.SetId(NewStorageManagementPolicyID(account.SubscriptionID, account.ResourceGroup, account.Name, StorageManagementPolicyName).ID())
+			}
+
+			id, err := ParseStorageManagementPolicyID(d.Id())
+			if err != nil {
+				return nil, fmt.Errorf("Error importing %q: expected the Resource ID of a Storage Account or its Management Policy: %+v", d.Id(), err)
+			}
+			if !strings.EqualFold(id.Name, StorageManagementPolicyName) {
+				return nil, fmt.Errorf("Error importing %q: the Management Policy of a Storage Account must be named %q", d.Id(), StorageManagementPolicyName)
+			}
+
+			return []*schema.ResourceData{d}, nil
+		},
+	}
+}
+
+// StorageManagementPolicyCustomizeDiff validates at plan time that the names of the Rules within a Management Policy
+// are unique, that each Rule has at least one Action, and that the Base Blob Actions are taken in order
+// (e.g. Blobs are moved to the Cool tier before they're moved to the Archive tier, and before they're deleted)
+func StorageManagementPolicyCustomizeDiff(d *schema.ResourceDiff, _ interface{}) error {
+	if !valuesKnown(d, "rule") {
+		return nil
+	}
+
+	names := make(map[string]struct{})
+	for i, raw := range d.Get("rule").([]interface{}) {
+		rule, ok := raw.(map[string]interface{})
+		if !ok || !valuesKnown(d, fmt.Sprintf("rule.%d", i)) {
+			continue
+		}
+
+		name := rule["name"].(string)
+		if _, exists := names[name]; exists && name != "" {
+			return fmt.Errorf("`rule.%d.name`: the name %q is used by more than one Rule", i, name)
+		}
+		names[name] = struct{}{}
+
+		actions := rule["actions"].([]interface{})
+		if len(actions) == 0 || actions[0] == nil {
+			continue
+		}
+		action := actions[0].(map[string]interface{})
+
+		hasAction := false
+		if snapshots := action["snapshot"].([]interface{}); len(snapshots) > 0 && snapshots[0] != nil {
+			snapshot := snapshots[0].(map[string]interface{})
+			hasAction = snapshot["delete_after_days_since_creation_greater_than"].(int) >= 0
+		}
+
+		if baseBlobs := action["base_blob"].([]interface{}); len(baseBlobs) > 0 && baseBlobs[0] != nil {
+			baseBlob := baseBlobs[0].(map[string]interface{})
+			cool := baseBlob["tier_to_cool_after_days_since_modification_greater_than"].(int)
+			archive := baseBlob["tier_to_archive_after_days_since_modification_greater_than"].(int)
+			del := baseBlob["delete_after_days_since_modification_greater_than"].(int)
+
+			if cool >= 0 || archive >= 0 || del >= 0 {
+				hasAction = true
+			}
+
+			if cool >= 0 && archive >= 0 && archive <= cool {
+				return fmt.Errorf("`rule.%d`: Blobs must be moved to the Archive tier after they're moved to the Cool tier (got %d days for Archive and %d days for Cool)", i, archive, cool)
+			}
+			if del >= 0 && cool >= 0 && del <= cool {
+				return fmt.Errorf("`rule.%d`: Blobs must be deleted after they're moved to the Cool tier (got %d days for Delete and %d days for Cool)", i, del, cool)
+			}
+			if del >= 0 && archive >= 0 && del <= archive {
+				return fmt.Errorf("`rule.%d`: Blobs must be deleted after they're moved to the Archive tier (got %d days for Delete and %d days for Archive)", i, del, archive)
+			}
+		}
+
+		if !hasAction {
+			return fmt.Errorf("`rule.%d`: at least one Action must be specified for either `base_blob` or `snapshot`", i)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,129 @@
+package azure
+
+import (
+	"context"
+	"encoding/xml"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/storage"
+	"github.com/Azure/go-autorest/autorest"
+	az "github.com/Azure/go-autorest/autorest/azure"
+)
+
+// storageServicePropertiesAPIVersion is the earliest version of the Storage data-plane APIs which supports
+// both the Delete Retention Policy and Static Website properties of the Blob Service
+const storageServicePropertiesAPIVersion = "2018-03-28"
+
+// StorageServiceProperties are the properties of the Blob or Queue Service within a Storage Account - where any
+// omitted (nil) properties are left unchanged when these are set
+type StorageServiceProperties struct {
+	autorest.Response     `xml:"-"`
+	XMLName               xml.Name                 `xml:"StorageServiceProperties"`
+	Logging               *storage.Logging         `xml:"Logging,omitempty"`
+	HourMetrics           *storage.Metrics         `xml:"HourMetrics,omitempty"`
+	MinuteMetrics         *storage.Metrics         `xml:"MinuteMetrics,omitempty"`
+	Cors                  *storage.Cors            `xml:"Cors,omitempty"`
+	DeleteRetentionPolicy *storage.RetentionPolicy `xml:"DeleteRetentionPolicy,omitempty"`
+	StaticWebsite         *StorageStaticWebsite    `xml:"StaticWebsite,omitempty"`
+}
+
+// StorageStaticWebsite is the Static Website configuration of the Blob Service within a Storage Account
+type StorageStaticWebsite struct {
+	Enabled              bool   `xml:"Enabled"`
+	IndexDocument        string `xml:"IndexDocument,omitempty"`
+	ErrorDocument404Path string `xml:"ErrorDocument404Path,omitempty"`
+}
+
+// StorageServicePropertiesClient is a client for the properties of a single Service (e.g. Blob or Queue) within a
+// Storage Account - which is used since the Storage SDK doesn't support the Delete Retention Policy and Static
+// Website properties of the Blob Service
+type StorageServicePropertiesClient struct {
+	autorest.Client
+	BaseURI string
+}
+
+// NewStorageServicePropertiesClient returns a StorageServicePropertiesClient for the Service at the specified endpoint,
+// for example `https://example.blob.core.windows.net`
+func NewStorageServicePropertiesClient(endpoint string) StorageServicePropertiesClient {
+	return StorageServicePropertiesClient{
+		Client:  autorest.NewClientWithUserAgent(""),
+		BaseURI: endpoint,
+	}
+}
+
+// GetServiceProperties retrieves the properties of the Service
+func (client StorageServicePropertiesClient) GetServiceProperties(ctx context.Context) (result StorageServiceProperties, err error) {
+	req, err := client.preparer(ctx, autorest.AsGet())
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "azure.StorageServicePropertiesClient", "GetServiceProperties", nil, "Failure preparing request")
+	}
+
+	resp, err := client.send(req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		return result, autorest.NewErrorWithError(err, "azure.StorageServicePropertiesClient", "GetServiceProperties", resp, "Failure sending request")
+	}
+
+	err = autorest.Respond(
+		resp,
+		client.ByInspecting(),
+		az.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingXML(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "azure.StorageServicePropertiesClient", "GetServiceProperties", resp, "Failure responding to request")
+	}
+
+	return result, nil
+}
+
+// SetServiceProperties sets the properties of the Service - any properties which are nil are left unchanged
+func (client StorageServicePropertiesClient) SetServiceProperties(ctx context.Context, properties StorageServiceProperties) error {
+	body, err := xml.Marshal(properties)
+	if err != nil {
+		return autorest.NewErrorWithError(err, "azure.StorageServicePropertiesClient", "SetServiceProperties", nil, "Failure marshalling request")
+	}
+
+	req, err := client.preparer(ctx,
+		autorest.AsPut(),
+		autorest.AsContentType("application/xml; charset=utf-8"),
+		autorest.WithString(string(body)))
+	if err != nil {
+		return autorest.NewErrorWithError(err, "azure.StorageServicePropertiesClient", "SetServiceProperties", nil, "Failure preparing request")
+	}
+
+	resp, err := client.send(req)
+	if err != nil {
+		return autorest.NewErrorWithError(err, "azure.StorageServicePropertiesClient", "SetServiceProperties", resp, "Failure sending request")
+	}
+
+	err = autorest.Respond(
+		resp,
+		client.ByInspecting(),
+		az.WithErrorUnlessStatusCode(http.StatusAccepted),
+		autorest.ByClosing())
+	if err != nil {
+		return autorest.NewErrorWithError(err, "azure.StorageServicePropertiesClient", "SetServiceProperties", resp, "Failure responding to request")
+	}
+
+	return nil
+}
+
+func (client StorageServicePropertiesClient) preparer(ctx context.Context, decorators ...autorest.PrepareDecorator) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"restype": "service",
+		"comp":    "properties",
+	}
+
+	decorators = append(decorators,
+		autorest.WithBaseURL(client.BaseURI),
+		autorest.WithPath("/"),
+		autorest.WithQueryParameters(queryParameters),
+		autorest.WithHeader("x-ms-version", storageServicePropertiesAPIVersion))
+	return autorest.CreatePreparer(decorators...).Prepare((&http.Request{}).WithContext(ctx))
+}
+
+func (client StorageServicePropertiesClient) send(req *http.Request) (*http.Response, error) {
+	return autorest.SendWithSender(client, req)
+}
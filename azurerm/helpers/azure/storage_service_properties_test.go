@@ -0,0 +1,92 @@
+package azure
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/storage"
+)
+
+func TestStorageServicePropertiesMarshal(t *testing.T) {
+	days := 7
+	cases := []struct {
+		Name       string
+		Properties StorageServiceProperties
+		Expected   string
+	}{
+		{
+			Name:       "Empty",
+			Properties: StorageServiceProperties{},
+			Expected:   "<StorageServiceProperties></StorageServiceProperties>",
+		},
+		{
+			Name: "Removing CORS Rules",
+			Properties: StorageServiceProperties{
+				Cors: &storage.Cors{},
+			},
+			Expected: "<StorageServiceProperties><Cors></Cors></StorageServiceProperties>",
+		},
+		{
+			Name: "Delete Retention Policy",
+			Properties: StorageServiceProperties{
+				DeleteRetentionPolicy: &storage.RetentionPolicy{
+					Enabled: true,
+					Days:    &days,
+				},
+			},
+			Expected: "<StorageServiceProperties><DeleteRetentionPolicy><Enabled>true</Enabled><Days>7</Days></DeleteRetentionPolicy></StorageServiceProperties>",
+		},
+		{
+			Name: "Static Website",
+			Properties: StorageServiceProperties{
+				StaticWebsite: &StorageStaticWebsite{
+					Enabled:       true,
+					IndexDocument: "index.html",
+				},
+			},
+			Expected: "<StorageServiceProperties><StaticWebsite><Enabled>true</Enabled><IndexDocument>index.html</IndexDocument></StaticWebsite></StorageServiceProperties>",
+		},
+		{
+			Name: "Disabling the Static Website",
+			Properties: StorageServiceProperties{
+				StaticWebsite: &StorageStaticWebsite{},
+			},
+			Expected: "<StorageServiceProperties><StaticWebsite><Enabled>false</Enabled></StaticWebsite></StorageServiceProperties>",
+		},
+	}
+
+	for _, v := range cases {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual, err := xml.Marshal(v.Properties)
+		if err != nil {
+			t.Fatalf("Error marshalling: %+v", err)
+		}
+
+		if string(actual) != v.Expected {
+			t.Fatalf("Expected %q but got %q", v.Expected, string(actual))
+		}
+	}
+}
+
+func TestStorageServicePropertiesUnmarshal(t *testing.T) {
+	input := `<?xml version="1.0" encoding="utf-8"?><StorageServiceProperties><Logging><Version>1.0</Version><Read>false</Read><Write>false</Write><Delete>false</Delete><RetentionPolicy><Enabled>false</Enabled></RetentionPolicy></Logging><Cors><CorsRule><AllowedMethods>GET,PUT</AllowedMethods><AllowedOrigins>*</AllowedOrigins><AllowedHeaders>*</AllowedHeaders><ExposedHeaders>*</ExposedHeaders><MaxAgeInSeconds>3600</MaxAgeInSeconds></CorsRule></Cors><DeleteRetentionPolicy><Enabled>true</Enabled><Days>14</Days></DeleteRetentionPolicy><StaticWebsite><Enabled>true</Enabled><IndexDocument>index.html</IndexDocument><ErrorDocument404Path>404.html</ErrorDocument404Path></StaticWebsite></StorageServiceProperties>`
+
+	var props StorageServiceProperties
+	if err := xml.Unmarshal([]byte(input), &props); err != nil {
+		t.Fatalf("Error unmarshalling: %+v", err)
+	}
+
+	if props.Logging == nil || props.Logging.Version != "1.0" {
+		t.Fatalf("Expected the Logging Version to be `1.0` but got %+v", props.Logging)
+	}
+	if props.Cors == nil || len(props.Cors.CorsRule) != 1 || props.Cors.CorsRule[0].AllowedMethods != "GET,PUT" {
+		t.Fatalf("Expected a single CORS Rule but got %+v", props.Cors)
+	}
+	if p := props.DeleteRetentionPolicy; p == nil || !p.Enabled || p.Days == nil || *p.Days != 14 {
+		t.Fatalf("Expected the Delete Retention Policy to be enabled for 14 days but got %+v", p)
+	}
+	if w := props.StaticWebsite; w == nil || !w.Enabled || w.IndexDocument != "index.html" || w.ErrorDocument404Path != "404.html" {
+		t.Fatalf("Expected the Static Website to be enabled but got %+v", w)
+	}
+}
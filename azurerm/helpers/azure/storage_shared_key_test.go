@@ -0,0 +1,78 @@
+package azure
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+func TestStorageSharedKeyStringToSign(t *testing.T) {
+	req, err := http.NewRequest(http.MethodPut, "https://example.blob.core.windows.net/?restype=service&comp=properties", strings.NewReader("<StorageServiceProperties />"))
+	if err != nil {
+		t.Fatalf("Error building the request: %+v", err)
+	}
+	req.Header.Set("Content-Type", "application/xml")
+	req.Header.Set("x-ms-version", "2018-03-28")
+	req.Header.Set("x-ms-date", "Mon, 01 Apr 2019 12:00:00 GMT")
+	req.Header.Set("User-Agent", "example")
+
+	expected := strings.Join([]string{
+		"PUT",
+		"",
+		"",
+		"28",
+		"",
+		"application/xml",
+		"",
+		"",
+		"",
+		"",
+		"",
+		"",
+		"x-ms-date:Mon, 01 Apr 2019 12:00:00 GMT",
+		"x-ms-version:2018-03-28",
+		"/example/",
+		"comp:properties",
+		"restype:service",
+	}, "\n")
+
+	if actual := storageSharedKeyStringToSign("example", req); actual != expected {
+		t.Fatalf("Expected the String to Sign to be:\n%q\n\nbut got:\n%q", expected, actual)
+	}
+}
+
+func TestStorageSharedKeyAuthorizer(t *testing.T) {
+	if _, err := NewStorageSharedKeyAuthorizer("example", "not base64!"); err == nil {
+		t.Fatalf("Expected an error for an invalid Access Key but didn't get one")
+	}
+
+	authorizer, err := NewStorageSharedKeyAuthorizer("example", "ZXhhbXBsZQ==")
+	if err != nil {
+		t.Fatalf("Error building the Authorizer: %+v", err)
+	}
+
+	req, err := http.NewRequest(http.MethodGet, "https://example.queue.core.windows.net/?restype=service&comp=properties", nil)
+	if err != nil {
+		t.Fatalf("Error building the request: %+v", err)
+	}
+	req = req.WithContext(WithCorrelationRequestID(req.Context(), "abc123"))
+
+	req, err = autorest.Prepare(req, authorizer.WithAuthorization())
+	if err != nil {
+		t.Fatalf("Error preparing the request: %+v", err)
+	}
+
+	if v := req.Header.Get(correlationRequestIDHeader); v != "abc123" {
+		t.Fatalf("Expected the Correlation Request ID to be %q but got %q", "abc123", v)
+	}
+	if v := req.Header.Get("x-ms-date"); v == "" {
+		t.Fatalf("Expected the `x-ms-date` header to be set")
+	}
+
+	expected := "SharedKey example:" + authorizer.sign(storageSharedKeyStringToSign("example", req))
+	if v := req.Header.Get("Authorization"); v != expected {
+		t.Fatalf("Expected the Authorization header to be %q but got %q", expected, v)
+	}
+}
@@ -0,0 +1,230 @@
+package azure
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// FlattenTemplateDeploymentOutputs returns the Outputs of a Template Deployment as a JSON object of the name of each
+// Output to its value - preserving the type of the value, such that arrays and objects can be decoded using `jsondecode`
+func FlattenTemplateDeploymentOutputs(input interface{}) (string, error) {
+	outputs := make(map[string]interface{})
+
+	if values, ok := input.(map[string]interface{}); ok {
+		for key, raw := range values {
+			output, ok := raw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			if value, ok := output["value"]; ok {
+				outputs[key] = value
+			}
+		}
+	}
+
+	result, err := json.Marshal(outputs)
+	if err != nil {
+		return "", fmt.Errorf("Error serializing the Template Deployment Outputs to JSON: %+v", err)
+	}
+
+	return string(result), nil
+}
+
+// TemplateDeploymentWhatIfFunc evaluates a `what-if` of the Template Deployment being planned, returning the changes
+// which it would make to the resources in Azure
+type TemplateDeploymentWhatIfFunc func(d *schema.ResourceDiff, meta interface{}) ([]TemplateDeploymentWhatIfChange, error)
+
+// TemplateDeploymentCustomizeDiff returns a CustomizeDiffFunc which populates `template_changes` using a `what-if`
+// of the Template Deployment when it's created or any of the keys change - and marks `outputs_json` as computed, since
+// the Outputs may change as a result. The `what-if` can only be evaluated once each of the keys are known, and can't
+// be evaluated when the scope doesn't exist yet (such as a Resource Group created in the same apply), in which case
+// `template_changes` is left as computed
+func TemplateDeploymentCustomizeDiff(whatIf TemplateDeploymentWhatIfFunc, keys ...string) schema.CustomizeDiffFunc {
+	return func(d *schema.ResourceDiff, meta interface{}) error {
+		changed := d.Id() == ""
+		for _, key := range keys {
+			if d.HasChange(key) {
+				changed = true
+			}
+		}
+		if !changed {
+			return nil
+		}
+
+		if err := d.SetNewComputed("outputs_json"); err != nil {
+			return fmt.Errorf("Error marking `outputs_json` as computed: %+v", err)
+		}
+
+		if !valuesKnown(d, keys...) {
+			return d.SetNewComputed("template_changes")
+		}
+
+		changes, err := whatIf(d, meta)
+		if err != nil {
+			log.Printf("[WARN] Unable to determine the changes the Template Deployment %q would make: %+v", d.Get("name"), err)
+			return d.SetNewComputed("template_changes")
+		}
+
+		return d.SetNew("template_changes", FlattenTemplateDeploymentWhatIfChanges(changes))
+	}
+}
+
+// FlattenTemplateDeploymentWhatIfChanges returns a summary of each resource which a Template Deployment would create
+// (`+`), change (`~`) or delete (`-`), sorted by the ID of the resource. Resources which wouldn't be changed (or which
+// are ignored, since these aren't within the Template in an Incremental deployment) aren't included, and those
+// whose changes can't be determined by Azure are shown as `?`
+func FlattenTemplateDeploymentWhatIfChanges(input []TemplateDeploymentWhatIfChange) []string {
+	symbols := map[string]string{
+		"create":      "+",
+		"delete":      "-",
+		"deploy":      "~",
+		"modify":      "~",
+		"unsupported": "?",
+	}
+
+	changes := make([]TemplateDeploymentWhatIfChange, 0)
+	for _, change := range input {
+		if _, ok := symbols[strings.ToLower(change.ChangeType)]; ok && change.ResourceID != nil {
+			changes = append(changes, change)
+		}
+	}
+	sort.SliceStable(changes, func(i, j int) bool {
+		return strings.ToLower(*changes[i].ResourceID) < strings.ToLower(*changes[j].ResourceID)
+	})
+
+	result := make([]string, 0)
+	for _, change := range changes {
+		result = append(result, fmt.Sprintf("%s %s", symbols[strings.ToLower(change.ChangeType)], *change.ResourceID))
+	}
+
+	return result
+}
+
+// TemplateDeploymentCreatedResourceIDs returns the ID of each resource created by the Operations of a Template Deployment,
+// the most recently deployed first. Resources which were only read (or acted upon) by the Deployment aren't included
+func TemplateDeploymentCreatedResourceIDs(operations []TemplateDeploymentOperation) []string {
+	sorted := make([]TemplateDeploymentOperation, len(operations))
+	copy(sorted, operations)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return templateDeploymentOperationTimestamp(sorted[i]) > templateDeploymentOperationTimestamp(sorted[j])
+	})
+
+	ids := make([]string, 0)
+	seen := make(map[string]struct{})
+	for _, operation := range sorted {
+		if operation.Properties == nil || operation.Properties.TargetResource == nil || operation.Properties.TargetResource.ID == nil {
+			continue
+		}
+
+		if !strings.EqualFold(operation.Properties.ProvisioningOperation, TemplateDeploymentProvisioningOperationCreate) {
+			continue
+		}
+
+		id := *operation.Properties.TargetResource.ID
+		if _, ok := seen[strings.ToLower(id)]; ok {
+			continue
+		}
+		seen[strings.ToLower(id)] = struct{}{}
+		ids = append(ids, id)
+	}
+
+	return ids
+}
+
+// TemplateDeploymentResourceIDs returns the ID of each resource created by the Operations of a Template Deployment, in the
+// order they should be deleted (the most recently deployed first) - excluding those nested within another resource,
+// since these are deleted with their parent (for example resources within a Resource Group created by the Deployment).
+// The `retained` resources (for example a Resource Group which also contains other resources) aren't included, but any
+// resources created within them are
+func TemplateDeploymentResourceIDs(operations []TemplateDeploymentOperation, retained []string) []string {
+	ids := make([]string, 0)
+	for _, id := range TemplateDeploymentCreatedResourceIDs(operations) {
+		keep := false
+		for _, v := range retained {
+			if strings.EqualFold(id, v) {
+				keep = true
+				break
+			}
+		}
+
+		if !keep {
+			ids = append(ids, id)
+		}
+	}
+
+	result := make([]string, 0)
+	for _, id := range ids {
+		nested := false
+		for _, parent := range ids {
+			if strings.HasPrefix(strings.ToLower(id), strings.ToLower(parent)+"/") {
+				nested = true
+				break
+			}
+		}
+
+		if !nested {
+			result = append(result, id)
+		}
+	}
+
+	return result
+}
+
+// ParseResourceProviderAndType returns the Resource Provider Namespace and the Resource Type of the Resource ID,
+// for example `Microsoft.Network` and `virtualNetworks/subnets` for the ID of a Subnet
+func ParseResourceProviderAndType(id string) (string, string, error) {
+	segments := strings.Split(strings.Trim(id, "/"), "/")
+
+	index := -1
+	for i, segment := range segments {
+		if strings.EqualFold(segment, "providers") {
+			index = i
+		}
+	}
+
+	// the namespace should be followed by (one or more) pairs of the type and the name
+	if index == -1 || len(segments) < index+4 || (len(segments)-index-2)%2 != 0 {
+		return "", "", fmt.Errorf("Expected %q to be the ID of a resource within a Resource Provider", id)
+	}
+
+	types := make([]string, 0)
+	for i := index + 2; i < len(segments); i += 2 {
+		types = append(types, segments[i])
+	}
+
+	return segments[index+1], strings.Join(types, "/"), nil
+}
+
+// LatestAPIVersion returns the most recent stable API Version from the API Versions of a Resource Type,
+// falling back to the most recent Preview API Version where no stable API Version is available
+func LatestAPIVersion(versions []string) string {
+	sorted := make([]string, len(versions))
+	copy(sorted, versions)
+	sort.Sort(sort.Reverse(sort.StringSlice(sorted)))
+
+	for _, version := range sorted {
+		if !strings.Contains(strings.ToLower(version), "preview") {
+			return version
+		}
+	}
+
+	if len(sorted) > 0 {
+		return sorted[0]
+	}
+
+	return ""
+}
+
+func templateDeploymentOperationTimestamp(operation TemplateDeploymentOperation) int64 {
+	if operation.Properties == nil || operation.Properties.Timestamp == nil {
+		return 0
+	}
+
+	return operation.Properties.Timestamp.UnixNano()
+}
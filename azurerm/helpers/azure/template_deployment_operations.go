@@ -0,0 +1,130 @@
+package azure
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2018-05-01/resources"
+	"github.com/Azure/go-autorest/autorest"
+	az "github.com/Azure/go-autorest/autorest/azure"
+)
+
+// templateDeploymentOperationsAPIVersion is the version of the Resources API which returns the Provisioning Operation
+// of each Deployment Operation (and supports Deployments scoped to a Management Group)
+const templateDeploymentOperationsAPIVersion = "2019-10-01"
+
+// TemplateDeploymentProvisioningOperationCreate is the Provisioning Operation of a Deployment Operation which created a resource
+const TemplateDeploymentProvisioningOperationCreate = "Create"
+
+// TemplateDeploymentOperation is an Operation performed by a Template Deployment
+type TemplateDeploymentOperation struct {
+	ID          *string                                `json:"id,omitempty"`
+	OperationID *string                                `json:"operationId,omitempty"`
+	Properties  *TemplateDeploymentOperationProperties `json:"properties,omitempty"`
+}
+
+// TemplateDeploymentOperationProperties are the properties of a Template Deployment Operation, including the
+// Provisioning Operation which isn't available in the version of the Resources SDK we're using
+type TemplateDeploymentOperationProperties struct {
+	resources.DeploymentOperationProperties
+
+	// ProvisioningOperation is the operation performed on the Target Resource - such as `Create`, `Read` or `Action`
+	ProvisioningOperation string `json:"provisioningOperation,omitempty"`
+}
+
+type templateDeploymentOperationsListResult struct {
+	Value    *[]TemplateDeploymentOperation `json:"value,omitempty"`
+	NextLink *string                        `json:"nextLink,omitempty"`
+}
+
+// TemplateDeploymentOperationsClient is a client for the Operations of Template Deployments at any scope
+type TemplateDeploymentOperationsClient struct {
+	resources.BaseClient
+}
+
+// NewTemplateDeploymentOperationsClientWithBaseURI returns a TemplateDeploymentOperationsClient using the specified endpoint
+func NewTemplateDeploymentOperationsClientWithBaseURI(baseURI string, subscriptionID string) TemplateDeploymentOperationsClient {
+	return TemplateDeploymentOperationsClient{
+		BaseClient: resources.NewWithBaseURI(baseURI, subscriptionID),
+	}
+}
+
+// ListAtResourceGroup returns all of the Operations performed by the Template Deployment within the Resource Group
+func (client TemplateDeploymentOperationsClient) ListAtResourceGroup(ctx context.Context, resourceGroup string, deploymentName string) ([]TemplateDeploymentOperation, error) {
+	pathParameters := map[string]interface{}{
+		"deploymentName":    autorest.Encode("path", deploymentName),
+		"resourceGroupName": autorest.Encode("path", resourceGroup),
+		"subscriptionId":    autorest.Encode("path", client.SubscriptionID),
+	}
+
+	path := autorest.WithPathParameters("/subscriptions/{subscriptionId}/resourcegroups/{resourceGroupName}/providers/Microsoft.Resources/deployments/{deploymentName}/operations", pathParameters)
+	return client.list(ctx, "ListAtResourceGroup", path)
+}
+
+// ListAtSubscription returns all of the Operations performed by the Template Deployment within the Subscription
+func (client TemplateDeploymentOperationsClient) ListAtSubscription(ctx context.Context, deploymentName string) ([]TemplateDeploymentOperation, error) {
+	pathParameters := map[string]interface{}{
+		"deploymentName": autorest.Encode("path", deploymentName),
+		"subscriptionId": autorest.Encode("path", client.SubscriptionID),
+	}
+
+	path := autorest.WithPathParameters("/subscriptions/{subscriptionId}/providers/Microsoft.Resources/deployments/{deploymentName}/operations", pathParameters)
+	return client.list(ctx, "ListAtSubscription", path)
+}
+
+// ListAtManagementGroup returns all of the Operations performed by the Template Deployment within the Management Group
+func (client TemplateDeploymentOperationsClient) ListAtManagementGroup(ctx context.Context, managementGroupName string, deploymentName string) ([]TemplateDeploymentOperation, error) {
+	pathParameters := map[string]interface{}{
+		"deploymentName": autorest.Encode("path", deploymentName),
+		"groupId":        autorest.Encode("path", managementGroupName),
+	}
+
+	path := autorest.WithPathParameters("/providers/Microsoft.Management/managementGroups/{groupId}/providers/Microsoft.Resources/deployments/{deploymentName}/operations", pathParameters)
+	return client.list(ctx, "ListAtManagementGroup", path)
+}
+
+func (client TemplateDeploymentOperationsClient) list(ctx context.Context, method string, path autorest.PrepareDecorator) ([]TemplateDeploymentOperation, error) {
+	operations := make([]TemplateDeploymentOperation, 0)
+
+	req, err := autorest.CreatePreparer(
+		autorest.AsGet(),
+		autorest.WithBaseURL(client.BaseURI),
+		path,
+		autorest.WithQueryParameters(map[string]interface{}{
+			"api-version": templateDeploymentOperationsAPIVersion,
+		})).Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		return nil, autorest.NewErrorWithError(err, "azure.TemplateDeploymentOperationsClient", method, nil, "Failure preparing request")
+	}
+
+	for {
+		resp, err := autorest.SendWithSender(client, req)
+		if err != nil {
+			return nil, autorest.NewErrorWithError(err, "azure.TemplateDeploymentOperationsClient", method, resp, "Failure sending request")
+		}
+
+		var page templateDeploymentOperationsListResult
+		err = autorest.Respond(
+			resp,
+			client.ByInspecting(),
+			az.WithErrorUnlessStatusCode(http.StatusOK),
+			autorest.ByUnmarshallingJSON(&page),
+			autorest.ByClosing())
+		if err != nil {
+			return nil, autorest.NewErrorWithError(err, "azure.TemplateDeploymentOperationsClient", method, resp, "Failure responding to request")
+		}
+
+		if page.Value != nil {
+			operations = append(operations, *page.Value...)
+		}
+
+		if page.NextLink == nil || *page.NextLink == "" {
+			return operations, nil
+		}
+
+		req, err = autorest.Prepare((&http.Request{}).WithContext(ctx), autorest.AsGet(), autorest.WithBaseURL(*page.NextLink))
+		if err != nil {
+			return nil, autorest.NewErrorWithError(err, "azure.TemplateDeploymentOperationsClient", method, nil, "Failure preparing request")
+		}
+	}
+}
@@ -0,0 +1,337 @@
+package azure
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2018-05-01/resources"
+	"github.com/Azure/go-autorest/autorest/date"
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestFlattenTemplateDeploymentOutputs(t *testing.T) {
+	input := map[string]interface{}{
+		"name": map[string]interface{}{
+			"type":  "String",
+			"value": "example",
+		},
+		"count": map[string]interface{}{
+			"type":  "Int",
+			"value": float64(3),
+		},
+		"zones": map[string]interface{}{
+			"type":  "Array",
+			"value": []interface{}{"1", "2"},
+		},
+		"tags": map[string]interface{}{
+			"type":  "Object",
+			"value": map[string]interface{}{"environment": "Production"},
+		},
+	}
+
+	actual, err := FlattenTemplateDeploymentOutputs(input)
+	if err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+
+	expected := `{"count":3,"name":"example","tags":{"environment":"Production"},"zones":["1","2"]}`
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+
+	actual, err = FlattenTemplateDeploymentOutputs(nil)
+	if err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+	if actual != "{}" {
+		t.Fatalf("Expected an empty object but got %q", actual)
+	}
+}
+
+func TestFlattenTemplateDeploymentWhatIfChanges(t *testing.T) {
+	id := func(name string) *string {
+		v := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Storage/storageAccounts/" + name
+		return &v
+	}
+
+	input := []TemplateDeploymentWhatIfChange{
+		{ResourceID: id("fourth"), ChangeType: "Modify"},
+		{ResourceID: id("first"), ChangeType: "Create"},
+		{ResourceID: id("second"), ChangeType: "NoChange"},
+		{ResourceID: id("third"), ChangeType: "Delete"},
+		{ResourceID: id("fifth"), ChangeType: "Ignore"},
+		{ResourceID: id("sixth"), ChangeType: "Deploy"},
+		{ResourceID: id("seventh"), ChangeType: "Unsupported"},
+		{ChangeType: "Create"},
+	}
+
+	expected := []string{
+		"+ " + *id("first"),
+		"~ " + *id("fourth"),
+		"? " + *id("seventh"),
+		"~ " + *id("sixth"),
+		"- " + *id("third"),
+	}
+	if actual := FlattenTemplateDeploymentWhatIfChanges(input); !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+
+	if actual := FlattenTemplateDeploymentWhatIfChanges(nil); len(actual) != 0 {
+		t.Fatalf("Expected no changes but got %+v", actual)
+	}
+}
+
+func TestTemplateDeploymentWhatIfClient(t *testing.T) {
+	var polled int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/resourcegroups/example/providers/Microsoft.Resources/deployments/deploy/whatIf"):
+			body, _ := ioutil.ReadAll(r.Body)
+			if !strings.Contains(string(body), `"mode":"Incremental"`) {
+				t.Errorf("Expected the Deployment Mode to be sent but got %s", body)
+			}
+
+			w.Header().Set("Location", "http://"+r.Host+"/operationResults/1")
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusAccepted)
+
+		case r.Method == http.MethodGet && r.URL.Path == "/operationResults/1":
+			// the `what-if` is still being evaluated the first time the result is polled
+			if atomic.AddInt32(&polled, 1) == 1 {
+				w.Header().Set("Location", "http://"+r.Host+"/operationResults/1")
+				w.Header().Set("Retry-After", "0")
+				w.WriteHeader(http.StatusAccepted)
+				return
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"status":"Succeeded","properties":{"changes":[{"resourceId":"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Storage/storageAccounts/first","changeType":"Create"}]}}`)) // nolint: errcheck
+
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := NewTemplateDeploymentWhatIfClientWithBaseURI(server.URL, "00000000-0000-0000-0000-000000000000")
+	client.PollingDelay = 0
+
+	result, err := client.WhatIfAtResourceGroup(context.Background(), "example", "deploy", TemplateDeploymentWhatIf{
+		Properties: &resources.DeploymentProperties{
+			Mode:     resources.Incremental,
+			Template: map[string]interface{}{"resources": []interface{}{}},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+
+	if result.Properties == nil || result.Properties.Changes == nil || len(*result.Properties.Changes) != 1 {
+		t.Fatalf("Expected a single change but got %+v", result.Properties)
+	}
+	if change := (*result.Properties.Changes)[0]; change.ChangeType != "Create" {
+		t.Fatalf("Expected the Storage Account to be created but got %q", change.ChangeType)
+	}
+}
+
+func TestTemplateDeploymentResourceIDs(t *testing.T) {
+	operation := func(id string, provisioningOperation string, minutes int) TemplateDeploymentOperation {
+		timestamp := date.Time{Time: time.Date(2019, 1, 1, 0, minutes, 0, 0, time.UTC)}
+		return TemplateDeploymentOperation{
+			Properties: &TemplateDeploymentOperationProperties{
+				DeploymentOperationProperties: resources.DeploymentOperationProperties{
+					Timestamp:      &timestamp,
+					TargetResource: &resources.TargetResource{ID: &id},
+				},
+				ProvisioningOperation: provisioningOperation,
+			},
+		}
+	}
+
+	operations := []TemplateDeploymentOperation{
+		operation("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1", "Create", 1),
+		operation("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1", "Create", 2),
+		operation("/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Authorization/roleAssignments/assignment1", "Create", 3),
+		operation("/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Authorization/roleAssignments/assignment1", "Create", 4),
+		// a pre-existing Resource Group which the Deployment only read is left alone, but the resources it created within it aren't
+		operation("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/existing", "Read", 5),
+		operation("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/existing/providers/Microsoft.Network/virtualNetworks/network2", "Create", 6),
+		operation("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/existing/providers/Microsoft.Network/virtualNetworks/network3", "Action", 7),
+		{},
+	}
+
+	expected := []string{
+		"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/existing/providers/Microsoft.Network/virtualNetworks/network2",
+		"/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Authorization/roleAssignments/assignment1",
+		"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1",
+	}
+
+	actual := TemplateDeploymentResourceIDs(operations, nil)
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+
+	// a Resource Group created by the Deployment which also contains other resources is retained
+	retained := []string{"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/GROUP1"}
+	expected = []string{
+		"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/existing/providers/Microsoft.Network/virtualNetworks/network2",
+		"/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Authorization/roleAssignments/assignment1",
+		"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1",
+	}
+
+	actual = TemplateDeploymentResourceIDs(operations, retained)
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
+func TestParseResourceProviderAndType(t *testing.T) {
+	cases := []struct {
+		ID        string
+		Namespace string
+		Type      string
+		Valid     bool
+	}{
+		{
+			ID:        "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1",
+			Namespace: "Microsoft.Network",
+			Type:      "virtualNetworks",
+			Valid:     true,
+		},
+		{
+			ID:        "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1",
+			Namespace: "Microsoft.Network",
+			Type:      "virtualNetworks/subnets",
+			Valid:     true,
+		},
+		{
+			ID:        "/providers/Microsoft.Management/managementGroups/group1/providers/Microsoft.Authorization/policyDefinitions/policy1",
+			Namespace: "Microsoft.Authorization",
+			Type:      "policyDefinitions",
+			Valid:     true,
+		},
+		{
+			ID:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1",
+			Valid: false,
+		},
+		{
+			ID:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks",
+			Valid: false,
+		},
+	}
+
+	for _, v := range cases {
+		t.Logf("[DEBUG] Testing %q", v.ID)
+
+		namespace, resourceType, err := ParseResourceProviderAndType(v.ID)
+		if !v.Valid {
+			if err == nil {
+				t.Fatalf("Expected an error but didn't get one")
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+		if namespace != v.Namespace || resourceType != v.Type {
+			t.Fatalf("Expected %q / %q but got %q / %q", v.Namespace, v.Type, namespace, resourceType)
+		}
+	}
+}
+
+func TestLatestAPIVersion(t *testing.T) {
+	cases := []struct {
+		Versions []string
+		Expected string
+	}{
+		{
+			Versions: []string{"2018-02-01", "2019-04-01", "2019-06-01-preview", "2017-10-01"},
+			Expected: "2019-04-01",
+		},
+		{
+			Versions: []string{"2018-02-01-preview", "2019-01-01-preview"},
+			Expected: "2019-01-01-preview",
+		},
+		{
+			Versions: []string{},
+			Expected: "",
+		},
+	}
+
+	for _, v := range cases {
+		if actual := LatestAPIVersion(v.Versions); actual != v.Expected {
+			t.Fatalf("Expected %q for %+v but got %q", v.Expected, v.Versions, actual)
+		}
+	}
+}
+
+func TestTemplateDeploymentCustomizeDiff(t *testing.T) {
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"template_body": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"parameters_body": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"template_changes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"outputs_json": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+
+	storageAccountId := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Storage/storageAccounts/first"
+	whatIf := func(d *schema.ResourceDiff, _ interface{}) ([]TemplateDeploymentWhatIfChange, error) {
+		if d.Get("template_body").(string) == "" {
+			return nil, fmt.Errorf("Expected the `template_body` to be available")
+		}
+
+		return []TemplateDeploymentWhatIfChange{
+			{
+				ResourceID: &storageAccountId,
+				ChangeType: "Create",
+			},
+		}, nil
+	}
+	resource.CustomizeDiff = TemplateDeploymentCustomizeDiff(whatIf, "template_body", "parameters_body")
+
+	raw, err := config.NewRawConfig(map[string]interface{}{
+		"template_body": `{"resources":[{"type":"Microsoft.Storage/storageAccounts","name":"first"}]}`,
+	})
+	if err != nil {
+		t.Fatalf("Error building the config: %+v", err)
+	}
+
+	diff, err := resource.Diff(nil, terraform.NewResourceConfig(raw), nil)
+	if err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+
+	if v := diff.Attributes["template_changes.0"]; v == nil || v.New != "+ "+storageAccountId {
+		t.Fatalf("Expected the Storage Account to be created but got %+v", v)
+	}
+	if v := diff.Attributes["outputs_json"]; v == nil || !v.NewComputed {
+		t.Fatalf("Expected `outputs_json` to be computed but got %+v", v)
+	}
+}
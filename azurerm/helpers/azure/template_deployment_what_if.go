@@ -0,0 +1,144 @@
+package azure
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2018-05-01/resources"
+	"github.com/Azure/go-autorest/autorest"
+	az "github.com/Azure/go-autorest/autorest/azure"
+)
+
+// templateDeploymentWhatIfAPIVersion is the earliest version of the Resources API which supports a `what-if` of
+// Template Deployments at each scope (including those scoped to a Management Group)
+const templateDeploymentWhatIfAPIVersion = "2020-10-01"
+
+// TemplateDeploymentWhatIf is the Template Deployment which a `what-if` is evaluated for
+type TemplateDeploymentWhatIf struct {
+	// Location is required for Template Deployments scoped to a Subscription or Management Group
+	Location   *string                         `json:"location,omitempty"`
+	Properties *resources.DeploymentProperties `json:"properties,omitempty"`
+}
+
+// TemplateDeploymentWhatIfResult is the result of a `what-if` of a Template Deployment
+type TemplateDeploymentWhatIfResult struct {
+	autorest.Response `json:"-"`
+	Status            *string                                   `json:"status,omitempty"`
+	Properties        *TemplateDeploymentWhatIfResultProperties `json:"properties,omitempty"`
+	Error             *resources.ManagementErrorWithDetails     `json:"error,omitempty"`
+}
+
+// TemplateDeploymentWhatIfResultProperties are the properties of the result of a `what-if` of a Template Deployment
+type TemplateDeploymentWhatIfResultProperties struct {
+	Changes *[]TemplateDeploymentWhatIfChange `json:"changes,omitempty"`
+}
+
+// TemplateDeploymentWhatIfChange is a change which the Template Deployment would make to a single resource
+type TemplateDeploymentWhatIfChange struct {
+	ResourceID *string `json:"resourceId,omitempty"`
+
+	// ChangeType is one of `Create`, `Delete`, `Deploy`, `Ignore`, `Modify`, `NoChange` or `Unsupported`
+	ChangeType string `json:"changeType,omitempty"`
+}
+
+// TemplateDeploymentWhatIfClient is a client for the `what-if` of Template Deployments at any scope - which isn't
+// supported by the version of the Resources SDK we're using, so these requests are built using the models from it
+type TemplateDeploymentWhatIfClient struct {
+	resources.BaseClient
+}
+
+// NewTemplateDeploymentWhatIfClientWithBaseURI returns a TemplateDeploymentWhatIfClient using the specified endpoint
+func NewTemplateDeploymentWhatIfClientWithBaseURI(baseURI string, subscriptionID string) TemplateDeploymentWhatIfClient {
+	return TemplateDeploymentWhatIfClient{
+		BaseClient: resources.NewWithBaseURI(baseURI, subscriptionID),
+	}
+}
+
+// WhatIfAtResourceGroup returns the changes the Template Deployment would make within the Resource Group
+func (client TemplateDeploymentWhatIfClient) WhatIfAtResourceGroup(ctx context.Context, resourceGroup string, deploymentName string, parameters TemplateDeploymentWhatIf) (TemplateDeploymentWhatIfResult, error) {
+	pathParameters := map[string]interface{}{
+		"deploymentName":    autorest.Encode("path", deploymentName),
+		"resourceGroupName": autorest.Encode("path", resourceGroup),
+		"subscriptionId":    autorest.Encode("path", client.SubscriptionID),
+	}
+
+	path := autorest.WithPathParameters("/subscriptions/{subscriptionId}/resourcegroups/{resourceGroupName}/providers/Microsoft.Resources/deployments/{deploymentName}/whatIf", pathParameters)
+	return client.whatIf(ctx, "WhatIfAtResourceGroup", path, parameters)
+}
+
+// WhatIfAtSubscription returns the changes the Template Deployment would make within the Subscription
+func (client TemplateDeploymentWhatIfClient) WhatIfAtSubscription(ctx context.Context, deploymentName string, parameters TemplateDeploymentWhatIf) (TemplateDeploymentWhatIfResult, error) {
+	pathParameters := map[string]interface{}{
+		"deploymentName": autorest.Encode("path", deploymentName),
+		"subscriptionId": autorest.Encode("path", client.SubscriptionID),
+	}
+
+	path := autorest.WithPathParameters("/subscriptions/{subscriptionId}/providers/Microsoft.Resources/deployments/{deploymentName}/whatIf", pathParameters)
+	return client.whatIf(ctx, "WhatIfAtSubscription", path, parameters)
+}
+
+// WhatIfAtManagementGroup returns the changes the Template Deployment would make within the Management Group
+func (client TemplateDeploymentWhatIfClient) WhatIfAtManagementGroup(ctx context.Context, managementGroupName string, deploymentName string, parameters TemplateDeploymentWhatIf) (TemplateDeploymentWhatIfResult, error) {
+	pathParameters := map[string]interface{}{
+		"deploymentName": autorest.Encode("path", deploymentName),
+		"groupId":        autorest.Encode("path", managementGroupName),
+	}
+
+	path := autorest.WithPathParameters("/providers/Microsoft.Management/managementGroups/{groupId}/providers/Microsoft.Resources/deployments/{deploymentName}/whatIf", pathParameters)
+	return client.whatIf(ctx, "WhatIfAtManagementGroup", path, parameters)
+}
+
+func (client TemplateDeploymentWhatIfClient) whatIf(ctx context.Context, method string, path autorest.PrepareDecorator, parameters TemplateDeploymentWhatIf) (result TemplateDeploymentWhatIfResult, err error) {
+	req, err := autorest.CreatePreparer(
+		autorest.AsPost(),
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.WithBaseURL(client.BaseURI),
+		path,
+		autorest.WithQueryParameters(map[string]interface{}{
+			"api-version": templateDeploymentWhatIfAPIVersion,
+		}),
+		autorest.WithJSON(parameters)).Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "azure.TemplateDeploymentWhatIfClient", method, nil, "Failure preparing request")
+	}
+
+	resp, err := autorest.SendWithSender(client, req, az.DoRetryWithRegistration(client.Client))
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		return result, autorest.NewErrorWithError(err, "azure.TemplateDeploymentWhatIfClient", method, resp, "Failure sending request")
+	}
+
+	// the `what-if` is evaluated asynchronously, with the result available once it's completed
+	future, err := az.NewFutureFromResponse(resp)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		return result, autorest.NewErrorWithError(err, "azure.TemplateDeploymentWhatIfClient", method, resp, "Failure sending request")
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return result, autorest.NewErrorWithError(err, "azure.TemplateDeploymentWhatIfClient", method, future.Response(), "Failure polling the result")
+	}
+
+	resp, err = future.GetResult(client)
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "azure.TemplateDeploymentWhatIfClient", method, resp, "Failure retrieving the result")
+	}
+
+	err = autorest.Respond(
+		resp,
+		client.ByInspecting(),
+		az.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "azure.TemplateDeploymentWhatIfClient", method, resp, "Failure responding to request")
+	}
+
+	if result.Error != nil && result.Error.Message != nil {
+		return result, fmt.Errorf("Error evaluating the `what-if` of the Template Deployment: %s", *result.Error.Message)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,566 @@
+package azure
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func SchemaVirtualMachineOSDisk() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"caching": {
+					Type:     schema.TypeString,
+					Required: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(compute.CachingTypesNone),
+						string(compute.CachingTypesReadOnly),
+						string(compute.CachingTypesReadWrite),
+					}, false),
+				},
+
+				"storage_account_type": {
+					Type:     schema.TypeString,
+					Required: true,
+					// whilst this can be updated it requires the Virtual Machine to be deallocated and the Disk converted
+					ForceNew: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(compute.StorageAccountTypesPremiumLRS),
+						string(compute.StorageAccountTypesStandardLRS),
+						string(compute.StorageAccountTypesStandardSSDLRS),
+					}, false),
+				},
+
+				"disk_size_gb": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.IntBetween(1, 4095),
+				},
+
+				"name": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ForceNew:     true,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+
+				"write_accelerator_enabled": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+
+				"id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func ExpandVirtualMachineOSDisk(input []interface{}, osType compute.OperatingSystemTypes) *compute.OSDisk {
+	raw := input[0].(map[string]interface{})
+
+	disk := compute.OSDisk{
+		Caching: compute.CachingTypes(raw["caching"].(string)),
+		ManagedDisk: &compute.ManagedDiskParameters{
+			StorageAccountType: compute.StorageAccountTypes(raw["storage_account_type"].(string)),
+		},
+		WriteAcceleratorEnabled: utils.Bool(raw["write_accelerator_enabled"].(bool)),
+
+		// these have to be hard-coded so there's no point exposing them
+		CreateOption: compute.DiskCreateOptionTypesFromImage,
+		OsType:       osType,
+	}
+
+	if v := raw["disk_size_gb"].(int); v > 0 {
+		disk.DiskSizeGB = utils.Int32(int32(v))
+	}
+
+	if v := raw["name"].(string); v != "" {
+		disk.Name = utils.String(v)
+	}
+
+	return &disk
+}
+
+func FlattenVirtualMachineOSDisk(input *compute.OSDisk) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	diskSizeGB := 0
+	if input.DiskSizeGB != nil {
+		diskSizeGB = int(*input.DiskSizeGB)
+	}
+
+	name := ""
+	if input.Name != nil {
+		name = *input.Name
+	}
+
+	id := ""
+	storageAccountType := ""
+	if disk := input.ManagedDisk; disk != nil {
+		storageAccountType = string(disk.StorageAccountType)
+		if disk.ID != nil {
+			id = *disk.ID
+		}
+	}
+
+	writeAcceleratorEnabled := false
+	if input.WriteAcceleratorEnabled != nil {
+		writeAcceleratorEnabled = *input.WriteAcceleratorEnabled
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"caching":                   string(input.Caching),
+			"storage_account_type":      storageAccountType,
+			"disk_size_gb":              diskSizeGB,
+			"name":                      name,
+			"write_accelerator_enabled": writeAcceleratorEnabled,
+			"id":                        id,
+		},
+	}
+}
+
+func SchemaVirtualMachineSourceImageReference() *schema.Schema {
+	return &schema.Schema{
+		Type:          schema.TypeList,
+		Optional:      true,
+		ForceNew:      true,
+		MaxItems:      1,
+		ConflictsWith: []string{"source_image_id"},
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"publisher": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+
+				"offer": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+
+				"sku": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+
+				"version": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+			},
+		},
+	}
+}
+
+func SchemaVirtualMachineSourceImageID() *schema.Schema {
+	return &schema.Schema{
+		Type:          schema.TypeString,
+		Optional:      true,
+		ForceNew:      true,
+		ValidateFunc:  ValidateResourceID,
+		ConflictsWith: []string{"source_image_reference"},
+	}
+}
+
+// ExpandVirtualMachineSourceImageReference returns the Image Reference for either the ID of a (Shared) Image
+// or a Platform Image - one of which must be specified
+func ExpandVirtualMachineSourceImageReference(referenceInput []interface{}, imageId string) (*compute.ImageReference, error) {
+	if imageId != "" {
+		return &compute.ImageReference{
+			ID: utils.String(imageId),
+		}, nil
+	}
+
+	if len(referenceInput) == 0 || referenceInput[0] == nil {
+		return nil, fmt.Errorf("Either a `source_image_id` or a `source_image_reference` block must be specified")
+	}
+
+	raw := referenceInput[0].(map[string]interface{})
+	return &compute.ImageReference{
+		Publisher: utils.String(raw["publisher"].(string)),
+		Offer:     utils.String(raw["offer"].(string)),
+		Sku:       utils.String(raw["sku"].(string)),
+		Version:   utils.String(raw["version"].(string)),
+	}, nil
+}
+
+func FlattenVirtualMachineSourceImageReference(input *compute.ImageReference) []interface{} {
+	// Images referenced by ID are exposed as `source_image_id` instead
+	if input == nil || input.ID != nil {
+		return []interface{}{}
+	}
+
+	publisher := ""
+	if input.Publisher != nil {
+		publisher = *input.Publisher
+	}
+
+	offer := ""
+	if input.Offer != nil {
+		offer = *input.Offer
+	}
+
+	sku := ""
+	if input.Sku != nil {
+		sku = *input.Sku
+	}
+
+	version := ""
+	if input.Version != nil {
+		version = *input.Version
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"publisher": publisher,
+			"offer":     offer,
+			"sku":       sku,
+			"version":   version,
+		},
+	}
+}
+
+func SchemaVirtualMachineBootDiagnostics() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"storage_account_uri": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validate.URLIsHTTPS,
+				},
+			},
+		},
+	}
+}
+
+func ExpandVirtualMachineBootDiagnostics(input []interface{}) *compute.DiagnosticsProfile {
+	if len(input) == 0 || input[0] == nil {
+		return &compute.DiagnosticsProfile{
+			BootDiagnostics: &compute.BootDiagnostics{
+				Enabled: utils.Bool(false),
+			},
+		}
+	}
+
+	raw := input[0].(map[string]interface{})
+	return &compute.DiagnosticsProfile{
+		BootDiagnostics: &compute.BootDiagnostics{
+			Enabled:    utils.Bool(true),
+			StorageURI: utils.String(raw["storage_account_uri"].(string)),
+		},
+	}
+}
+
+func FlattenVirtualMachineBootDiagnostics(input *compute.DiagnosticsProfile) []interface{} {
+	if input == nil || input.BootDiagnostics == nil {
+		return []interface{}{}
+	}
+
+	diagnostics := input.BootDiagnostics
+	if diagnostics.Enabled == nil || !*diagnostics.Enabled {
+		return []interface{}{}
+	}
+
+	storageAccountUri := ""
+	if diagnostics.StorageURI != nil {
+		storageAccountUri = *diagnostics.StorageURI
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"storage_account_uri": storageAccountUri,
+		},
+	}
+}
+
+func SchemaVirtualMachineIdentity() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"type": {
+					Type:     schema.TypeString,
+					Required: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(compute.ResourceIdentityTypeSystemAssigned),
+						string(compute.ResourceIdentityTypeUserAssigned),
+						string(compute.ResourceIdentityTypeSystemAssignedUserAssigned),
+					}, false),
+				},
+
+				"identity_ids": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: ValidateUserAssignedIdentityID,
+					},
+				},
+
+				"principal_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+
+				"tenant_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func ExpandVirtualMachineIdentity(input []interface{}) *compute.VirtualMachineIdentity {
+	if len(input) == 0 || input[0] == nil {
+		// the identity has to be explicitly removed
+		return &compute.VirtualMachineIdentity{
+			Type: compute.ResourceIdentityTypeNone,
+		}
+	}
+
+	raw := input[0].(map[string]interface{})
+	identity := compute.VirtualMachineIdentity{
+		Type: compute.ResourceIdentityType(raw["type"].(string)),
+	}
+
+	if identity.Type == compute.ResourceIdentityTypeUserAssigned || identity.Type == compute.ResourceIdentityTypeSystemAssignedUserAssigned {
+		identityIds := make(map[string]*compute.VirtualMachineIdentityUserAssignedIdentitiesValue)
+		for _, id := range raw["identity_ids"].(*schema.Set).List() {
+			identityIds[id.(string)] = &compute.VirtualMachineIdentityUserAssignedIdentitiesValue{}
+		}
+		identity.UserAssignedIdentities = identityIds
+	}
+
+	return &identity
+}
+
+func FlattenVirtualMachineIdentity(input *compute.VirtualMachineIdentity) []interface{} {
+	if input == nil || input.Type == compute.ResourceIdentityTypeNone {
+		return []interface{}{}
+	}
+
+	identityIds := make([]interface{}, 0)
+	for id := range input.UserAssignedIdentities {
+		identityIds = append(identityIds, id)
+	}
+
+	principalId := ""
+	if input.PrincipalID != nil {
+		principalId = *input.PrincipalID
+	}
+
+	tenantId := ""
+	if input.TenantID != nil {
+		tenantId = *input.TenantID
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"type":         string(input.Type),
+			"identity_ids": schema.NewSet(schema.HashString, identityIds),
+			"principal_id": principalId,
+			"tenant_id":    tenantId,
+		},
+	}
+}
+
+func SchemaVirtualMachineNetworkInterfaceIDs() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		MinItems: 1,
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: ValidateNetworkInterfaceID,
+		},
+	}
+}
+
+// ExpandVirtualMachineNetworkInterfaceIDs returns the Network Interface references for a Virtual Machine - where
+// the first Network Interface is the Primary
+func ExpandVirtualMachineNetworkInterfaceIDs(input []interface{}) *[]compute.NetworkInterfaceReference {
+	results := make([]compute.NetworkInterfaceReference, 0)
+
+	for i, v := range input {
+		results = append(results, compute.NetworkInterfaceReference{
+			ID: utils.String(v.(string)),
+			NetworkInterfaceReferenceProperties: &compute.NetworkInterfaceReferenceProperties{
+				Primary: utils.Bool(i == 0),
+			},
+		})
+	}
+
+	return &results
+}
+
+func FlattenVirtualMachineNetworkInterfaceIDs(input *[]compute.NetworkInterfaceReference) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	primary := make([]interface{}, 0)
+	others := make([]interface{}, 0)
+	for _, v := range *input {
+		if v.ID == nil {
+			continue
+		}
+
+		if props := v.NetworkInterfaceReferenceProperties; props != nil && props.Primary != nil && *props.Primary {
+			primary = append(primary, *v.ID)
+			continue
+		}
+
+		others = append(others, *v.ID)
+	}
+
+	return append(primary, others...)
+}
+
+// VirtualMachineSizeIsAvailable returns whether a Virtual Machine can be resized to the specified size whilst
+// it's running - other sizes require it to be deallocated, so that it can be moved to a different hardware cluster
+func VirtualMachineSizeIsAvailable(availableSizes *[]compute.VirtualMachineSize, size string) bool {
+	if availableSizes == nil {
+		return false
+	}
+
+	for _, v := range *availableSizes {
+		if v.Name != nil && strings.EqualFold(*v.Name, size) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// LinuxVirtualMachineAuthenticationCustomizeDiff validates at plan time that a Linux Virtual Machine can
+// be authenticated to using either an SSH Key or a Password
+func LinuxVirtualMachineAuthenticationCustomizeDiff(d *schema.ResourceDiff, _ interface{}) error {
+	if !valuesKnown(d, "admin_username", "admin_password", "admin_ssh_key", "disable_password_authentication") {
+		return nil
+	}
+
+	adminUsername := d.Get("admin_username").(string)
+	adminPassword := d.Get("admin_password").(string)
+	sshKeys := d.Get("admin_ssh_key").(*schema.Set).List()
+
+	if d.Get("disable_password_authentication").(bool) {
+		if adminPassword != "" {
+			return fmt.Errorf("`admin_password` can't be specified when `disable_password_authentication` is enabled")
+		}
+
+		if len(sshKeys) == 0 {
+			return fmt.Errorf("At least one `admin_ssh_key` must be specified when `disable_password_authentication` is enabled")
+		}
+	} else if adminPassword == "" {
+		return fmt.Errorf("An `admin_password` must be specified when `disable_password_authentication` is disabled")
+	}
+
+	// SSH Keys can only be added for the Admin User
+	for _, raw := range sshKeys {
+		v := raw.(map[string]interface{})
+		if username := v["username"].(string); username != "" && username != adminUsername {
+			return fmt.Errorf("The `username` of each `admin_ssh_key` must match the `admin_username` (%q) - got %q", adminUsername, username)
+		}
+	}
+
+	return nil
+}
+
+// VirtualMachineOSDiskCustomizeDiff validates at plan time that the OS Disk of a Virtual Machine isn't shrunk,
+// since Disks can only be expanded
+func VirtualMachineOSDiskCustomizeDiff(d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" || !d.HasChange("os_disk.0.disk_size_gb") || !valuesKnown(d, "os_disk.0.disk_size_gb") {
+		return nil
+	}
+
+	old, new := d.GetChange("os_disk.0.disk_size_gb")
+	if new.(int) > 0 && new.(int) < old.(int) {
+		return fmt.Errorf("The `disk_size_gb` of the `os_disk` can only be increased - got %d but it's currently %d", new.(int), old.(int))
+	}
+
+	return nil
+}
+
+// VirtualMachineIdentityCustomizeDiff validates at plan time that User Assigned Identities are only specified
+// when the type of the Identity includes them
+func VirtualMachineIdentityCustomizeDiff(d *schema.ResourceDiff, _ interface{}) error {
+	if !valuesKnown(d, "identity") {
+		return nil
+	}
+
+	identities := d.Get("identity").([]interface{})
+	if len(identities) == 0 || identities[0] == nil {
+		return nil
+	}
+
+	raw := identities[0].(map[string]interface{})
+	identityType := raw["type"].(string)
+	userAssigned := identityType == string(compute.ResourceIdentityTypeUserAssigned) || identityType == string(compute.ResourceIdentityTypeSystemAssignedUserAssigned)
+	identityIds := raw["identity_ids"].(*schema.Set).Len()
+
+	if userAssigned && identityIds == 0 {
+		return fmt.Errorf("At least one `identity_ids` must be specified when the `type` of the `identity` is %q", identityType)
+	}
+
+	if !userAssigned && identityIds > 0 {
+		return fmt.Errorf("`identity_ids` can only be specified when the `type` of the `identity` includes `UserAssigned`")
+	}
+
+	return nil
+}
+
+func SchemaVirtualMachineLicenseType() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		ValidateFunc: validation.StringInSlice([]string{
+			"Windows_Client",
+			"Windows_Server",
+		}, false),
+		DiffSuppressFunc: suppress.CaseDifference,
+	}
+}
+
+// FlattenVirtualMachineLicenseType returns the License Type of a Virtual Machine - where `None` is returned
+// by the API once the License Type has been removed
+func FlattenVirtualMachineLicenseType(input *string) string {
+	if input == nil || strings.EqualFold(*input, "None") {
+		return ""
+	}
+
+	return *input
+}
@@ -0,0 +1,304 @@
+package azure
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
+	"github.com/Azure/go-autorest/autorest"
+	az "github.com/Azure/go-autorest/autorest/azure"
+)
+
+// virtualMachineScaleSetAPIVersion is the version of the Compute API which supports Scale-In Policies and the
+// Protection Policies of the instances within a Virtual Machine Scale Set
+const virtualMachineScaleSetAPIVersion = "2019-03-01"
+
+const (
+	// VirtualMachineScaleSetScaleInPolicyDefault removes instances balanced across Zones and Fault Domains
+	VirtualMachineScaleSetScaleInPolicyDefault = "Default"
+
+	// VirtualMachineScaleSetScaleInPolicyNewestVM removes the newest instances first
+	VirtualMachineScaleSetScaleInPolicyNewestVM = "NewestVM"
+
+	// VirtualMachineScaleSetScaleInPolicyOldestVM removes the oldest instances first
+	VirtualMachineScaleSetScaleInPolicyOldestVM = "OldestVM"
+)
+
+// VirtualMachineScaleSetsClient is a client for Virtual Machine Scale Sets and their instances which supports the
+// properties which aren't available in the version of the Compute SDK we're using - the request for a Scale Set
+// is built from the SDK model, with the additional properties merged into it before it's sent
+type VirtualMachineScaleSetsClient struct {
+	compute.BaseClient
+}
+
+// NewVirtualMachineScaleSetsClient returns a VirtualMachineScaleSetsClient built on the (configured) SDK client
+func NewVirtualMachineScaleSetsClient(client compute.VirtualMachineScaleSetsClient) VirtualMachineScaleSetsClient {
+	return VirtualMachineScaleSetsClient{
+		BaseClient: client.BaseClient,
+	}
+}
+
+// VirtualMachineScaleSetExtendedProperties are the properties of a Virtual Machine Scale Set which aren't
+// available in the version of the Compute SDK we're using
+type VirtualMachineScaleSetExtendedProperties struct {
+	ScaleInPolicy *VirtualMachineScaleSetScaleInPolicy
+}
+
+// VirtualMachineScaleSetScaleInPolicy controls the order in which instances are removed when a Scale Set is scaled in
+type VirtualMachineScaleSetScaleInPolicy struct {
+	Rules *[]string `json:"rules,omitempty"`
+}
+
+// VirtualMachineScaleSetVMProtectionPolicy protects an instance within a Scale Set from being removed when the
+// Scale Set is scaled in, and/or from being modified by operations on the Scale Set
+type VirtualMachineScaleSetVMProtectionPolicy struct {
+	ProtectFromScaleIn         *bool `json:"protectFromScaleIn,omitempty"`
+	ProtectFromScaleSetActions *bool `json:"protectFromScaleSetActions,omitempty"`
+}
+
+type virtualMachineScaleSetExtendedResponse struct {
+	Properties *virtualMachineScaleSetExtendedResponseProperties `json:"properties,omitempty"`
+}
+
+type virtualMachineScaleSetExtendedResponseProperties struct {
+	ScaleInPolicy *VirtualMachineScaleSetScaleInPolicy `json:"scaleInPolicy,omitempty"`
+}
+
+type virtualMachineScaleSetVMResponse struct {
+	Properties *virtualMachineScaleSetVMResponseProperties `json:"properties,omitempty"`
+}
+
+type virtualMachineScaleSetVMResponseProperties struct {
+	ProtectionPolicy *VirtualMachineScaleSetVMProtectionPolicy `json:"protectionPolicy,omitempty"`
+}
+
+// CreateOrUpdate creates or updates the specified Virtual Machine Scale Set, including its extended properties
+func (client VirtualMachineScaleSetsClient) CreateOrUpdate(ctx context.Context, resourceGroup string, name string, scaleSet compute.VirtualMachineScaleSet, extended VirtualMachineScaleSetExtendedProperties) (result az.Future, err error) {
+	body, err := expandVirtualMachineScaleSetRequestBody(scaleSet, extended)
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "azure.VirtualMachineScaleSetsClient", "CreateOrUpdate", nil, "Failure building request body")
+	}
+
+	id := NewVirtualMachineScaleSetID(client.SubscriptionID, resourceGroup, name).ID()
+	return client.put(ctx, "CreateOrUpdate", id, body)
+}
+
+// Get retrieves the specified Virtual Machine Scale Set, along with its extended properties
+func (client VirtualMachineScaleSetsClient) Get(ctx context.Context, resourceGroup string, name string) (result compute.VirtualMachineScaleSet, extended *VirtualMachineScaleSetExtendedProperties, err error) {
+	var body json.RawMessage
+	id := NewVirtualMachineScaleSetID(client.SubscriptionID, resourceGroup, name).ID()
+	result.Response, err = client.get(ctx, "Get", id, &body)
+	if err != nil {
+		return result, nil, err
+	}
+
+	resp := result.Response
+	result, extended, err = parseVirtualMachineScaleSetResponseBody(body)
+	result.Response = resp
+	if err != nil {
+		return result, nil, autorest.NewErrorWithError(err, "azure.VirtualMachineScaleSetsClient", "Get", resp.Response, "Failure parsing response body")
+	}
+
+	return result, extended, nil
+}
+
+// GetInstanceProtectionPolicy retrieves the Protection Policy of the specified instance within a Virtual Machine Scale Set
+func (client VirtualMachineScaleSetsClient) GetInstanceProtectionPolicy(ctx context.Context, resourceGroup string, name string, instanceId string) (*VirtualMachineScaleSetVMProtectionPolicy, error) {
+	var instance virtualMachineScaleSetVMResponse
+	id := NewVirtualMachineScaleSetInstanceID(client.SubscriptionID, resourceGroup, name, instanceId).ID()
+	if _, err := client.get(ctx, "GetInstanceProtectionPolicy", id, &instance); err != nil {
+		return nil, err
+	}
+
+	if instance.Properties == nil || instance.Properties.ProtectionPolicy == nil {
+		return &VirtualMachineScaleSetVMProtectionPolicy{}, nil
+	}
+
+	return instance.Properties.ProtectionPolicy, nil
+}
+
+// UpdateInstanceProtectionPolicy updates the Protection Policy of the specified instance within a Virtual Machine
+// Scale Set - since instances can only be replaced as a whole, the existing instance is retrieved and sent back
+// with the updated Protection Policy
+func (client VirtualMachineScaleSetsClient) UpdateInstanceProtectionPolicy(ctx context.Context, resourceGroup string, name string, instanceId string, policy VirtualMachineScaleSetVMProtectionPolicy) (result az.Future, err error) {
+	instance := make(map[string]interface{})
+	id := NewVirtualMachineScaleSetInstanceID(client.SubscriptionID, resourceGroup, name, instanceId).ID()
+	if _, err := client.get(ctx, "UpdateInstanceProtectionPolicy", id, &instance); err != nil {
+		return result, err
+	}
+
+	properties, ok := instance["properties"].(map[string]interface{})
+	if !ok {
+		properties = make(map[string]interface{})
+		instance["properties"] = properties
+	}
+
+	// the Protection Policy is sent in full, since unset values are treated as `false`
+	properties["protectionPolicy"] = map[string]interface{}{
+		"protectFromScaleIn":         policy.ProtectFromScaleIn != nil && *policy.ProtectFromScaleIn,
+		"protectFromScaleSetActions": policy.ProtectFromScaleSetActions != nil && *policy.ProtectFromScaleSetActions,
+	}
+
+	return client.put(ctx, "UpdateInstanceProtectionPolicy", id, instance)
+}
+
+func (client VirtualMachineScaleSetsClient) put(ctx context.Context, method string, id string, parameters interface{}) (result az.Future, err error) {
+	req, err := client.preparer(ctx, id,
+		autorest.AsPut(),
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.WithJSON(parameters))
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "azure.VirtualMachineScaleSetsClient", method, nil, "Failure preparing request")
+	}
+
+	resp, err := client.send(req)
+	if err != nil {
+		return result, autorest.NewErrorWithError(err, "azure.VirtualMachineScaleSetsClient", method, resp, "Failure sending request")
+	}
+
+	return az.NewFutureFromResponse(resp)
+}
+
+func (client VirtualMachineScaleSetsClient) get(ctx context.Context, method string, id string, result interface{}) (autorest.Response, error) {
+	req, err := client.preparer(ctx, id, autorest.AsGet())
+	if err != nil {
+		return autorest.Response{}, autorest.NewErrorWithError(err, "azure.VirtualMachineScaleSetsClient", method, nil, "Failure preparing request")
+	}
+
+	resp, err := client.send(req)
+	if err != nil {
+		return autorest.Response{Response: resp}, autorest.NewErrorWithError(err, "azure.VirtualMachineScaleSetsClient", method, resp, "Failure sending request")
+	}
+
+	err = autorest.Respond(
+		resp,
+		client.ByInspecting(),
+		az.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(result),
+		autorest.ByClosing())
+	if err != nil {
+		return autorest.Response{Response: resp}, autorest.NewErrorWithError(err, "azure.VirtualMachineScaleSetsClient", method, resp, "Failure responding to request")
+	}
+
+	return autorest.Response{Response: resp}, nil
+}
+
+func (client VirtualMachineScaleSetsClient) preparer(ctx context.Context, id string, decorators ...autorest.PrepareDecorator) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": virtualMachineScaleSetAPIVersion,
+	}
+
+	decorators = append(decorators,
+		autorest.WithBaseURL(client.BaseURI),
+		autorest.WithPath(id),
+		autorest.WithQueryParameters(queryParameters))
+	return autorest.CreatePreparer(decorators...).Prepare((&http.Request{}).WithContext(ctx))
+}
+
+func (client VirtualMachineScaleSetsClient) send(req *http.Request) (*http.Response, error) {
+	return autorest.SendWithSender(client, req)
+}
+
+// expandVirtualMachineScaleSetRequestBody merges the extended properties into the request body built from the SDK model
+func expandVirtualMachineScaleSetRequestBody(scaleSet compute.VirtualMachineScaleSet, extended VirtualMachineScaleSetExtendedProperties) (map[string]interface{}, error) {
+	raw, err := json.Marshal(scaleSet)
+	if err != nil {
+		return nil, fmt.Errorf("Error serializing Virtual Machine Scale Set: %+v", err)
+	}
+
+	body := make(map[string]interface{})
+	if err := json.Unmarshal(raw, &body); err != nil {
+		return nil, fmt.Errorf("Error deserializing Virtual Machine Scale Set: %+v", err)
+	}
+
+	properties, ok := body["properties"].(map[string]interface{})
+	if !ok {
+		properties = make(map[string]interface{})
+		body["properties"] = properties
+	}
+
+	if extended.ScaleInPolicy != nil {
+		properties["scaleInPolicy"] = extended.ScaleInPolicy
+	}
+
+	return body, nil
+}
+
+// parseVirtualMachineScaleSetResponseBody parses the response body into the SDK model and the extended properties
+func parseVirtualMachineScaleSetResponseBody(body []byte) (compute.VirtualMachineScaleSet, *VirtualMachineScaleSetExtendedProperties, error) {
+	var scaleSet compute.VirtualMachineScaleSet
+	if err := json.Unmarshal(body, &scaleSet); err != nil {
+		return scaleSet, nil, fmt.Errorf("Error deserializing Virtual Machine Scale Set: %+v", err)
+	}
+
+	var response virtualMachineScaleSetExtendedResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return scaleSet, nil, fmt.Errorf("Error deserializing the extended properties of Virtual Machine Scale Set: %+v", err)
+	}
+
+	extended := VirtualMachineScaleSetExtendedProperties{}
+	if props := response.Properties; props != nil {
+		extended.ScaleInPolicy = props.ScaleInPolicy
+	}
+
+	return scaleSet, &extended, nil
+}
+
+// VirtualMachineScaleSetUpgradeBatches splits the instances which need to be upgraded into batches, where each
+// batch contains at most the specified percentage of the instances (and at least one instance)
+func VirtualMachineScaleSetUpgradeBatches(instanceIds []string, maxBatchInstancePercent int) [][]string {
+	batches := make([][]string, 0)
+	if len(instanceIds) == 0 {
+		return batches
+	}
+
+	batchSize := int(math.Ceil(float64(len(instanceIds)) * float64(maxBatchInstancePercent) / 100))
+	if batchSize < 1 {
+		batchSize = 1
+	}
+
+	for start := 0; start < len(instanceIds); start += batchSize {
+		end := start + batchSize
+		if end > len(instanceIds) {
+			end = len(instanceIds)
+		}
+
+		batches = append(batches, instanceIds[start:end])
+	}
+
+	return batches
+}
+
+// ParseISO8601Duration parses an ISO 8601 Duration made up of Days, Hours, Minutes and Seconds (e.g. `PT1H30M`)
+// into a time.Duration - Years, Months and Weeks aren't supported since their length varies
+func ParseISO8601Duration(input string) (time.Duration, error) {
+	matches := regexp.MustCompile(`^P(?:([0-9]+)D)?(?:T(?:([0-9]+)H)?(?:([0-9]+)M)?(?:([0-9]+(?:\.[0-9]+)?)S)?)?$`).FindStringSubmatch(input)
+	if matches == nil || input == "P" || input == "PT" {
+		return 0, fmt.Errorf("Expected %q to be an ISO 8601 Duration in the format `PnDTnHnMnS`", input)
+	}
+
+	units := []time.Duration{24 * time.Hour, time.Hour, time.Minute, time.Second}
+
+	var duration time.Duration
+	for i, unit := range units {
+		value := matches[i+1]
+		if value == "" {
+			continue
+		}
+
+		v, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return 0, fmt.Errorf("Error parsing %q within the ISO 8601 Duration %q: %+v", value, input, err)
+		}
+
+		duration += time.Duration(v * float64(unit))
+	}
+
+	return duration, nil
+}
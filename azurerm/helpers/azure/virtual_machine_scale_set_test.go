@@ -0,0 +1,151 @@
+package azure
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
+)
+
+func TestExpandVirtualMachineScaleSetRequestBody(t *testing.T) {
+	location := "westeurope"
+	overprovision := false
+
+	scaleSet := compute.VirtualMachineScaleSet{
+		Location: &location,
+		VirtualMachineScaleSetProperties: &compute.VirtualMachineScaleSetProperties{
+			Overprovision: &overprovision,
+			UpgradePolicy: &compute.UpgradePolicy{
+				Mode: compute.Manual,
+			},
+		},
+	}
+
+	extended := VirtualMachineScaleSetExtendedProperties{
+		ScaleInPolicy: &VirtualMachineScaleSetScaleInPolicy{
+			Rules: &[]string{VirtualMachineScaleSetScaleInPolicyOldestVM},
+		},
+	}
+
+	body, err := expandVirtualMachineScaleSetRequestBody(scaleSet, extended)
+	if err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+
+	// round-trip the request body to confirm the extended properties are parsed from where they were written
+	raw, err := json.Marshal(body)
+	if err != nil {
+		t.Fatalf("Error serializing the request body: %+v", err)
+	}
+
+	parsed, parsedExtended, err := parseVirtualMachineScaleSetResponseBody(raw)
+	if err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+
+	props := parsed.VirtualMachineScaleSetProperties
+	if props == nil || props.Overprovision == nil || *props.Overprovision || props.UpgradePolicy == nil || props.UpgradePolicy.Mode != compute.Manual {
+		t.Fatalf("Expected the properties of the Scale Set to be retained but got %+v", props)
+	}
+
+	policy := parsedExtended.ScaleInPolicy
+	if policy == nil || policy.Rules == nil || len(*policy.Rules) != 1 || (*policy.Rules)[0] != VirtualMachineScaleSetScaleInPolicyOldestVM {
+		t.Fatalf("Expected the Scale-In Policy to be retained but got %+v", policy)
+	}
+}
+
+func TestVirtualMachineScaleSetUpgradeBatches(t *testing.T) {
+	cases := []struct {
+		InstanceIds []string
+		Percent     int
+		Expected    [][]string
+	}{
+		{
+			InstanceIds: []string{},
+			Percent:     20,
+			Expected:    [][]string{},
+		},
+		{
+			InstanceIds: []string{"0", "1", "2"},
+			Percent:     20,
+			Expected:    [][]string{{"0"}, {"1"}, {"2"}},
+		},
+		{
+			InstanceIds: []string{"0", "1", "2", "3", "4"},
+			Percent:     50,
+			Expected:    [][]string{{"0", "1", "2"}, {"3", "4"}},
+		},
+		{
+			InstanceIds: []string{"0", "1", "2", "3"},
+			Percent:     100,
+			Expected:    [][]string{{"0", "1", "2", "3"}},
+		},
+	}
+
+	for _, v := range cases {
+		actual := VirtualMachineScaleSetUpgradeBatches(v.InstanceIds, v.Percent)
+		if !reflect.DeepEqual(v.Expected, actual) {
+			t.Fatalf("Expected %+v for %d%% of %+v but got %+v", v.Expected, v.Percent, v.InstanceIds, actual)
+		}
+	}
+}
+
+func TestParseISO8601Duration(t *testing.T) {
+	cases := []struct {
+		Input    string
+		Expected time.Duration
+		Valid    bool
+	}{
+		{
+			Input:    "PT0S",
+			Expected: 0,
+			Valid:    true,
+		},
+		{
+			Input:    "PT30S",
+			Expected: 30 * time.Second,
+			Valid:    true,
+		},
+		{
+			Input:    "PT1H30M",
+			Expected: 90 * time.Minute,
+			Valid:    true,
+		},
+		{
+			Input:    "P1DT2.5S",
+			Expected: 24*time.Hour + 2500*time.Millisecond,
+			Valid:    true,
+		},
+		{
+			Input: "PT",
+			Valid: false,
+		},
+		{
+			Input: "P1M",
+			Valid: false,
+		},
+		{
+			Input: "30s",
+			Valid: false,
+		},
+	}
+
+	for _, v := range cases {
+		actual, err := ParseISO8601Duration(v.Input)
+		if v.Valid && err != nil {
+			t.Fatalf("Expected no error for %q but got: %+v", v.Input, err)
+		}
+		if !v.Valid {
+			if err == nil {
+				t.Fatalf("Expected an error for %q but didn't get one", v.Input)
+			}
+			continue
+		}
+
+		if actual != v.Expected {
+			t.Fatalf("Expected %q to be %s but got %s", v.Input, v.Expected, actual)
+		}
+	}
+}
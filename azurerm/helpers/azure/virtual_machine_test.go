@@ -0,0 +1,121 @@
+package azure
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestVirtualMachineNetworkInterfaceIDs(t *testing.T) {
+	input := []interface{}{
+		"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/networkInterfaces/primary",
+		"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/networkInterfaces/secondary",
+	}
+
+	expanded := ExpandVirtualMachineNetworkInterfaceIDs(input)
+	if len(*expanded) != 2 {
+		t.Fatalf("Expected 2 Network Interfaces but got %d", len(*expanded))
+	}
+	if !*(*expanded)[0].Primary || *(*expanded)[1].Primary {
+		t.Fatalf("Expected only the first Network Interface to be the Primary")
+	}
+
+	// the API doesn't guarantee the order of the Network Interfaces, so the Primary is returned first
+	reversed := []compute.NetworkInterfaceReference{(*expanded)[1], (*expanded)[0]}
+	actual := FlattenVirtualMachineNetworkInterfaceIDs(&reversed)
+	if !reflect.DeepEqual(input, actual) {
+		t.Fatalf("Expected %+v but got %+v", input, actual)
+	}
+}
+
+func TestExpandVirtualMachineSourceImageReference(t *testing.T) {
+	imageId := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Compute/images/image1"
+	platformImage := []interface{}{
+		map[string]interface{}{
+			"publisher": "Canonical",
+			"offer":     "UbuntuServer",
+			"sku":       "16.04-LTS",
+			"version":   "latest",
+		},
+	}
+
+	cases := []struct {
+		Name      string
+		Reference []interface{}
+		ImageId   string
+		Expected  *compute.ImageReference
+	}{
+		{
+			Name:      "None",
+			Reference: []interface{}{},
+		},
+		{
+			Name:      "Image ID",
+			Reference: []interface{}{},
+			ImageId:   imageId,
+			Expected: &compute.ImageReference{
+				ID: utils.String(imageId),
+			},
+		},
+		{
+			Name:      "Platform Image",
+			Reference: platformImage,
+			Expected: &compute.ImageReference{
+				Publisher: utils.String("Canonical"),
+				Offer:     utils.String("UbuntuServer"),
+				Sku:       utils.String("16.04-LTS"),
+				Version:   utils.String("latest"),
+			},
+		},
+	}
+
+	for _, v := range cases {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual, err := ExpandVirtualMachineSourceImageReference(v.Reference, v.ImageId)
+		if v.Expected == nil {
+			if err == nil {
+				t.Fatalf("Expected an error but didn't get one")
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+
+		if !reflect.DeepEqual(v.Expected, actual) {
+			t.Fatalf("Expected %+v but got %+v", v.Expected, actual)
+		}
+
+		// Images referenced by ID are exposed as `source_image_id`
+		flattened := FlattenVirtualMachineSourceImageReference(actual)
+		if v.ImageId != "" && len(flattened) != 0 {
+			t.Fatalf("Expected no `source_image_reference` for an Image ID but got %+v", flattened)
+		}
+		if v.ImageId == "" && !reflect.DeepEqual(v.Reference, flattened) {
+			t.Fatalf("Expected %+v but got %+v", v.Reference, flattened)
+		}
+	}
+}
+
+func TestVirtualMachineSizeIsAvailable(t *testing.T) {
+	sizes := &[]compute.VirtualMachineSize{
+		{Name: utils.String("Standard_F2")},
+		{Name: utils.String("Standard_F4")},
+	}
+
+	if !VirtualMachineSizeIsAvailable(sizes, "standard_f4") {
+		t.Fatalf("Expected `standard_f4` to be available")
+	}
+
+	if VirtualMachineSizeIsAvailable(sizes, "Standard_D2s_v3") {
+		t.Fatalf("Expected `Standard_D2s_v3` not to be available")
+	}
+
+	if VirtualMachineSizeIsAvailable(nil, "Standard_F2") {
+		t.Fatalf("Expected no sizes to be available when none are returned")
+	}
+}
@@ -1,6 +1,49 @@
 package azure
 
-import "github.com/hashicorp/terraform/helper/schema"
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+// zoneSupportedLocations are the Azure Regions known to support Availability Zones - since Availability Zones are
+// regularly rolled out to further Regions, only a warning is logged for Regions which aren't in either list
+var zoneSupportedLocations = map[string]bool{
+	"centralus":     true,
+	"eastus":        true,
+	"eastus2":       true,
+	"francecentral": true,
+	"japaneast":     true,
+	"northeurope":   true,
+	"southeastasia": true,
+	"uksouth":       true,
+	"westeurope":    true,
+	"westus2":       true,
+}
+
+// zoneUnsupportedLocations are the Azure Regions known not to support Availability Zones
+var zoneUnsupportedLocations = map[string]bool{
+	"australiacentral":   true,
+	"australiacentral2":  true,
+	"australiasoutheast": true,
+	"brazilsouth":        true,
+	"canadacentral":      true,
+	"canadaeast":         true,
+	"centralindia":       true,
+	"eastasia":           true,
+	"francesouth":        true,
+	"japanwest":          true,
+	"koreacentral":       true,
+	"koreasouth":         true,
+	"northcentralus":     true,
+	"southindia":         true,
+	"ukwest":             true,
+	"westcentralus":      true,
+	"westindia":          true,
+	"westus":             true,
+}
 
 func SchemaZones() *schema.Schema {
 	return &schema.Schema{
@@ -9,7 +52,8 @@ func SchemaZones() *schema.Schema {
 		ForceNew: true,
 		MinItems: 1,
 		Elem: &schema.Schema{
-			Type: schema.TypeString,
+			Type:         schema.TypeString,
+			ValidateFunc: validation.StringInSlice([]string{"1", "2", "3"}, false),
 		},
 	}
 }
@@ -21,7 +65,8 @@ func SchemaSingleZone() *schema.Schema {
 		ForceNew: true,
 		MaxItems: 1,
 		Elem: &schema.Schema{
-			Type: schema.TypeString,
+			Type:         schema.TypeString,
+			ValidateFunc: validation.StringInSlice([]string{"1", "2", "3"}, false),
 		},
 	}
 }
@@ -48,3 +93,43 @@ func ExpandZones(v []interface{}) *[]string {
 		return nil
 	}
 }
+
+// LocationSupportsZones returns whether Availability Zones are available in the specified Azure Region
+func LocationSupportsZones(location string) bool {
+	return zoneSupportedLocations[NormalizeLocation(location)]
+}
+
+// ValidateZonesInLocation returns an error when Availability Zones are specified in an Azure Region which is known
+// not to support them, and logs a warning for Regions which aren't known to support them - if they're genuinely
+// unsupported Azure returns an error when the resource is created
+func ValidateZonesInLocation(location string, zones []interface{}) error {
+	if len(zones) == 0 || LocationSupportsZones(location) {
+		return nil
+	}
+
+	location = NormalizeLocation(location)
+	if zoneUnsupportedLocations[location] {
+		return fmt.Errorf("Availability Zones are not supported in the location %q", location)
+	}
+
+	log.Printf("[WARN] Availability Zones may not be supported in the location %q - if they aren't, Azure will return an error when the resource is created", location)
+	return nil
+}
+
+// ZonesCustomizeDiff checks at plan time whether the `zones` specified are available in the `location` of the
+// resource, which is only done when the resource is being created or the `zones` are changing
+func ZonesCustomizeDiff(d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() != "" && !d.HasChange("zones") {
+		return nil
+	}
+
+	if !valuesKnown(d, "location", "zones") {
+		return nil
+	}
+
+	if err := ValidateZonesInLocation(d.Get("location").(string), d.Get("zones").([]interface{})); err != nil {
+		return fmt.Errorf("`zones` cannot be specified: %+v", err)
+	}
+
+	return nil
+}
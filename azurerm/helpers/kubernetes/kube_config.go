@@ -2,6 +2,7 @@ package kubernetes
 
 import (
 	"fmt"
+	"net/url"
 
 	"gopkg.in/yaml.v2"
 )
@@ -122,3 +123,34 @@ func ParseKubeConfigAAD(config string) (*KubeConfigAAD, error) {
 
 	return &kubeConfig, nil
 }
+
+// ParseKubeConfigServerHost parses the host name of the API Server out of the specified Kube Config - which for a
+// Private Cluster is the Private FQDN of the API Server
+func ParseKubeConfigServerHost(config string) (string, error) {
+	if config == "" {
+		return "", fmt.Errorf("Cannot parse empty config")
+	}
+
+	var kubeConfig KubeConfigBase
+	if err := yaml.Unmarshal([]byte(config), &kubeConfig); err != nil {
+		return "", fmt.Errorf("Failed to unmarshal YAML config with error %+v", err)
+	}
+	if len(kubeConfig.Clusters) <= 0 {
+		return "", fmt.Errorf("Config %+v contains no valid clusters", kubeConfig)
+	}
+
+	c := kubeConfig.Clusters[0].Cluster
+	if c.Server == "" {
+		return "", fmt.Errorf("Config has invalid or non existent server for cluster %+v", c)
+	}
+
+	server, err := url.Parse(c.Server)
+	if err != nil {
+		return "", fmt.Errorf("Failed to parse server %q with error %+v", c.Server, err)
+	}
+	if server.Hostname() == "" {
+		return "", fmt.Errorf("Config has no host name for server %q", c.Server)
+	}
+
+	return server.Hostname(), nil
+}
@@ -192,3 +192,60 @@ func LoadConfig(fileName string) string {
 
 	return string(bytes)
 }
+
+func TestParseKubeConfigServerHost(t *testing.T) {
+	testCases := []struct {
+		sourceFile string
+		expected   string
+		valid      bool
+	}{
+		{
+			"user_with_token.yml",
+			"testcluster.net",
+			true,
+		},
+		{
+			"user_with_cert.yml",
+			"testcluster.org",
+			true,
+		},
+		{
+			"private_cluster.yml",
+			"testcluster-1a2b3c4d.8f7e6d5c-privatelink.westeurope.azmk8s.io",
+			true,
+		},
+		{
+			"no_cluster.yml",
+			"",
+			false,
+		},
+		{
+			"cluster_with_no_server.yml",
+			"",
+			false,
+		},
+	}
+
+	for i, test := range testCases {
+		encodedConfig := LoadConfig(test.sourceFile)
+		if len(encodedConfig) <= 0 {
+			t.Fatalf("Test case [%d]: Failed to read config from file '%+v' \n",
+				i, test.sourceFile)
+		}
+
+		host, err := ParseKubeConfigServerHost(encodedConfig)
+		if !test.valid {
+			if err == nil {
+				t.Fatalf("Test case [%d]: expected config '%+v' to throw error but didn't", i, test.sourceFile)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Fatalf("Test case [%d]: Failed, config '%+v' with error: '%+v'", i, test.sourceFile, err)
+		}
+		if host != test.expected {
+			t.Fatalf("Test case [%d]: expected host '%s' but got '%s'", i, test.expected, host)
+		}
+	}
+}
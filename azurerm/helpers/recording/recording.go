@@ -0,0 +1,412 @@
+package recording
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+// Mode determines whether requests to Azure are recorded, replayed or sent as normal
+type Mode string
+
+const (
+	// ModeDisabled sends requests to Azure as normal
+	ModeDisabled Mode = ""
+
+	// ModeRecord sends requests to Azure and records each request/response pair into a cassette
+	ModeRecord Mode = "record"
+
+	// ModeReplay serves responses from a previously recorded cassette without making any network requests
+	ModeReplay Mode = "replay"
+)
+
+const (
+	// ModeEnvironmentVariable is the Environment Variable used to select the Mode
+	ModeEnvironmentVariable = "ARM_TEST_RECORDING_MODE"
+
+	// DirectoryEnvironmentVariable is the Environment Variable used to override the directory cassettes are stored in
+	DirectoryEnvironmentVariable = "ARM_TEST_RECORDINGS_DIR"
+
+	defaultDirectory = "testdata/recordings"
+)
+
+// the headers which are removed from requests before they're written to a cassette
+var scrubbedHeaders = []string{
+	"Authorization",
+	"x-ms-authorization-auxiliary",
+}
+
+// the names of the fields within a JSON response body whose values are redacted before it's written to a cassette
+var scrubbedFieldRegex = regexp.MustCompile(`(?i)(password|secret|connectionstring|accesskey|masterkey|sharedkey|accountkey|authorizationkey|sasurl|sastoken|storageaccounturl|^primarykey$|^secondarykey$|^key[12]$|^token$|^accesstoken$|^refreshtoken$)`)
+
+// the paths of the Key Vault data-plane APIs whose (string) `value` fields contain the contents of a Secret, Key or
+// Certificate, which are redacted from responses regardless of the field's name
+var keyVaultDataPlanePathRegex = regexp.MustCompile(`(?i)^/(deleted)?(secrets|keys|certificates)(/|$)`)
+
+const redactedValue = "REDACTED"
+
+var subscriptionIdRegex = regexp.MustCompile(`(?i)/subscriptions/[^/?]+`)
+
+// CurrentMode returns the Mode configured via the Environment
+func CurrentMode() Mode {
+	switch Mode(strings.ToLower(os.Getenv(ModeEnvironmentVariable))) {
+	case ModeRecord:
+		return ModeRecord
+	case ModeReplay:
+		return ModeReplay
+	}
+
+	return ModeDisabled
+}
+
+// Directory returns the directory cassettes are read from and written to
+func Directory() string {
+	if v := os.Getenv(DirectoryEnvironmentVariable); v != "" {
+		return v
+	}
+
+	return defaultDirectory
+}
+
+// Request is the recorded form of a HTTP Request
+type Request struct {
+	Method  string      `json:"method"`
+	URL     string      `json:"url"`
+	Headers http.Header `json:"headers,omitempty"`
+}
+
+// Response is the recorded form of a HTTP Response
+type Response struct {
+	StatusCode int         `json:"status_code"`
+	Headers    http.Header `json:"headers,omitempty"`
+	Body       string      `json:"body,omitempty"`
+}
+
+// Interaction is a single request/response pair
+type Interaction struct {
+	Request  Request  `json:"request"`
+	Response Response `json:"response"`
+}
+
+// Cassette contains the Interactions recorded for a single test
+type Cassette struct {
+	Name         string        `json:"name"`
+	Interactions []Interaction `json:"interactions"`
+
+	path string
+	// the number of times each Interaction has been replayed
+	replayed []int
+}
+
+var (
+	currentLock     sync.Mutex
+	currentCassette *Cassette
+
+	// sessionLock is held for the duration of each test using a cassette - since requests can't be attributed to
+	// the test which sent them, tests which would otherwise run in parallel are run one at a time
+	sessionLock sync.Mutex
+)
+
+// TestingT is the subset of `testing.T` used to scope a cassette to a test
+type TestingT interface {
+	Name() string
+	Cleanup(func())
+}
+
+// Start makes the cassette named after the test the one which is recorded into or replayed from, until the test
+// completes. Whilst recording or replaying, tests are run one at a time (including those using `resource.ParallelTest`)
+// such that the requests sent by one test can't be written into (or served from) the cassette of another
+//
+// This is a no-op when recording is disabled
+func Start(t TestingT) error {
+	mode := CurrentMode()
+	if mode == ModeDisabled {
+		return nil
+	}
+
+	name := t.Name()
+
+	// a test which runs more than one TestCase continues to use the cassette it's already started
+	currentLock.Lock()
+	if currentCassette != nil && currentCassette.Name == name {
+		currentLock.Unlock()
+		return nil
+	}
+	currentLock.Unlock()
+
+	sessionLock.Lock()
+	t.Cleanup(func() {
+		currentLock.Lock()
+		currentCassette = nil
+		currentLock.Unlock()
+
+		sessionLock.Unlock()
+	})
+
+	currentLock.Lock()
+	defer currentLock.Unlock()
+
+	path := filepath.Join(Directory(), cassetteFileName(name))
+	cassette := &Cassette{
+		Name: name,
+		path: path,
+	}
+
+	if mode == ModeReplay {
+		contents, err := ioutil.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("Error loading recording %q from %q: %+v", name, path, err)
+		}
+
+		if err := json.Unmarshal(contents, cassette); err != nil {
+			return fmt.Errorf("Error parsing recording %q from %q: %+v", name, path, err)
+		}
+		cassette.replayed = make([]int, len(cassette.Interactions))
+	} else {
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			return fmt.Errorf("Error creating directory for recording %q: %+v", name, err)
+		}
+
+		if err := cassette.save(); err != nil {
+			return err
+		}
+	}
+
+	log.Printf("[DEBUG] Using recording %q (mode %q)", path, mode)
+	currentCassette = cassette
+	return nil
+}
+
+// WithRecording returns a SendDecorator which records requests and responses into the current cassette, or
+// replays responses from the current cassette without sending the request, depending on the configured Mode
+func WithRecording(mode Mode) autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			switch mode {
+			case ModeRecord:
+				return record(s, r)
+			case ModeReplay:
+				return replay(r)
+			}
+
+			return s.Do(r)
+		})
+	}
+}
+
+func record(s autorest.Sender, r *http.Request) (*http.Response, error) {
+	resp, err := s.Do(r)
+	if err != nil || resp == nil {
+		return resp, err
+	}
+
+	var body []byte
+	if resp.Body != nil {
+		body, err = ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return resp, err
+		}
+		resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+	}
+
+	interaction := Interaction{
+		Request: Request{
+			Method:  r.Method,
+			URL:     r.URL.String(),
+			Headers: scrubHeaders(r.Header),
+		},
+		Response: Response{
+			StatusCode: resp.StatusCode,
+			Headers:    resp.Header,
+			Body:       scrubBody(body, isKeyVaultDataPlaneURL(r.URL)),
+		},
+	}
+
+	currentLock.Lock()
+	defer currentLock.Unlock()
+
+	if currentCassette == nil {
+		log.Printf("[WARN] No recording has been started - %s %s won't be recorded", r.Method, r.URL)
+		return resp, nil
+	}
+
+	currentCassette.Interactions = append(currentCassette.Interactions, interaction)
+	if err := currentCassette.save(); err != nil {
+		return resp, err
+	}
+
+	return resp, nil
+}
+
+func replay(r *http.Request) (*http.Response, error) {
+	currentLock.Lock()
+	defer currentLock.Unlock()
+
+	if currentCassette == nil {
+		return nil, fmt.Errorf("No recording has been started - unable to replay %s %s", r.Method, r.URL)
+	}
+
+	interaction := currentCassette.find(r.Method, r.URL)
+	if interaction == nil {
+		return nil, fmt.Errorf("No recorded interaction was found for %s %s in %q", r.Method, r.URL, currentCassette.path)
+	}
+
+	if r.Body != nil {
+		r.Body.Close()
+	}
+
+	headers := http.Header{}
+	for k, v := range interaction.Response.Headers {
+		headers[k] = v
+	}
+	// there's no need to wait when replaying, so the polling delays Azure returned are removed
+	headers.Del("Retry-After")
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", interaction.Response.StatusCode, http.StatusText(interaction.Response.StatusCode)),
+		StatusCode:    interaction.Response.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        headers,
+		Body:          ioutil.NopCloser(strings.NewReader(interaction.Response.Body)),
+		ContentLength: int64(len(interaction.Response.Body)),
+		Request:       r,
+	}, nil
+}
+
+// find returns the first Interaction matching the request which hasn't been replayed yet - or when all of
+// them have been replayed, the last matching Interaction (for example when polling a long running operation)
+func (c *Cassette) find(method string, u *url.URL) *Interaction {
+	key := normalizeURL(u.String())
+	last := -1
+	for i, interaction := range c.Interactions {
+		if !strings.EqualFold(interaction.Request.Method, method) || normalizeURL(interaction.Request.URL) != key {
+			continue
+		}
+
+		if c.replayed[i] == 0 {
+			c.replayed[i]++
+			return &c.Interactions[i]
+		}
+		last = i
+	}
+
+	if last == -1 {
+		return nil
+	}
+
+	c.replayed[last]++
+	return &c.Interactions[last]
+}
+
+func (c *Cassette) save() error {
+	contents, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return fmt.Errorf("Error serializing recording %q: %+v", c.Name, err)
+	}
+
+	if err := ioutil.WriteFile(c.path, contents, 0644); err != nil {
+		return fmt.Errorf("Error writing recording %q to %q: %+v", c.Name, c.path, err)
+	}
+
+	return nil
+}
+
+// normalizeURL returns a representation of the URL which is used to match requests, where the Subscription ID
+// is removed (so recordings can be replayed using any Subscription) and the Query String is sorted
+func normalizeURL(input string) string {
+	u, err := url.Parse(input)
+	if err != nil {
+		return strings.ToLower(input)
+	}
+
+	path := subscriptionIdRegex.ReplaceAllString(u.Path, "/subscriptions/{subscriptionId}")
+	return strings.ToLower(fmt.Sprintf("%s%s?%s", u.Host, path, u.Query().Encode()))
+}
+
+func scrubHeaders(input http.Header) http.Header {
+	output := http.Header{}
+	for k, v := range input {
+		output[k] = v
+	}
+
+	for _, header := range scrubbedHeaders {
+		output.Del(header)
+	}
+
+	return output
+}
+
+// isKeyVaultDataPlaneURL returns whether the URL is for the Key Vault data-plane APIs for Secrets, Keys or Certificates,
+// for example `https://example.vault.azure.net/secrets/example`
+func isKeyVaultDataPlaneURL(u *url.URL) bool {
+	return strings.Contains(strings.ToLower(u.Hostname()), ".vault.") && keyVaultDataPlanePathRegex.MatchString(u.Path)
+}
+
+// scrubBody redacts the values of any secrets (such as Access Keys, Connection Strings and Passwords) within a JSON
+// response body, including any `value` fields when scrubValues is set - bodies which aren't JSON are returned as-is
+func scrubBody(input []byte, scrubValues bool) string {
+	if len(input) == 0 {
+		return ""
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(input))
+	decoder.UseNumber()
+
+	var body interface{}
+	if err := decoder.Decode(&body); err != nil {
+		return string(input)
+	}
+
+	output, err := json.Marshal(scrubValue(body, scrubValues))
+	if err != nil {
+		return string(input)
+	}
+
+	return string(output)
+}
+
+func scrubValue(input interface{}, scrubValues bool) interface{} {
+	switch v := input.(type) {
+	case map[string]interface{}:
+		// the keys returned from a `listKeys` operation are in the form `{ "keyName": "key1", "value": "..." }`
+		_, isKey := v["keyName"]
+
+		for key, value := range v {
+			if _, ok := value.(string); ok && (scrubbedFieldRegex.MatchString(key) || ((isKey || scrubValues) && key == "value")) {
+				v[key] = redactedValue
+				continue
+			}
+
+			v[key] = scrubValue(value, scrubValues)
+		}
+
+		return v
+
+	case []interface{}:
+		for i, value := range v {
+			v[i] = scrubValue(value, scrubValues)
+		}
+
+		return v
+	}
+
+	return input
+}
+
+func cassetteFileName(name string) string {
+	return strings.NewReplacer("/", "_", "\\", "_", " ", "_").Replace(name) + ".json"
+}
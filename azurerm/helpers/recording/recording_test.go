@@ -0,0 +1,282 @@
+package recording
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+func TestRecordAndReplay(t *testing.T) {
+	dir, err := ioutil.TempDir("", "recordings")
+	if err != nil {
+		t.Fatalf("Error creating temporary directory: %+v", err)
+	}
+	defer os.RemoveAll(dir)
+	os.Setenv(DirectoryEnvironmentVariable, dir)
+	defer os.Unsetenv(DirectoryEnvironmentVariable)
+
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.Method == http.MethodPut {
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"provisioningState":"Creating","primaryKey":"supersecret"}`)) // nolint: errcheck
+			return
+		}
+
+		w.Header().Set("Retry-After", "10")
+		w.Write([]byte(`{"provisioningState":"Succeeded"}`)) // nolint: errcheck
+	}))
+
+	sendRequests := func(sender autorest.Sender, subscriptionId string) []string {
+		bodies := make([]string, 0)
+		for _, method := range []string{http.MethodPut, http.MethodGet, http.MethodGet} {
+			req, _ := http.NewRequest(method, server.URL+"/subscriptions/"+subscriptionId+"/resourceGroups/example?api-version=2018-05-01", nil)
+			req.Header.Set("Authorization", "Bearer secret")
+			resp, err := sender.Do(req)
+			if err != nil {
+				t.Fatalf("Error sending request: %+v", err)
+			}
+			body, _ := ioutil.ReadAll(resp.Body)
+			resp.Body.Close()
+			bodies = append(bodies, string(body))
+		}
+		return bodies
+	}
+
+	os.Setenv(ModeEnvironmentVariable, "record")
+	defer os.Unsetenv(ModeEnvironmentVariable)
+	recordT := &fakeT{name: "TestExample/basic"}
+	if err := Start(recordT); err != nil {
+		t.Fatalf("Error starting recording: %+v", err)
+	}
+	recorded := sendRequests(autorest.DecorateSender(http.DefaultClient, WithRecording(CurrentMode())), "11111111-1111-1111-1111-111111111111")
+	recordT.finish()
+	server.Close()
+
+	if requests != 3 {
+		t.Fatalf("Expected 3 requests to be sent whilst recording but got %d", requests)
+	}
+
+	contents, err := ioutil.ReadFile(filepath.Join(dir, "TestExample_basic.json"))
+	if err != nil {
+		t.Fatalf("Error reading recording: %+v", err)
+	}
+	if strings.Contains(string(contents), "secret") {
+		t.Fatalf("Expected the Authorization header and the Primary Key to be scrubbed from the recording but they weren't")
+	}
+
+	// the server has been closed, so these must be served from the recording
+	os.Setenv(ModeEnvironmentVariable, "replay")
+	replayT := &fakeT{name: "TestExample/basic"}
+	if err := Start(replayT); err != nil {
+		t.Fatalf("Error starting replay: %+v", err)
+	}
+	replayed := sendRequests(autorest.DecorateSender(http.DefaultClient, WithRecording(CurrentMode())), "22222222-2222-2222-2222-222222222222")
+	replayT.finish()
+
+	// the recorded secrets are redacted, so the first response differs from the one which was sent
+	if expected := `{"primaryKey":"REDACTED","provisioningState":"Creating"}`; replayed[0] != expected {
+		t.Fatalf("Expected response 0 to be %q but got %q", expected, replayed[0])
+	}
+	for i := 1; i < len(recorded); i++ {
+		if recorded[i] != replayed[i] {
+			t.Fatalf("Expected response %d to be %q but got %q", i, recorded[i], replayed[i])
+		}
+	}
+}
+
+func TestStart_TestsRunOneAtATime(t *testing.T) {
+	dir, err := ioutil.TempDir("", "recordings")
+	if err != nil {
+		t.Fatalf("Error creating temporary directory: %+v", err)
+	}
+	defer os.RemoveAll(dir)
+	os.Setenv(DirectoryEnvironmentVariable, dir)
+	defer os.Unsetenv(DirectoryEnvironmentVariable)
+	os.Setenv(ModeEnvironmentVariable, "record")
+	defer os.Unsetenv(ModeEnvironmentVariable)
+
+	first := &fakeT{name: "TestExample/first"}
+	if err := Start(first); err != nil {
+		t.Fatalf("Error starting recording: %+v", err)
+	}
+
+	// starting the cassette again from the same test continues to use it
+	if err := Start(first); err != nil {
+		t.Fatalf("Error restarting recording: %+v", err)
+	}
+
+	second := &fakeT{name: "TestExample/second"}
+	started := make(chan struct{})
+	go func() {
+		if err := Start(second); err != nil {
+			t.Errorf("Error starting recording: %+v", err)
+		}
+		close(started)
+	}()
+
+	select {
+	case <-started:
+		t.Fatalf("Expected the second test to wait for the first to complete")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	first.finish()
+	select {
+	case <-started:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("Expected the second test to start once the first completed")
+	}
+
+	currentLock.Lock()
+	name := currentCassette.Name
+	currentLock.Unlock()
+	if name != "TestExample/second" {
+		t.Fatalf("Expected the current recording to be %q but got %q", "TestExample/second", name)
+	}
+	second.finish()
+}
+
+func TestScrubBody(t *testing.T) {
+	cases := []struct {
+		Input       string
+		ScrubValues bool
+		Expected    string
+	}{
+		{
+			Input:    "",
+			Expected: "",
+		},
+		{
+			Input:    "not json",
+			Expected: "not json",
+		},
+		{
+			Input:    `{"name":"example","properties":{"count":12345678901234567890}}`,
+			Expected: `{"name":"example","properties":{"count":12345678901234567890}}`,
+		},
+		{
+			Input:    `{"keys":[{"keyName":"key1","value":"abc","permissions":"FULL"}]}`,
+			Expected: `{"keys":[{"keyName":"key1","permissions":"FULL","value":"REDACTED"}]}`,
+		},
+		{
+			Input:    `{"primaryConnectionString":"Endpoint=sb://example","secondaryKey":"def","properties":{"administratorLoginPassword":"p@ss","clientSecret":"ghi"}}`,
+			Expected: `{"primaryConnectionString":"REDACTED","properties":{"administratorLoginPassword":"REDACTED","clientSecret":"REDACTED"},"secondaryKey":"REDACTED"}`,
+		},
+		{
+			// values which aren't strings (such as whether a Secret is enabled) are left as-is
+			Input:    `{"properties":{"passwordAuthenticationDisabled":true,"keyVaultId":"/example"}}`,
+			Expected: `{"properties":{"keyVaultId":"/example","passwordAuthenticationDisabled":true}}`,
+		},
+		{
+			// a Key Vault Secret, where the list of items returned from the Key Vault data-plane APIs is left as-is
+			Input:       `{"value":"s3cr3t","id":"https://example.vault.azure.net/secrets/example/abc123","attributes":{"enabled":true}}`,
+			ScrubValues: true,
+			Expected:    `{"attributes":{"enabled":true},"id":"https://example.vault.azure.net/secrets/example/abc123","value":"REDACTED"}`,
+		},
+		{
+			Input:       `{"value":[{"id":"https://example.vault.azure.net/secrets/example"}],"nextLink":null}`,
+			ScrubValues: true,
+			Expected:    `{"nextLink":null,"value":[{"id":"https://example.vault.azure.net/secrets/example"}]}`,
+		},
+	}
+
+	for _, v := range cases {
+		actual := scrubBody([]byte(v.Input), v.ScrubValues)
+		if actual != v.Expected {
+			t.Fatalf("Expected %q but got %q", v.Expected, actual)
+		}
+	}
+}
+
+func TestIsKeyVaultDataPlaneURL(t *testing.T) {
+	cases := map[string]bool{
+		"https://example.vault.azure.net/secrets/example/abc123?api-version=7.0":                                    true,
+		"https://example.vault.azure.net/keys/example/abc123/decrypt?api-version=7.0":                               true,
+		"https://example.vault.usgovcloudapi.net/deletedcertificates/example?api-version=7.0":                       true,
+		"https://example.vault.azure.net/storage/example?api-version=7.0":                                           false,
+		"https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/providers/secrets/example": false,
+		"https://example.blob.core.windows.net/keys/example":                                                        false,
+	}
+
+	for input, expected := range cases {
+		u, err := url.Parse(input)
+		if err != nil {
+			t.Fatalf("Error parsing %q: %+v", input, err)
+		}
+
+		if actual := isKeyVaultDataPlaneURL(u); actual != expected {
+			t.Fatalf("Expected %q to return %t but got %t", input, expected, actual)
+		}
+	}
+}
+
+type fakeT struct {
+	name     string
+	cleanups []func()
+}
+
+func (t *fakeT) Name() string {
+	return t.name
+}
+
+func (t *fakeT) Cleanup(f func()) {
+	t.cleanups = append(t.cleanups, f)
+}
+
+func (t *fakeT) finish() {
+	for i := len(t.cleanups) - 1; i >= 0; i-- {
+		t.cleanups[i]()
+	}
+}
+
+func TestReplay_MissingInteraction(t *testing.T) {
+	currentCassette = &Cassette{
+		Name:     "example",
+		replayed: []int{},
+	}
+	defer func() {
+		currentCassette = nil
+	}()
+
+	req, _ := http.NewRequest(http.MethodGet, "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000", nil)
+	if _, err := replay(req); err == nil {
+		t.Fatalf("Expected an error when replaying a request which wasn't recorded but didn't get one")
+	}
+}
+
+func TestNormalizeURL(t *testing.T) {
+	cases := []struct {
+		Input    string
+		Expected string
+	}{
+		{
+			Input:    "https://management.azure.com/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/example?api-version=2018-05-01",
+			Expected: "management.azure.com/subscriptions/{subscriptionid}/resourcegroups/example?api-version=2018-05-01",
+		},
+		{
+			Input:    "https://management.azure.com/subscriptions/22222222-2222-2222-2222-222222222222/resourcegroups/Example?b=2&a=1",
+			Expected: "management.azure.com/subscriptions/{subscriptionid}/resourcegroups/example?a=1&b=2",
+		},
+		{
+			Input:    "https://example.vault.azure.net/secrets/example?api-version=2016-10-01",
+			Expected: "example.vault.azure.net/secrets/example?api-version=2016-10-01",
+		},
+	}
+
+	for _, v := range cases {
+		actual := normalizeURL(v.Input)
+		if actual != v.Expected {
+			t.Fatalf("Expected %q but got %q", v.Expected, actual)
+		}
+	}
+}
@@ -1,14 +1,24 @@
 package tf
 
 import (
+	"fmt"
+	"hash/fnv"
+	"runtime"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/recording"
 )
 
 func AccRandTimeInt() int {
+	// recorded requests contain the names of the resources being tested, so these need to be stable
+	if recording.CurrentMode() != recording.ModeDisabled {
+		return accDeterministicInt()
+	}
+
 	// acctest.RantInt() returns a value of size:
 	// 000000000000000000
 	// YYMMddHHmmsshhRRRR
@@ -25,3 +35,35 @@ func AccRandTimeInt() int {
 
 	return i
 }
+
+var (
+	accDeterministicLock  sync.Mutex
+	accDeterministicCalls = map[string]int{}
+)
+
+// accDeterministicInt returns a value in the same format as AccRandTimeInt which is derived from the name of
+// the calling function (and how many times it's been called), so that it's the same when a test is recorded and replayed
+func accDeterministicInt() int {
+	caller := "unknown"
+	if pc, _, _, ok := runtime.Caller(2); ok {
+		if fn := runtime.FuncForPC(pc); fn != nil {
+			caller = fn.Name()
+		}
+	}
+
+	accDeterministicLock.Lock()
+	accDeterministicCalls[caller]++
+	call := accDeterministicCalls[caller]
+	accDeterministicLock.Unlock()
+
+	hash := fnv.New32a()
+	hash.Write([]byte(fmt.Sprintf("%s/%d", caller, call))) // nolint: errcheck
+
+	// a fixed date keeps the value the same length as AccRandTimeInt
+	i, err := strconv.Atoi(fmt.Sprintf("190101000000%06d", hash.Sum32()%1000000))
+	if err != nil {
+		panic(err)
+	}
+
+	return i
+}
@@ -1,6 +1,11 @@
 package tf
 
-import "testing"
+import (
+	"os"
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/recording"
+)
 
 func TestAccRandTimeInt(t *testing.T) {
 	t.Run("Rand Date int", func(t *testing.T) {
@@ -16,3 +21,25 @@ func TestAccRandTimeInt(t *testing.T) {
 
 	})
 }
+
+func TestAccRandTimeInt_Recording(t *testing.T) {
+	os.Setenv(recording.ModeEnvironmentVariable, "replay")
+	defer os.Unsetenv(recording.ModeEnvironmentVariable)
+
+	first := AccRandTimeInt()
+	second := AccRandTimeInt()
+
+	if first < 100000000000000000 || first > 999999999999999999 {
+		t.Fatalf("AccRandTimeInt returned a value (%d) which isn't the expected length", first)
+	}
+
+	if first == second {
+		t.Fatalf("Expected each call to AccRandTimeInt to return a different value but got %d twice", first)
+	}
+
+	// the values are determined by the caller, so resetting the number of calls should return the same values
+	accDeterministicCalls = map[string]int{}
+	if actual := AccRandTimeInt(); actual != first {
+		t.Fatalf("Expected AccRandTimeInt to return %d when recording but got %d", first, actual)
+	}
+}
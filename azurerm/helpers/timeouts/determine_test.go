@@ -0,0 +1,94 @@
+package timeouts
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+func TestDetermine(t *testing.T) {
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(2 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+	}
+
+	newResource := r.Data(nil)
+	newResource.MarkNewResource()
+	existingResource := r.Data(&terraform.InstanceState{ID: "example"})
+
+	cases := []struct {
+		Name     string
+		Func     func(context.Context, *schema.ResourceData) (context.Context, context.CancelFunc)
+		Data     *schema.ResourceData
+		Expected time.Duration
+	}{
+		{Name: "Create", Func: ForCreate, Data: newResource, Expected: 10 * time.Minute},
+		{Name: "CreateUpdate New", Func: ForCreateUpdate, Data: newResource, Expected: 10 * time.Minute},
+		{Name: "CreateUpdate Existing", Func: ForCreateUpdate, Data: existingResource, Expected: 20 * time.Minute},
+		{Name: "Read", Func: ForRead, Data: existingResource, Expected: 2 * time.Minute},
+		{Name: "Update", Func: ForUpdate, Data: existingResource, Expected: 20 * time.Minute},
+		{Name: "Delete", Func: ForDelete, Data: existingResource, Expected: 30 * time.Minute},
+	}
+
+	for _, v := range cases {
+		t.Run(v.Name, func(t *testing.T) {
+			start := time.Now()
+			ctx, cancel := v.Func(context.Background(), v.Data)
+			defer cancel()
+
+			deadline, ok := ctx.Deadline()
+			if !ok {
+				t.Fatalf("Expected the context to have a deadline but it didn't")
+			}
+
+			remaining := deadline.Sub(start)
+			if remaining < v.Expected || remaining > v.Expected+time.Minute {
+				t.Fatalf("Expected a deadline of roughly %s but got %s", v.Expected, remaining)
+			}
+		})
+	}
+}
+
+func TestCorrelation(t *testing.T) {
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{},
+	}
+	first := r.Data(&terraform.InstanceState{ID: "first"})
+	second := r.Data(&terraform.InstanceState{ID: "second"})
+
+	done := azure.StartCorrelatedOperation(first, azure.Correlation{
+		RequestID:    "correlation-123",
+		ResourceType: "azurerm_resource_group",
+		ResourceName: "first",
+	})
+
+	ctx, cancel := ForRead(context.Background(), first)
+	defer cancel()
+	correlation, ok := azure.CorrelationFromContext(ctx)
+	if !ok || correlation.RequestID != "correlation-123" || correlation.ResourceName != "first" {
+		t.Fatalf("Expected the context to have the Correlation of the operation but got %+v", correlation)
+	}
+
+	// concurrent operations share the same parent context, but not the Correlation
+	ctx, cancel = ForRead(context.Background(), second)
+	defer cancel()
+	if correlation, ok := azure.CorrelationFromContext(ctx); ok {
+		t.Fatalf("Expected the context not to have a Correlation but got %+v", correlation)
+	}
+
+	done()
+	ctx, cancel = ForRead(context.Background(), first)
+	defer cancel()
+	if correlation, ok := azure.CorrelationFromContext(ctx); ok {
+		t.Fatalf("Expected the Correlation to be removed once the operation is done but got %+v", correlation)
+	}
+}
@@ -3,6 +3,7 @@ package validate
 import (
 	"fmt"
 	"regexp"
+	"strings"
 )
 
 func SharedImageGalleryName(v interface{}, k string) (warnings []string, errors []error) {
@@ -48,3 +49,36 @@ func SharedImageVersionName(v interface{}, k string) (warnings []string, errors
 
 	return warnings, errors
 }
+
+func LinuxComputerName(v interface{}, k string) (warnings []string, errors []error) {
+	return validateComputerName(v, k, 64, false)
+}
+
+func WindowsComputerName(v interface{}, k string) (warnings []string, errors []error) {
+	return validateComputerName(v, k, 15, true)
+}
+
+func validateComputerName(v interface{}, k string, maxLength int, allowsPeriods bool) (warnings []string, errors []error) {
+	value := v.(string)
+
+	length := len(value)
+	if length == 0 || length > maxLength {
+		errors = append(errors, fmt.Errorf("%s must be between 1 and %d characters, currently %d.", k, maxLength, length))
+	}
+
+	// Computer Names can't begin with an underscore or end with a hyphen or period, and can't be entirely numeric
+	r := regexp.MustCompile(`^[A-Za-z0-9]([A-Za-z0-9._-]*[A-Za-z0-9_])?$`)
+	if !r.MatchString(value) {
+		errors = append(errors, fmt.Errorf("%s can only contain alphanumeric characters, full stops, underscores and hyphens, must start with an alphanumeric character and can't end with a hyphen or full stop. Got %q.", k, value))
+	}
+
+	if regexp.MustCompile(`^[0-9]+$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf("%s cannot be entirely numeric. Got %q.", k, value))
+	}
+
+	if !allowsPeriods && strings.Contains(value, ".") {
+		errors = append(errors, fmt.Errorf("%s cannot contain full stops. Got %q.", k, value))
+	}
+
+	return warnings, errors
+}
@@ -174,3 +174,113 @@ func TestSharedImageVersionName(t *testing.T) {
 		})
 	}
 }
+
+func TestLinuxComputerName(t *testing.T) {
+	cases := []struct {
+		Input       string
+		ShouldError bool
+	}{
+		{
+			Input:       "",
+			ShouldError: true,
+		},
+		{
+			Input:       "hello",
+			ShouldError: false,
+		},
+		{
+			Input:       "hello-world_123",
+			ShouldError: false,
+		},
+		{
+			Input:       "hello.world",
+			ShouldError: true,
+		},
+		{
+			Input:       "hello-",
+			ShouldError: true,
+		},
+		{
+			Input:       "_hello",
+			ShouldError: true,
+		},
+		{
+			Input:       "12345",
+			ShouldError: true,
+		},
+		{
+			Input:       acctest.RandString(64),
+			ShouldError: false,
+		},
+		{
+			Input:       acctest.RandString(65),
+			ShouldError: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Input, func(t *testing.T) {
+			_, errors := LinuxComputerName(tc.Input, "test")
+
+			hasErrors := len(errors) > 0
+			if !hasErrors && tc.ShouldError {
+				t.Fatalf("Expected an error but didn't get one for %q", tc.Input)
+			}
+
+			if hasErrors && !tc.ShouldError {
+				t.Fatalf("Expected to get no errors for %q but got %d", tc.Input, len(errors))
+			}
+		})
+	}
+}
+
+func TestWindowsComputerName(t *testing.T) {
+	cases := []struct {
+		Input       string
+		ShouldError bool
+	}{
+		{
+			Input:       "",
+			ShouldError: true,
+		},
+		{
+			Input:       "hello",
+			ShouldError: false,
+		},
+		{
+			Input:       "hello.world",
+			ShouldError: false,
+		},
+		{
+			Input:       "hello.",
+			ShouldError: true,
+		},
+		{
+			Input:       "12345",
+			ShouldError: true,
+		},
+		{
+			Input:       acctest.RandString(15),
+			ShouldError: false,
+		},
+		{
+			Input:       acctest.RandString(16),
+			ShouldError: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Input, func(t *testing.T) {
+			_, errors := WindowsComputerName(tc.Input, "test")
+
+			hasErrors := len(errors) > 0
+			if !hasErrors && tc.ShouldError {
+				t.Fatalf("Expected an error but didn't get one for %q", tc.Input)
+			}
+
+			if hasErrors && !tc.ShouldError {
+				t.Fatalf("Expected to get no errors for %q but got %d", tc.Input, len(errors))
+			}
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+// CosmosDBEntityName validates the name of a Database, Container, Keyspace, Graph or Table within a Cosmos DB Account
+func CosmosDBEntityName() schema.SchemaValidateFunc {
+	return func(i interface{}, k string) (warnings []string, errors []error) {
+		if warnings, errors = validation.StringLenBetween(1, 255)(i, k); len(errors) > 0 {
+			return warnings, errors
+		}
+
+		errMsg := "must not contain the characters `/`, `\\`, `#` or `?`, or end with a space"
+		return validation.StringMatch(regexp.MustCompile(`^[^/\\#?]*[^/\\#? ]$`), errMsg)(i, k)
+	}
+}
+
+// CosmosDBThroughput validates the throughput (in Request Units per second) provisioned for a Cosmos DB resource,
+// which must be at least 400 and a multiple of 100
+func CosmosDBThroughput(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(int)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be int", k))
+		return warnings, errors
+	}
+
+	if v < 400 {
+		errors = append(errors, fmt.Errorf("%q must be at least 400, got %d", k, v))
+	}
+
+	if v%100 != 0 {
+		errors = append(errors, fmt.Errorf("%q must be a multiple of 100, got %d", k, v))
+	}
+
+	return warnings, errors
+}
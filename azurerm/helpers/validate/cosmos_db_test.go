@@ -0,0 +1,53 @@
+package validate
+
+import "testing"
+
+func TestCosmosDBEntityName(t *testing.T) {
+	validNames := []string{
+		"database",
+		"my-container_1",
+		"name with spaces",
+	}
+	for _, v := range validNames {
+		_, errors := CosmosDBEntityName()(v, "name")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid Cosmos DB Entity Name: %q", v, errors)
+		}
+	}
+
+	invalidNames := []string{
+		"",
+		"with/slash",
+		"with\\backslash",
+		"with#hash",
+		"with?question",
+		"trailing ",
+	}
+	for _, v := range invalidNames {
+		_, errors := CosmosDBEntityName()(v, "name")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid Cosmos DB Entity Name", v)
+		}
+	}
+}
+
+func TestCosmosDBThroughput(t *testing.T) {
+	cases := []struct {
+		Value  int
+		Errors int
+	}{
+		{Value: 400, Errors: 0},
+		{Value: 1000, Errors: 0},
+		{Value: 100000, Errors: 0},
+		{Value: 300, Errors: 1},
+		{Value: 450, Errors: 1},
+		{Value: 350, Errors: 2},
+	}
+
+	for _, tc := range cases {
+		_, errors := CosmosDBThroughput(tc.Value, "throughput")
+		if len(errors) != tc.Errors {
+			t.Fatalf("Expected %d errors for %d but got %d: %+v", tc.Errors, tc.Value, len(errors), errors)
+		}
+	}
+}
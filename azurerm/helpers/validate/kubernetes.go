@@ -37,3 +37,14 @@ func KubernetesDNSPrefix(i interface{}, k string) (warnings []string, errors []e
 
 	return warnings, errors
 }
+
+func KubernetesNodeTaint(i interface{}, k string) (warnings []string, errors []error) {
+	taint := i.(string)
+
+	re := regexp.MustCompile(`^[A-Za-z0-9][-A-Za-z0-9_./]*=[-A-Za-z0-9_.]*:(NoSchedule|PreferNoSchedule|NoExecute)$`)
+	if re != nil && !re.MatchString(taint) {
+		errors = append(errors, fmt.Errorf("%s must be in the format `key=value:Effect`, where the Effect is one of `NoSchedule`, `PreferNoSchedule` or `NoExecute`. Got %q.", k, taint))
+	}
+
+	return warnings, errors
+}
@@ -133,3 +133,45 @@ func TestKubernetesDNSPrefix(t *testing.T) {
 		})
 	}
 }
+
+func TestKubernetesNodeTaint(t *testing.T) {
+	cases := []struct {
+		Taint  string
+		Errors int
+	}{
+		{
+			Taint:  "",
+			Errors: 1,
+		},
+		{
+			Taint:  "key=value",
+			Errors: 1,
+		},
+		{
+			Taint:  "key=value:NoSchedule",
+			Errors: 0,
+		},
+		{
+			Taint:  "kubernetes.io/os=windows:NoExecute",
+			Errors: 0,
+		},
+		{
+			Taint:  "key=:PreferNoSchedule",
+			Errors: 0,
+		},
+		{
+			Taint:  "key=value:Sometimes",
+			Errors: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Taint, func(t *testing.T) {
+			_, errors := KubernetesNodeTaint(tc.Taint, "test")
+
+			if len(errors) != tc.Errors {
+				t.Fatalf("Expected KubernetesNodeTaint to return %d error(s) not %d", tc.Errors, len(errors))
+			}
+		})
+	}
+}
@@ -6,11 +6,15 @@ import (
 	"encoding/hex"
 	"fmt"
 	"strings"
+	"time"
 
+	"github.com/Azure/azure-sdk-for-go/profiles/2017-03-09/resources/mgmt/resources"
 	"github.com/hashicorp/go-azure-helpers/authentication"
 	"github.com/hashicorp/terraform/helper/mutexkv"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 )
@@ -37,6 +41,16 @@ func Provider() terraform.ResourceProvider {
 				DefaultFunc: schema.EnvDefaultFunc("ARM_TENANT_ID", ""),
 			},
 
+			"auxiliary_tenant_ids": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 3,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validate.UUID,
+				},
+			},
+
 			"environment": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -95,6 +109,112 @@ func Provider() terraform.ResourceProvider {
 				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("ARM_SKIP_PROVIDER_REGISTRATION", false),
 			},
+
+			"resource_providers_to_register": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+			},
+
+			"require_resources_to_be_imported": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_PROVIDER_STRICT", false),
+			},
+
+			"storage_use_azuread": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_STORAGE_USE_AZUREAD", false),
+			},
+
+			"key_vault": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"recover_soft_deleted_key_vaults": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+
+						"purge_soft_delete_on_destroy": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+
+			// Retry Policy for throttled or failed requests
+			"retry_max_attempts": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ARM_RETRY_MAX_ATTEMPTS", 4),
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"retry_min_backoff": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ARM_RETRY_MIN_BACKOFF", 2),
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+
+			"retry_max_backoff": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ARM_RETRY_MAX_BACKOFF", 60),
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+
+			"default_tags": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tags": {
+							Type:         schema.TypeMap,
+							Optional:     true,
+							ValidateFunc: validateAzureRMTags,
+						},
+					},
+				},
+			},
+
+			"ignore_tags": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"keys": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validate.NoEmptyStrings,
+							},
+						},
+
+						"key_prefixes": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validate.NoEmptyStrings,
+							},
+						},
+					},
+				},
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
@@ -209,6 +329,15 @@ func Provider() terraform.ResourceProvider {
 			"azurerm_container_registry":                     resourceArmContainerRegistry(),
 			"azurerm_container_service":                      resourceArmContainerService(),
 			"azurerm_cosmosdb_account":                       resourceArmCosmosDBAccount(),
+			"azurerm_cosmosdb_cassandra_keyspace":            resourceArmCosmosDBCassandraKeyspace(),
+			"azurerm_cosmosdb_cassandra_table":               resourceArmCosmosDBCassandraTable(),
+			"azurerm_cosmosdb_gremlin_database":              resourceArmCosmosDBGremlinDatabase(),
+			"azurerm_cosmosdb_gremlin_graph":                 resourceArmCosmosDBGremlinGraph(),
+			"azurerm_cosmosdb_mongo_collection":              resourceArmCosmosDBMongoCollection(),
+			"azurerm_cosmosdb_mongo_database":                resourceArmCosmosDBMongoDatabase(),
+			"azurerm_cosmosdb_sql_container":                 resourceArmCosmosDBSqlContainer(),
+			"azurerm_cosmosdb_sql_database":                  resourceArmCosmosDBSqlDatabase(),
+			"azurerm_cosmosdb_table":                         resourceArmCosmosDBTable(),
 			"azurerm_data_lake_analytics_account":            resourceArmDataLakeAnalyticsAccount(),
 			"azurerm_data_lake_analytics_firewall_rule":      resourceArmDataLakeAnalyticsFirewallRule(),
 			"azurerm_data_lake_store_file":                   resourceArmDataLakeStoreFile(),
@@ -244,6 +373,7 @@ func Provider() terraform.ResourceProvider {
 			"azurerm_express_route_circuit_peering":          resourceArmExpressRouteCircuitPeering(),
 			"azurerm_express_route_circuit":                  resourceArmExpressRouteCircuit(),
 			"azurerm_firewall_application_rule_collection":   resourceArmFirewallApplicationRuleCollection(),
+			"azurerm_firewall_nat_rule_collection":           resourceArmFirewallNatRuleCollection(),
 			"azurerm_firewall_network_rule_collection":       resourceArmFirewallNetworkRuleCollection(),
 			"azurerm_firewall":                               resourceArmFirewall(),
 			"azurerm_function_app":                           resourceArmFunctionApp(),
@@ -256,6 +386,7 @@ func Provider() terraform.ResourceProvider {
 			"azurerm_key_vault_secret":                       resourceArmKeyVaultSecret(),
 			"azurerm_key_vault":                              resourceArmKeyVault(),
 			"azurerm_kubernetes_cluster":                     resourceArmKubernetesCluster(),
+			"azurerm_kubernetes_cluster_node_pool":           resourceArmKubernetesClusterNodePool(),
 			"azurerm_lb_backend_address_pool":                resourceArmLoadBalancerBackendAddressPool(),
 			"azurerm_lb_nat_pool":                            resourceArmLoadBalancerNatPool(),
 			"azurerm_lb_nat_rule":                            resourceArmLoadBalancerNatRule(),
@@ -263,6 +394,7 @@ func Provider() terraform.ResourceProvider {
 			"azurerm_lb_outbound_rule":                       resourceArmLoadBalancerOutboundRule(),
 			"azurerm_lb_rule":                                resourceArmLoadBalancerRule(),
 			"azurerm_lb":                                     resourceArmLoadBalancer(),
+			"azurerm_linux_virtual_machine":                  resourceArmLinuxVirtualMachine(),
 			"azurerm_local_network_gateway":                  resourceArmLocalNetworkGateway(),
 			"azurerm_log_analytics_solution":                 resourceArmLogAnalyticsSolution(),
 			"azurerm_log_analytics_linked_service":           resourceArmLogAnalyticsLinkedService(),
@@ -276,6 +408,7 @@ func Provider() terraform.ResourceProvider {
 			"azurerm_logic_app_workflow":                     resourceArmLogicAppWorkflow(),
 			"azurerm_managed_disk":                           resourceArmManagedDisk(),
 			"azurerm_management_group":                       resourceArmManagementGroup(),
+			"azurerm_management_group_template_deployment":   resourceArmManagementGroupTemplateDeployment(),
 			"azurerm_management_lock":                        resourceArmManagementLock(),
 			"azurerm_mariadb_database":                       resourceArmMariaDbDatabase(),
 			"azurerm_mariadb_server":                         resourceArmMariaDbServer(),
@@ -314,6 +447,15 @@ func Provider() terraform.ResourceProvider {
 			"azurerm_postgresql_firewall_rule":                                               resourceArmPostgreSQLFirewallRule(),
 			"azurerm_postgresql_server":                                                      resourceArmPostgreSQLServer(),
 			"azurerm_postgresql_virtual_network_rule":                                        resourceArmPostgreSQLVirtualNetworkRule(),
+			"azurerm_private_dns_a_record":                                                   resourceArmPrivateDnsARecord(),
+			"azurerm_private_dns_aaaa_record":                                                resourceArmPrivateDnsAaaaRecord(),
+			"azurerm_private_dns_cname_record":                                               resourceArmPrivateDnsCNameRecord(),
+			"azurerm_private_dns_mx_record":                                                  resourceArmPrivateDnsMxRecord(),
+			"azurerm_private_dns_ptr_record":                                                 resourceArmPrivateDnsPtrRecord(),
+			"azurerm_private_dns_srv_record":                                                 resourceArmPrivateDnsSrvRecord(),
+			"azurerm_private_dns_txt_record":                                                 resourceArmPrivateDnsTxtRecord(),
+			"azurerm_private_dns_zone":                                                       resourceArmPrivateDnsZone(),
+			"azurerm_private_dns_zone_virtual_network_link":                                  resourceArmPrivateDnsZoneVirtualNetworkLink(),
 			"azurerm_public_ip":                                                              resourceArmPublicIp(),
 			"azurerm_recovery_services_protected_vm":                                         resourceArmRecoveryServicesProtectedVm(),
 			"azurerm_recovery_services_protection_policy_vm":                                 resourceArmRecoveryServicesProtectionPolicyVm(),
@@ -355,12 +497,14 @@ func Provider() terraform.ResourceProvider {
 			"azurerm_storage_account":                                                        resourceArmStorageAccount(),
 			"azurerm_storage_blob":                                                           resourceArmStorageBlob(),
 			"azurerm_storage_container":                                                      resourceArmStorageContainer(),
+			"azurerm_storage_management_policy":                                              resourceArmStorageManagementPolicy(),
 			"azurerm_storage_queue":                                                          resourceArmStorageQueue(),
 			"azurerm_storage_share":                                                          resourceArmStorageShare(),
 			"azurerm_storage_table":                                                          resourceArmStorageTable(),
 			"azurerm_subnet_network_security_group_association":                              resourceArmSubnetNetworkSecurityGroupAssociation(),
 			"azurerm_subnet_route_table_association":                                         resourceArmSubnetRouteTableAssociation(),
 			"azurerm_subnet":                                                                 resourceArmSubnet(),
+			"azurerm_subscription_template_deployment":                                       resourceArmSubscriptionTemplateDeployment(),
 			"azurerm_template_deployment":                                                    resourceArmTemplateDeployment(),
 			"azurerm_traffic_manager_endpoint":                                               resourceArmTrafficManagerEndpoint(),
 			"azurerm_traffic_manager_profile":                                                resourceArmTrafficManagerProfile(),
@@ -373,9 +517,26 @@ func Provider() terraform.ResourceProvider {
 			"azurerm_virtual_network_gateway":                                                resourceArmVirtualNetworkGateway(),
 			"azurerm_virtual_network_peering":                                                resourceArmVirtualNetworkPeering(),
 			"azurerm_virtual_network":                                                        resourceArmVirtualNetwork(),
+			"azurerm_windows_virtual_machine":                                                resourceArmWindowsVirtualMachine(),
 		},
 	}
 
+	// applies the Provider's `default_tags` and `ignore_tags` to each resource, registers the Resource Provider
+	// used by each resource when it's first created, and assigns each operation a Correlation Request ID,
+	// which is surfaced in any errors to aid debugging
+	for name, r := range p.DataSourcesMap {
+		withProviderTags(r, true)
+		if r.Read != nil {
+			r.Read = schema.ReadFunc(withMissingSubscriptionRegistrationDetails("", crudFunc(r.Read)))
+		}
+		withCorrelationRequestIds(name, r)
+	}
+	for name, r := range p.ResourcesMap {
+		withProviderTags(r, false)
+		withResourceProviderRegistration(name, r)
+		withCorrelationRequestIds(name, r)
+	}
+
 	p.ConfigureFunc = providerConfigure(p)
 
 	return p
@@ -407,13 +568,27 @@ func providerConfigure(p *schema.Provider) schema.ConfigureFunc {
 
 		partnerId := d.Get("partner_id").(string)
 		skipProviderRegistration := d.Get("skip_provider_registration").(bool)
-		client, err := getArmClient(config, skipProviderRegistration, partnerId)
+		retryPolicy, err := expandProviderRetryPolicy(d)
+		if err != nil {
+			return nil, err
+		}
+
+		auxiliaryTenantIDs := make([]string, 0)
+		for _, v := range d.Get("auxiliary_tenant_ids").([]interface{}) {
+			auxiliaryTenantIDs = append(auxiliaryTenantIDs, v.(string))
+		}
+
+		storageUseAzureAD := d.Get("storage_use_azuread").(bool)
+		client, err := getArmClient(config, skipProviderRegistration, partnerId, *retryPolicy, auxiliaryTenantIDs, storageUseAzureAD)
 
 		if err != nil {
 			return nil, err
 		}
 
 		client.StopContext = p.StopContext()
+		client.providerTags = expandProviderTags(d)
+		client.requireResourcesToBeImported = d.Get("require_resources_to_be_imported").(bool)
+		client.keyVaultFeatures = expandProviderKeyVaultFeatures(d)
 
 		// replaces the context between tests
 		p.MetaReset = func() error {
@@ -421,6 +596,7 @@ func providerConfigure(p *schema.Provider) schema.ConfigureFunc {
 			return nil
 		}
 
+		var availableResourceProviders []resources.Provider
 		skipCredentialsValidation := d.Get("skip_credentials_validation").(bool)
 		if !skipCredentialsValidation {
 			// List all the available providers and their registration state to avoid unnecessary
@@ -433,12 +609,17 @@ func providerConfigure(p *schema.Provider) schema.ConfigureFunc {
 					"error: %s", err)
 			}
 
-			if !skipProviderRegistration {
-				availableResourceProviders := providerList.Values()
-				requiredResourceProviders := requiredResourceProviders()
+			availableResourceProviders = providerList.Values()
+		}
+
+		if !skipProviderRegistration {
+			// the Resource Providers used by each Resource are registered the first time that Resource is created,
+			// however any which are explicitly specified are registered up-front
+			client.resourceProviderRegistrations = newResourceProviderRegistrations(availableResourceProviders)
 
-				err := ensureResourceProvidersAreRegistered(ctx, client.providersClient, availableResourceProviders, requiredResourceProviders)
-				if err != nil {
+			for _, v := range d.Get("resource_providers_to_register").(*schema.Set).List() {
+				namespace := v.(string)
+				if err := client.resourceProviderRegistrations.ensureRegistered(client.StopContext, client.providersClient, namespace); err != nil {
 					return nil, fmt.Errorf("Error ensuring Resource Providers are registered: %s", err)
 				}
 			}
@@ -448,6 +629,36 @@ func providerConfigure(p *schema.Provider) schema.ConfigureFunc {
 	}
 }
 
+func expandProviderRetryPolicy(d *schema.ResourceData) (*azure.RetryPolicy, error) {
+	minBackoff := d.Get("retry_min_backoff").(int)
+	maxBackoff := d.Get("retry_max_backoff").(int)
+	if minBackoff > maxBackoff {
+		return nil, fmt.Errorf("`retry_min_backoff` (%d) must be less than or equal to `retry_max_backoff` (%d)", minBackoff, maxBackoff)
+	}
+
+	return &azure.RetryPolicy{
+		MaxAttempts: d.Get("retry_max_attempts").(int),
+		MinBackoff:  time.Duration(minBackoff) * time.Second,
+		MaxBackoff:  time.Duration(maxBackoff) * time.Second,
+	}, nil
+}
+
+func expandProviderKeyVaultFeatures(d *schema.ResourceData) keyVaultFeatures {
+	// Soft-Deleted Key Vaults (and the Certificates, Keys and Secrets within them) are recovered by default
+	features := keyVaultFeatures{
+		recoverSoftDeleted:       true,
+		purgeSoftDeleteOnDestroy: false,
+	}
+
+	if raw := d.Get("key_vault").([]interface{}); len(raw) > 0 && raw[0] != nil {
+		block := raw[0].(map[string]interface{})
+		features.recoverSoftDeleted = block["recover_soft_deleted_key_vaults"].(bool)
+		features.purgeSoftDeleteOnDestroy = block["purge_soft_delete_on_destroy"].(bool)
+	}
+
+	return features
+}
+
 // armMutexKV is the instance of MutexKV for ARM resources
 var armMutexKV = mutexkv.NewMutexKV()
 
@@ -0,0 +1,366 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-08-01/network"
+	"github.com/Azure/azure-sdk-for-go/services/preview/dns/mgmt/2018-03-01-preview/dns"
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2018-05-01/resources"
+	"github.com/Azure/azure-sdk-for-go/services/servicebus/mgmt/2017-04-01/servicebus"
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// resourcesExemptFromRequiringImport are resources whose Create function doesn't (and can't) check for an
+// existing resource, since the object they manage always exists in Azure
+var resourcesExemptFromRequiringImport = map[string]string{
+	"azurerm_app_service_active_slot":              "swaps the Active Slot of an App Service, which always has one",
+	"azurerm_mysql_configuration":                  "manages a server setting, which always exists",
+	"azurerm_postgresql_configuration":             "manages a server setting, which always exists",
+	"azurerm_security_center_subscription_pricing": "manages the Pricing Tier of the Subscription, which always exists",
+}
+
+// TestProvider_resourcesRequireImport ensures that the Create function of every resource checks for an existing
+// resource when `require_resources_to_be_imported` is enabled, by inspecting the source of the Create function
+// (and the functions it calls within this package) for the `tf.ImportAsExistsError` error
+func TestProvider_resourcesRequireImport(t *testing.T) {
+	fset := token.NewFileSet()
+	packages, err := parser.ParseDir(fset, ".", func(info os.FileInfo) bool {
+		return !strings.HasSuffix(info.Name(), "_test.go")
+	}, 0)
+	if err != nil {
+		t.Fatalf("Error parsing the provider source: %+v", err)
+	}
+
+	funcs := make(map[string]*ast.FuncDecl)
+	var providerFile *ast.File
+	for _, pkg := range packages {
+		for name, file := range pkg.Files {
+			if strings.HasSuffix(name, "provider.go") {
+				providerFile = file
+			}
+
+			for _, decl := range file.Decls {
+				if fn, ok := decl.(*ast.FuncDecl); ok && fn.Recv == nil {
+					funcs[fn.Name.Name] = fn
+				}
+			}
+		}
+	}
+	if providerFile == nil {
+		t.Fatalf("Unable to locate provider.go")
+	}
+
+	constructors := resourceConstructorsFromProviderSource(providerFile)
+
+	var failures []string
+	for name, resource := range Provider().(*schema.Provider).ResourcesMap {
+		if _, exempt := resourcesExemptFromRequiringImport[name]; exempt || resource.Create == nil {
+			continue
+		}
+
+		constructor, ok := funcs[constructors[name]]
+		if !ok {
+			failures = append(failures, name+": unable to locate the resource's constructor")
+			continue
+		}
+
+		createFunc := createFuncNameFromConstructor(constructor)
+		if createFunc == "" {
+			failures = append(failures, name+": unable to locate the resource's Create function")
+			continue
+		}
+
+		// resources which can never be adopted (e.g. Service Principals) check for an existing resource regardless
+		returnsError := false
+		walkFuncsCalledFrom(funcs, createFunc, 3, map[string]bool{}, func(n ast.Node) {
+			if sel, ok := n.(*ast.SelectorExpr); ok && sel.Sel.Name == "ImportAsExistsError" {
+				returnsError = true
+			}
+		})
+
+		if !returnsError {
+			failures = append(failures, name+": "+createFunc+" doesn't check for an existing resource when `require_resources_to_be_imported` is enabled")
+		}
+	}
+
+	if len(failures) > 0 {
+		sort.Strings(failures)
+		t.Fatalf("%d resources don't honour `require_resources_to_be_imported`:\n\n%s", len(failures), strings.Join(failures, "\n"))
+	}
+}
+
+// resourceConstructorsFromProviderSource returns a map of the resource name to the name of the function which returns it
+func resourceConstructorsFromProviderSource(file *ast.File) map[string]string {
+	output := make(map[string]string)
+
+	ast.Inspect(file, func(n ast.Node) bool {
+		kv, ok := n.(*ast.KeyValueExpr)
+		if !ok {
+			return true
+		}
+
+		if key, ok := kv.Key.(*ast.Ident); !ok || key.Name != "ResourcesMap" {
+			return true
+		}
+
+		ast.Inspect(kv.Value, func(n ast.Node) bool {
+			resource, ok := n.(*ast.KeyValueExpr)
+			if !ok {
+				return true
+			}
+
+			name, ok := resource.Key.(*ast.BasicLit)
+			if !ok {
+				return true
+			}
+
+			if call, ok := resource.Value.(*ast.CallExpr); ok {
+				if fn, ok := call.Fun.(*ast.Ident); ok {
+					output[strings.Trim(name.Value, `"`)] = fn.Name
+				}
+			}
+			return false
+		})
+		return false
+	})
+
+	return output
+}
+
+func createFuncNameFromConstructor(constructor *ast.FuncDecl) string {
+	var output string
+
+	ast.Inspect(constructor.Body, func(n ast.Node) bool {
+		if output != "" {
+			return false
+		}
+
+		kv, ok := n.(*ast.KeyValueExpr)
+		if !ok {
+			return true
+		}
+
+		if key, ok := kv.Key.(*ast.Ident); ok && key.Name == "Create" {
+			if fn, ok := kv.Value.(*ast.Ident); ok {
+				output = fn.Name
+			}
+			return false
+		}
+		return true
+	})
+
+	return output
+}
+
+// walkFuncsCalledFrom visits each node within the function and any functions within this package it calls,
+// up to the specified depth
+func walkFuncsCalledFrom(funcs map[string]*ast.FuncDecl, name string, depth int, visited map[string]bool, visit func(ast.Node)) {
+	fn, ok := funcs[name]
+	if !ok || depth == 0 || visited[name] {
+		return
+	}
+	visited[name] = true
+
+	ast.Inspect(fn.Body, func(n ast.Node) bool {
+		if n == nil {
+			return false
+		}
+
+		visit(n)
+
+		if call, ok := n.(*ast.CallExpr); ok {
+			if ident, ok := call.Fun.(*ast.Ident); ok {
+				walkFuncsCalledFrom(funcs, ident.Name, depth-1, visited, visit)
+			}
+		}
+		return true
+	})
+}
+
+// TestProvider_requireResourcesToBeImported applies a representative set of resources (a top-level resource, child
+// resources and a "virtual" association resource) against a stubbed API which reports that the resource already exists,
+// to ensure that the existence check happens - and that `require_resources_to_be_imported` is honoured
+func TestProvider_requireResourcesToBeImported(t *testing.T) {
+	const subscriptionId = "00000000-0000-0000-0000-000000000000"
+	subnetId := fmt.Sprintf("/subscriptions/%s/resourceGroups/example-resources/providers/Microsoft.Network/virtualNetworks/example-network/subnets/example", subscriptionId)
+	networkSecurityGroupId := fmt.Sprintf("/subscriptions/%s/resourceGroups/example-resources/providers/Microsoft.Network/networkSecurityGroups/example", subscriptionId)
+	serviceBusNamespaceId := fmt.Sprintf("/subscriptions/%s/resourceGroups/example-resources/providers/Microsoft.ServiceBus/namespaces/example-namespace", subscriptionId)
+
+	cases := []struct {
+		ResourceType string
+		Config       map[string]interface{}
+		ExistingPath string
+
+		// Responses are the bodies returned for specific paths, rather than just the ID of the resource
+		Responses map[string]string
+		Configure func(client *ArmClient, endpoint string)
+	}{
+		{
+			ResourceType: "azurerm_resource_group",
+			Config: map[string]interface{}{
+				"name":     "example-resources",
+				"location": "West Europe",
+			},
+			ExistingPath: fmt.Sprintf("/subscriptions/%s/resourceGroups/example-resources", subscriptionId),
+			Configure: func(client *ArmClient, endpoint string) {
+				client.resourceGroupsClient = resources.NewGroupsClientWithBaseURI(endpoint, subscriptionId)
+			},
+		},
+		{
+			ResourceType: "azurerm_subnet",
+			Config: map[string]interface{}{
+				"name":                 "example",
+				"resource_group_name":  "example-resources",
+				"virtual_network_name": "example-network",
+				"address_prefix":       "10.0.2.0/24",
+			},
+			ExistingPath: subnetId,
+			Configure: func(client *ArmClient, endpoint string) {
+				client.subnetClient = network.NewSubnetsClientWithBaseURI(endpoint, subscriptionId)
+			},
+		},
+		{
+			ResourceType: "azurerm_network_security_rule",
+			Config: map[string]interface{}{
+				"name":                        "example",
+				"resource_group_name":         "example-resources",
+				"network_security_group_name": "example",
+				"priority":                    100,
+				"direction":                   "Inbound",
+				"access":                      "Allow",
+				"protocol":                    "Tcp",
+				"source_port_range":           "*",
+				"destination_port_range":      "*",
+				"source_address_prefix":       "*",
+				"destination_address_prefix":  "*",
+			},
+			ExistingPath: networkSecurityGroupId + "/securityRules/example",
+			Configure: func(client *ArmClient, endpoint string) {
+				client.secRuleClient = network.NewSecurityRulesClientWithBaseURI(endpoint, subscriptionId)
+			},
+		},
+		{
+			ResourceType: "azurerm_dns_a_record",
+			Config: map[string]interface{}{
+				"name":                "example",
+				"resource_group_name": "example-resources",
+				"zone_name":           "example.com",
+				"ttl":                 300,
+				"records":             []interface{}{"10.0.180.17"},
+			},
+			ExistingPath: fmt.Sprintf("/subscriptions/%s/resourceGroups/example-resources/providers/Microsoft.Network/dnsZones/example.com/A/example", subscriptionId),
+			Configure: func(client *ArmClient, endpoint string) {
+				client.dnsClient = dns.NewRecordSetsClientWithBaseURI(endpoint, subscriptionId)
+			},
+		},
+		{
+			ResourceType: "azurerm_servicebus_queue",
+			Config: map[string]interface{}{
+				"name":                "example",
+				"resource_group_name": "example-resources",
+				"namespace_name":      "example-namespace",
+			},
+			ExistingPath: serviceBusNamespaceId + "/queues/example",
+			Responses: map[string]string{
+				serviceBusNamespaceId: fmt.Sprintf(`{"id": %q, "sku": {"name": "Standard", "tier": "Standard"}}`, serviceBusNamespaceId),
+			},
+			Configure: func(client *ArmClient, endpoint string) {
+				client.serviceBusQueuesClient = servicebus.NewQueuesClientWithBaseURI(endpoint, subscriptionId)
+				client.serviceBusNamespacesClient = servicebus.NewNamespacesClientWithBaseURI(endpoint, subscriptionId)
+			},
+		},
+		{
+			// the Subnet always exists - it's the Network Security Group being assigned to it which determines this
+			ResourceType: "azurerm_subnet_network_security_group_association",
+			Config: map[string]interface{}{
+				"subnet_id":                 subnetId,
+				"network_security_group_id": networkSecurityGroupId,
+			},
+			ExistingPath: subnetId,
+			Responses: map[string]string{
+				subnetId: fmt.Sprintf(`{"id": %q, "properties": {"networkSecurityGroup": {"id": %q}}}`, subnetId, networkSecurityGroupId),
+			},
+			Configure: func(client *ArmClient, endpoint string) {
+				client.subnetClient = network.NewSubnetsClientWithBaseURI(endpoint, subscriptionId)
+			},
+		},
+	}
+
+	resources := Provider().(*schema.Provider).ResourcesMap
+	for _, v := range cases {
+		for _, requireImport := range []bool{true, false} {
+			t.Run(fmt.Sprintf("%s/%t", v.ResourceType, requireImport), func(t *testing.T) {
+				var requests []string
+				server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					requests = append(requests, r.Method+" "+r.URL.Path)
+
+					w.Header().Set("Content-Type", "application/json")
+					if r.Method != http.MethodGet {
+						// the resource is only created when it's adopted rather than needing to be imported
+						w.WriteHeader(http.StatusBadRequest)
+						w.Write([]byte(`{"error": {"code": "Stubbed", "message": "Creation isn't stubbed"}}`)) // nolint: errcheck
+						return
+					}
+
+					body, ok := v.Responses[r.URL.Path]
+					if !ok {
+						body = fmt.Sprintf(`{"id": %q}`, r.URL.Path)
+					}
+					w.Write([]byte(body)) // nolint: errcheck
+				}))
+				defer server.Close()
+
+				client := &ArmClient{
+					StopContext:                  context.Background(),
+					requireResourcesToBeImported: requireImport,
+				}
+				v.Configure(client, server.URL)
+
+				resource := resources[v.ResourceType]
+				raw, err := config.NewRawConfig(v.Config)
+				if err != nil {
+					t.Fatalf("Error building the config: %+v", err)
+				}
+				diff, err := resource.Diff(nil, terraform.NewResourceConfig(raw), client)
+				if err != nil {
+					t.Fatalf("Error building the diff: %+v", err)
+				}
+
+				_, err = resource.Apply(nil, diff, client)
+				if err == nil {
+					t.Fatalf("Expected an error but didn't get one")
+				}
+				requiresImportErr := strings.Contains(err.Error(), "already exists - to be managed via Terraform")
+
+				if !requireImport {
+					if requiresImportErr {
+						t.Fatalf("Expected the existing resource to be adopted but got: %+v", err)
+					}
+					if len(requests) == 0 || requests[len(requests)-1] == http.MethodGet+" "+v.ExistingPath {
+						t.Fatalf("Expected the resource to be created but the requests were: %+v", requests)
+					}
+					return
+				}
+
+				if !requiresImportErr || !strings.Contains(err.Error(), fmt.Sprintf("%q", v.ResourceType)) {
+					t.Fatalf("Expected an error that the %q needs to be imported but got: %+v", v.ResourceType, err)
+				}
+				if len(requests) != 1 || !strings.EqualFold(requests[0], http.MethodGet+" "+v.ExistingPath) {
+					t.Fatalf("Expected a single request to retrieve %q but the requests were: %+v", v.ExistingPath, requests)
+				}
+			})
+		}
+	}
+}
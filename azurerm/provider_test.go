@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/Azure/go-autorest/autorest/azure"
@@ -11,11 +12,16 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/recording"
 )
 
 var testAccProviders map[string]terraform.ResourceProvider
 var testAccProvider *schema.Provider
 
+// requireResourcesToBeImported mirrors the default of the Provider's `require_resources_to_be_imported` field,
+// which is used to determine whether the `requiresImport` acceptance tests should be run
+var requireResourcesToBeImported = strings.EqualFold(os.Getenv("ARM_PROVIDER_STRICT"), "true")
+
 func init() {
 	testAccProvider = Provider().(*schema.Provider)
 	testAccProviders = map[string]terraform.ResourceProvider{
@@ -33,6 +39,60 @@ func TestProvider_impl(t *testing.T) {
 	var _ = Provider()
 }
 
+func TestExpandProviderKeyVaultFeatures(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Input    map[string]interface{}
+		Expected keyVaultFeatures
+	}{
+		{
+			Name:  "Defaults",
+			Input: map[string]interface{}{},
+			Expected: keyVaultFeatures{
+				recoverSoftDeleted:       true,
+				purgeSoftDeleteOnDestroy: false,
+			},
+		},
+		{
+			Name: "Purge on Destroy",
+			Input: map[string]interface{}{
+				"key_vault": []interface{}{
+					map[string]interface{}{
+						"purge_soft_delete_on_destroy": true,
+					},
+				},
+			},
+			Expected: keyVaultFeatures{
+				recoverSoftDeleted:       true,
+				purgeSoftDeleteOnDestroy: true,
+			},
+		},
+		{
+			Name: "Recovery Disabled",
+			Input: map[string]interface{}{
+				"key_vault": []interface{}{
+					map[string]interface{}{
+						"recover_soft_deleted_key_vaults": false,
+					},
+				},
+			},
+			Expected: keyVaultFeatures{
+				recoverSoftDeleted:       false,
+				purgeSoftDeleteOnDestroy: false,
+			},
+		},
+	}
+
+	for _, v := range cases {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		d := schema.TestResourceDataRaw(t, Provider().(*schema.Provider).Schema, v.Input)
+		if actual := expandProviderKeyVaultFeatures(d); actual != v.Expected {
+			t.Fatalf("Expected %+v but got %+v", v.Expected, actual)
+		}
+	}
+}
+
 func testAccPreCheck(t *testing.T) {
 	variables := []string{
 		"ARM_CLIENT_ID",
@@ -49,6 +109,10 @@ func testAccPreCheck(t *testing.T) {
 			t.Fatalf("`%s` must be set for acceptance tests!", variable)
 		}
 	}
+
+	if err := recording.Start(t); err != nil {
+		t.Fatalf("Error starting the recording for %q: %+v", t.Name(), err)
+	}
 }
 
 func testLocation() string {
@@ -3,8 +3,12 @@ package azurerm
 import (
 	"testing"
 
+	"github.com/Azure/azure-sdk-for-go/profiles/2017-03-09/resources/mgmt/resources"
 	"github.com/davecgh/go-spew/spew"
 	"github.com/hashicorp/go-azure-helpers/resourceproviders"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func TestAccAzureRMEnsureRequiredResourceProvidersAreRegistered(t *testing.T) {
@@ -14,7 +18,7 @@ func TestAccAzureRMEnsureRequiredResourceProvidersAreRegistered(t *testing.T) {
 	}
 
 	// this test intentionally checks all the RP's are registered - so this is intentional
-	armClient, err := getArmClient(config, true, "")
+	armClient, err := getArmClient(config, true, "", azure.DefaultRetryPolicy(), nil, false)
 	if err != nil {
 		t.Fatalf("Error building ARM Client: %+v", err)
 	}
@@ -48,3 +52,49 @@ func TestAccAzureRMEnsureRequiredResourceProvidersAreRegistered(t *testing.T) {
 		t.Fatalf("'%d' Resource Providers are still Pending Registration: %s", len(stillRequiringRegistration), spew.Sprint(stillRequiringRegistration))
 	}
 }
+
+func TestResourceProviderNamespaces(t *testing.T) {
+	namespaces := resourceProviderNamespaces()
+	required := requiredResourceProviders()
+
+	for name := range Provider().(*schema.Provider).ResourcesMap {
+		namespace, ok := namespaces[name]
+		if !ok {
+			t.Fatalf("No Resource Provider is defined for %q in `resourceProviderNamespaces`", name)
+		}
+
+		if namespace == "" {
+			continue
+		}
+
+		if _, ok := required[namespace]; !ok {
+			t.Fatalf("The Resource Provider %q used by %q isn't defined in `requiredResourceProviders`", namespace, name)
+		}
+	}
+}
+
+func TestResourceProviderRegistrations(t *testing.T) {
+	available := []resources.Provider{
+		{
+			Namespace:         utils.String("Microsoft.Cache"),
+			RegistrationState: utils.String("Registered"),
+		},
+		{
+			Namespace:         utils.String("Microsoft.Batch"),
+			RegistrationState: utils.String("NotRegistered"),
+		},
+	}
+
+	registrations := newResourceProviderRegistrations(available)
+	if !registrations.isRegistered("microsoft.cache") {
+		t.Fatalf("Expected `Microsoft.Cache` to be registered")
+	}
+	if registrations.isRegistered("Microsoft.Batch") {
+		t.Fatalf("Expected `Microsoft.Batch` not to be registered")
+	}
+
+	registrations.markAsRegistered("Microsoft.Batch")
+	if !registrations.isRegistered("Microsoft.Batch") {
+		t.Fatalf("Expected `Microsoft.Batch` to be registered")
+	}
+}
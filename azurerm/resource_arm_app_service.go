@@ -4,23 +4,31 @@ import (
 	"fmt"
 	"log"
 	"regexp"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2018-02-01/web"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmAppService() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmAppServiceCreate,
-		Read:   resourceArmAppServiceRead,
-		Update: resourceArmAppServiceUpdate,
-		Delete: resourceArmAppServiceDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmAppServiceCreate,
+		Read:     resourceArmAppServiceRead,
+		Update:   resourceArmAppServiceUpdate,
+		Delete:   resourceArmAppServiceDelete,
+		Importer: azure.ValidateResourceIDPriorToImport(azure.ValidateAppServiceID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -63,12 +71,22 @@ func resourceArmAppService() *schema.Resource {
 			"location": locationSchema(),
 
 			"app_service_plan_id": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     azure.ValidateAppServicePlanID,
+				DiffSuppressFunc: suppress.CaseDifference,
 			},
 
 			"site_config": azure.SchemaAppServiceSiteConfig(),
 
+			"auth_settings": azure.SchemaAppServiceAuthSettings(),
+
+			"backup": azure.SchemaAppServiceBackup(),
+
+			"logs": azure.SchemaAppServiceLogsConfig(),
+
+			"storage_account": azure.SchemaAppServiceStorageAccounts(),
+
 			"client_affinity_enabled": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -192,14 +210,15 @@ func resourceArmAppService() *schema.Resource {
 
 func resourceArmAppServiceCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).appServicesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for AzureRM App Service creation.")
 
 	name := d.Get("name").(string)
 	resGroup := d.Get("resource_group_name").(string)
 
-	if requireResourcesToBeImported && d.IsNewResource() {
+	if meta.(*ArmClient).requireResourcesToBeImported && d.IsNewResource() {
 		existing, err := client.Get(ctx, resGroup, name)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
@@ -284,7 +303,8 @@ func resourceArmAppServiceCreate(d *schema.ResourceData, meta interface{}) error
 
 func resourceArmAppServiceUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).appServicesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -378,6 +398,55 @@ func resourceArmAppServiceUpdate(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
+	if d.HasChange("auth_settings") {
+		authSettingsProperties := azure.ExpandAppServiceAuthSettings(d.Get("auth_settings").([]interface{}))
+		authSettings := web.SiteAuthSettings{
+			SiteAuthSettingsProperties: &authSettingsProperties,
+		}
+
+		if _, err := client.UpdateAuthSettings(ctx, resGroup, name, authSettings); err != nil {
+			return fmt.Errorf("Error updating Authentication Settings for App Service %q: %+v", name, err)
+		}
+	}
+
+	if d.HasChange("backup") {
+		backup, err := azure.ExpandAppServiceBackup(d.Get("backup").([]interface{}))
+		if err != nil {
+			return fmt.Errorf("Error expanding Backup Configuration for App Service %q: %+v", name, err)
+		}
+
+		if backup == nil {
+			if resp, err := client.DeleteBackupConfiguration(ctx, resGroup, name); err != nil && !utils.ResponseWasNotFound(resp) {
+				return fmt.Errorf("Error deleting Backup Configuration for App Service %q: %+v", name, err)
+			}
+		} else {
+			if _, err := client.UpdateBackupConfiguration(ctx, resGroup, name, *backup); err != nil {
+				return fmt.Errorf("Error updating Backup Configuration for App Service %q: %+v", name, err)
+			}
+		}
+	}
+
+	if d.HasChange("logs") {
+		logsConfigProperties := azure.ExpandAppServiceLogs(d.Get("logs").([]interface{}))
+		logsConfig := web.SiteLogsConfig{
+			SiteLogsConfigProperties: &logsConfigProperties,
+		}
+
+		if _, err := client.UpdateDiagnosticLogsConfig(ctx, resGroup, name, logsConfig); err != nil {
+			return fmt.Errorf("Error updating Diagnostic Logs Configuration for App Service %q: %+v", name, err)
+		}
+	}
+
+	if d.HasChange("storage_account") {
+		storageAccounts := web.AzureStoragePropertyDictionaryResource{
+			Properties: azure.ExpandAppServiceStorageAccounts(d.Get("storage_account").(*schema.Set).List()),
+		}
+
+		if _, err := client.UpdateAzureStorageAccounts(ctx, resGroup, name, storageAccounts); err != nil {
+			return fmt.Errorf("Error updating Storage Accounts for App Service %q: %+v", name, err)
+		}
+	}
+
 	if d.HasChange("identity") {
 		site, err := client.Get(ctx, resGroup, name)
 		if err != nil {
@@ -412,7 +481,8 @@ func resourceArmAppServiceRead(d *schema.ResourceData, meta interface{}) error {
 	resGroup := id.ResourceGroup
 	name := id.Path["sites"]
 
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	resp, err := client.Get(ctx, resGroup, name)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
@@ -438,6 +508,26 @@ func resourceArmAppServiceRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error making Read request on AzureRM App Service ConnectionStrings %q: %+v", name, err)
 	}
 
+	authResp, err := client.GetAuthSettings(ctx, resGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error making Read request on AzureRM App Service AuthSettings %q: %+v", name, err)
+	}
+
+	backupResp, err := client.GetBackupConfiguration(ctx, resGroup, name)
+	if err != nil && !utils.ResponseWasNotFound(backupResp.Response) {
+		return fmt.Errorf("Error making Read request on AzureRM App Service Backup Configuration %q: %+v", name, err)
+	}
+
+	logsResp, err := client.GetDiagnosticLogsConfiguration(ctx, resGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error making Read request on AzureRM App Service Diagnostic Logs Configuration %q: %+v", name, err)
+	}
+
+	storageAccountsResp, err := client.ListAzureStorageAccounts(ctx, resGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error making Read request on AzureRM App Service Storage Accounts %q: %+v", name, err)
+	}
+
 	scmResp, err := client.GetSourceControl(ctx, resGroup, name)
 	if err != nil {
 		return fmt.Errorf("Error making Read request on AzureRM App Service Source Control %q: %+v", name, err)
@@ -486,6 +576,26 @@ func resourceArmAppServiceRead(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
+	authSettings := azure.FlattenAppServiceAuthSettings(authResp.SiteAuthSettingsProperties)
+	if err := d.Set("auth_settings", authSettings); err != nil {
+		return err
+	}
+
+	backup := azure.FlattenAppServiceBackup(backupResp.BackupRequestProperties)
+	if err := d.Set("backup", backup); err != nil {
+		return err
+	}
+
+	logs := azure.FlattenAppServiceLogs(logsResp.SiteLogsConfigProperties)
+	if err := d.Set("logs", logs); err != nil {
+		return err
+	}
+
+	storageAccounts := azure.FlattenAppServiceStorageAccounts(storageAccountsResp.Properties)
+	if err := d.Set("storage_account", storageAccounts); err != nil {
+		return err
+	}
+
 	scm := flattenAppServiceSourceControl(scmResp.SiteSourceControlProperties)
 	if err := d.Set("source_control", scm); err != nil {
 		return err
@@ -520,7 +630,8 @@ func resourceArmAppServiceDelete(d *schema.ResourceData, meta interface{}) error
 
 	deleteMetrics := true
 	deleteEmptyServerFarm := false
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	resp, err := client.Delete(ctx, resGroup, name, &deleteMetrics, &deleteEmptyServerFarm)
 	if err != nil {
 		if !utils.ResponseWasNotFound(resp) {
@@ -3,12 +3,15 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2018-02-01/web"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -22,6 +25,13 @@ func resourceArmAppServiceSlot() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -68,13 +78,23 @@ func resourceArmAppServiceSlot() *schema.Resource {
 			},
 
 			"app_service_plan_id": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     azure.ValidateAppServicePlanID,
+				DiffSuppressFunc: suppress.CaseDifference,
 			},
 
 			"site_config": azure.SchemaAppServiceSiteConfig(),
 
+			"auth_settings": azure.SchemaAppServiceAuthSettings(),
+
+			"backup": azure.SchemaAppServiceBackup(),
+
+			"logs": azure.SchemaAppServiceLogsConfig(),
+
+			"storage_account": azure.SchemaAppServiceStorageAccounts(),
+
 			"client_affinity_enabled": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -148,7 +168,8 @@ func resourceArmAppServiceSlot() *schema.Resource {
 
 func resourceArmAppServiceSlotCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).appServicesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for AzureRM App Service Slot creation.")
 
@@ -156,7 +177,7 @@ func resourceArmAppServiceSlotCreate(d *schema.ResourceData, meta interface{}) e
 	resGroup := d.Get("resource_group_name").(string)
 	appServiceName := d.Get("app_service_name").(string)
 
-	if requireResourcesToBeImported && d.IsNewResource() {
+	if meta.(*ArmClient).requireResourcesToBeImported && d.IsNewResource() {
 		existing, err := client.GetSlot(ctx, resGroup, appServiceName, slot)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
@@ -231,7 +252,8 @@ func resourceArmAppServiceSlotCreate(d *schema.ResourceData, meta interface{}) e
 
 func resourceArmAppServiceSlotUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).appServicesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -319,6 +341,55 @@ func resourceArmAppServiceSlotUpdate(d *schema.ResourceData, meta interface{}) e
 		}
 	}
 
+	if d.HasChange("auth_settings") {
+		authSettingsProperties := azure.ExpandAppServiceAuthSettings(d.Get("auth_settings").([]interface{}))
+		authSettings := web.SiteAuthSettings{
+			SiteAuthSettingsProperties: &authSettingsProperties,
+		}
+
+		if _, err := client.UpdateAuthSettingsSlot(ctx, resGroup, appServiceName, authSettings, slot); err != nil {
+			return fmt.Errorf("Error updating Authentication Settings for App Service Slot %q/%q: %+v", appServiceName, slot, err)
+		}
+	}
+
+	if d.HasChange("backup") {
+		backup, err := azure.ExpandAppServiceBackup(d.Get("backup").([]interface{}))
+		if err != nil {
+			return fmt.Errorf("Error expanding Backup Configuration for App Service Slot %q/%q: %+v", appServiceName, slot, err)
+		}
+
+		if backup == nil {
+			if resp, err := client.DeleteBackupConfigurationSlot(ctx, resGroup, appServiceName, slot); err != nil && !utils.ResponseWasNotFound(resp) {
+				return fmt.Errorf("Error deleting Backup Configuration for App Service Slot %q/%q: %+v", appServiceName, slot, err)
+			}
+		} else {
+			if _, err := client.UpdateBackupConfigurationSlot(ctx, resGroup, appServiceName, *backup, slot); err != nil {
+				return fmt.Errorf("Error updating Backup Configuration for App Service Slot %q/%q: %+v", appServiceName, slot, err)
+			}
+		}
+	}
+
+	if d.HasChange("logs") {
+		logsConfigProperties := azure.ExpandAppServiceLogs(d.Get("logs").([]interface{}))
+		logsConfig := web.SiteLogsConfig{
+			SiteLogsConfigProperties: &logsConfigProperties,
+		}
+
+		if _, err := client.UpdateDiagnosticLogsConfigSlot(ctx, resGroup, appServiceName, logsConfig, slot); err != nil {
+			return fmt.Errorf("Error updating Diagnostic Logs Configuration for App Service Slot %q/%q: %+v", appServiceName, slot, err)
+		}
+	}
+
+	if d.HasChange("storage_account") {
+		storageAccounts := web.AzureStoragePropertyDictionaryResource{
+			Properties: azure.ExpandAppServiceStorageAccounts(d.Get("storage_account").(*schema.Set).List()),
+		}
+
+		if _, err := client.UpdateAzureStorageAccountsSlot(ctx, resGroup, appServiceName, storageAccounts, slot); err != nil {
+			return fmt.Errorf("Error updating Storage Accounts for App Service Slot %q/%q: %+v", appServiceName, slot, err)
+		}
+	}
+
 	return resourceArmAppServiceSlotRead(d, meta)
 }
 
@@ -334,7 +405,8 @@ func resourceArmAppServiceSlotRead(d *schema.ResourceData, meta interface{}) err
 	appServiceName := id.Path["sites"]
 	slot := id.Path["slots"]
 
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	resp, err := client.GetSlot(ctx, resGroup, appServiceName, slot)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
@@ -360,6 +432,26 @@ func resourceArmAppServiceSlotRead(d *schema.ResourceData, meta interface{}) err
 		return fmt.Errorf("Error making Read request on AzureRM App Service Slot ConnectionStrings %q/%q: %+v", appServiceName, slot, err)
 	}
 
+	authResp, err := client.GetAuthSettingsSlot(ctx, resGroup, appServiceName, slot)
+	if err != nil {
+		return fmt.Errorf("Error making Read request on AzureRM App Service Slot AuthSettings %q/%q: %+v", appServiceName, slot, err)
+	}
+
+	backupResp, err := client.GetBackupConfigurationSlot(ctx, resGroup, appServiceName, slot)
+	if err != nil && !utils.ResponseWasNotFound(backupResp.Response) {
+		return fmt.Errorf("Error making Read request on AzureRM App Service Slot Backup Configuration %q/%q: %+v", appServiceName, slot, err)
+	}
+
+	logsResp, err := client.GetDiagnosticLogsConfigurationSlot(ctx, resGroup, appServiceName, slot)
+	if err != nil {
+		return fmt.Errorf("Error making Read request on AzureRM App Service Slot Diagnostic Logs Configuration %q/%q: %+v", appServiceName, slot, err)
+	}
+
+	storageAccountsResp, err := client.ListAzureStorageAccountsSlot(ctx, resGroup, appServiceName, slot)
+	if err != nil {
+		return fmt.Errorf("Error making Read request on AzureRM App Service Slot Storage Accounts %q/%q: %+v", appServiceName, slot, err)
+	}
+
 	d.Set("name", slot)
 	d.Set("app_service_name", appServiceName)
 	d.Set("resource_group_name", resGroup)
@@ -387,6 +479,26 @@ func resourceArmAppServiceSlotRead(d *schema.ResourceData, meta interface{}) err
 		return err
 	}
 
+	authSettings := azure.FlattenAppServiceAuthSettings(authResp.SiteAuthSettingsProperties)
+	if err := d.Set("auth_settings", authSettings); err != nil {
+		return err
+	}
+
+	backup := azure.FlattenAppServiceBackup(backupResp.BackupRequestProperties)
+	if err := d.Set("backup", backup); err != nil {
+		return err
+	}
+
+	logs := azure.FlattenAppServiceLogs(logsResp.SiteLogsConfigProperties)
+	if err := d.Set("logs", logs); err != nil {
+		return err
+	}
+
+	storageAccounts := azure.FlattenAppServiceStorageAccounts(storageAccountsResp.Properties)
+	if err := d.Set("storage_account", storageAccounts); err != nil {
+		return err
+	}
+
 	identity := flattenAzureRmAppServiceMachineIdentity(resp.Identity)
 	if err := d.Set("identity", identity); err != nil {
 		return err
@@ -412,7 +524,8 @@ func resourceArmAppServiceSlotDelete(d *schema.ResourceData, meta interface{}) e
 
 	deleteMetrics := true
 	deleteEmptyServerFarm := false
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	resp, err := client.DeleteSlot(ctx, resGroup, appServiceName, slot, &deleteMetrics, &deleteEmptyServerFarm)
 	if err != nil {
 		if !utils.ResponseWasNotFound(resp) {
@@ -3,6 +3,8 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"sort"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-08-01/network"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -10,18 +12,29 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmApplicationGateway() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmApplicationGatewayCreateUpdate,
-		Read:   resourceArmApplicationGatewayRead,
-		Update: resourceArmApplicationGatewayCreateUpdate,
-		Delete: resourceArmApplicationGatewayDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmApplicationGatewayCreateUpdate,
+		Read:     resourceArmApplicationGatewayRead,
+		Update:   resourceArmApplicationGatewayCreateUpdate,
+		Delete:   resourceArmApplicationGatewayDelete,
+		Importer: azure.ValidateResourceIDPriorToImport(azure.ValidateApplicationGatewayID),
+
+		CustomizeDiff: azure.CustomizeDiffAll(
+			azure.ApplicationGatewayCustomizeDiff,
+			azure.ZonesCustomizeDiff,
+		),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -39,6 +52,8 @@ func resourceArmApplicationGateway() *schema.Resource {
 				ForceNew: true,
 			},
 
+			"zones": zonesSchema(),
+
 			// Required
 			"backend_address_pool": {
 				Type:     schema.TypeList,
@@ -181,6 +196,15 @@ func resourceArmApplicationGateway() *schema.Resource {
 							},
 						},
 
+						"trusted_root_certificate_names": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validate.NoEmptyStrings,
+							},
+						},
+
 						"connection_draining": {
 							Type:     schema.TypeList,
 							MaxItems: 1,
@@ -231,9 +255,11 @@ func resourceArmApplicationGateway() *schema.Resource {
 						},
 
 						"subnet_id": {
-							Type:     schema.TypeString,
-							Optional: true,
-							Computed: true,
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							ValidateFunc:     azure.ValidateSubnetID,
+							DiffSuppressFunc: suppress.CaseDifference,
 						},
 
 						"private_ip_address": {
@@ -243,9 +269,11 @@ func resourceArmApplicationGateway() *schema.Resource {
 						},
 
 						"public_ip_address_id": {
-							Type:     schema.TypeString,
-							Optional: true,
-							Computed: true,
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							ValidateFunc:     azure.ValidatePublicIPAddressID,
+							DiffSuppressFunc: suppress.CaseDifference,
 						},
 
 						"private_ip_address_allocation": {
@@ -302,9 +330,10 @@ func resourceArmApplicationGateway() *schema.Resource {
 						},
 
 						"subnet_id": {
-							Type:         schema.TypeString,
-							Required:     true,
-							ValidateFunc: azure.ValidateResourceID,
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateFunc:     azure.ValidateSubnetID,
+							DiffSuppressFunc: suppress.CaseDifference,
 						},
 
 						"id": {
@@ -456,6 +485,12 @@ func resourceArmApplicationGateway() *schema.Resource {
 							ValidateFunc: validate.NoEmptyStrings,
 						},
 
+						"rewrite_rule_set_name": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+
 						"backend_address_pool_id": {
 							Type:     schema.TypeString,
 							Computed: true,
@@ -485,6 +520,11 @@ func resourceArmApplicationGateway() *schema.Resource {
 							Type:     schema.TypeString,
 							Computed: true,
 						},
+
+						"rewrite_rule_set_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
 					},
 				},
 			},
@@ -581,8 +621,8 @@ func resourceArmApplicationGateway() *schema.Resource {
 
 						"capacity": {
 							Type:         schema.TypeInt,
-							Required:     true,
-							ValidateFunc: validation.IntBetween(1, 10),
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(1, 125),
 						},
 					},
 				},
@@ -735,17 +775,23 @@ func resourceArmApplicationGateway() *schema.Resource {
 
 						"data": {
 							Type:      schema.TypeString,
-							Required:  true,
+							Optional:  true,
 							Sensitive: true,
 							StateFunc: base64EncodedStateFunc,
 						},
 
 						"password": {
 							Type:      schema.TypeString,
-							Required:  true,
+							Optional:  true,
 							Sensitive: true,
 						},
 
+						"key_vault_secret_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validate.URLIsHTTPS,
+						},
+
 						"id": {
 							Type:     schema.TypeString,
 							Computed: true,
@@ -819,6 +865,12 @@ func resourceArmApplicationGateway() *schema.Resource {
 										ValidateFunc: validate.NoEmptyStrings,
 									},
 
+									"rewrite_rule_set_name": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validate.NoEmptyStrings,
+									},
+
 									"backend_address_pool_id": {
 										Type:     schema.TypeString,
 										Computed: true,
@@ -834,6 +886,11 @@ func resourceArmApplicationGateway() *schema.Resource {
 										Computed: true,
 									},
 
+									"rewrite_rule_set_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+
 									"id": {
 										Type:     schema.TypeString,
 										Computed: true,
@@ -917,6 +974,214 @@ func resourceArmApplicationGateway() *schema.Resource {
 							ValidateFunc: validation.IntBetween(1, 128),
 							Default:      128,
 						},
+
+						"disabled_rule_group": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"rule_group_name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validate.NoEmptyStrings,
+									},
+
+									"rules": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeInt,
+											ValidateFunc: validation.IntAtLeast(1),
+										},
+									},
+								},
+							},
+						},
+
+						"exclusion": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"match_variable": {
+										Type:     schema.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											"RequestArgNames",
+											"RequestCookieNames",
+											"RequestHeaderNames",
+										}, false),
+									},
+
+									"selector_match_operator": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											"Contains",
+											"EndsWith",
+											"Equals",
+											"EqualsAny",
+											"StartsWith",
+										}, false),
+									},
+
+									"selector": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"autoscale_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"min_capacity": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 100),
+						},
+
+						"max_capacity": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(2, 125),
+						},
+					},
+				},
+			},
+
+			"identity": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "UserAssigned",
+							ValidateFunc: validation.StringInSlice([]string{
+								"UserAssigned",
+							}, false),
+						},
+
+						"identity_ids": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Schema{
+								Type:             schema.TypeString,
+								ValidateFunc:     azure.ValidateUserAssignedIdentityID,
+								DiffSuppressFunc: suppress.CaseDifference,
+							},
+						},
+					},
+				},
+			},
+
+			"trusted_root_certificate": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+
+						"data": {
+							Type:      schema.TypeString,
+							Required:  true,
+							Sensitive: true,
+							StateFunc: base64EncodedStateFunc,
+						},
+
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"rewrite_rule_set": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+
+						"rewrite_rule": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validate.NoEmptyStrings,
+									},
+
+									"rule_sequence": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntBetween(1, 1000),
+									},
+
+									"condition": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"variable": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validate.NoEmptyStrings,
+												},
+
+												"pattern": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validate.NoEmptyStrings,
+												},
+
+												"ignore_case": {
+													Type:     schema.TypeBool,
+													Optional: true,
+													Default:  false,
+												},
+
+												"negate": {
+													Type:     schema.TypeBool,
+													Optional: true,
+													Default:  false,
+												},
+											},
+										},
+									},
+
+									"request_header_configuration":  applicationGatewayRewriteRuleHeaderConfigurationSchema(),
+									"response_header_configuration": applicationGatewayRewriteRuleHeaderConfigurationSchema(),
+								},
+							},
+						},
+
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
 					},
 				},
 			},
@@ -953,17 +1218,41 @@ func resourceArmApplicationGateway() *schema.Resource {
 	}
 }
 
+func applicationGatewayRewriteRuleHeaderConfigurationSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"header_name": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+
+				// an empty value removes the header
+				"header_value": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+			},
+		},
+	}
+}
+
 func resourceArmApplicationGatewayCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	armClient := meta.(*ArmClient)
 	client := armClient.applicationGatewayClient
-	ctx := armClient.StopContext
+	extendedClient := armClient.applicationGatewayExtendedClient
+	ctx, cancel := timeouts.ForCreateUpdate(armClient.StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for Application Gateway creation.")
 
 	name := d.Get("name").(string)
 	resGroup := d.Get("resource_group_name").(string)
 
-	if requireResourcesToBeImported && d.IsNewResource() {
+	if meta.(*ArmClient).requireResourcesToBeImported && d.IsNewResource() {
 		existing, err := client.Get(ctx, resGroup, name)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
@@ -995,17 +1284,21 @@ func resourceArmApplicationGatewayCreateUpdate(d *schema.ResourceData, meta inte
 	requestRoutingRules := expandApplicationGatewayRequestRoutingRules(d, gatewayID)
 	redirectConfigurations := expandApplicationGatewayRedirectConfigurations(d, gatewayID)
 	sku := expandApplicationGatewaySku(d)
-	sslCertificates := expandApplicationGatewaySslCertificates(d)
+	sslCertificates, sslCertificateKeyVaultSecretIDs := expandApplicationGatewaySslCertificates(d)
 	sslPolicy := expandApplicationGatewaySslPolicy(d)
+	trustedRootCertificates := expandApplicationGatewayTrustedRootCertificates(d)
 	customErrorConfigurations := expandApplicationGatewayCustomErrorConfigurations(d.Get("custom_error_configuration").([]interface{}))
 	urlPathMaps := expandApplicationGatewayURLPathMaps(d, gatewayID)
+	autoscaleConfiguration, autoscaleMaxCapacity := expandApplicationGatewayAutoscaleConfiguration(d.Get("autoscale_configuration").([]interface{}))
 
 	gateway := network.ApplicationGateway{
 		Location: utils.String(location),
+		Zones:    expandZones(d.Get("zones").([]interface{})),
 
 		Tags: expandTags(tags),
 		ApplicationGatewayPropertiesFormat: &network.ApplicationGatewayPropertiesFormat{
 			AuthenticationCertificates:    authenticationCertificates,
+			AutoscaleConfiguration:        autoscaleConfiguration,
 			BackendAddressPools:           backendAddressPools,
 			BackendHTTPSettingsCollection: backendHTTPSettingsCollection,
 			EnableHTTP2:                   utils.Bool(enablehttp2),
@@ -1019,11 +1312,22 @@ func resourceArmApplicationGatewayCreateUpdate(d *schema.ResourceData, meta inte
 			Sku:                           sku,
 			SslCertificates:               sslCertificates,
 			SslPolicy:                     sslPolicy,
+			TrustedRootCertificates:       trustedRootCertificates,
 			CustomErrorConfigurations:     customErrorConfigurations,
 			URLPathMaps:                   urlPathMaps,
 		},
 	}
 
+	// these properties aren't available in the version of the Network SDK we're using
+	extended := azure.ApplicationGatewayExtendedProperties{
+		Identity:                            expandApplicationGatewayIdentity(d.Get("identity").([]interface{})),
+		AutoscaleMaxCapacity:                autoscaleMaxCapacity,
+		RewriteRuleSets:                     expandApplicationGatewayRewriteRuleSets(d.Get("rewrite_rule_set").([]interface{})),
+		SslCertificateKeyVaultSecretIDs:     sslCertificateKeyVaultSecretIDs,
+		RequestRoutingRuleRewriteRuleSetIDs: expandApplicationGatewayRequestRoutingRuleRewriteRuleSetIDs(d, gatewayID),
+		PathRuleRewriteRuleSetIDs:           expandApplicationGatewayPathRuleRewriteRuleSetIDs(d, gatewayID),
+	}
+
 	for _, backendHttpSettings := range *backendHTTPSettingsCollection {
 		backendHttpSettingsProperties := *backendHttpSettings.ApplicationGatewayBackendHTTPSettingsPropertiesFormat
 		if backendHttpSettingsProperties.HostName != nil {
@@ -1054,16 +1358,16 @@ func resourceArmApplicationGatewayCreateUpdate(d *schema.ResourceData, meta inte
 		gateway.ApplicationGatewayPropertiesFormat.WebApplicationFirewallConfiguration = expandApplicationGatewayWafConfig(d)
 	}
 
-	future, err := client.CreateOrUpdate(ctx, resGroup, name, gateway)
+	future, err := extendedClient.CreateOrUpdate(ctx, resGroup, name, gateway, extended)
 	if err != nil {
 		return fmt.Errorf("Error Creating/Updating Application Gateway %q (Resource Group %q): %+v", name, resGroup, err)
 	}
 
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+	if err = future.WaitForCompletionRef(ctx, extendedClient.Client); err != nil {
 		return fmt.Errorf("Error waiting for the create/update of Application Gateway %q (Resource Group %q): %+v", name, resGroup, err)
 	}
 
-	read, err := client.Get(ctx, resGroup, name)
+	read, _, err := extendedClient.Get(ctx, resGroup, name)
 	if err != nil {
 		return fmt.Errorf("Error retrieving Application Gateway %q (Resource Group %q): %+v", name, resGroup, err)
 	}
@@ -1077,8 +1381,9 @@ func resourceArmApplicationGatewayCreateUpdate(d *schema.ResourceData, meta inte
 }
 
 func resourceArmApplicationGatewayRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).applicationGatewayClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).applicationGatewayExtendedClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -1087,7 +1392,7 @@ func resourceArmApplicationGatewayRead(d *schema.ResourceData, meta interface{})
 	resGroup := id.ResourceGroup
 	name := id.Path["applicationGateways"]
 
-	applicationGateway, err := client.Get(ctx, resGroup, name)
+	applicationGateway, extended, err := client.Get(ctx, resGroup, name)
 	if err != nil {
 		if utils.ResponseWasNotFound(applicationGateway.Response) {
 			log.Printf("[DEBUG] Application Gateway %q was not found in Resource Group %q - removing from state", name, resGroup)
@@ -1104,7 +1409,23 @@ func resourceArmApplicationGatewayRead(d *schema.ResourceData, meta interface{})
 		d.Set("location", azureRMNormalizeLocation(*location))
 	}
 
+	if setErr := d.Set("zones", utils.FlattenStringArray(applicationGateway.Zones)); setErr != nil {
+		return fmt.Errorf("Error setting `zones`: %+v", setErr)
+	}
+
+	if setErr := d.Set("identity", flattenApplicationGatewayIdentity(extended.Identity)); setErr != nil {
+		return fmt.Errorf("Error setting `identity`: %+v", setErr)
+	}
+
+	if setErr := d.Set("rewrite_rule_set", flattenApplicationGatewayRewriteRuleSets(extended.RewriteRuleSets)); setErr != nil {
+		return fmt.Errorf("Error setting `rewrite_rule_set`: %+v", setErr)
+	}
+
 	if props := applicationGateway.ApplicationGatewayPropertiesFormat; props != nil {
+		if setErr := d.Set("autoscale_configuration", flattenApplicationGatewayAutoscaleConfiguration(props.AutoscaleConfiguration, extended.AutoscaleMaxCapacity)); setErr != nil {
+			return fmt.Errorf("Error setting `autoscale_configuration`: %+v", setErr)
+		}
+
 		flattenedCerts := flattenApplicationGatewayAuthenticationCertificates(props.AuthenticationCertificates, d)
 		if setErr := d.Set("authentication_certificate", flattenedCerts); setErr != nil {
 			return fmt.Errorf("Error setting `authentication_certificate`: %+v", setErr)
@@ -1152,7 +1473,7 @@ func resourceArmApplicationGatewayRead(d *schema.ResourceData, meta interface{})
 			return fmt.Errorf("Error setting `probe`: %+v", setErr)
 		}
 
-		requestRoutingRules, err := flattenApplicationGatewayRequestRoutingRules(props.RequestRoutingRules)
+		requestRoutingRules, err := flattenApplicationGatewayRequestRoutingRules(props.RequestRoutingRules, extended.RequestRoutingRuleRewriteRuleSetIDs)
 		if err != nil {
 			return fmt.Errorf("Error flattening `request_routing_rule`: %+v", err)
 		}
@@ -1168,19 +1489,23 @@ func resourceArmApplicationGatewayRead(d *schema.ResourceData, meta interface{})
 			return fmt.Errorf("Error setting `redirect configuration`: %+v", setErr)
 		}
 
-		if setErr := d.Set("sku", flattenApplicationGatewaySku(props.Sku)); setErr != nil {
+		if setErr := d.Set("sku", flattenApplicationGatewaySku(props.Sku, props.AutoscaleConfiguration)); setErr != nil {
 			return fmt.Errorf("Error setting `sku`: %+v", setErr)
 		}
 
-		if setErr := d.Set("ssl_certificate", flattenApplicationGatewaySslCertificates(props.SslCertificates, d)); setErr != nil {
+		if setErr := d.Set("ssl_certificate", flattenApplicationGatewaySslCertificates(props.SslCertificates, extended.SslCertificateKeyVaultSecretIDs, d)); setErr != nil {
 			return fmt.Errorf("Error setting `ssl_certificate`: %+v", setErr)
 		}
 
+		if setErr := d.Set("trusted_root_certificate", flattenApplicationGatewayTrustedRootCertificates(props.TrustedRootCertificates, d)); setErr != nil {
+			return fmt.Errorf("Error setting `trusted_root_certificate`: %+v", setErr)
+		}
+
 		if setErr := d.Set("custom_error_configuration", flattenApplicationGatewayCustomErrorConfigurations(props.CustomErrorConfigurations)); setErr != nil {
 			return fmt.Errorf("Error setting `custom_error_configuration`: %+v", setErr)
 		}
 
-		urlPathMaps, err := flattenApplicationGatewayURLPathMaps(props.URLPathMaps)
+		urlPathMaps, err := flattenApplicationGatewayURLPathMaps(props.URLPathMaps, extended.PathRuleRewriteRuleSetIDs)
 		if err != nil {
 			return fmt.Errorf("Error flattening `url_path_map`: %+v", err)
 		}
@@ -1200,7 +1525,8 @@ func resourceArmApplicationGatewayRead(d *schema.ResourceData, meta interface{})
 
 func resourceArmApplicationGatewayDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).applicationGatewayClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -1281,6 +1607,80 @@ func flattenApplicationGatewayAuthenticationCertificates(input *[]network.Applic
 	return results
 }
 
+func expandApplicationGatewayAutoscaleConfiguration(input []interface{}) (*network.ApplicationGatewayAutoscaleConfiguration, *int32) {
+	if len(input) == 0 || input[0] == nil {
+		return nil, nil
+	}
+
+	v := input[0].(map[string]interface{})
+
+	configuration := network.ApplicationGatewayAutoscaleConfiguration{
+		MinCapacity: utils.Int32(int32(v["min_capacity"].(int))),
+	}
+
+	var maxCapacity *int32
+	if capacity := v["max_capacity"].(int); capacity > 0 {
+		maxCapacity = utils.Int32(int32(capacity))
+	}
+
+	return &configuration, maxCapacity
+}
+
+func flattenApplicationGatewayAutoscaleConfiguration(input *network.ApplicationGatewayAutoscaleConfiguration, maxCapacity *int32) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	output := make(map[string]interface{})
+
+	if input.MinCapacity != nil {
+		output["min_capacity"] = int(*input.MinCapacity)
+	}
+
+	if maxCapacity != nil {
+		output["max_capacity"] = int(*maxCapacity)
+	}
+
+	return []interface{}{output}
+}
+
+func expandApplicationGatewayIdentity(input []interface{}) *azure.ApplicationGatewayIdentity {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+
+	identities := make(map[string]*azure.ApplicationGatewayUserAssignedIdentity)
+	for _, id := range v["identity_ids"].([]interface{}) {
+		identities[id.(string)] = &azure.ApplicationGatewayUserAssignedIdentity{}
+	}
+
+	return &azure.ApplicationGatewayIdentity{
+		Type:                   v["type"].(string),
+		UserAssignedIdentities: identities,
+	}
+}
+
+func flattenApplicationGatewayIdentity(input *azure.ApplicationGatewayIdentity) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	identityIds := make([]string, 0)
+	for id := range input.UserAssignedIdentities {
+		identityIds = append(identityIds, id)
+	}
+	sort.Strings(identityIds)
+
+	return []interface{}{
+		map[string]interface{}{
+			"type":         input.Type,
+			"identity_ids": identityIds,
+		},
+	}
+}
+
 func expandApplicationGatewayBackendAddressPools(d *schema.ResourceData) *[]network.ApplicationGatewayBackendAddressPool {
 	vs := d.Get("backend_address_pool").([]interface{})
 	results := make([]network.ApplicationGatewayBackendAddressPool, 0)
@@ -1422,7 +1822,19 @@ func expandApplicationGatewayBackendHTTPSettings(d *schema.ResourceData, gateway
 				authCertSubResources = append(authCertSubResources, authCertSubResource)
 			}
 
-			setting.ApplicationGatewayBackendHTTPSettingsPropertiesFormat.AuthenticationCertificates = &authCertSubResources
+			setting.ApplicationGatewayBackendHTTPSettingsPropertiesFormat.AuthenticationCertificates = &authCertSubResources
+		}
+
+		if trustedRootCertificateNames := v["trusted_root_certificate_names"].([]interface{}); len(trustedRootCertificateNames) > 0 {
+			trustedRootCertificates := make([]network.SubResource, 0)
+			for _, trustedRootCertificateName := range trustedRootCertificateNames {
+				trustedRootCertificateID := fmt.Sprintf("%s/trustedRootCertificates/%s", gatewayID, trustedRootCertificateName.(string))
+				trustedRootCertificates = append(trustedRootCertificates, network.SubResource{
+					ID: utils.String(trustedRootCertificateID),
+				})
+			}
+
+			setting.ApplicationGatewayBackendHTTPSettingsPropertiesFormat.TrustedRootCertificates = &trustedRootCertificates
 		}
 
 		probeName := v["probe_name"].(string)
@@ -1504,6 +1916,23 @@ func flattenApplicationGatewayBackendHTTPSettings(input *[]network.ApplicationGa
 			}
 			output["authentication_certificate"] = authenticationCertificates
 
+			trustedRootCertificateNames := make([]interface{}, 0)
+			if certs := props.TrustedRootCertificates; certs != nil {
+				for _, cert := range *certs {
+					if cert.ID == nil {
+						continue
+					}
+
+					certId, err := parseAzureResourceID(*cert.ID)
+					if err != nil {
+						return nil, err
+					}
+
+					trustedRootCertificateNames = append(trustedRootCertificateNames, certId.Path["trustedRootCertificates"])
+				}
+			}
+			output["trusted_root_certificate_names"] = trustedRootCertificateNames
+
 			if probe := props.Probe; probe != nil {
 				if probe.ID != nil {
 					id, err := parseAzureResourceID(*probe.ID)
@@ -2071,7 +2500,7 @@ func expandApplicationGatewayRequestRoutingRules(d *schema.ResourceData, gateway
 	return &results
 }
 
-func flattenApplicationGatewayRequestRoutingRules(input *[]network.ApplicationGatewayRequestRoutingRule) ([]interface{}, error) {
+func flattenApplicationGatewayRequestRoutingRules(input *[]network.ApplicationGatewayRequestRoutingRule, rewriteRuleSetIDs map[string]string) ([]interface{}, error) {
 	results := make([]interface{}, 0)
 	if input == nil {
 		return results, nil
@@ -2098,6 +2527,15 @@ func flattenApplicationGatewayRequestRoutingRules(input *[]network.ApplicationGa
 
 			if config.Name != nil {
 				output["name"] = *config.Name
+
+				if rewriteRuleSetID, ok := rewriteRuleSetIDs[*config.Name]; ok {
+					rewriteRuleSetId, err := parseAzureResourceID(rewriteRuleSetID)
+					if err != nil {
+						return nil, err
+					}
+					output["rewrite_rule_set_name"] = rewriteRuleSetId.Path["rewriteRuleSets"]
+					output["rewrite_rule_set_id"] = rewriteRuleSetID
+				}
 			}
 
 			if pool := props.BackendAddressPool; pool != nil {
@@ -2167,6 +2605,21 @@ func flattenApplicationGatewayRequestRoutingRules(input *[]network.ApplicationGa
 	return results, nil
 }
 
+// expandApplicationGatewayRequestRoutingRuleRewriteRuleSetIDs returns the ID of the Rewrite Rule Set used by each Request Routing Rule, keyed by name
+func expandApplicationGatewayRequestRoutingRuleRewriteRuleSetIDs(d *schema.ResourceData, gatewayID string) map[string]string {
+	results := make(map[string]string)
+
+	for _, raw := range d.Get("request_routing_rule").([]interface{}) {
+		v := raw.(map[string]interface{})
+
+		if rewriteRuleSetName := v["rewrite_rule_set_name"].(string); rewriteRuleSetName != "" {
+			results[v["name"].(string)] = fmt.Sprintf("%s/rewriteRuleSets/%s", gatewayID, rewriteRuleSetName)
+		}
+	}
+
+	return results
+}
+
 func applicationGatewayHasSubResource(subResource *network.SubResource) bool {
 	return subResource != nil && subResource.ID != nil && *subResource.ID != ""
 }
@@ -2277,6 +2730,159 @@ func flattenApplicationGatewayRedirectConfigurations(input *[]network.Applicatio
 	return results, nil
 }
 
+func expandApplicationGatewayRewriteRuleSets(input []interface{}) *[]azure.ApplicationGatewayRewriteRuleSet {
+	results := make([]azure.ApplicationGatewayRewriteRuleSet, 0)
+
+	for _, raw := range input {
+		v := raw.(map[string]interface{})
+
+		rules := make([]azure.ApplicationGatewayRewriteRule, 0)
+		for _, rawRule := range v["rewrite_rule"].([]interface{}) {
+			rule := rawRule.(map[string]interface{})
+
+			conditions := make([]azure.ApplicationGatewayRewriteRuleCondition, 0)
+			for _, rawCondition := range rule["condition"].([]interface{}) {
+				condition := rawCondition.(map[string]interface{})
+				conditions = append(conditions, azure.ApplicationGatewayRewriteRuleCondition{
+					Variable:   utils.String(condition["variable"].(string)),
+					Pattern:    utils.String(condition["pattern"].(string)),
+					IgnoreCase: utils.Bool(condition["ignore_case"].(bool)),
+					Negate:     utils.Bool(condition["negate"].(bool)),
+				})
+			}
+
+			rules = append(rules, azure.ApplicationGatewayRewriteRule{
+				Name:         utils.String(rule["name"].(string)),
+				RuleSequence: utils.Int32(int32(rule["rule_sequence"].(int))),
+				Conditions:   &conditions,
+				ActionSet: &azure.ApplicationGatewayRewriteRuleActionSet{
+					RequestHeaderConfigurations:  expandApplicationGatewayRewriteRuleHeaderConfigurations(rule["request_header_configuration"].([]interface{})),
+					ResponseHeaderConfigurations: expandApplicationGatewayRewriteRuleHeaderConfigurations(rule["response_header_configuration"].([]interface{})),
+				},
+			})
+		}
+
+		results = append(results, azure.ApplicationGatewayRewriteRuleSet{
+			Name: utils.String(v["name"].(string)),
+			Properties: &azure.ApplicationGatewayRewriteRuleSetProperties{
+				RewriteRules: &rules,
+			},
+		})
+	}
+
+	return &results
+}
+
+func expandApplicationGatewayRewriteRuleHeaderConfigurations(input []interface{}) *[]azure.ApplicationGatewayHeaderConfiguration {
+	results := make([]azure.ApplicationGatewayHeaderConfiguration, 0)
+
+	for _, raw := range input {
+		v := raw.(map[string]interface{})
+
+		results = append(results, azure.ApplicationGatewayHeaderConfiguration{
+			HeaderName:  utils.String(v["header_name"].(string)),
+			HeaderValue: utils.String(v["header_value"].(string)),
+		})
+	}
+
+	return &results
+}
+
+func flattenApplicationGatewayRewriteRuleSets(input *[]azure.ApplicationGatewayRewriteRuleSet) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, v := range *input {
+		output := map[string]interface{}{}
+
+		if v.ID != nil {
+			output["id"] = *v.ID
+		}
+
+		if v.Name != nil {
+			output["name"] = *v.Name
+		}
+
+		rules := make([]interface{}, 0)
+		if props := v.Properties; props != nil && props.RewriteRules != nil {
+			for _, rule := range *props.RewriteRules {
+				ruleOutput := map[string]interface{}{}
+
+				if rule.Name != nil {
+					ruleOutput["name"] = *rule.Name
+				}
+
+				if rule.RuleSequence != nil {
+					ruleOutput["rule_sequence"] = int(*rule.RuleSequence)
+				}
+
+				conditions := make([]interface{}, 0)
+				if rule.Conditions != nil {
+					for _, condition := range *rule.Conditions {
+						conditionOutput := map[string]interface{}{}
+
+						if condition.Variable != nil {
+							conditionOutput["variable"] = *condition.Variable
+						}
+
+						if condition.Pattern != nil {
+							conditionOutput["pattern"] = *condition.Pattern
+						}
+
+						if condition.IgnoreCase != nil {
+							conditionOutput["ignore_case"] = *condition.IgnoreCase
+						}
+
+						if condition.Negate != nil {
+							conditionOutput["negate"] = *condition.Negate
+						}
+
+						conditions = append(conditions, conditionOutput)
+					}
+				}
+				ruleOutput["condition"] = conditions
+
+				if actionSet := rule.ActionSet; actionSet != nil {
+					ruleOutput["request_header_configuration"] = flattenApplicationGatewayRewriteRuleHeaderConfigurations(actionSet.RequestHeaderConfigurations)
+					ruleOutput["response_header_configuration"] = flattenApplicationGatewayRewriteRuleHeaderConfigurations(actionSet.ResponseHeaderConfigurations)
+				}
+
+				rules = append(rules, ruleOutput)
+			}
+		}
+		output["rewrite_rule"] = rules
+
+		results = append(results, output)
+	}
+
+	return results
+}
+
+func flattenApplicationGatewayRewriteRuleHeaderConfigurations(input *[]azure.ApplicationGatewayHeaderConfiguration) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, v := range *input {
+		output := map[string]interface{}{}
+
+		if v.HeaderName != nil {
+			output["header_name"] = *v.HeaderName
+		}
+
+		if v.HeaderValue != nil {
+			output["header_value"] = *v.HeaderValue
+		}
+
+		results = append(results, output)
+	}
+
+	return results
+}
+
 func expandApplicationGatewaySku(d *schema.ResourceData) *network.ApplicationGatewaySku {
 	vs := d.Get("sku").([]interface{})
 	v := vs[0].(map[string]interface{})
@@ -2285,28 +2891,35 @@ func expandApplicationGatewaySku(d *schema.ResourceData) *network.ApplicationGat
 	tier := v["tier"].(string)
 	capacity := int32(v["capacity"].(int))
 
-	return &network.ApplicationGatewaySku{
-		Name:     network.ApplicationGatewaySkuName(name),
-		Tier:     network.ApplicationGatewayTier(tier),
-		Capacity: utils.Int32(capacity),
+	sku := network.ApplicationGatewaySku{
+		Name: network.ApplicationGatewaySkuName(name),
+		Tier: network.ApplicationGatewayTier(tier),
+	}
+
+	// the capacity is omitted when the instance count is managed by autoscaling
+	if capacity > 0 {
+		sku.Capacity = utils.Int32(capacity)
 	}
+
+	return &sku
 }
 
-func flattenApplicationGatewaySku(input *network.ApplicationGatewaySku) []interface{} {
+func flattenApplicationGatewaySku(input *network.ApplicationGatewaySku, autoscaleConfiguration *network.ApplicationGatewayAutoscaleConfiguration) []interface{} {
 	result := make(map[string]interface{})
 
 	result["name"] = string(input.Name)
 	result["tier"] = string(input.Tier)
-	if input.Capacity != nil {
+	if input.Capacity != nil && autoscaleConfiguration == nil {
 		result["capacity"] = int(*input.Capacity)
 	}
 
 	return []interface{}{result}
 }
 
-func expandApplicationGatewaySslCertificates(d *schema.ResourceData) *[]network.ApplicationGatewaySslCertificate {
+func expandApplicationGatewaySslCertificates(d *schema.ResourceData) (*[]network.ApplicationGatewaySslCertificate, map[string]string) {
 	vs := d.Get("ssl_certificate").([]interface{})
 	results := make([]network.ApplicationGatewaySslCertificate, 0)
+	keyVaultSecretIDs := make(map[string]string)
 
 	for _, raw := range vs {
 		v := raw.(map[string]interface{})
@@ -2314,25 +2927,29 @@ func expandApplicationGatewaySslCertificates(d *schema.ResourceData) *[]network.
 		name := v["name"].(string)
 		data := v["data"].(string)
 		password := v["password"].(string)
-
-		// data must be base64 encoded
-		data = base64Encode(data)
+		keyVaultSecretID := v["key_vault_secret_id"].(string)
 
 		output := network.ApplicationGatewaySslCertificate{
 			Name: utils.String(name),
-			ApplicationGatewaySslCertificatePropertiesFormat: &network.ApplicationGatewaySslCertificatePropertiesFormat{
-				Data:     utils.String(data),
-				Password: utils.String(password),
-			},
+			ApplicationGatewaySslCertificatePropertiesFormat: &network.ApplicationGatewaySslCertificatePropertiesFormat{},
+		}
+
+		// the certificate is either retrieved from Key Vault using the Managed Identity, or uploaded directly
+		if keyVaultSecretID != "" {
+			keyVaultSecretIDs[name] = keyVaultSecretID
+		} else {
+			// data must be base64 encoded
+			output.ApplicationGatewaySslCertificatePropertiesFormat.Data = utils.String(base64Encode(data))
+			output.ApplicationGatewaySslCertificatePropertiesFormat.Password = utils.String(password)
 		}
 
 		results = append(results, output)
 	}
 
-	return &results
+	return &results, keyVaultSecretIDs
 }
 
-func flattenApplicationGatewaySslCertificates(input *[]network.ApplicationGatewaySslCertificate, d *schema.ResourceData) []interface{} {
+func flattenApplicationGatewaySslCertificates(input *[]network.ApplicationGatewaySslCertificate, keyVaultSecretIDs map[string]string, d *schema.ResourceData) []interface{} {
 	results := make([]interface{}, 0)
 	if input == nil {
 		return results
@@ -2351,6 +2968,7 @@ func flattenApplicationGatewaySslCertificates(input *[]network.ApplicationGatewa
 		}
 
 		output["name"] = name
+		output["key_vault_secret_id"] = keyVaultSecretIDs[name]
 
 		if props := v.ApplicationGatewaySslCertificatePropertiesFormat; props != nil {
 			if data := props.PublicCertData; data != nil {
@@ -2384,6 +3002,70 @@ func flattenApplicationGatewaySslCertificates(input *[]network.ApplicationGatewa
 	return results
 }
 
+func expandApplicationGatewayTrustedRootCertificates(d *schema.ResourceData) *[]network.ApplicationGatewayTrustedRootCertificate {
+	vs := d.Get("trusted_root_certificate").([]interface{})
+	results := make([]network.ApplicationGatewayTrustedRootCertificate, 0)
+
+	for _, raw := range vs {
+		v := raw.(map[string]interface{})
+
+		name := v["name"].(string)
+		data := v["data"].(string)
+
+		output := network.ApplicationGatewayTrustedRootCertificate{
+			Name: utils.String(name),
+			ApplicationGatewayTrustedRootCertificatePropertiesFormat: &network.ApplicationGatewayTrustedRootCertificatePropertiesFormat{
+				// data must be base64 encoded
+				Data: utils.String(base64Encode(data)),
+			},
+		}
+
+		results = append(results, output)
+	}
+
+	return &results
+}
+
+func flattenApplicationGatewayTrustedRootCertificates(input *[]network.ApplicationGatewayTrustedRootCertificate, d *schema.ResourceData) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, v := range *input {
+		if v.Name == nil {
+			continue
+		}
+
+		name := *v.Name
+		output := map[string]interface{}{
+			"name": name,
+		}
+
+		if v.ID != nil {
+			output["id"] = *v.ID
+		}
+
+		if props := v.ApplicationGatewayTrustedRootCertificatePropertiesFormat; props != nil && props.Data != nil {
+			output["data"] = *props.Data
+		}
+
+		// the certificate data is returned in a different encoding, so we load it from the certificate with the same name
+		if existing, ok := d.GetOk("trusted_root_certificate"); ok && existing != nil {
+			for _, existingVal := range existing.([]interface{}) {
+				existingCert := existingVal.(map[string]interface{})
+				if existingCert["name"].(string) == name {
+					output["data"] = base64Encode(existingCert["data"].(string))
+				}
+			}
+		}
+
+		results = append(results, output)
+	}
+
+	return results
+}
+
 func expandApplicationGatewayURLPathMaps(d *schema.ResourceData, gatewayID string) *[]network.ApplicationGatewayURLPathMap {
 	vs := d.Get("url_path_map").([]interface{})
 	results := make([]network.ApplicationGatewayURLPathMap, 0)
@@ -2473,7 +3155,7 @@ func expandApplicationGatewayURLPathMaps(d *schema.ResourceData, gatewayID strin
 	return &results
 }
 
-func flattenApplicationGatewayURLPathMaps(input *[]network.ApplicationGatewayURLPathMap) ([]interface{}, error) {
+func flattenApplicationGatewayURLPathMaps(input *[]network.ApplicationGatewayURLPathMap, pathRuleRewriteRuleSetIDs map[string]map[string]string) ([]interface{}, error) {
 	results := make([]interface{}, 0)
 	if input == nil {
 		return results, nil
@@ -2521,6 +3203,11 @@ func flattenApplicationGatewayURLPathMaps(input *[]network.ApplicationGatewayURL
 				output["default_redirect_configuration_id"] = *redirect.ID
 			}
 
+			rewriteRuleSetIDs := make(map[string]string)
+			if v.Name != nil {
+				rewriteRuleSetIDs = pathRuleRewriteRuleSetIDs[*v.Name]
+			}
+
 			pathRules := make([]interface{}, 0)
 			if rules := props.PathRules; rules != nil {
 				for _, rule := range *rules {
@@ -2532,6 +3219,15 @@ func flattenApplicationGatewayURLPathMaps(input *[]network.ApplicationGatewayURL
 
 					if rule.Name != nil {
 						ruleOutput["name"] = *rule.Name
+
+						if rewriteRuleSetID, ok := rewriteRuleSetIDs[*rule.Name]; ok {
+							rewriteRuleSetId, err := parseAzureResourceID(rewriteRuleSetID)
+							if err != nil {
+								return nil, err
+							}
+							ruleOutput["rewrite_rule_set_name"] = rewriteRuleSetId.Path["rewriteRuleSets"]
+							ruleOutput["rewrite_rule_set_id"] = rewriteRuleSetID
+						}
 					}
 
 					if ruleProps := rule.ApplicationGatewayPathRulePropertiesFormat; ruleProps != nil {
@@ -2602,6 +3298,29 @@ func flattenApplicationGatewayURLPathMaps(input *[]network.ApplicationGatewayURL
 	return results, nil
 }
 
+// expandApplicationGatewayPathRuleRewriteRuleSetIDs returns the ID of the Rewrite Rule Set used by each Path Rule,
+// keyed by the name of the URL Path Map and then by the name of the Path Rule
+func expandApplicationGatewayPathRuleRewriteRuleSetIDs(d *schema.ResourceData, gatewayID string) map[string]map[string]string {
+	results := make(map[string]map[string]string)
+
+	for _, raw := range d.Get("url_path_map").([]interface{}) {
+		v := raw.(map[string]interface{})
+
+		ids := make(map[string]string)
+		for _, rawRule := range v["path_rule"].([]interface{}) {
+			rule := rawRule.(map[string]interface{})
+
+			if rewriteRuleSetName := rule["rewrite_rule_set_name"].(string); rewriteRuleSetName != "" {
+				ids[rule["name"].(string)] = fmt.Sprintf("%s/rewriteRuleSets/%s", gatewayID, rewriteRuleSetName)
+			}
+		}
+
+		results[v["name"].(string)] = ids
+	}
+
+	return results
+}
+
 func expandApplicationGatewayWafConfig(d *schema.ResourceData) *network.ApplicationGatewayWebApplicationFirewallConfiguration {
 	vs := d.Get("waf_configuration").([]interface{})
 	v := vs[0].(map[string]interface{})
@@ -2622,7 +3341,113 @@ func expandApplicationGatewayWafConfig(d *schema.ResourceData) *network.Applicat
 		FileUploadLimitInMb:    utils.Int32(int32(fileUploadLimitInMb)),
 		RequestBodyCheck:       utils.Bool(requestBodyCheck),
 		MaxRequestBodySizeInKb: utils.Int32(int32(maxRequestBodySizeInKb)),
+		DisabledRuleGroups:     expandApplicationGatewayFirewallDisabledRuleGroups(v["disabled_rule_group"].([]interface{})),
+		Exclusions:             expandApplicationGatewayFirewallExclusions(v["exclusion"].([]interface{})),
+	}
+}
+
+func expandApplicationGatewayFirewallDisabledRuleGroups(input []interface{}) *[]network.ApplicationGatewayFirewallDisabledRuleGroup {
+	results := make([]network.ApplicationGatewayFirewallDisabledRuleGroup, 0)
+
+	for _, raw := range input {
+		v := raw.(map[string]interface{})
+
+		ruleGroup := network.ApplicationGatewayFirewallDisabledRuleGroup{
+			RuleGroupName: utils.String(v["rule_group_name"].(string)),
+		}
+
+		// when no rules are specified the entire rule group is disabled
+		if rawRules := v["rules"].([]interface{}); len(rawRules) > 0 {
+			rules := make([]int32, 0)
+			for _, rule := range rawRules {
+				rules = append(rules, int32(rule.(int)))
+			}
+			ruleGroup.Rules = &rules
+		}
+
+		results = append(results, ruleGroup)
+	}
+
+	return &results
+}
+
+func flattenApplicationGatewayFirewallDisabledRuleGroups(input *[]network.ApplicationGatewayFirewallDisabledRuleGroup) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, v := range *input {
+		output := map[string]interface{}{}
+
+		if v.RuleGroupName != nil {
+			output["rule_group_name"] = *v.RuleGroupName
+		}
+
+		rules := make([]interface{}, 0)
+		if v.Rules != nil {
+			for _, rule := range *v.Rules {
+				rules = append(rules, int(rule))
+			}
+		}
+		output["rules"] = rules
+
+		results = append(results, output)
+	}
+
+	return results
+}
+
+func expandApplicationGatewayFirewallExclusions(input []interface{}) *[]network.ApplicationGatewayFirewallExclusion {
+	results := make([]network.ApplicationGatewayFirewallExclusion, 0)
+
+	for _, raw := range input {
+		v := raw.(map[string]interface{})
+
+		exclusion := network.ApplicationGatewayFirewallExclusion{
+			MatchVariable: utils.String(v["match_variable"].(string)),
+		}
+
+		// when no selector is specified the exclusion applies to every element of the `match_variable`
+		if selectorMatchOperator := v["selector_match_operator"].(string); selectorMatchOperator != "" {
+			exclusion.SelectorMatchOperator = utils.String(selectorMatchOperator)
+		}
+
+		if selector := v["selector"].(string); selector != "" {
+			exclusion.Selector = utils.String(selector)
+		}
+
+		results = append(results, exclusion)
+	}
+
+	return &results
+}
+
+func flattenApplicationGatewayFirewallExclusions(input *[]network.ApplicationGatewayFirewallExclusion) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, v := range *input {
+		output := map[string]interface{}{}
+
+		if v.MatchVariable != nil {
+			output["match_variable"] = *v.MatchVariable
+		}
+
+		if v.SelectorMatchOperator != nil {
+			output["selector_match_operator"] = *v.SelectorMatchOperator
+		}
+
+		if v.Selector != nil {
+			output["selector"] = *v.Selector
+		}
+
+		results = append(results, output)
 	}
+
+	return results
 }
 
 func flattenApplicationGatewayWafConfig(input *network.ApplicationGatewayWebApplicationFirewallConfiguration) []interface{} {
@@ -2659,6 +3484,9 @@ func flattenApplicationGatewayWafConfig(input *network.ApplicationGatewayWebAppl
 		output["max_request_body_size_kb"] = int(*input.MaxRequestBodySizeInKb)
 	}
 
+	output["disabled_rule_group"] = flattenApplicationGatewayFirewallDisabledRuleGroups(input.DisabledRuleGroups)
+	output["exclusion"] = flattenApplicationGatewayFirewallExclusions(input.Exclusions)
+
 	results = append(results, output)
 
 	return results
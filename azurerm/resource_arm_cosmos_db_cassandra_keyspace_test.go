@@ -0,0 +1,100 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/cosmos-db/mgmt/2015-04-08/documentdb"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+)
+
+func TestAccAzureRMCosmosDBCassandraKeyspace_basic(t *testing.T) {
+	resourceName := "azurerm_cosmosdb_cassandra_keyspace.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMCosmosDBResourceDestroy("azurerm_cosmosdb_cassandra_keyspace"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMCosmosDBCassandraKeyspace_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMCosmosDBResourceExists(resourceName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMCosmosDBCassandraKeyspace_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_cosmosdb_cassandra_keyspace.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMCosmosDBResourceDestroy("azurerm_cosmosdb_cassandra_keyspace"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMCosmosDBCassandraKeyspace_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMCosmosDBResourceExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMCosmosDBCassandraKeyspace_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_cosmosdb_cassandra_keyspace"),
+			},
+		},
+	})
+}
+
+func testAccAzureRMCosmosDBCassandraKeyspace_account(rInt int, location string) string {
+	return testAccAzureRMCosmosDBAccount_basic(rInt, location, string(documentdb.Session), "", `
+  kind = "GlobalDocumentDB"
+
+  capabilities {
+    name = "EnableCassandra"
+  }
+`)
+}
+
+func testAccAzureRMCosmosDBCassandraKeyspace_basic(rInt int, location string) string {
+	template := testAccAzureRMCosmosDBCassandraKeyspace_account(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_cosmosdb_cassandra_keyspace" "test" {
+  name                = "acctest-%[2]d"
+  resource_group_name = "${azurerm_cosmosdb_account.test.resource_group_name}"
+  account_name        = "${azurerm_cosmosdb_account.test.name}"
+}
+`, template, rInt)
+}
+
+func testAccAzureRMCosmosDBCassandraKeyspace_requiresImport(rInt int, location string) string {
+	template := testAccAzureRMCosmosDBCassandraKeyspace_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_cosmosdb_cassandra_keyspace" "import" {
+  name                = "${azurerm_cosmosdb_cassandra_keyspace.test.name}"
+  resource_group_name = "${azurerm_cosmosdb_cassandra_keyspace.test.resource_group_name}"
+  account_name        = "${azurerm_cosmosdb_cassandra_keyspace.test.account_name}"
+}
+`, template)
+}
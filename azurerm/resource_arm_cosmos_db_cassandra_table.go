@@ -0,0 +1,304 @@
+package azurerm
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmCosmosDBCassandraTable() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmCosmosDBCassandraTableCreateUpdate,
+		Read:   resourceArmCosmosDBCassandraTableRead,
+		Update: resourceArmCosmosDBCassandraTableCreateUpdate,
+		Delete: resourceArmCosmosDBCassandraTableDelete,
+
+		Importer: azure.ValidateResourceIDPriorToImport(azure.ValidateCosmosDBCassandraTableID),
+
+		CustomizeDiff: azure.CosmosDBThroughputCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": cosmosDBEntityNameSchema(),
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"account_name": cosmosDBAccountNameSchema(),
+
+			"keyspace_name": cosmosDBEntityNameSchema(),
+
+			"schema": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"column": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.NoZeroValues,
+									},
+
+									"type": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.NoZeroValues,
+									},
+								},
+							},
+						},
+
+						"partition_key": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.NoZeroValues,
+									},
+								},
+							},
+						},
+
+						"cluster_key": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.NoZeroValues,
+									},
+
+									"order_by": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+										Default:  "Asc",
+										ValidateFunc: validation.StringInSlice([]string{
+											"Asc",
+											"Desc",
+										}, false),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"default_ttl": cosmosDBDefaultTTLSchema(),
+
+			"throughput": cosmosDBThroughputSchema(),
+		},
+	}
+}
+
+func resourceArmCosmosDBCassandraTableCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	account := d.Get("account_name").(string)
+	keyspace := d.Get("keyspace_name").(string)
+
+	id := azure.NewCosmosDBCassandraTableID(meta.(*ArmClient).subscriptionId, resourceGroup, account, keyspace, name).ID()
+	description := fmt.Sprintf("Cosmos DB Cassandra Table %q (Keyspace %q / Account %q / Resource Group %q)", name, keyspace, account, resourceGroup)
+
+	if err := cosmosDBCheckForExisting(ctx, d, meta, "azurerm_cosmosdb_cassandra_table", id, description); err != nil {
+		return err
+	}
+
+	resource := azure.CosmosDBCassandraTableResource{
+		ID:         utils.String(name),
+		DefaultTTL: expandCosmosDBDefaultTTL(d),
+		Schema:     expandCosmosDBCassandraTableSchema(d.Get("schema").([]interface{})),
+	}
+
+	if err := cosmosDBCreateUpdate(ctx, d, meta, id, description, resource); err != nil {
+		return err
+	}
+
+	d.SetId(id)
+
+	return resourceArmCosmosDBCassandraTableRead(d, meta)
+}
+
+func resourceArmCosmosDBCassandraTableRead(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseCosmosDBCassandraTableID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	description := fmt.Sprintf("Cosmos DB Cassandra Table %q (Keyspace %q / Account %q / Resource Group %q)", id.Name, id.KeyspaceName, id.AccountName, id.ResourceGroup)
+
+	var resource azure.CosmosDBCassandraTableResource
+	exists, err := cosmosDBRead(ctx, d, meta, d.Id(), description, &resource)
+	if err != nil || !exists {
+		return err
+	}
+
+	d.Set("name", resource.ID)
+	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("account_name", id.AccountName)
+	d.Set("keyspace_name", id.KeyspaceName)
+	d.Set("default_ttl", flattenCosmosDBDefaultTTL(resource.DefaultTTL))
+
+	if err := d.Set("schema", flattenCosmosDBCassandraTableSchema(resource.Schema)); err != nil {
+		return fmt.Errorf("Error setting `schema`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceArmCosmosDBCassandraTableDelete(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseCosmosDBCassandraTableID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	description := fmt.Sprintf("Cosmos DB Cassandra Table %q (Keyspace %q / Account %q / Resource Group %q)", id.Name, id.KeyspaceName, id.AccountName, id.ResourceGroup)
+	return cosmosDBDelete(ctx, meta, d.Id(), description)
+}
+
+func expandCosmosDBCassandraTableSchema(input []interface{}) *azure.CosmosDBCassandraTableSchema {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+
+	columns := make([]azure.CosmosDBCassandraColumn, 0)
+	for _, c := range v["column"].([]interface{}) {
+		column := c.(map[string]interface{})
+		columns = append(columns, azure.CosmosDBCassandraColumn{
+			Name: utils.String(column["name"].(string)),
+			Type: utils.String(column["type"].(string)),
+		})
+	}
+
+	partitionKeys := make([]azure.CosmosDBCassandraColumn, 0)
+	for _, k := range v["partition_key"].([]interface{}) {
+		key := k.(map[string]interface{})
+		partitionKeys = append(partitionKeys, azure.CosmosDBCassandraColumn{
+			Name: utils.String(key["name"].(string)),
+		})
+	}
+
+	clusterKeys := make([]azure.CosmosDBCassandraClusterKey, 0)
+	for _, k := range v["cluster_key"].([]interface{}) {
+		key := k.(map[string]interface{})
+		clusterKeys = append(clusterKeys, azure.CosmosDBCassandraClusterKey{
+			Name:    utils.String(key["name"].(string)),
+			OrderBy: utils.String(key["order_by"].(string)),
+		})
+	}
+
+	return &azure.CosmosDBCassandraTableSchema{
+		Columns:       &columns,
+		PartitionKeys: &partitionKeys,
+		ClusterKeys:   &clusterKeys,
+	}
+}
+
+func flattenCosmosDBCassandraTableSchema(input *azure.CosmosDBCassandraTableSchema) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	columns := make([]interface{}, 0)
+	if input.Columns != nil {
+		for _, column := range *input.Columns {
+			name := ""
+			if column.Name != nil {
+				name = *column.Name
+			}
+
+			columnType := ""
+			if column.Type != nil {
+				columnType = *column.Type
+			}
+
+			columns = append(columns, map[string]interface{}{
+				"name": name,
+				"type": columnType,
+			})
+		}
+	}
+
+	partitionKeys := make([]interface{}, 0)
+	if input.PartitionKeys != nil {
+		for _, key := range *input.PartitionKeys {
+			name := ""
+			if key.Name != nil {
+				name = *key.Name
+			}
+
+			partitionKeys = append(partitionKeys, map[string]interface{}{
+				"name": name,
+			})
+		}
+	}
+
+	clusterKeys := make([]interface{}, 0)
+	if input.ClusterKeys != nil {
+		for _, key := range *input.ClusterKeys {
+			name := ""
+			if key.Name != nil {
+				name = *key.Name
+			}
+
+			orderBy := ""
+			if key.OrderBy != nil {
+				orderBy = *key.OrderBy
+			}
+
+			clusterKeys = append(clusterKeys, map[string]interface{}{
+				"name":     name,
+				"order_by": orderBy,
+			})
+		}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"column":        columns,
+			"partition_key": partitionKeys,
+			"cluster_key":   clusterKeys,
+		},
+	}
+}
@@ -0,0 +1,155 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/cosmos-db/mgmt/2015-04-08/documentdb"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+)
+
+func TestAccAzureRMCosmosDBCassandraTable_basic(t *testing.T) {
+	resourceName := "azurerm_cosmosdb_cassandra_table.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMCosmosDBResourceDestroy("azurerm_cosmosdb_cassandra_table"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMCosmosDBCassandraTable_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMCosmosDBResourceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "throughput", "400"),
+					resource.TestCheckResourceAttr(resourceName, "schema.0.column.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "schema.0.cluster_key.0.order_by", "Desc"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMCosmosDBCassandraTable_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_cosmosdb_cassandra_table.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMCosmosDBResourceDestroy("azurerm_cosmosdb_cassandra_table"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMCosmosDBCassandraTable_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMCosmosDBResourceExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMCosmosDBCassandraTable_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_cosmosdb_cassandra_table"),
+			},
+		},
+	})
+}
+
+func testAccAzureRMCosmosDBCassandraTable_account(rInt int, location string) string {
+	return testAccAzureRMCosmosDBAccount_basic(rInt, location, string(documentdb.Session), "", `
+  kind = "GlobalDocumentDB"
+
+  capabilities {
+    name = "EnableCassandra"
+  }
+`)
+}
+
+func testAccAzureRMCosmosDBCassandraTable_basic(rInt int, location string) string {
+	template := testAccAzureRMCosmosDBCassandraTable_account(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_cosmosdb_cassandra_keyspace" "test" {
+  name                = "acctest-%[2]d"
+  resource_group_name = "${azurerm_cosmosdb_account.test.resource_group_name}"
+  account_name        = "${azurerm_cosmosdb_account.test.name}"
+}
+
+resource "azurerm_cosmosdb_cassandra_table" "test" {
+  name                = "acctest-%[2]d"
+  resource_group_name = "${azurerm_cosmosdb_account.test.resource_group_name}"
+  account_name        = "${azurerm_cosmosdb_account.test.name}"
+  keyspace_name       = "${azurerm_cosmosdb_cassandra_keyspace.test.name}"
+  throughput          = 400
+
+  schema {
+    column {
+      name = "id"
+      type = "uuid"
+    }
+
+    column {
+      name = "created"
+      type = "timestamp"
+    }
+
+    partition_key {
+      name = "id"
+    }
+
+    cluster_key {
+      name     = "created"
+      order_by = "Desc"
+    }
+  }
+}
+`, template, rInt)
+}
+
+func testAccAzureRMCosmosDBCassandraTable_requiresImport(rInt int, location string) string {
+	template := testAccAzureRMCosmosDBCassandraTable_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_cosmosdb_cassandra_table" "import" {
+  name                = "${azurerm_cosmosdb_cassandra_table.test.name}"
+  resource_group_name = "${azurerm_cosmosdb_cassandra_table.test.resource_group_name}"
+  account_name        = "${azurerm_cosmosdb_cassandra_table.test.account_name}"
+  keyspace_name       = "${azurerm_cosmosdb_cassandra_table.test.keyspace_name}"
+  throughput          = "${azurerm_cosmosdb_cassandra_table.test.throughput}"
+
+  schema {
+    column {
+      name = "id"
+      type = "uuid"
+    }
+
+    column {
+      name = "created"
+      type = "timestamp"
+    }
+
+    partition_key {
+      name = "id"
+    }
+
+    cluster_key {
+      name     = "created"
+      order_by = "Desc"
+    }
+  }
+}
+`, template)
+}
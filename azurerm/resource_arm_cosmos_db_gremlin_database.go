@@ -0,0 +1,106 @@
+package azurerm
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmCosmosDBGremlinDatabase() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmCosmosDBGremlinDatabaseCreateUpdate,
+		Read:   resourceArmCosmosDBGremlinDatabaseRead,
+		Update: resourceArmCosmosDBGremlinDatabaseCreateUpdate,
+		Delete: resourceArmCosmosDBGremlinDatabaseDelete,
+
+		Importer: azure.ValidateResourceIDPriorToImport(azure.ValidateCosmosDBGremlinDatabaseID),
+
+		CustomizeDiff: azure.CosmosDBThroughputCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": cosmosDBEntityNameSchema(),
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"account_name": cosmosDBAccountNameSchema(),
+
+			"throughput": cosmosDBThroughputSchema(),
+		},
+	}
+}
+
+func resourceArmCosmosDBGremlinDatabaseCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	account := d.Get("account_name").(string)
+
+	id := azure.NewCosmosDBGremlinDatabaseID(meta.(*ArmClient).subscriptionId, resourceGroup, account, name).ID()
+	description := fmt.Sprintf("Cosmos DB Gremlin Database %q (Account %q / Resource Group %q)", name, account, resourceGroup)
+
+	if err := cosmosDBCheckForExisting(ctx, d, meta, "azurerm_cosmosdb_gremlin_database", id, description); err != nil {
+		return err
+	}
+
+	resource := azure.CosmosDBNamedResource{
+		ID: utils.String(name),
+	}
+
+	if err := cosmosDBCreateUpdate(ctx, d, meta, id, description, resource); err != nil {
+		return err
+	}
+
+	d.SetId(id)
+
+	return resourceArmCosmosDBGremlinDatabaseRead(d, meta)
+}
+
+func resourceArmCosmosDBGremlinDatabaseRead(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseCosmosDBGremlinDatabaseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	description := fmt.Sprintf("Cosmos DB Gremlin Database %q (Account %q / Resource Group %q)", id.Name, id.AccountName, id.ResourceGroup)
+
+	var resource azure.CosmosDBNamedResource
+	exists, err := cosmosDBRead(ctx, d, meta, d.Id(), description, &resource)
+	if err != nil || !exists {
+		return err
+	}
+
+	d.Set("name", resource.ID)
+	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("account_name", id.AccountName)
+
+	return nil
+}
+
+func resourceArmCosmosDBGremlinDatabaseDelete(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseCosmosDBGremlinDatabaseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	description := fmt.Sprintf("Cosmos DB Gremlin Database %q (Account %q / Resource Group %q)", id.Name, id.AccountName, id.ResourceGroup)
+	return cosmosDBDelete(ctx, meta, d.Id(), description)
+}
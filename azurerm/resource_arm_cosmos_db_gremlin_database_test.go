@@ -0,0 +1,103 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/cosmos-db/mgmt/2015-04-08/documentdb"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+)
+
+func TestAccAzureRMCosmosDBGremlinDatabase_basic(t *testing.T) {
+	resourceName := "azurerm_cosmosdb_gremlin_database.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMCosmosDBResourceDestroy("azurerm_cosmosdb_gremlin_database"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMCosmosDBGremlinDatabase_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMCosmosDBResourceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "throughput", "400"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMCosmosDBGremlinDatabase_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_cosmosdb_gremlin_database.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMCosmosDBResourceDestroy("azurerm_cosmosdb_gremlin_database"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMCosmosDBGremlinDatabase_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMCosmosDBResourceExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMCosmosDBGremlinDatabase_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_cosmosdb_gremlin_database"),
+			},
+		},
+	})
+}
+
+func testAccAzureRMCosmosDBGremlinDatabase_account(rInt int, location string) string {
+	return testAccAzureRMCosmosDBAccount_basic(rInt, location, string(documentdb.Session), "", `
+  kind = "GlobalDocumentDB"
+
+  capabilities {
+    name = "EnableGremlin"
+  }
+`)
+}
+
+func testAccAzureRMCosmosDBGremlinDatabase_basic(rInt int, location string) string {
+	template := testAccAzureRMCosmosDBGremlinDatabase_account(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_cosmosdb_gremlin_database" "test" {
+  name                = "acctest-%[2]d"
+  resource_group_name = "${azurerm_cosmosdb_account.test.resource_group_name}"
+  account_name        = "${azurerm_cosmosdb_account.test.name}"
+  throughput          = 400
+}
+`, template, rInt)
+}
+
+func testAccAzureRMCosmosDBGremlinDatabase_requiresImport(rInt int, location string) string {
+	template := testAccAzureRMCosmosDBGremlinDatabase_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_cosmosdb_gremlin_database" "import" {
+  name                = "${azurerm_cosmosdb_gremlin_database.test.name}"
+  resource_group_name = "${azurerm_cosmosdb_gremlin_database.test.resource_group_name}"
+  account_name        = "${azurerm_cosmosdb_gremlin_database.test.account_name}"
+  throughput          = "${azurerm_cosmosdb_gremlin_database.test.throughput}"
+}
+`, template)
+}
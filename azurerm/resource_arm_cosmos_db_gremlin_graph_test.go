@@ -0,0 +1,123 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/cosmos-db/mgmt/2015-04-08/documentdb"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+)
+
+func TestAccAzureRMCosmosDBGremlinGraph_basic(t *testing.T) {
+	resourceName := "azurerm_cosmosdb_gremlin_graph.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMCosmosDBResourceDestroy("azurerm_cosmosdb_gremlin_graph"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMCosmosDBGremlinGraph_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMCosmosDBResourceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "partition_key_path", "/city"),
+					resource.TestCheckResourceAttr(resourceName, "throughput", "400"),
+					resource.TestCheckResourceAttr(resourceName, "indexing_policy.0.indexing_mode", "Consistent"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMCosmosDBGremlinGraph_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_cosmosdb_gremlin_graph.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMCosmosDBResourceDestroy("azurerm_cosmosdb_gremlin_graph"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMCosmosDBGremlinGraph_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMCosmosDBResourceExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMCosmosDBGremlinGraph_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_cosmosdb_gremlin_graph"),
+			},
+		},
+	})
+}
+
+func testAccAzureRMCosmosDBGremlinGraph_account(rInt int, location string) string {
+	return testAccAzureRMCosmosDBAccount_basic(rInt, location, string(documentdb.Session), "", `
+  kind = "GlobalDocumentDB"
+
+  capabilities {
+    name = "EnableGremlin"
+  }
+`)
+}
+
+func testAccAzureRMCosmosDBGremlinGraph_basic(rInt int, location string) string {
+	template := testAccAzureRMCosmosDBGremlinGraph_account(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_cosmosdb_gremlin_database" "test" {
+  name                = "acctest-%[2]d"
+  resource_group_name = "${azurerm_cosmosdb_account.test.resource_group_name}"
+  account_name        = "${azurerm_cosmosdb_account.test.name}"
+}
+
+resource "azurerm_cosmosdb_gremlin_graph" "test" {
+  name                = "acctest-%[2]d"
+  resource_group_name = "${azurerm_cosmosdb_account.test.resource_group_name}"
+  account_name        = "${azurerm_cosmosdb_account.test.name}"
+  database_name       = "${azurerm_cosmosdb_gremlin_database.test.name}"
+  partition_key_path  = "/city"
+  throughput          = 400
+
+  indexing_policy {
+    indexing_mode = "Consistent"
+
+    included_path {
+      path = "/*"
+    }
+  }
+}
+`, template, rInt)
+}
+
+func testAccAzureRMCosmosDBGremlinGraph_requiresImport(rInt int, location string) string {
+	template := testAccAzureRMCosmosDBGremlinGraph_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_cosmosdb_gremlin_graph" "import" {
+  name                = "${azurerm_cosmosdb_gremlin_graph.test.name}"
+  resource_group_name = "${azurerm_cosmosdb_gremlin_graph.test.resource_group_name}"
+  account_name        = "${azurerm_cosmosdb_gremlin_graph.test.account_name}"
+  database_name       = "${azurerm_cosmosdb_gremlin_graph.test.database_name}"
+  partition_key_path  = "${azurerm_cosmosdb_gremlin_graph.test.partition_key_path}"
+  throughput          = "${azurerm_cosmosdb_gremlin_graph.test.throughput}"
+}
+`, template)
+}
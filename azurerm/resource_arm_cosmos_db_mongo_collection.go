@@ -0,0 +1,239 @@
+package azurerm
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmCosmosDBMongoCollection() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmCosmosDBMongoCollectionCreateUpdate,
+		Read:   resourceArmCosmosDBMongoCollectionRead,
+		Update: resourceArmCosmosDBMongoCollectionCreateUpdate,
+		Delete: resourceArmCosmosDBMongoCollectionDelete,
+
+		Importer: azure.ValidateResourceIDPriorToImport(azure.ValidateCosmosDBMongoCollectionID),
+
+		CustomizeDiff: azure.CosmosDBThroughputCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": cosmosDBEntityNameSchema(),
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"account_name": cosmosDBAccountNameSchema(),
+
+			"database_name": cosmosDBEntityNameSchema(),
+
+			"shard_key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"default_ttl_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(-1),
+			},
+
+			"index": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"keys": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.NoZeroValues,
+							},
+						},
+
+						"unique": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+
+			"throughput": cosmosDBThroughputSchema(),
+		},
+	}
+}
+
+func resourceArmCosmosDBMongoCollectionCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	account := d.Get("account_name").(string)
+	database := d.Get("database_name").(string)
+
+	id := azure.NewCosmosDBMongoCollectionID(meta.(*ArmClient).subscriptionId, resourceGroup, account, database, name).ID()
+	description := fmt.Sprintf("Cosmos DB MongoDB Collection %q (Database %q / Account %q / Resource Group %q)", name, database, account, resourceGroup)
+
+	if err := cosmosDBCheckForExisting(ctx, d, meta, "azurerm_cosmosdb_mongo_collection", id, description); err != nil {
+		return err
+	}
+
+	resource := azure.CosmosDBMongoCollectionResource{
+		ID:      utils.String(name),
+		Indexes: expandCosmosDBMongoCollectionIndexes(d.Get("index").([]interface{}), d.Get("default_ttl_seconds").(int)),
+	}
+
+	if shardKey := d.Get("shard_key").(string); shardKey != "" {
+		resource.ShardKey = map[string]*string{
+			shardKey: utils.String("Hash"),
+		}
+	}
+
+	if err := cosmosDBCreateUpdate(ctx, d, meta, id, description, resource); err != nil {
+		return err
+	}
+
+	d.SetId(id)
+
+	return resourceArmCosmosDBMongoCollectionRead(d, meta)
+}
+
+func resourceArmCosmosDBMongoCollectionRead(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseCosmosDBMongoCollectionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	description := fmt.Sprintf("Cosmos DB MongoDB Collection %q (Database %q / Account %q / Resource Group %q)", id.Name, id.DatabaseName, id.AccountName, id.ResourceGroup)
+
+	var resource azure.CosmosDBMongoCollectionResource
+	exists, err := cosmosDBRead(ctx, d, meta, d.Id(), description, &resource)
+	if err != nil || !exists {
+		return err
+	}
+
+	d.Set("name", resource.ID)
+	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("account_name", id.AccountName)
+	d.Set("database_name", id.DatabaseName)
+
+	for k := range resource.ShardKey {
+		d.Set("shard_key", k)
+	}
+
+	indexes, ttl := flattenCosmosDBMongoCollectionIndexes(resource.Indexes)
+	d.Set("default_ttl_seconds", ttl)
+	if err := d.Set("index", indexes); err != nil {
+		return fmt.Errorf("Error setting `index`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceArmCosmosDBMongoCollectionDelete(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseCosmosDBMongoCollectionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	description := fmt.Sprintf("Cosmos DB MongoDB Collection %q (Database %q / Account %q / Resource Group %q)", id.Name, id.DatabaseName, id.AccountName, id.ResourceGroup)
+	return cosmosDBDelete(ctx, meta, d.Id(), description)
+}
+
+// the TTL of the documents in a MongoDB Collection is configured using an Index on the `_ts` field
+const cosmosDBMongoCollectionTTLKey = "_ts"
+
+// the `_id` Index is created automatically for each MongoDB Collection
+const cosmosDBMongoCollectionIDKey = "_id"
+
+func expandCosmosDBMongoCollectionIndexes(input []interface{}, defaultTTLSeconds int) *[]azure.CosmosDBMongoIndex {
+	indexes := make([]azure.CosmosDBMongoIndex, 0)
+
+	for _, v := range input {
+		if v == nil {
+			continue
+		}
+
+		index := v.(map[string]interface{})
+		indexes = append(indexes, azure.CosmosDBMongoIndex{
+			Key: &azure.CosmosDBMongoIndexKeys{
+				Keys: utils.ExpandStringArray(index["keys"].([]interface{})),
+			},
+			Options: &azure.CosmosDBMongoIndexOptions{
+				Unique: utils.Bool(index["unique"].(bool)),
+			},
+		})
+	}
+
+	if defaultTTLSeconds != 0 {
+		indexes = append(indexes, azure.CosmosDBMongoIndex{
+			Key: &azure.CosmosDBMongoIndexKeys{
+				Keys: &[]string{cosmosDBMongoCollectionTTLKey},
+			},
+			Options: &azure.CosmosDBMongoIndexOptions{
+				ExpireAfterSeconds: utils.Int32(int32(defaultTTLSeconds)),
+			},
+		})
+	}
+
+	return &indexes
+}
+
+func flattenCosmosDBMongoCollectionIndexes(input *[]azure.CosmosDBMongoIndex) ([]interface{}, int) {
+	results := make([]interface{}, 0)
+	ttl := 0
+	if input == nil {
+		return results, ttl
+	}
+
+	for _, index := range *input {
+		keys := make([]string, 0)
+		if index.Key != nil && index.Key.Keys != nil {
+			keys = *index.Key.Keys
+		}
+
+		if len(keys) == 1 && keys[0] == cosmosDBMongoCollectionIDKey {
+			continue
+		}
+
+		if len(keys) == 1 && keys[0] == cosmosDBMongoCollectionTTLKey && index.Options != nil && index.Options.ExpireAfterSeconds != nil {
+			ttl = int(*index.Options.ExpireAfterSeconds)
+			continue
+		}
+
+		unique := false
+		if index.Options != nil && index.Options.Unique != nil {
+			unique = *index.Options.Unique
+		}
+
+		results = append(results, map[string]interface{}{
+			"keys":   utils.FlattenStringArray(&keys),
+			"unique": unique,
+		})
+	}
+
+	return results, ttl
+}
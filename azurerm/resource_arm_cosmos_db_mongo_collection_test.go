@@ -0,0 +1,169 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/cosmos-db/mgmt/2015-04-08/documentdb"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+)
+
+func TestAccAzureRMCosmosDBMongoCollection_basic(t *testing.T) {
+	resourceName := "azurerm_cosmosdb_mongo_collection.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMCosmosDBResourceDestroy("azurerm_cosmosdb_mongo_collection"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMCosmosDBMongoCollection_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMCosmosDBResourceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "throughput", "400"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMCosmosDBMongoCollection_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_cosmosdb_mongo_collection.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMCosmosDBResourceDestroy("azurerm_cosmosdb_mongo_collection"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMCosmosDBMongoCollection_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMCosmosDBResourceExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMCosmosDBMongoCollection_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_cosmosdb_mongo_collection"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMCosmosDBMongoCollection_update(t *testing.T) {
+	resourceName := "azurerm_cosmosdb_mongo_collection.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMCosmosDBResourceDestroy("azurerm_cosmosdb_mongo_collection"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMCosmosDBMongoCollection_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMCosmosDBResourceExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAzureRMCosmosDBMongoCollection_complete(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMCosmosDBResourceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "throughput", "600"),
+					resource.TestCheckResourceAttr(resourceName, "default_ttl_seconds", "707"),
+					resource.TestCheckResourceAttr(resourceName, "index.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "index.0.unique", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAzureRMCosmosDBMongoCollection_account(rInt int, location string) string {
+	return testAccAzureRMCosmosDBAccount_basic(rInt, location, string(documentdb.Session), "", `
+  kind = "MongoDB"
+`)
+}
+
+func testAccAzureRMCosmosDBMongoCollection_basic(rInt int, location string) string {
+	template := testAccAzureRMCosmosDBMongoCollection_account(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_cosmosdb_mongo_database" "test" {
+  name                = "acctest-%[2]d"
+  resource_group_name = "${azurerm_cosmosdb_account.test.resource_group_name}"
+  account_name        = "${azurerm_cosmosdb_account.test.name}"
+}
+
+resource "azurerm_cosmosdb_mongo_collection" "test" {
+  name                = "acctest-%[2]d"
+  resource_group_name = "${azurerm_cosmosdb_account.test.resource_group_name}"
+  account_name        = "${azurerm_cosmosdb_account.test.name}"
+  database_name       = "${azurerm_cosmosdb_mongo_database.test.name}"
+  throughput          = 400
+}
+`, template, rInt)
+}
+
+func testAccAzureRMCosmosDBMongoCollection_complete(rInt int, location string) string {
+	template := testAccAzureRMCosmosDBMongoCollection_account(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_cosmosdb_mongo_database" "test" {
+  name                = "acctest-%[2]d"
+  resource_group_name = "${azurerm_cosmosdb_account.test.resource_group_name}"
+  account_name        = "${azurerm_cosmosdb_account.test.name}"
+}
+
+resource "azurerm_cosmosdb_mongo_collection" "test" {
+  name                = "acctest-%[2]d"
+  resource_group_name = "${azurerm_cosmosdb_account.test.resource_group_name}"
+  account_name        = "${azurerm_cosmosdb_account.test.name}"
+  database_name       = "${azurerm_cosmosdb_mongo_database.test.name}"
+  throughput          = 600
+  default_ttl_seconds = 707
+
+  index {
+    keys   = ["email"]
+    unique = true
+  }
+}
+`, template, rInt)
+}
+
+func testAccAzureRMCosmosDBMongoCollection_requiresImport(rInt int, location string) string {
+	template := testAccAzureRMCosmosDBMongoCollection_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_cosmosdb_mongo_collection" "import" {
+  name                = "${azurerm_cosmosdb_mongo_collection.test.name}"
+  resource_group_name = "${azurerm_cosmosdb_mongo_collection.test.resource_group_name}"
+  account_name        = "${azurerm_cosmosdb_mongo_collection.test.account_name}"
+  database_name       = "${azurerm_cosmosdb_mongo_collection.test.database_name}"
+  throughput          = "${azurerm_cosmosdb_mongo_collection.test.throughput}"
+}
+`, template)
+}
@@ -0,0 +1,96 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/cosmos-db/mgmt/2015-04-08/documentdb"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+)
+
+func TestAccAzureRMCosmosDBMongoDatabase_basic(t *testing.T) {
+	resourceName := "azurerm_cosmosdb_mongo_database.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMCosmosDBResourceDestroy("azurerm_cosmosdb_mongo_database"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMCosmosDBMongoDatabase_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMCosmosDBResourceExists(resourceName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMCosmosDBMongoDatabase_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_cosmosdb_mongo_database.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMCosmosDBResourceDestroy("azurerm_cosmosdb_mongo_database"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMCosmosDBMongoDatabase_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMCosmosDBResourceExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMCosmosDBMongoDatabase_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_cosmosdb_mongo_database"),
+			},
+		},
+	})
+}
+
+func testAccAzureRMCosmosDBMongoDatabase_account(rInt int, location string) string {
+	return testAccAzureRMCosmosDBAccount_basic(rInt, location, string(documentdb.Session), "", `
+  kind = "MongoDB"
+`)
+}
+
+func testAccAzureRMCosmosDBMongoDatabase_basic(rInt int, location string) string {
+	template := testAccAzureRMCosmosDBMongoDatabase_account(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_cosmosdb_mongo_database" "test" {
+  name                = "acctest-%[2]d"
+  resource_group_name = "${azurerm_cosmosdb_account.test.resource_group_name}"
+  account_name        = "${azurerm_cosmosdb_account.test.name}"
+}
+`, template, rInt)
+}
+
+func testAccAzureRMCosmosDBMongoDatabase_requiresImport(rInt int, location string) string {
+	template := testAccAzureRMCosmosDBMongoDatabase_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_cosmosdb_mongo_database" "import" {
+  name                = "${azurerm_cosmosdb_mongo_database.test.name}"
+  resource_group_name = "${azurerm_cosmosdb_mongo_database.test.resource_group_name}"
+  account_name        = "${azurerm_cosmosdb_mongo_database.test.account_name}"
+}
+`, template)
+}
@@ -0,0 +1,132 @@
+package azurerm
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmCosmosDBSqlContainer() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmCosmosDBSqlContainerCreateUpdate,
+		Read:   resourceArmCosmosDBSqlContainerRead,
+		Update: resourceArmCosmosDBSqlContainerCreateUpdate,
+		Delete: resourceArmCosmosDBSqlContainerDelete,
+
+		Importer: azure.ValidateResourceIDPriorToImport(azure.ValidateCosmosDBSqlContainerID),
+
+		CustomizeDiff: azure.CosmosDBThroughputCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": cosmosDBEntityNameSchema(),
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"account_name": cosmosDBAccountNameSchema(),
+
+			"database_name": cosmosDBEntityNameSchema(),
+
+			"partition_key_path": cosmosDBPartitionKeyPathSchema(),
+
+			"unique_key": cosmosDBUniqueKeySchema(),
+
+			"indexing_policy": cosmosDBIndexingPolicySchema(),
+
+			"default_ttl": cosmosDBDefaultTTLSchema(),
+
+			"throughput": cosmosDBThroughputSchema(),
+		},
+	}
+}
+
+func resourceArmCosmosDBSqlContainerCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	account := d.Get("account_name").(string)
+	database := d.Get("database_name").(string)
+
+	id := azure.NewCosmosDBSqlContainerID(meta.(*ArmClient).subscriptionId, resourceGroup, account, database, name).ID()
+	description := fmt.Sprintf("Cosmos DB SQL Container %q (Database %q / Account %q / Resource Group %q)", name, database, account, resourceGroup)
+
+	if err := cosmosDBCheckForExisting(ctx, d, meta, "azurerm_cosmosdb_sql_container", id, description); err != nil {
+		return err
+	}
+
+	resource := azure.CosmosDBContainerResource{
+		ID:              utils.String(name),
+		PartitionKey:    expandCosmosDBPartitionKey(d),
+		UniqueKeyPolicy: expandCosmosDBUniqueKeyPolicy(d.Get("unique_key").([]interface{})),
+		IndexingPolicy:  expandCosmosDBIndexingPolicy(d.Get("indexing_policy").([]interface{})),
+		DefaultTTL:      expandCosmosDBDefaultTTL(d),
+	}
+
+	if err := cosmosDBCreateUpdate(ctx, d, meta, id, description, resource); err != nil {
+		return err
+	}
+
+	d.SetId(id)
+
+	return resourceArmCosmosDBSqlContainerRead(d, meta)
+}
+
+func resourceArmCosmosDBSqlContainerRead(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseCosmosDBSqlContainerID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	description := fmt.Sprintf("Cosmos DB SQL Container %q (Database %q / Account %q / Resource Group %q)", id.Name, id.DatabaseName, id.AccountName, id.ResourceGroup)
+
+	var resource azure.CosmosDBContainerResource
+	exists, err := cosmosDBRead(ctx, d, meta, d.Id(), description, &resource)
+	if err != nil || !exists {
+		return err
+	}
+
+	d.Set("name", resource.ID)
+	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("account_name", id.AccountName)
+	d.Set("database_name", id.DatabaseName)
+	d.Set("partition_key_path", flattenCosmosDBPartitionKey(resource.PartitionKey))
+	d.Set("default_ttl", flattenCosmosDBDefaultTTL(resource.DefaultTTL))
+
+	if err := d.Set("unique_key", flattenCosmosDBUniqueKeyPolicy(resource.UniqueKeyPolicy)); err != nil {
+		return fmt.Errorf("Error setting `unique_key`: %+v", err)
+	}
+
+	if err := d.Set("indexing_policy", flattenCosmosDBIndexingPolicy(resource.IndexingPolicy)); err != nil {
+		return fmt.Errorf("Error setting `indexing_policy`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceArmCosmosDBSqlContainerDelete(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseCosmosDBSqlContainerID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	description := fmt.Sprintf("Cosmos DB SQL Container %q (Database %q / Account %q / Resource Group %q)", id.Name, id.DatabaseName, id.AccountName, id.ResourceGroup)
+	return cosmosDBDelete(ctx, meta, d.Id(), description)
+}
@@ -0,0 +1,191 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/cosmos-db/mgmt/2015-04-08/documentdb"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+)
+
+func TestAccAzureRMCosmosDBSqlContainer_basic(t *testing.T) {
+	resourceName := "azurerm_cosmosdb_sql_container.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMCosmosDBResourceDestroy("azurerm_cosmosdb_sql_container"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMCosmosDBSqlContainer_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMCosmosDBResourceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "partition_key_path", "/definition/id"),
+					resource.TestCheckResourceAttr(resourceName, "unique_key.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "unique_key.0.paths.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "throughput", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMCosmosDBSqlContainer_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_cosmosdb_sql_container.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMCosmosDBResourceDestroy("azurerm_cosmosdb_sql_container"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMCosmosDBSqlContainer_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMCosmosDBResourceExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMCosmosDBSqlContainer_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_cosmosdb_sql_container"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMCosmosDBSqlContainer_update(t *testing.T) {
+	resourceName := "azurerm_cosmosdb_sql_container.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMCosmosDBResourceDestroy("azurerm_cosmosdb_sql_container"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMCosmosDBSqlContainer_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMCosmosDBResourceExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAzureRMCosmosDBSqlContainer_complete(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMCosmosDBResourceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "default_ttl", "500"),
+					resource.TestCheckResourceAttr(resourceName, "indexing_policy.0.excluded_path.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAzureRMCosmosDBSqlContainer_account(rInt int, location string) string {
+	return testAccAzureRMCosmosDBAccount_basic(rInt, location, string(documentdb.Session), "", ``)
+}
+
+func testAccAzureRMCosmosDBSqlContainer_basic(rInt int, location string) string {
+	template := testAccAzureRMCosmosDBSqlContainer_account(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_cosmosdb_sql_database" "test" {
+  name                = "acctest-%[2]d"
+  resource_group_name = "${azurerm_cosmosdb_account.test.resource_group_name}"
+  account_name        = "${azurerm_cosmosdb_account.test.name}"
+}
+
+resource "azurerm_cosmosdb_sql_container" "test" {
+  name                = "acctest-%[2]d"
+  resource_group_name = "${azurerm_cosmosdb_account.test.resource_group_name}"
+  account_name        = "${azurerm_cosmosdb_account.test.name}"
+  database_name       = "${azurerm_cosmosdb_sql_database.test.name}"
+  partition_key_path  = "/definition/id"
+
+  unique_key {
+    paths = ["/definition/idlong", "/definition/idshort"]
+  }
+}
+`, template, rInt)
+}
+
+func testAccAzureRMCosmosDBSqlContainer_complete(rInt int, location string) string {
+	template := testAccAzureRMCosmosDBSqlContainer_account(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_cosmosdb_sql_database" "test" {
+  name                = "acctest-%[2]d"
+  resource_group_name = "${azurerm_cosmosdb_account.test.resource_group_name}"
+  account_name        = "${azurerm_cosmosdb_account.test.name}"
+}
+
+resource "azurerm_cosmosdb_sql_container" "test" {
+  name                = "acctest-%[2]d"
+  resource_group_name = "${azurerm_cosmosdb_account.test.resource_group_name}"
+  account_name        = "${azurerm_cosmosdb_account.test.name}"
+  database_name       = "${azurerm_cosmosdb_sql_database.test.name}"
+  partition_key_path  = "/definition/id"
+  default_ttl         = 500
+
+  unique_key {
+    paths = ["/definition/idlong", "/definition/idshort"]
+  }
+
+  indexing_policy {
+    indexing_mode = "Consistent"
+
+    included_path {
+      path = "/*"
+    }
+
+    excluded_path {
+      path = "/\"_etag\"/?"
+    }
+
+    excluded_path {
+      path = "/definition/description/*"
+    }
+  }
+}
+`, template, rInt)
+}
+
+func testAccAzureRMCosmosDBSqlContainer_requiresImport(rInt int, location string) string {
+	template := testAccAzureRMCosmosDBSqlContainer_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_cosmosdb_sql_container" "import" {
+  name                = "${azurerm_cosmosdb_sql_container.test.name}"
+  resource_group_name = "${azurerm_cosmosdb_sql_container.test.resource_group_name}"
+  account_name        = "${azurerm_cosmosdb_sql_container.test.account_name}"
+  database_name       = "${azurerm_cosmosdb_sql_container.test.database_name}"
+  partition_key_path  = "${azurerm_cosmosdb_sql_container.test.partition_key_path}"
+
+  unique_key {
+    paths = ["/definition/idlong", "/definition/idshort"]
+  }
+}
+`, template)
+}
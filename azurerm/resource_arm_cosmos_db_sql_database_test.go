@@ -0,0 +1,143 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/cosmos-db/mgmt/2015-04-08/documentdb"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+)
+
+func TestAccAzureRMCosmosDBSqlDatabase_basic(t *testing.T) {
+	resourceName := "azurerm_cosmosdb_sql_database.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMCosmosDBResourceDestroy("azurerm_cosmosdb_sql_database"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMCosmosDBSqlDatabase_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMCosmosDBResourceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "throughput", "400"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMCosmosDBSqlDatabase_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_cosmosdb_sql_database.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMCosmosDBResourceDestroy("azurerm_cosmosdb_sql_database"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMCosmosDBSqlDatabase_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMCosmosDBResourceExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMCosmosDBSqlDatabase_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_cosmosdb_sql_database"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMCosmosDBSqlDatabase_update(t *testing.T) {
+	resourceName := "azurerm_cosmosdb_sql_database.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMCosmosDBResourceDestroy("azurerm_cosmosdb_sql_database"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMCosmosDBSqlDatabase_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMCosmosDBResourceExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAzureRMCosmosDBSqlDatabase_complete(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMCosmosDBResourceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "throughput", "700"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAzureRMCosmosDBSqlDatabase_account(rInt int, location string) string {
+	return testAccAzureRMCosmosDBAccount_basic(rInt, location, string(documentdb.Session), "", ``)
+}
+
+func testAccAzureRMCosmosDBSqlDatabase_basic(rInt int, location string) string {
+	template := testAccAzureRMCosmosDBSqlDatabase_account(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_cosmosdb_sql_database" "test" {
+  name                = "acctest-%[2]d"
+  resource_group_name = "${azurerm_cosmosdb_account.test.resource_group_name}"
+  account_name        = "${azurerm_cosmosdb_account.test.name}"
+  throughput          = 400
+}
+`, template, rInt)
+}
+
+func testAccAzureRMCosmosDBSqlDatabase_complete(rInt int, location string) string {
+	template := testAccAzureRMCosmosDBSqlDatabase_account(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_cosmosdb_sql_database" "test" {
+  name                = "acctest-%[2]d"
+  resource_group_name = "${azurerm_cosmosdb_account.test.resource_group_name}"
+  account_name        = "${azurerm_cosmosdb_account.test.name}"
+  throughput          = 700
+}
+`, template, rInt)
+}
+
+func testAccAzureRMCosmosDBSqlDatabase_requiresImport(rInt int, location string) string {
+	template := testAccAzureRMCosmosDBSqlDatabase_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_cosmosdb_sql_database" "import" {
+  name                = "${azurerm_cosmosdb_sql_database.test.name}"
+  resource_group_name = "${azurerm_cosmosdb_sql_database.test.resource_group_name}"
+  account_name        = "${azurerm_cosmosdb_sql_database.test.account_name}"
+  throughput          = "${azurerm_cosmosdb_sql_database.test.throughput}"
+}
+`, template)
+}
@@ -0,0 +1,100 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/cosmos-db/mgmt/2015-04-08/documentdb"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+)
+
+func TestAccAzureRMCosmosDBTable_basic(t *testing.T) {
+	resourceName := "azurerm_cosmosdb_table.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMCosmosDBResourceDestroy("azurerm_cosmosdb_table"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMCosmosDBTable_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMCosmosDBResourceExists(resourceName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMCosmosDBTable_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_cosmosdb_table.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMCosmosDBResourceDestroy("azurerm_cosmosdb_table"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMCosmosDBTable_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMCosmosDBResourceExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMCosmosDBTable_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_cosmosdb_table"),
+			},
+		},
+	})
+}
+
+func testAccAzureRMCosmosDBTable_account(rInt int, location string) string {
+	return testAccAzureRMCosmosDBAccount_basic(rInt, location, string(documentdb.Session), "", `
+  kind = "GlobalDocumentDB"
+
+  capabilities {
+    name = "EnableTable"
+  }
+`)
+}
+
+func testAccAzureRMCosmosDBTable_basic(rInt int, location string) string {
+	template := testAccAzureRMCosmosDBTable_account(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_cosmosdb_table" "test" {
+  name                = "acctest-%[2]d"
+  resource_group_name = "${azurerm_cosmosdb_account.test.resource_group_name}"
+  account_name        = "${azurerm_cosmosdb_account.test.name}"
+}
+`, template, rInt)
+}
+
+func testAccAzureRMCosmosDBTable_requiresImport(rInt int, location string) string {
+	template := testAccAzureRMCosmosDBTable_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_cosmosdb_table" "import" {
+  name                = "${azurerm_cosmosdb_table.test.name}"
+  resource_group_name = "${azurerm_cosmosdb_table.test.resource_group_name}"
+  account_name        = "${azurerm_cosmosdb_table.test.account_name}"
+}
+`, template)
+}
@@ -4,11 +4,16 @@ import (
 	"fmt"
 	"log"
 	"regexp"
+	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-08-01/network"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
@@ -17,12 +22,19 @@ var azureFirewallResourceName = "azurerm_firewall"
 
 func resourceArmFirewall() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmFirewallCreateUpdate,
-		Read:   resourceArmFirewallRead,
-		Update: resourceArmFirewallCreateUpdate,
-		Delete: resourceArmFirewallDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmFirewallCreateUpdate,
+		Read:     resourceArmFirewallRead,
+		Update:   resourceArmFirewallCreateUpdate,
+		Delete:   resourceArmFirewallDelete,
+		Importer: azure.ValidateResourceIDPriorToImport(azure.ValidateFirewallID),
+
+		CustomizeDiff: azure.CustomizeDiffAll(azure.ZonesCustomizeDiff, azure.AzureFirewallCustomizeDiff),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(90 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(90 * time.Minute),
+			Delete: schema.DefaultTimeout(90 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -49,24 +61,61 @@ func resourceArmFirewall() *schema.Resource {
 							ValidateFunc: validate.NoEmptyStrings,
 						},
 						"subnet_id": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateFunc:     azure.ValidateSubnetID,
+							DiffSuppressFunc: suppress.CaseDifference,
+						},
+						"internal_public_ip_address_id": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							ValidateFunc:     azure.ValidatePublicIPAddressID,
+							DiffSuppressFunc: suppress.CaseDifference,
+							Deprecated:       "This field has been deprecated. Use `public_ip_address_id` instead.",
+							ConflictsWith:    []string{"ip_configuration.0.public_ip_address_id"},
+						},
+						"public_ip_address_id": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							ValidateFunc:     azure.ValidatePublicIPAddressID,
+							DiffSuppressFunc: suppress.CaseDifference,
+							ConflictsWith:    []string{"ip_configuration.0.internal_public_ip_address_id"},
+						},
+						"private_ip_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"management_ip_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
 							Type:         schema.TypeString,
 							Required:     true,
-							ValidateFunc: azure.ValidateResourceID,
+							ForceNew:     true,
+							ValidateFunc: validate.NoEmptyStrings,
 						},
-						"internal_public_ip_address_id": {
-							Type:          schema.TypeString,
-							Optional:      true,
-							Computed:      true,
-							ValidateFunc:  azure.ValidateResourceID,
-							Deprecated:    "This field has been deprecated. Use `public_ip_address_id` instead.",
-							ConflictsWith: []string{"ip_configuration.0.public_ip_address_id"},
+						"subnet_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validateAzureFirewallManagementSubnetID,
 						},
 						"public_ip_address_id": {
-							Type:          schema.TypeString,
-							Optional:      true,
-							Computed:      true,
-							ValidateFunc:  azure.ValidateResourceID,
-							ConflictsWith: []string{"ip_configuration.0.internal_public_ip_address_id"},
+							Type:             schema.TypeString,
+							Required:         true,
+							ForceNew:         true,
+							ValidateFunc:     azure.ValidatePublicIPAddressID,
+							DiffSuppressFunc: suppress.CaseDifference,
 						},
 						"private_ip_address": {
 							Type:     schema.TypeString,
@@ -76,6 +125,19 @@ func resourceArmFirewall() *schema.Resource {
 				},
 			},
 
+			"threat_intel_mode": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "Alert",
+				ValidateFunc: validation.StringInSlice([]string{
+					"Alert",
+					"Deny",
+					"Off",
+				}, false),
+			},
+
+			"zones": zonesSchema(),
+
 			"tags": tagsSchema(),
 		},
 	}
@@ -83,14 +145,15 @@ func resourceArmFirewall() *schema.Resource {
 
 func resourceArmFirewallCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).azureFirewallsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for AzureRM Azure Firewall creation")
 
 	name := d.Get("name").(string)
 	resourceGroup := d.Get("resource_group_name").(string)
 
-	if requireResourcesToBeImported && d.IsNewResource() {
+	if meta.(*ArmClient).requireResourcesToBeImported && d.IsNewResource() {
 		existing, err := client.Get(ctx, resourceGroup, name)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
@@ -110,6 +173,11 @@ func resourceArmFirewallCreateUpdate(d *schema.ResourceData, meta interface{}) e
 		return fmt.Errorf("Error Building list of Azure Firewall IP Configurations: %+v", err)
 	}
 
+	managementIPConfig, err := expandArmFirewallManagementIPConfiguration(d, subnetToLock, vnetToLock)
+	if err != nil {
+		return fmt.Errorf("Error Building Azure Firewall Management IP Configuration: %+v", err)
+	}
+
 	azureRMLockByName(name, azureFirewallResourceName)
 	defer azureRMUnlockByName(name, azureFirewallResourceName)
 
@@ -119,11 +187,14 @@ func resourceArmFirewallCreateUpdate(d *schema.ResourceData, meta interface{}) e
 	azureRMLockMultipleByName(vnetToLock, virtualNetworkResourceName)
 	defer azureRMUnlockMultipleByName(vnetToLock, virtualNetworkResourceName)
 
-	parameters := network.AzureFirewall{
+	parameters := azure.AzureFirewall{
 		Location: &location,
 		Tags:     expandTags(tags),
-		AzureFirewallPropertiesFormat: &network.AzureFirewallPropertiesFormat{
-			IPConfigurations: ipConfigs,
+		Zones:    expandZones(d.Get("zones").([]interface{})),
+		AzureFirewallPropertiesFormat: &azure.AzureFirewallPropertiesFormat{
+			IPConfigurations:          ipConfigs,
+			ManagementIPConfiguration: managementIPConfig,
+			ThreatIntelMode:           d.Get("threat_intel_mode").(string),
 		},
 	}
 
@@ -169,7 +240,8 @@ func resourceArmFirewallCreateUpdate(d *schema.ResourceData, meta interface{}) e
 
 func resourceArmFirewallRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).azureFirewallsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -200,8 +272,17 @@ func resourceArmFirewallRead(d *schema.ResourceData, meta interface{}) error {
 		if err := d.Set("ip_configuration", ipConfigs); err != nil {
 			return fmt.Errorf("Error setting `ip_configuration`: %+v", err)
 		}
+
+		managementIPConfig := flattenArmFirewallManagementIPConfiguration(props.ManagementIPConfiguration)
+		if err := d.Set("management_ip_configuration", managementIPConfig); err != nil {
+			return fmt.Errorf("Error setting `management_ip_configuration`: %+v", err)
+		}
+
+		d.Set("threat_intel_mode", props.ThreatIntelMode)
 	}
 
+	d.Set("zones", read.Zones)
+
 	flattenAndSetTags(d, read.Tags)
 
 	return nil
@@ -209,7 +290,8 @@ func resourceArmFirewallRead(d *schema.ResourceData, meta interface{}) error {
 
 func resourceArmFirewallDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).azureFirewallsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -232,26 +314,32 @@ func resourceArmFirewallDelete(d *schema.ResourceData, meta interface{}) error {
 	subnetNamesToLock := make([]string, 0)
 	virtualNetworkNamesToLock := make([]string, 0)
 	if props := read.AzureFirewallPropertiesFormat; props != nil {
-		if configs := props.IPConfigurations; configs != nil {
-			for _, config := range *configs {
-				if config.Subnet == nil || config.Subnet.ID == nil {
-					continue
-				}
-
-				parsedSubnetId, err2 := parseAzureResourceID(*config.Subnet.ID)
-				if err2 != nil {
-					return err2
-				}
-				subnetName := parsedSubnetId.Path["subnets"]
-
-				if !sliceContainsValue(subnetNamesToLock, subnetName) {
-					subnetNamesToLock = append(subnetNamesToLock, subnetName)
-				}
-
-				virtualNetworkName := parsedSubnetId.Path["virtualNetworks"]
-				if !sliceContainsValue(virtualNetworkNamesToLock, virtualNetworkName) {
-					virtualNetworkNamesToLock = append(virtualNetworkNamesToLock, virtualNetworkName)
-				}
+		configs := make([]network.AzureFirewallIPConfiguration, 0)
+		if props.IPConfigurations != nil {
+			configs = append(configs, *props.IPConfigurations...)
+		}
+		if props.ManagementIPConfiguration != nil {
+			configs = append(configs, *props.ManagementIPConfiguration)
+		}
+
+		for _, config := range configs {
+			if config.Subnet == nil || config.Subnet.ID == nil {
+				continue
+			}
+
+			parsedSubnetId, err2 := parseAzureResourceID(*config.Subnet.ID)
+			if err2 != nil {
+				return err2
+			}
+			subnetName := parsedSubnetId.Path["subnets"]
+
+			if !sliceContainsValue(subnetNamesToLock, subnetName) {
+				subnetNamesToLock = append(subnetNamesToLock, subnetName)
+			}
+
+			virtualNetworkName := parsedSubnetId.Path["virtualNetworks"]
+			if !sliceContainsValue(virtualNetworkNamesToLock, virtualNetworkName) {
+				virtualNetworkNamesToLock = append(virtualNetworkNamesToLock, virtualNetworkName)
 			}
 		}
 	}
@@ -329,6 +417,44 @@ func expandArmFirewallIPConfigurations(d *schema.ResourceData) (*[]network.Azure
 	return &ipConfigs, &subnetNamesToLock, &virtualNetworkNamesToLock, nil
 }
 
+func expandArmFirewallManagementIPConfiguration(d *schema.ResourceData, subnetNamesToLock *[]string, virtualNetworkNamesToLock *[]string) (*network.AzureFirewallIPConfiguration, error) {
+	configs := d.Get("management_ip_configuration").([]interface{})
+	if len(configs) == 0 || configs[0] == nil {
+		return nil, nil
+	}
+
+	data := configs[0].(map[string]interface{})
+	subnetId := data["subnet_id"].(string)
+
+	subnetID, err := parseAzureResourceID(subnetId)
+	if err != nil {
+		return nil, err
+	}
+
+	subnetName := subnetID.Path["subnets"]
+	virtualNetworkName := subnetID.Path["virtualNetworks"]
+
+	if !sliceContainsValue(*subnetNamesToLock, subnetName) {
+		*subnetNamesToLock = append(*subnetNamesToLock, subnetName)
+	}
+
+	if !sliceContainsValue(*virtualNetworkNamesToLock, virtualNetworkName) {
+		*virtualNetworkNamesToLock = append(*virtualNetworkNamesToLock, virtualNetworkName)
+	}
+
+	return &network.AzureFirewallIPConfiguration{
+		Name: utils.String(data["name"].(string)),
+		AzureFirewallIPConfigurationPropertiesFormat: &network.AzureFirewallIPConfigurationPropertiesFormat{
+			Subnet: &network.SubResource{
+				ID: utils.String(subnetId),
+			},
+			PublicIPAddress: &network.SubResource{
+				ID: utils.String(data["public_ip_address_id"].(string)),
+			},
+		},
+	}, nil
+}
+
 func flattenArmFirewallIPConfigurations(input *[]network.AzureFirewallIPConfiguration) []interface{} {
 	result := make([]interface{}, 0)
 	if input == nil {
@@ -368,6 +494,42 @@ func flattenArmFirewallIPConfigurations(input *[]network.AzureFirewallIPConfigur
 	return result
 }
 
+func flattenArmFirewallManagementIPConfiguration(input *network.AzureFirewallIPConfiguration) []interface{} {
+	if input == nil || input.AzureFirewallIPConfigurationPropertiesFormat == nil {
+		return []interface{}{}
+	}
+
+	props := input.AzureFirewallIPConfigurationPropertiesFormat
+	name := ""
+	if input.Name != nil {
+		name = *input.Name
+	}
+
+	subnetId := ""
+	if subnet := props.Subnet; subnet != nil && subnet.ID != nil {
+		subnetId = *subnet.ID
+	}
+
+	publicIPAddressId := ""
+	if pip := props.PublicIPAddress; pip != nil && pip.ID != nil {
+		publicIPAddressId = *pip.ID
+	}
+
+	privateIPAddress := ""
+	if props.PrivateIPAddress != nil {
+		privateIPAddress = *props.PrivateIPAddress
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"name":                 name,
+			"subnet_id":            subnetId,
+			"public_ip_address_id": publicIPAddressId,
+			"private_ip_address":   privateIPAddress,
+		},
+	}
+}
+
 func validateAzureFirewallName(v interface{}, k string) (warnings []string, errors []error) {
 	value := v.(string)
 
@@ -379,3 +541,22 @@ func validateAzureFirewallName(v interface{}, k string) (warnings []string, erro
 
 	return warnings, errors
 }
+
+func validateAzureFirewallManagementSubnetID(i interface{}, k string) (warnings []string, errors []error) {
+	if warnings, errors = azure.ValidateSubnetID(i, k); len(errors) > 0 {
+		return warnings, errors
+	}
+
+	id, err := azure.ParseSubnetID(i.(string))
+	if err != nil {
+		errors = append(errors, err)
+		return warnings, errors
+	}
+
+	// the Management IP Configuration of a Firewall must use a dedicated Subnet with this name
+	if !strings.EqualFold(id.Name, "AzureFirewallManagementSubnet") {
+		errors = append(errors, fmt.Errorf("expected %s to reference a subnet with name AzureFirewallManagementSubnet", k))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,549 @@
+package azurerm
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-08-01/network"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestFirewallNatRuleCollectionRulesRoundTrip(t *testing.T) {
+	cases := []struct {
+		Name  string
+		Input *[]network.AzureFirewallNatRule
+	}{
+		{
+			Name:  "None",
+			Input: &[]network.AzureFirewallNatRule{},
+		},
+		{
+			Name: "Single Rule",
+			Input: &[]network.AzureFirewallNatRule{
+				{
+					Name:                 utils.String("rdp"),
+					Description:          utils.String("Publish RDP"),
+					SourceAddresses:      &[]string{"*"},
+					DestinationAddresses: &[]string{"20.0.0.4"},
+					DestinationPorts:     &[]string{"3389"},
+					Protocols:            &[]network.AzureFirewallNetworkRuleProtocol{network.TCP},
+					TranslatedAddress:    utils.String("10.0.1.4"),
+					TranslatedPort:       utils.String("3389"),
+				},
+			},
+		},
+		{
+			Name: "Multiple Rules",
+			Input: &[]network.AzureFirewallNatRule{
+				{
+					Name:                 utils.String("dns"),
+					Description:          utils.String(""),
+					SourceAddresses:      &[]string{"10.0.0.0/16", "192.168.0.0/24"},
+					DestinationAddresses: &[]string{"20.0.0.4", "20.0.0.5"},
+					DestinationPorts:     &[]string{"53"},
+					Protocols:            &[]network.AzureFirewallNetworkRuleProtocol{network.TCP, network.UDP},
+					TranslatedAddress:    utils.String("10.0.2.4"),
+					TranslatedPort:       utils.String("53"),
+				},
+				{
+					Name:                 utils.String("web"),
+					Description:          utils.String("Publish HTTPS"),
+					SourceAddresses:      &[]string{"*"},
+					DestinationAddresses: &[]string{"20.0.0.4"},
+					DestinationPorts:     &[]string{"443", "8443"},
+					Protocols:            &[]network.AzureFirewallNetworkRuleProtocol{network.TCP},
+					TranslatedAddress:    utils.String("10.0.3.4"),
+					TranslatedPort:       utils.String("443"),
+				},
+			},
+		},
+	}
+
+	ruleSchema := resourceArmFirewallNatRuleCollection().Schema["rule"].Elem.(*schema.Resource)
+
+	for _, v := range cases {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		flattened := flattenFirewallNatRuleCollectionRules(v.Input)
+		if len(flattened) != len(*v.Input) {
+			t.Fatalf("Expected %d rules but got %d", len(*v.Input), len(flattened))
+		}
+
+		rules := schema.NewSet(schema.HashResource(ruleSchema), nil)
+		for _, rule := range flattened {
+			rules.Add(rule)
+		}
+
+		actual := expandArmFirewallNatRules(rules)
+
+		// the rules (and their addresses, ports and protocols) are Sets - so their order isn't preserved
+		expected := sortFirewallNatRules(*v.Input)
+		if !reflect.DeepEqual(expected, sortFirewallNatRules(actual)) {
+			t.Fatalf("Expected %+v but got %+v", expected, actual)
+		}
+	}
+
+	if flattened := flattenFirewallNatRuleCollectionRules(nil); len(flattened) != 0 {
+		t.Fatalf("Expected no rules but got %+v", flattened)
+	}
+}
+
+func sortFirewallNatRules(input []network.AzureFirewallNatRule) []network.AzureFirewallNatRule {
+	sort.Slice(input, func(i, j int) bool {
+		return *input[i].Name < *input[j].Name
+	})
+
+	for _, rule := range input {
+		sort.Strings(*rule.SourceAddresses)
+		sort.Strings(*rule.DestinationAddresses)
+		sort.Strings(*rule.DestinationPorts)
+
+		protocols := *rule.Protocols
+		sort.Slice(protocols, func(i, j int) bool {
+			return protocols[i] < protocols[j]
+		})
+	}
+
+	return input
+}
+
+func TestAccAzureRMFirewallNatRuleCollection_basic(t *testing.T) {
+	resourceName := "azurerm_firewall_nat_rule_collection.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMFirewallDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMFirewallNatRuleCollection_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMFirewallNatRuleCollectionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", "acctestnatrc"),
+					resource.TestCheckResourceAttr(resourceName, "priority", "100"),
+					resource.TestCheckResourceAttr(resourceName, "action", "Dnat"),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMFirewallNatRuleCollection_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_firewall_nat_rule_collection.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMFirewallDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMFirewallNatRuleCollection_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMFirewallNatRuleCollectionExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMFirewallNatRuleCollection_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_firewall_nat_rule_collection"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMFirewallNatRuleCollection_update(t *testing.T) {
+	resourceName := "azurerm_firewall_nat_rule_collection.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMFirewallDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMFirewallNatRuleCollection_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMFirewallNatRuleCollectionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "priority", "100"),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "1"),
+				),
+			},
+			{
+				Config: testAccAzureRMFirewallNatRuleCollection_multipleRules(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMFirewallNatRuleCollectionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "priority", "200"),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "2"),
+				),
+			},
+			{
+				Config: testAccAzureRMFirewallNatRuleCollection_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMFirewallNatRuleCollectionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "priority", "100"),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMFirewallNatRuleCollection_multipleRuleCollections(t *testing.T) {
+	firstResourceName := "azurerm_firewall_nat_rule_collection.test"
+	secondResourceName := "azurerm_firewall_nat_rule_collection.test_add"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMFirewallDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMFirewallNatRuleCollection_multipleRuleCollections(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMFirewallNatRuleCollectionExists(firstResourceName),
+					testCheckAzureRMFirewallNatRuleCollectionExists(secondResourceName),
+				),
+			},
+			{
+				Config: testAccAzureRMFirewallNatRuleCollection_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMFirewallNatRuleCollectionExists(firstResourceName),
+					testCheckAzureRMFirewallNatRuleCollectionDoesNotExist("azurerm_firewall.test", "acctestnatrc_add"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMFirewallNatRuleCollection_disappears(t *testing.T) {
+	resourceName := "azurerm_firewall_nat_rule_collection.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMFirewallDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMFirewallNatRuleCollection_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMFirewallNatRuleCollectionExists(resourceName),
+					testCheckAzureRMFirewallNatRuleCollectionDisappears(resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMFirewallNatRuleCollectionExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		firewallName := rs.Primary.Attributes["azure_firewall_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		client := testAccProvider.Meta().(*ArmClient).azureFirewallsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+		read, err := client.Get(ctx, resourceGroup, firewallName)
+		if err != nil {
+			return err
+		}
+
+		found := false
+		if collections := read.AzureFirewallPropertiesFormat.NatRuleCollections; collections != nil {
+			for _, collection := range *collections {
+				if *collection.Name == name {
+					found = true
+					break
+				}
+			}
+		}
+
+		if !found {
+			return fmt.Errorf("Expected NAT Rule Collection %q (Firewall %q / Resource Group %q) to exist but it didn't", name, firewallName, resourceGroup)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMFirewallNatRuleCollectionDoesNotExist(resourceName string, collectionName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		firewallName := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		client := testAccProvider.Meta().(*ArmClient).azureFirewallsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+		read, err := client.Get(ctx, resourceGroup, firewallName)
+		if err != nil {
+			return err
+		}
+
+		if collections := read.AzureFirewallPropertiesFormat.NatRuleCollections; collections != nil {
+			for _, collection := range *collections {
+				if *collection.Name == collectionName {
+					return fmt.Errorf("NAT Rule Collection %q exists in Firewall %q: %+v", collectionName, firewallName, collection)
+				}
+			}
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMFirewallNatRuleCollectionDisappears(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		firewallName := rs.Primary.Attributes["azure_firewall_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		client := testAccProvider.Meta().(*ArmClient).azureFirewallsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+		read, err := client.Get(ctx, resourceGroup, firewallName)
+		if err != nil {
+			return err
+		}
+
+		rules := make([]network.AzureFirewallNatRuleCollection, 0)
+		for _, collection := range *read.AzureFirewallPropertiesFormat.NatRuleCollections {
+			if *collection.Name != name {
+				rules = append(rules, collection)
+			}
+		}
+
+		read.AzureFirewallPropertiesFormat.NatRuleCollections = &rules
+
+		future, err := client.CreateOrUpdate(ctx, resourceGroup, firewallName, read)
+		if err != nil {
+			return fmt.Errorf("Error removing NAT Rule Collection from Firewall: %+v", err)
+		}
+
+		if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("Error waiting for the removal of NAT Rule Collection from Firewall: %+v", err)
+		}
+
+		_, err = client.Get(ctx, resourceGroup, firewallName)
+		return err
+	}
+}
+
+func testAccAzureRMFirewallNatRuleCollection_basic(rInt int, location string) string {
+	template := testAccAzureRMFirewall_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_firewall_nat_rule_collection" "test" {
+  name                = "acctestnatrc"
+  azure_firewall_name = "${azurerm_firewall.test.name}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  priority            = 100
+  action              = "Dnat"
+
+  rule {
+    name = "rule1"
+
+    source_addresses = [
+      "10.0.0.0/16",
+    ]
+
+    destination_ports = [
+      "53",
+    ]
+
+    destination_addresses = [
+      "${azurerm_public_ip.test.ip_address}",
+    ]
+
+    protocols = [
+      "TCP",
+      "UDP",
+    ]
+
+    translated_port    = "53"
+    translated_address = "8.8.8.8"
+  }
+}
+`, template)
+}
+
+func testAccAzureRMFirewallNatRuleCollection_requiresImport(rInt int, location string) string {
+	template := testAccAzureRMFirewallNatRuleCollection_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_firewall_nat_rule_collection" "import" {
+  name                = "${azurerm_firewall_nat_rule_collection.test.name}"
+  azure_firewall_name = "${azurerm_firewall_nat_rule_collection.test.azure_firewall_name}"
+  resource_group_name = "${azurerm_firewall_nat_rule_collection.test.resource_group_name}"
+  priority            = 100
+  action              = "Dnat"
+
+  rule {
+    name = "rule1"
+
+    source_addresses = [
+      "10.0.0.0/16",
+    ]
+
+    destination_ports = [
+      "53",
+    ]
+
+    destination_addresses = [
+      "${azurerm_public_ip.test.ip_address}",
+    ]
+
+    protocols = [
+      "TCP",
+      "UDP",
+    ]
+
+    translated_port    = "53"
+    translated_address = "8.8.8.8"
+  }
+}
+`, template)
+}
+
+func testAccAzureRMFirewallNatRuleCollection_multipleRules(rInt int, location string) string {
+	template := testAccAzureRMFirewall_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_firewall_nat_rule_collection" "test" {
+  name                = "acctestnatrc"
+  azure_firewall_name = "${azurerm_firewall.test.name}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  priority            = 200
+  action              = "Dnat"
+
+  rule {
+    name = "rule1"
+
+    source_addresses = [
+      "10.0.0.0/16",
+    ]
+
+    destination_ports = [
+      "53",
+    ]
+
+    destination_addresses = [
+      "${azurerm_public_ip.test.ip_address}",
+    ]
+
+    protocols = [
+      "TCP",
+      "UDP",
+    ]
+
+    translated_port    = "53"
+    translated_address = "8.8.8.8"
+  }
+
+  rule {
+    name        = "rule2"
+    description = "publishes the web server"
+
+    source_addresses = [
+      "*",
+    ]
+
+    destination_ports = [
+      "443",
+    ]
+
+    destination_addresses = [
+      "${azurerm_public_ip.test.ip_address}",
+    ]
+
+    protocols = [
+      "TCP",
+    ]
+
+    translated_port    = "8443"
+    translated_address = "10.0.2.4"
+  }
+}
+`, template)
+}
+
+func testAccAzureRMFirewallNatRuleCollection_multipleRuleCollections(rInt int, location string) string {
+	template := testAccAzureRMFirewallNatRuleCollection_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_firewall_nat_rule_collection" "test_add" {
+  name                = "acctestnatrc_add"
+  azure_firewall_name = "${azurerm_firewall.test.name}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  priority            = 200
+  action              = "Dnat"
+
+  rule {
+    name = "acctestruleadd"
+
+    source_addresses = [
+      "10.0.0.0/8",
+    ]
+
+    destination_ports = [
+      "8080",
+    ]
+
+    destination_addresses = [
+      "${azurerm_public_ip.test.ip_address}",
+    ]
+
+    protocols = [
+      "TCP",
+    ]
+
+    translated_port    = "80"
+    translated_address = "10.0.2.5"
+  }
+}
+`, template)
+}
@@ -2,10 +2,13 @@ package azurerm
 
 import (
 	"fmt"
+	"reflect"
 	"strings"
 	"testing"
 
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-08-01/network"
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
@@ -47,6 +50,119 @@ func TestValidateFirewallName(t *testing.T) {
 	}
 }
 
+func TestValidateFirewallManagementSubnetID(t *testing.T) {
+	validIDs := []string{
+		"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/subnets/AzureFirewallManagementSubnet",
+		"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/subnets/azurefirewallmanagementsubnet",
+	}
+	for _, v := range validIDs {
+		_, errors := validateAzureFirewallManagementSubnetID(v, "subnet_id")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid Firewall Management Subnet ID: %q", v, errors)
+		}
+	}
+
+	invalidIDs := []string{
+		"",
+		"AzureFirewallManagementSubnet",
+		"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1",
+		"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/subnets/AzureFirewallSubnet",
+	}
+	for _, v := range invalidIDs {
+		_, errors := validateAzureFirewallManagementSubnetID(v, "subnet_id")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid Firewall Management Subnet ID", v)
+		}
+	}
+}
+
+func TestFirewallManagementIPConfigurationRoundTrip(t *testing.T) {
+	subnetId := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/subnets/AzureFirewallManagementSubnet"
+	publicIPAddressId := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/publicIPAddresses/management"
+
+	cases := []struct {
+		Name     string
+		Input    *network.AzureFirewallIPConfiguration
+		Expected []interface{}
+	}{
+		{
+			Name:     "None",
+			Input:    nil,
+			Expected: []interface{}{},
+		},
+		{
+			Name: "Configured",
+			Input: &network.AzureFirewallIPConfiguration{
+				Name: utils.String("management"),
+				AzureFirewallIPConfigurationPropertiesFormat: &network.AzureFirewallIPConfigurationPropertiesFormat{
+					Subnet:           &network.SubResource{ID: utils.String(subnetId)},
+					PublicIPAddress:  &network.SubResource{ID: utils.String(publicIPAddressId)},
+					PrivateIPAddress: utils.String("10.0.2.4"),
+				},
+			},
+			Expected: []interface{}{
+				map[string]interface{}{
+					"name":                 "management",
+					"subnet_id":            subnetId,
+					"public_ip_address_id": publicIPAddressId,
+					"private_ip_address":   "10.0.2.4",
+				},
+			},
+		},
+	}
+
+	for _, v := range cases {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		flattened := flattenArmFirewallManagementIPConfiguration(v.Input)
+		if !reflect.DeepEqual(v.Expected, flattened) {
+			t.Fatalf("Expected %+v but got %+v", v.Expected, flattened)
+		}
+
+		// the Private IP Address is Computed, so can't be specified
+		config := make([]interface{}, 0)
+		for _, item := range flattened {
+			values := make(map[string]interface{})
+			for key, value := range item.(map[string]interface{}) {
+				if key != "private_ip_address" {
+					values[key] = value
+				}
+			}
+			config = append(config, values)
+		}
+
+		d := schema.TestResourceDataRaw(t, resourceArmFirewall().Schema, map[string]interface{}{
+			"management_ip_configuration": config,
+		})
+		subnetNamesToLock := make([]string, 0)
+		virtualNetworkNamesToLock := make([]string, 0)
+		actual, err := expandArmFirewallManagementIPConfiguration(d, &subnetNamesToLock, &virtualNetworkNamesToLock)
+		if err != nil {
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+
+		if v.Input == nil {
+			if actual != nil {
+				t.Fatalf("Expected no Management IP Configuration but got %+v", actual)
+			}
+			continue
+		}
+
+		// the Private IP Address is assigned by Azure, so isn't sent
+		expected := *v.Input
+		properties := *expected.AzureFirewallIPConfigurationPropertiesFormat
+		properties.PrivateIPAddress = nil
+		expected.AzureFirewallIPConfigurationPropertiesFormat = &properties
+		if !reflect.DeepEqual(&expected, actual) {
+			t.Fatalf("Expected %+v but got %+v", expected, actual)
+		}
+
+		if !reflect.DeepEqual(subnetNamesToLock, []string{"AzureFirewallManagementSubnet"}) || !reflect.DeepEqual(virtualNetworkNamesToLock, []string{"network1"}) {
+			t.Fatalf("Expected the Subnet and Virtual Network to be locked but got %+v and %+v", subnetNamesToLock, virtualNetworkNamesToLock)
+		}
+	}
+}
+
 func TestAccAzureRMFirewall_basicOld(t *testing.T) {
 	resourceName := "azurerm_firewall.test"
 	ri := tf.AccRandTimeInt()
@@ -175,6 +291,100 @@ func TestAccAzureRMFirewall_withTags(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMFirewall_threatIntelMode(t *testing.T) {
+	resourceName := "azurerm_firewall.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMFirewallDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMFirewall_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMFirewallExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "threat_intel_mode", "Alert"),
+				),
+			},
+			{
+				Config: testAccAzureRMFirewall_threatIntelMode(ri, location, "Deny"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMFirewallExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "threat_intel_mode", "Deny"),
+				),
+			},
+			{
+				Config: testAccAzureRMFirewall_threatIntelMode(ri, location, "Off"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMFirewallExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "threat_intel_mode", "Off"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMFirewall_withZones(t *testing.T) {
+	resourceName := "azurerm_firewall.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMFirewallDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMFirewall_withZones(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMFirewallExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "zones.#", "3"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMFirewall_withManagementIPConfiguration(t *testing.T) {
+	resourceName := "azurerm_firewall.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMFirewallDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMFirewall_withManagementIPConfiguration(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMFirewallExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "management_ip_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "management_ip_configuration.0.name", "management"),
+					resource.TestCheckResourceAttrSet(resourceName, "management_ip_configuration.0.private_ip_address"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccAzureRMFirewall_disappears(t *testing.T) {
 	resourceName := "azurerm_firewall.test"
 	ri := tf.AccRandTimeInt()
@@ -480,3 +690,155 @@ resource "azurerm_firewall" "test" {
 }
 `, rInt, location, rInt, rInt, rInt)
 }
+
+func testAccAzureRMFirewall_threatIntelMode(rInt int, location string, threatIntelMode string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvirtnet%d"
+  address_space       = ["10.0.0.0/16"]
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "AzureFirewallSubnet"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  virtual_network_name = "${azurerm_virtual_network.test.name}"
+  address_prefix       = "10.0.1.0/24"
+}
+
+resource "azurerm_public_ip" "test" {
+  name                = "acctestpip%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  allocation_method   = "Static"
+  sku                 = "Standard"
+}
+
+resource "azurerm_firewall" "test" {
+  name                = "acctestfirewall%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  threat_intel_mode   = "%s"
+
+  ip_configuration {
+    name                 = "configuration"
+    subnet_id            = "${azurerm_subnet.test.id}"
+    public_ip_address_id = "${azurerm_public_ip.test.id}"
+  }
+}
+`, rInt, location, rInt, rInt, rInt, threatIntelMode)
+}
+
+func testAccAzureRMFirewall_withZones(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvirtnet%d"
+  address_space       = ["10.0.0.0/16"]
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "AzureFirewallSubnet"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  virtual_network_name = "${azurerm_virtual_network.test.name}"
+  address_prefix       = "10.0.1.0/24"
+}
+
+resource "azurerm_public_ip" "test" {
+  name                = "acctestpip%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  allocation_method   = "Static"
+  sku                 = "Standard"
+}
+
+resource "azurerm_firewall" "test" {
+  name                = "acctestfirewall%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  zones               = ["1", "2", "3"]
+
+  ip_configuration {
+    name                 = "configuration"
+    subnet_id            = "${azurerm_subnet.test.id}"
+    public_ip_address_id = "${azurerm_public_ip.test.id}"
+  }
+}
+`, rInt, location, rInt, rInt, rInt)
+}
+
+func testAccAzureRMFirewall_withManagementIPConfiguration(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvirtnet%d"
+  address_space       = ["10.0.0.0/16"]
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "AzureFirewallSubnet"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  virtual_network_name = "${azurerm_virtual_network.test.name}"
+  address_prefix       = "10.0.1.0/24"
+}
+
+resource "azurerm_subnet" "management" {
+  name                 = "AzureFirewallManagementSubnet"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  virtual_network_name = "${azurerm_virtual_network.test.name}"
+  address_prefix       = "10.0.2.0/24"
+}
+
+resource "azurerm_public_ip" "test" {
+  name                = "acctestpip%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  allocation_method   = "Static"
+  sku                 = "Standard"
+}
+
+resource "azurerm_public_ip" "management" {
+  name                = "acctestpipmgmt%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  allocation_method   = "Static"
+  sku                 = "Standard"
+}
+
+resource "azurerm_firewall" "test" {
+  name                = "acctestfirewall%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  ip_configuration {
+    name                 = "configuration"
+    subnet_id            = "${azurerm_subnet.test.id}"
+    public_ip_address_id = "${azurerm_public_ip.test.id}"
+  }
+
+  management_ip_configuration {
+    name                 = "management"
+    subnet_id            = "${azurerm_subnet.management.id}"
+    public_ip_address_id = "${azurerm_public_ip.management.id}"
+  }
+}
+`, rInt, location, rInt, rInt, rInt, rInt)
+}
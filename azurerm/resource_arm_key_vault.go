@@ -1,10 +1,12 @@
 package azurerm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net/http"
 	"regexp"
+	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/keyvault/mgmt/2018-02-14/keyvault"
@@ -16,6 +18,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/set"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -33,13 +36,20 @@ func resourceArmKeyVault() *schema.Resource {
 		Update: resourceArmKeyVaultCreateUpdate,
 		Delete: resourceArmKeyVaultDelete,
 
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Importer: azure.ValidateResourceIDPriorToImport(azure.ValidateKeyVaultID),
 
 		MigrateState:  resourceAzureRMKeyVaultMigrateState,
 		SchemaVersion: 1,
 
+		CustomizeDiff: azure.KeyVaultCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -124,6 +134,16 @@ func resourceArmKeyVault() *schema.Resource {
 				Optional: true,
 			},
 
+			"soft_delete_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"purge_protection_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
 			"network_acls": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -169,13 +189,14 @@ func resourceArmKeyVault() *schema.Resource {
 
 func resourceArmKeyVaultCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).keyVaultClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	log.Printf("[INFO] preparing arguments for Azure ARM KeyVault creation.")
 
 	name := d.Get("name").(string)
 	resourceGroup := d.Get("resource_group_name").(string)
 
-	if requireResourcesToBeImported && d.IsNewResource() {
+	if meta.(*ArmClient).requireResourcesToBeImported && d.IsNewResource() {
 		existing, err := client.Get(ctx, resourceGroup, name)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
@@ -189,6 +210,35 @@ func resourceArmKeyVaultCreateUpdate(d *schema.ResourceData, meta interface{}) e
 	}
 
 	location := azureRMNormalizeLocation(d.Get("location").(string))
+
+	// a Key Vault which has been soft-deleted prevents a new one being created with the same name in this Location
+	recoverSoftDeleted := false
+	if d.IsNewResource() {
+		deleted, err := client.GetDeleted(ctx, name, location)
+		if err != nil {
+			if !utils.ResponseWasNotFound(deleted.Response) {
+				return fmt.Errorf("Error checking for the presence of a soft-deleted Key Vault %q (Location %q): %+v", name, location, err)
+			}
+		} else {
+			if !meta.(*ArmClient).keyVaultFeatures.recoverSoftDeleted {
+				return fmt.Errorf("A soft-deleted Key Vault %q exists in %q which must be recovered or purged before it can be created - alternatively set `recover_soft_deleted_key_vaults` to `true` within the `key_vault` block of the Provider to recover it automatically", name, location)
+			}
+
+			if props := deleted.Properties; props != nil && props.VaultID != nil {
+				deletedId, err := parseAzureResourceID(*props.VaultID)
+				if err != nil {
+					return err
+				}
+
+				if !strings.EqualFold(deletedId.ResourceGroup, resourceGroup) {
+					return fmt.Errorf("A soft-deleted Key Vault %q exists in Resource Group %q which must be purged before it can be created in Resource Group %q", name, deletedId.ResourceGroup, resourceGroup)
+				}
+			}
+
+			recoverSoftDeleted = true
+		}
+	}
+
 	tenantUUID := uuid.FromStringOrNil(d.Get("tenant_id").(string))
 	enabledForDeployment := d.Get("enabled_for_deployment").(bool)
 	enabledForDiskEncryption := d.Get("enabled_for_disk_encryption").(bool)
@@ -218,6 +268,14 @@ func resourceArmKeyVaultCreateUpdate(d *schema.ResourceData, meta interface{}) e
 		Tags: expandTags(tags),
 	}
 
+	// neither Soft Delete or Purge Protection can be disabled once they're enabled, so these are only sent when enabled
+	if d.Get("soft_delete_enabled").(bool) {
+		parameters.Properties.EnableSoftDelete = utils.Bool(true)
+	}
+	if d.Get("purge_protection_enabled").(bool) {
+		parameters.Properties.EnablePurgeProtection = utils.Bool(true)
+	}
+
 	// Locking this resource so we don't make modifications to it at the same time if there is a
 	// key vault access policy trying to update it as well
 	azureRMLockByName(name, keyVaultResourceName)
@@ -240,6 +298,29 @@ func resourceArmKeyVaultCreateUpdate(d *schema.ResourceData, meta interface{}) e
 	azureRMLockMultipleByName(&virtualNetworkNames, virtualNetworkResourceName)
 	defer azureRMUnlockMultipleByName(&virtualNetworkNames, virtualNetworkResourceName)
 
+	if recoverSoftDeleted {
+		log.Printf("[DEBUG] Recovering soft-deleted Key Vault %q (Resource Group %q)", name, resourceGroup)
+		recoverParameters := keyvault.VaultCreateOrUpdateParameters{
+			Location: &location,
+			Properties: &keyvault.VaultProperties{
+				TenantID:   &tenantUUID,
+				Sku:        expandKeyVaultSku(d),
+				CreateMode: keyvault.CreateModeRecover,
+			},
+		}
+
+		future, err := client.CreateOrUpdate(ctx, resourceGroup, name, recoverParameters)
+		if err != nil {
+			return fmt.Errorf("Error recovering soft-deleted Key Vault %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+
+		if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("Error waiting for soft-deleted Key Vault %q (Resource Group %q) to be recovered: %+v", name, resourceGroup, err)
+		}
+
+		// the recovered Key Vault has its original configuration, which is then updated to match the config below
+	}
+
 	if _, err = client.CreateOrUpdate(ctx, resourceGroup, name, parameters); err != nil {
 		return fmt.Errorf("Error updating Key Vault %q (Resource Group %q): %+v", name, resourceGroup, err)
 	}
@@ -280,7 +361,8 @@ func resourceArmKeyVaultCreateUpdate(d *schema.ResourceData, meta interface{}) e
 
 func resourceArmKeyVaultRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).keyVaultClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -312,6 +394,18 @@ func resourceArmKeyVaultRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("enabled_for_template_deployment", props.EnabledForTemplateDeployment)
 		d.Set("vault_uri", props.VaultURI)
 
+		softDeleteEnabled := false
+		if props.EnableSoftDelete != nil {
+			softDeleteEnabled = *props.EnableSoftDelete
+		}
+		d.Set("soft_delete_enabled", softDeleteEnabled)
+
+		purgeProtectionEnabled := false
+		if props.EnablePurgeProtection != nil {
+			purgeProtectionEnabled = *props.EnablePurgeProtection
+		}
+		d.Set("purge_protection_enabled", purgeProtectionEnabled)
+
 		if err := d.Set("sku", flattenKeyVaultSku(props.Sku)); err != nil {
 			return fmt.Errorf("Error setting `sku` for KeyVault %q: %+v", *resp.Name, err)
 		}
@@ -332,7 +426,8 @@ func resourceArmKeyVaultRead(d *schema.ResourceData, meta interface{}) error {
 
 func resourceArmKeyVaultDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).keyVaultClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -387,6 +482,45 @@ func resourceArmKeyVaultDelete(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	if !meta.(*ArmClient).keyVaultFeatures.purgeSoftDeleteOnDestroy {
+		return nil
+	}
+
+	softDeleteEnabled := false
+	purgeProtectionEnabled := false
+	if props := read.Properties; props != nil {
+		if props.EnableSoftDelete != nil {
+			softDeleteEnabled = *props.EnableSoftDelete
+		}
+		if props.EnablePurgeProtection != nil {
+			purgeProtectionEnabled = *props.EnablePurgeProtection
+		}
+	}
+
+	if !softDeleteEnabled {
+		return nil
+	}
+
+	if purgeProtectionEnabled {
+		log.Printf("[DEBUG] Purge Protection is enabled for Key Vault %q (Resource Group %q) - skipping purging it", name, resourceGroup)
+		return nil
+	}
+
+	location := ""
+	if read.Location != nil {
+		location = azureRMNormalizeLocation(*read.Location)
+	}
+
+	log.Printf("[DEBUG] Purging soft-deleted Key Vault %q (Location %q)", name, location)
+	future, err := client.PurgeDeleted(ctx, name, location)
+	if err != nil {
+		return fmt.Errorf("Error purging soft-deleted Key Vault %q (Location %q): %+v", name, location, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for soft-deleted Key Vault %q (Location %q) to be purged: %+v", name, location, err)
+	}
+
 	return nil
 }
 
@@ -517,3 +651,56 @@ func expandKeyVaultNetworkAcls(input []interface{}) (*keyvault.NetworkRuleSet, [
 	}
 	return &ruleSet, subnetIds
 }
+
+// recoverSoftDeletedKeyVaultChild recovers a soft-deleted Certificate, Key or Secret with the same name prior to it
+// being created (since one can't be created until it's been recovered or purged) - unless this is disabled in the Provider
+func recoverSoftDeletedKeyVaultChild(ctx context.Context, meta interface{}, childType azure.KeyVaultChildType, keyVaultId, keyVaultBaseUrl, name string) error {
+	armClient := meta.(*ArmClient)
+
+	// when the Key Vault can't be found (e.g. it's only referenced by `vault_uri`) there's nothing to check
+	if keyVaultId == "" {
+		return nil
+	}
+
+	softDeleteEnabled, _, err := azure.KeyVaultSoftDeleteEnabled(ctx, armClient.keyVaultClient, keyVaultId)
+	if err != nil {
+		return fmt.Errorf("Error checking if Soft Delete is enabled for Key Vault %q: %+v", keyVaultId, err)
+	}
+	if !softDeleteEnabled {
+		return nil
+	}
+
+	deleted, err := azure.KeyVaultChildIsSoftDeleted(ctx, armClient.keyVaultManagementClient, childType, keyVaultBaseUrl, name)
+	if err != nil || !deleted {
+		return err
+	}
+
+	if !armClient.keyVaultFeatures.recoverSoftDeleted {
+		return fmt.Errorf("A soft-deleted %s %q exists in Key Vault %q which must be recovered or purged before it can be created - alternatively set `recover_soft_deleted_key_vaults` to `true` within the `key_vault` block of the Provider to recover it automatically", childType, name, keyVaultBaseUrl)
+	}
+
+	return azure.RecoverSoftDeletedKeyVaultChild(ctx, armClient.keyVaultManagementClient, childType, keyVaultBaseUrl, name)
+}
+
+// purgeSoftDeletedKeyVaultChild purges a Certificate, Key or Secret once it's been deleted when this is enabled in the
+// Provider - providing Soft Delete is enabled and Purge Protection isn't enabled for the Key Vault
+func purgeSoftDeletedKeyVaultChild(ctx context.Context, meta interface{}, childType azure.KeyVaultChildType, keyVaultId, keyVaultBaseUrl, name string) error {
+	armClient := meta.(*ArmClient)
+	if !armClient.keyVaultFeatures.purgeSoftDeleteOnDestroy {
+		return nil
+	}
+
+	softDeleteEnabled, purgeProtectionEnabled, err := azure.KeyVaultSoftDeleteEnabled(ctx, armClient.keyVaultClient, keyVaultId)
+	if err != nil {
+		return fmt.Errorf("Error checking if Soft Delete is enabled for Key Vault %q: %+v", keyVaultId, err)
+	}
+	if !softDeleteEnabled {
+		return nil
+	}
+	if purgeProtectionEnabled {
+		log.Printf("[DEBUG] Purge Protection is enabled for Key Vault %q - skipping purging %s %q", keyVaultId, childType, name)
+		return nil
+	}
+
+	return azure.PurgeSoftDeletedKeyVaultChild(ctx, armClient.keyVaultManagementClient, childType, keyVaultBaseUrl, name)
+}
@@ -14,12 +14,14 @@ import (
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
-//todo refactor and find a home for this wayward func
+// todo refactor and find a home for this wayward func
 func resourceArmKeyVaultChildResourceImporter(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	client := meta.(*ArmClient).keyVaultClient
 	ctx := meta.(*ArmClient).StopContext
@@ -50,6 +52,12 @@ func resourceArmKeyVaultCertificate() *schema.Resource {
 			State: resourceArmKeyVaultChildResourceImporter,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -59,12 +67,13 @@ func resourceArmKeyVaultCertificate() *schema.Resource {
 			},
 
 			"key_vault_id": {
-				Type:          schema.TypeString,
-				Optional:      true, //todo required in 2.0
-				Computed:      true, //todo removed in 2.0
-				ForceNew:      true,
-				ValidateFunc:  azure.ValidateResourceID,
-				ConflictsWith: []string{"vault_uri"},
+				Type:             schema.TypeString,
+				Optional:         true, //todo required in 2.0
+				Computed:         true, //todo removed in 2.0
+				ForceNew:         true,
+				ValidateFunc:     azure.ValidateKeyVaultID,
+				DiffSuppressFunc: suppress.CaseDifference,
+				ConflictsWith:    []string{"vault_uri"},
 			},
 
 			//todo remove in 2.0
@@ -336,7 +345,8 @@ func resourceArmKeyVaultCertificate() *schema.Resource {
 func resourceArmKeyVaultCertificateCreate(d *schema.ResourceData, meta interface{}) error {
 	vaultClient := meta.(*ArmClient).keyVaultClient
 	client := meta.(*ArmClient).keyVaultManagementClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	keyVaultId := d.Get("key_vault_id").(string)
@@ -361,7 +371,7 @@ func resourceArmKeyVaultCertificateCreate(d *schema.ResourceData, meta interface
 		d.Set("key_vault_id", id)
 	}
 
-	if requireResourcesToBeImported {
+	if meta.(*ArmClient).requireResourcesToBeImported {
 		existing, err := client.GetCertificate(ctx, keyVaultBaseUrl, name, "")
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
@@ -374,6 +384,10 @@ func resourceArmKeyVaultCertificateCreate(d *schema.ResourceData, meta interface
 		}
 	}
 
+	if err := recoverSoftDeletedKeyVaultChild(ctx, meta, azure.KeyVaultChildTypeCertificate, d.Get("key_vault_id").(string), keyVaultBaseUrl, name); err != nil {
+		return err
+	}
+
 	tags := d.Get("tags").(map[string]interface{})
 	policy := expandKeyVaultCertificatePolicy(d)
 
@@ -404,7 +418,7 @@ func resourceArmKeyVaultCertificateCreate(d *schema.ResourceData, meta interface
 			Pending:    []string{"Provisioning"},
 			Target:     []string{"Ready"},
 			Refresh:    keyVaultCertificateCreationRefreshFunc(ctx, client, keyVaultBaseUrl, name),
-			Timeout:    60 * time.Minute,
+			Timeout:    d.Timeout(schema.TimeoutCreate),
 			MinTimeout: 15 * time.Second,
 		}
 		if _, err := stateConf.WaitForState(); err != nil {
@@ -440,7 +454,8 @@ func keyVaultCertificateCreationRefreshFunc(ctx context.Context, client keyvault
 func resourceArmKeyVaultCertificateRead(d *schema.ResourceData, meta interface{}) error {
 	keyVaultClient := meta.(*ArmClient).keyVaultClient
 	client := meta.(*ArmClient).keyVaultManagementClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseKeyVaultChildID(d.Id())
 	if err != nil {
@@ -478,6 +493,26 @@ func resourceArmKeyVaultCertificateRead(d *schema.ResourceData, meta interface{}
 		return fmt.Errorf("Error reading Key Vault Certificate: %+v", err)
 	}
 
+	// the latest version of the Certificate is tracked, so that versions created by auto-renewal (as configured in
+	// the `lifetime_action` of the `certificate_policy`) are picked up without the Certificate being replaced
+	if cert.ID != nil && *cert.ID != d.Id() {
+		if cert.Sid == nil || *cert.Sid == "" {
+			// the latest version is still being issued, so the current version is used until it's available
+			log.Printf("[DEBUG] The latest version of Certificate %q in Key Vault at URI %q is still being issued", id.Name, id.KeyVaultBaseUrl)
+			cert, err = client.GetCertificate(ctx, id.KeyVaultBaseUrl, id.Name, id.Version)
+			if err != nil {
+				return fmt.Errorf("Error reading version %q of Key Vault Certificate %q: %+v", id.Version, id.Name, err)
+			}
+		} else {
+			log.Printf("[DEBUG] The latest version of Certificate %q in Key Vault at URI %q is %q", id.Name, id.KeyVaultBaseUrl, *cert.ID)
+			d.SetId(*cert.ID)
+
+			if id, err = azure.ParseKeyVaultChildID(*cert.ID); err != nil {
+				return err
+			}
+		}
+	}
+
 	d.Set("name", id.Name)
 	d.Set("vault_uri", id.KeyVaultBaseUrl)
 
@@ -510,7 +545,8 @@ func resourceArmKeyVaultCertificateRead(d *schema.ResourceData, meta interface{}
 func resourceArmKeyVaultCertificateDelete(d *schema.ResourceData, meta interface{}) error {
 	keyVaultClient := meta.(*ArmClient).keyVaultClient
 	client := meta.(*ArmClient).keyVaultManagementClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseKeyVaultChildID(d.Id())
 	if err != nil {
@@ -544,7 +580,7 @@ func resourceArmKeyVaultCertificateDelete(d *schema.ResourceData, meta interface
 		return fmt.Errorf("Error deleting Certificate %q from Key Vault: %+v", id.Name, err)
 	}
 
-	return nil
+	return purgeSoftDeletedKeyVaultChild(ctx, meta, azure.KeyVaultChildTypeCertificate, *keyVaultId, id.KeyVaultBaseUrl, id.Name)
 }
 
 func expandKeyVaultCertificatePolicy(d *schema.ResourceData) keyvault.CertificatePolicy {
@@ -4,9 +4,11 @@ import (
 	"fmt"
 	"log"
 	"testing"
+	"time"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 
+	"github.com/Azure/azure-sdk-for-go/services/keyvault/2016-10-01/keyvault"
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
@@ -167,6 +169,37 @@ func TestAccAzureRMKeyVaultCertificate_basicGenerate(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMKeyVaultCertificate_renewedVersion(t *testing.T) {
+	resourceName := "azurerm_key_vault_certificate.test"
+	rs := acctest.RandString(6)
+	config := testAccAzureRMKeyVaultCertificate_basicGenerate(rs, testLocation())
+	var version string
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMKeyVaultCertificateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKeyVaultCertificateExists(resourceName),
+					testCheckAzureRMKeyVaultCertificateRenew(resourceName, &version),
+				),
+			},
+			{
+				// the renewed version is picked up without the Certificate being replaced
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKeyVaultCertificateExists(resourceName),
+					testCheckAzureRMKeyVaultCertificateVersionChanged(resourceName, &version),
+					resource.TestCheckResourceAttrSet(resourceName, "thumbprint"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAzureRMKeyVaultCertificate_basicGenerateSans(t *testing.T) {
 	resourceName := "azurerm_key_vault_certificate.test"
 	rs := acctest.RandString(6)
@@ -318,6 +351,56 @@ func testCheckAzureRMKeyVaultCertificateExists(resourceName string) resource.Tes
 	}
 }
 
+// testCheckAzureRMKeyVaultCertificateRenew creates a new version of the Certificate using its existing policy, in the
+// same way as auto-renewal - recording the version which was current beforehand
+func testCheckAzureRMKeyVaultCertificateRenew(resourceName string, version *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*ArmClient).keyVaultManagementClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		vaultBaseUrl := rs.Primary.Attributes["vault_uri"]
+		*version = rs.Primary.Attributes["version"]
+
+		if _, err := client.CreateCertificate(ctx, vaultBaseUrl, name, keyvault.CertificateCreateParameters{}); err != nil {
+			return fmt.Errorf("Bad: Error renewing Key Vault Certificate %q (Vault %q): %+v", name, vaultBaseUrl, err)
+		}
+
+		stateConf := &resource.StateChangeConf{
+			Pending:    []string{"Provisioning"},
+			Target:     []string{"Ready"},
+			Refresh:    keyVaultCertificateCreationRefreshFunc(ctx, client, vaultBaseUrl, name),
+			Timeout:    10 * time.Minute,
+			MinTimeout: 15 * time.Second,
+		}
+		if _, err := stateConf.WaitForState(); err != nil {
+			return fmt.Errorf("Bad: Error waiting for renewed Key Vault Certificate %q (Vault %q) to be issued: %+v", name, vaultBaseUrl, err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMKeyVaultCertificateVersionChanged(resourceName string, previousVersion *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		if version := rs.Primary.Attributes["version"]; version == *previousVersion {
+			return fmt.Errorf("Bad: Expected the version of Key Vault Certificate %q to have changed from %q", rs.Primary.Attributes["name"], version)
+		}
+
+		return nil
+	}
+}
+
 func testCheckAzureRMKeyVaultCertificateDisappears(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		client := testAccProvider.Meta().(*ArmClient).keyVaultManagementClient
@@ -3,12 +3,15 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/keyvault/2016-10-01/keyvault"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
@@ -23,6 +26,15 @@ func resourceArmKeyVaultKey() *schema.Resource {
 			State: resourceArmKeyVaultChildResourceImporter,
 		},
 
+		CustomizeDiff: azure.KeyVaultKeyRotationCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -32,12 +44,13 @@ func resourceArmKeyVaultKey() *schema.Resource {
 			},
 
 			"key_vault_id": {
-				Type:          schema.TypeString,
-				Optional:      true, //todo required in 2.0
-				Computed:      true, //todo removed in 2.0
-				ForceNew:      true,
-				ValidateFunc:  azure.ValidateResourceID,
-				ConflictsWith: []string{"vault_uri"},
+				Type:             schema.TypeString,
+				Optional:         true, //todo required in 2.0
+				Computed:         true, //todo removed in 2.0
+				ForceNew:         true,
+				ValidateFunc:     azure.ValidateKeyVaultID,
+				DiffSuppressFunc: suppress.CaseDifference,
+				ConflictsWith:    []string{"vault_uri"},
 			},
 
 			//todo remove in 2.0
@@ -90,12 +103,32 @@ func resourceArmKeyVaultKey() *schema.Resource {
 				},
 			},
 
+			"rotation_policy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"rotate_after_days": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+
 			// Computed
 			"version": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
 
+			"created": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
 			"n": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -114,7 +147,8 @@ func resourceArmKeyVaultKey() *schema.Resource {
 func resourceArmKeyVaultKeyCreate(d *schema.ResourceData, meta interface{}) error {
 	vaultClient := meta.(*ArmClient).keyVaultClient
 	client := meta.(*ArmClient).keyVaultManagementClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Print("[INFO] preparing arguments for AzureRM KeyVault Key creation.")
 	name := d.Get("name").(string)
@@ -140,7 +174,7 @@ func resourceArmKeyVaultKeyCreate(d *schema.ResourceData, meta interface{}) erro
 		d.Set("key_vault_id", id)
 	}
 
-	if requireResourcesToBeImported {
+	if meta.(*ArmClient).requireResourcesToBeImported {
 		existing, err := client.GetKey(ctx, keyVaultBaseUri, name, "")
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
@@ -153,21 +187,13 @@ func resourceArmKeyVaultKeyCreate(d *schema.ResourceData, meta interface{}) erro
 		}
 	}
 
-	keyType := d.Get("key_type").(string)
-	keyOptions := expandKeyVaultKeyOptions(d)
-	tags := d.Get("tags").(map[string]interface{})
+	if err := recoverSoftDeletedKeyVaultChild(ctx, meta, azure.KeyVaultChildTypeKey, d.Get("key_vault_id").(string), keyVaultBaseUri, name); err != nil {
+		return err
+	}
 
 	// TODO: support Importing Keys once this is fixed:
 	// https://github.com/Azure/azure-rest-api-specs/issues/1747
-	parameters := keyvault.KeyCreateParameters{
-		Kty:    keyvault.JSONWebKeyType(keyType),
-		KeyOps: keyOptions,
-		KeyAttributes: &keyvault.KeyAttributes{
-			Enabled: utils.Bool(true),
-		},
-		KeySize: utils.Int32(int32(d.Get("key_size").(int))),
-		Tags:    expandTags(tags),
-	}
+	parameters := expandKeyVaultKeyCreateParameters(d)
 
 	if _, err := client.CreateKey(ctx, keyVaultBaseUri, name, parameters); err != nil {
 		return fmt.Errorf("Error Creating Key: %+v", err)
@@ -187,7 +213,8 @@ func resourceArmKeyVaultKeyCreate(d *schema.ResourceData, meta interface{}) erro
 func resourceArmKeyVaultKeyUpdate(d *schema.ResourceData, meta interface{}) error {
 	vaultClient := meta.(*ArmClient).keyVaultClient
 	client := meta.(*ArmClient).keyVaultManagementClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseKeyVaultChildID(d.Id())
 	if err != nil {
@@ -212,6 +239,24 @@ func resourceArmKeyVaultKeyUpdate(d *schema.ResourceData, meta interface{}) erro
 		return nil
 	}
 
+	if d.HasChange("version") {
+		// the current version is older than the `rotation_policy` allows, so a new version of the Key is created
+		log.Printf("[DEBUG] Rotating Key %q in Key Vault at URI %q", id.Name, id.KeyVaultBaseUrl)
+		if _, err := client.CreateKey(ctx, id.KeyVaultBaseUrl, id.Name, expandKeyVaultKeyCreateParameters(d)); err != nil {
+			return fmt.Errorf("Error rotating Key %q in Key Vault at URI %q: %+v", id.Name, id.KeyVaultBaseUrl, err)
+		}
+
+		// "" indicates the latest version
+		read, err := client.GetKey(ctx, id.KeyVaultBaseUrl, id.Name, "")
+		if err != nil {
+			return fmt.Errorf("Error retrieving the rotated Key %q in Key Vault at URI %q: %+v", id.Name, id.KeyVaultBaseUrl, err)
+		}
+
+		d.SetId(*read.Key.Kid)
+
+		return resourceArmKeyVaultKeyRead(d, meta)
+	}
+
 	keyOptions := expandKeyVaultKeyOptions(d)
 	tags := d.Get("tags").(map[string]interface{})
 
@@ -233,7 +278,8 @@ func resourceArmKeyVaultKeyUpdate(d *schema.ResourceData, meta interface{}) erro
 func resourceArmKeyVaultKeyRead(d *schema.ResourceData, meta interface{}) error {
 	keyVaultClient := meta.(*ArmClient).keyVaultClient
 	client := meta.(*ArmClient).keyVaultManagementClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseKeyVaultChildID(d.Id())
 	if err != nil {
@@ -271,6 +317,16 @@ func resourceArmKeyVaultKeyRead(d *schema.ResourceData, meta interface{}) error
 		return err
 	}
 
+	// the latest version of the Key is tracked, so that versions created outside of Terraform are picked up
+	if key := resp.Key; key != nil && key.Kid != nil && *key.Kid != d.Id() {
+		log.Printf("[DEBUG] The latest version of Key %q in Key Vault at URI %q is %q", id.Name, id.KeyVaultBaseUrl, *key.Kid)
+		d.SetId(*key.Kid)
+
+		if id, err = azure.ParseKeyVaultChildID(*key.Kid); err != nil {
+			return err
+		}
+	}
+
 	d.Set("name", id.Name)
 	d.Set("vault_uri", id.KeyVaultBaseUrl)
 	if key := resp.Key; key != nil {
@@ -288,6 +344,12 @@ func resourceArmKeyVaultKeyRead(d *schema.ResourceData, meta interface{}) error
 	// Computed
 	d.Set("version", id.Version)
 
+	created := ""
+	if attributes := resp.Attributes; attributes != nil && attributes.Created != nil {
+		created = time.Time(*attributes.Created).Format(time.RFC3339)
+	}
+	d.Set("created", created)
+
 	flattenAndSetTags(d, resp.Tags)
 
 	return nil
@@ -296,7 +358,8 @@ func resourceArmKeyVaultKeyRead(d *schema.ResourceData, meta interface{}) error
 func resourceArmKeyVaultKeyDelete(d *schema.ResourceData, meta interface{}) error {
 	keyVaultClient := meta.(*ArmClient).keyVaultClient
 	client := meta.(*ArmClient).keyVaultManagementClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseKeyVaultChildID(d.Id())
 	if err != nil {
@@ -321,8 +384,26 @@ func resourceArmKeyVaultKeyDelete(d *schema.ResourceData, meta interface{}) erro
 		return nil
 	}
 
-	_, err = client.DeleteKey(ctx, id.KeyVaultBaseUrl, id.Name)
-	return err
+	if _, err = client.DeleteKey(ctx, id.KeyVaultBaseUrl, id.Name); err != nil {
+		return err
+	}
+
+	return purgeSoftDeletedKeyVaultChild(ctx, meta, azure.KeyVaultChildTypeKey, *keyVaultId, id.KeyVaultBaseUrl, id.Name)
+}
+
+func expandKeyVaultKeyCreateParameters(d *schema.ResourceData) keyvault.KeyCreateParameters {
+	keyType := d.Get("key_type").(string)
+	tags := d.Get("tags").(map[string]interface{})
+
+	return keyvault.KeyCreateParameters{
+		Kty:    keyvault.JSONWebKeyType(keyType),
+		KeyOps: expandKeyVaultKeyOptions(d),
+		KeyAttributes: &keyvault.KeyAttributes{
+			Enabled: utils.Bool(true),
+		},
+		KeySize: utils.Int32(int32(d.Get("key_size").(int))),
+		Tags:    expandTags(tags),
+	}
 }
 
 func expandKeyVaultKeyOptions(d *schema.ResourceData) *[]keyvault.JSONWebKeyOperation {
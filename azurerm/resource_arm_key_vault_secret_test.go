@@ -165,6 +165,39 @@ func TestAccAzureRMKeyVaultSecret_complete(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMKeyVaultSecret_softDeleteRecovery(t *testing.T) {
+	resourceName := "azurerm_key_vault_secret.test"
+	rs := acctest.RandString(6)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMKeyVaultSecretDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMKeyVaultSecret_softDeleteRecovery(rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKeyVaultSecretExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "value", "rick-and-morty"),
+				),
+			},
+			{
+				// deleting the Secret soft-deletes it..
+				Config: testAccAzureRMKeyVaultSecret_softDeleteTemplate(rs, location),
+			},
+			{
+				// .. which is then recovered when it's created again
+				Config: testAccAzureRMKeyVaultSecret_softDeleteRecovery(rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKeyVaultSecretExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "value", "rick-and-morty"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAzureRMKeyVaultSecret_update(t *testing.T) {
 	resourceName := "azurerm_key_vault_secret.test"
 	rs := acctest.RandString(6)
@@ -508,3 +541,52 @@ resource "azurerm_key_vault_secret" "test" {
 }
 `, rString, location, rString, rString)
 }
+
+func testAccAzureRMKeyVaultSecret_softDeleteTemplate(rString string, location string) string {
+	return fmt.Sprintf(`
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%s"
+  location = "%s"
+}
+
+resource "azurerm_key_vault" "test" {
+  name                = "acctestkv-%s"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  tenant_id           = "${data.azurerm_client_config.current.tenant_id}"
+  soft_delete_enabled = true
+
+  sku {
+    name = "premium"
+  }
+
+  access_policy {
+    tenant_id = "${data.azurerm_client_config.current.tenant_id}"
+    object_id = "${data.azurerm_client_config.current.service_principal_object_id}"
+
+    secret_permissions = [
+      "get",
+      "delete",
+      "purge",
+      "recover",
+      "set",
+    ]
+  }
+}
+`, rString, location, rString)
+}
+
+func testAccAzureRMKeyVaultSecret_softDeleteRecovery(rString string, location string) string {
+	template := testAccAzureRMKeyVaultSecret_softDeleteTemplate(rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_key_vault_secret" "test" {
+  name         = "secret-%s"
+  value        = "rick-and-morty"
+  key_vault_id = "${azurerm_key_vault.test.id}"
+}
+`, template, rString)
+}
@@ -268,6 +268,45 @@ func TestAccAzureRMKeyVault_update(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMKeyVault_softDelete(t *testing.T) {
+	ri := tf.AccRandTimeInt()
+	resourceName := "azurerm_key_vault.test"
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMKeyVaultDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMKeyVault_softDelete(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKeyVaultExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "soft_delete_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "purge_protection_enabled", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// deleting the Key Vault soft-deletes it..
+				Config: testAccAzureRMKeyVault_softDeleteAbsent(ri, location),
+			},
+			{
+				// .. which is then recovered when it's created again
+				Config: testAccAzureRMKeyVault_softDelete(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMKeyVaultExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "soft_delete_enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAzureRMKeyVault_justCert(t *testing.T) {
 	resourceName := "azurerm_key_vault.test"
 	ri := tf.AccRandTimeInt()
@@ -738,3 +777,33 @@ func testAccAzureRMKeyVault_generateAccessPolicyConfigs(accountNum int) string {
   }
 `, accountNum)
 }
+
+func testAccAzureRMKeyVault_softDeleteAbsent(rInt int, location string) string {
+	return fmt.Sprintf(`
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+`, rInt, location)
+}
+
+func testAccAzureRMKeyVault_softDelete(rInt int, location string) string {
+	template := testAccAzureRMKeyVault_softDeleteAbsent(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_key_vault" "test" {
+  name                = "vault%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  tenant_id           = "${data.azurerm_client_config.current.tenant_id}"
+  soft_delete_enabled = true
+
+  sku {
+    name = "premium"
+  }
+}
+`, template, rInt)
+}
@@ -1,66 +1,45 @@
 package azurerm
 
 import (
-	"bytes"
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/containerservice/mgmt/2018-03-31/containerservice"
-	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/kubernetes"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func resourceArmKubernetesCluster() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmKubernetesClusterCreateUpdate,
-		Read:   resourceArmKubernetesClusterRead,
-		Update: resourceArmKubernetesClusterCreateUpdate,
-		Delete: resourceArmKubernetesClusterDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
-
-		CustomizeDiff: func(diff *schema.ResourceDiff, v interface{}) error {
-			if v, exists := diff.GetOk("network_profile"); exists {
-				rawProfiles := v.([]interface{})
-				if len(rawProfiles) == 0 {
-					return nil
-				}
-
-				// then ensure the conditionally-required fields are set
-				profile := rawProfiles[0].(map[string]interface{})
-				networkPlugin := profile["network_plugin"].(string)
-
-				if networkPlugin != "kubenet" && networkPlugin != "azure" {
-					return nil
-				}
-
-				dockerBridgeCidr := profile["docker_bridge_cidr"].(string)
-				dnsServiceIP := profile["dns_service_ip"].(string)
-				serviceCidr := profile["service_cidr"].(string)
-
-				// All empty values.
-				if dockerBridgeCidr == "" && dnsServiceIP == "" && serviceCidr == "" {
-					return nil
-				}
-
-				// All set values.
-				if dockerBridgeCidr != "" && dnsServiceIP != "" && serviceCidr != "" {
-					return nil
-				}
-
-				return fmt.Errorf("`docker_bridge_cidr`, `dns_service_ip` and `service_cidr` should all be empty or all should be set.")
-			}
-
-			return nil
+		Create:   resourceArmKubernetesClusterCreateUpdate,
+		Read:     resourceArmKubernetesClusterRead,
+		Update:   resourceArmKubernetesClusterCreateUpdate,
+		Delete:   resourceArmKubernetesClusterDelete,
+		Importer: azure.ValidateResourceIDPriorToImport(azure.ValidateKubernetesClusterID),
+
+		MigrateState:  resourceArmKubernetesClusterMigrateState,
+		SchemaVersion: 1,
+
+		CustomizeDiff: azure.CustomizeDiffAll(
+			resourceArmKubernetesClusterNetworkProfileCustomizeDiff,
+			azure.KubernetesClusterCustomizeDiff,
+			azure.KubernetesClusterAccessCustomizeDiff,
+		),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(90 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(90 * time.Minute),
+			Delete: schema.DefaultTimeout(90 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -103,10 +82,11 @@ func resourceArmKubernetesCluster() *schema.Resource {
 						},
 
 						"count": {
-							Type:         schema.TypeInt,
-							Optional:     true,
-							Default:      1,
-							ValidateFunc: validation.IntBetween(1, 100),
+							Type:             schema.TypeInt,
+							Optional:         true,
+							Default:          1,
+							ValidateFunc:     validation.IntBetween(1, 100),
+							DiffSuppressFunc: kubernetesAgentPoolCountDiffSuppressFunc,
 						},
 
 						// TODO: remove this field in the next major version
@@ -139,10 +119,11 @@ func resourceArmKubernetesCluster() *schema.Resource {
 						},
 
 						"vnet_subnet_id": {
-							Type:         schema.TypeString,
-							Optional:     true,
-							ForceNew:     true,
-							ValidateFunc: azure.ValidateResourceID,
+							Type:             schema.TypeString,
+							Optional:         true,
+							ForceNew:         true,
+							ValidateFunc:     azure.ValidateSubnetID,
+							DiffSuppressFunc: suppress.CaseDifference,
 						},
 
 						"os_type": {
@@ -163,37 +144,121 @@ func resourceArmKubernetesCluster() *schema.Resource {
 							Computed: true,
 							ForceNew: true,
 						},
+
+						"type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+							Default:  azure.KubernetesAgentPoolTypeAvailabilitySet,
+							ValidateFunc: validation.StringInSlice([]string{
+								azure.KubernetesAgentPoolTypeAvailabilitySet,
+								azure.KubernetesAgentPoolTypeVirtualMachineScaleSets,
+							}, false),
+						},
+
+						"enable_auto_scaling": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+
+						"min_count": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(1, 100),
+						},
+
+						"max_count": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(1, 100),
+						},
+
+						"availability_zones": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validate.NoEmptyStrings,
+							},
+						},
+
+						"node_taints": kubernetesAgentPoolNodeTaintsSchema(),
 					},
 				},
 			},
 
-			// TODO: 2.0 - we should be able to make this a List to be able to detect changes in the Client Secret
+			// Optional
 			"service_principal": {
-				Type:     schema.TypeSet,
-				Required: true,
-				MaxItems: 1,
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"identity"},
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						// the credentials of the Service Principal can be rotated without recreating the Cluster
 						"client_id": {
 							Type:         schema.TypeString,
 							Required:     true,
-							ForceNew:     true,
 							ValidateFunc: validate.NoEmptyStrings,
 						},
 
 						"client_secret": {
 							Type:         schema.TypeString,
-							ForceNew:     true,
 							Required:     true,
 							Sensitive:    true,
 							ValidateFunc: validate.NoEmptyStrings,
 						},
 					},
 				},
-				Set: resourceKubernetesClusterServicePrincipalProfileHash,
 			},
 
-			// Optional
+			"identity": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				ForceNew:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"service_principal"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"SystemAssigned",
+							}, false),
+						},
+
+						"principal_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"tenant_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"api_server_authorized_ip_ranges": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validate.CIDR,
+				},
+			},
+
+			"private_cluster_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+
 			"addon_profile": {
 				Type:     schema.TypeList,
 				MaxItems: 1,
@@ -232,9 +297,10 @@ func resourceArmKubernetesCluster() *schema.Resource {
 										Required: true,
 									},
 									"log_analytics_workspace_id": {
-										Type:         schema.TypeString,
-										Required:     true,
-										ValidateFunc: azure.ValidateResourceID,
+										Type:             schema.TypeString,
+										Required:         true,
+										ValidateFunc:     azure.ValidateLogAnalyticsWorkspaceID,
+										DiffSuppressFunc: suppress.CaseDifference,
 									},
 								},
 							},
@@ -295,6 +361,31 @@ func resourceArmKubernetesCluster() *schema.Resource {
 				},
 			},
 
+			"windows_profile": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"admin_username": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+
+						"admin_password": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							Sensitive:    true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+					},
+				},
+			},
+
 			"network_profile": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -354,6 +445,18 @@ func resourceArmKubernetesCluster() *schema.Resource {
 							ForceNew:     true,
 							ValidateFunc: validate.CIDR,
 						},
+
+						"load_balancer_sku": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							ForceNew:         true,
+							DiffSuppressFunc: suppress.CaseDifference,
+							ValidateFunc: validation.StringInSlice([]string{
+								azure.KubernetesLoadBalancerSkuBasic,
+								azure.KubernetesLoadBalancerSkuStandard,
+							}, true),
+						},
 					},
 				},
 			},
@@ -422,6 +525,11 @@ func resourceArmKubernetesCluster() *schema.Resource {
 				Computed: true,
 			},
 
+			"private_fqdn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
 			// Computed
 			"kube_admin_config": {
 				Type:     schema.TypeList,
@@ -515,9 +623,45 @@ func resourceArmKubernetesCluster() *schema.Resource {
 	}
 }
 
+func resourceArmKubernetesClusterNetworkProfileCustomizeDiff(diff *schema.ResourceDiff, _ interface{}) error {
+	if v, exists := diff.GetOk("network_profile"); exists {
+		rawProfiles := v.([]interface{})
+		if len(rawProfiles) == 0 {
+			return nil
+		}
+
+		// then ensure the conditionally-required fields are set
+		profile := rawProfiles[0].(map[string]interface{})
+		networkPlugin := profile["network_plugin"].(string)
+
+		if networkPlugin != "kubenet" && networkPlugin != "azure" {
+			return nil
+		}
+
+		dockerBridgeCidr := profile["docker_bridge_cidr"].(string)
+		dnsServiceIP := profile["dns_service_ip"].(string)
+		serviceCidr := profile["service_cidr"].(string)
+
+		// All empty values.
+		if dockerBridgeCidr == "" && dnsServiceIP == "" && serviceCidr == "" {
+			return nil
+		}
+
+		// All set values.
+		if dockerBridgeCidr != "" && dnsServiceIP != "" && serviceCidr != "" {
+			return nil
+		}
+
+		return fmt.Errorf("`docker_bridge_cidr`, `dns_service_ip` and `service_cidr` should all be empty or all should be set.")
+	}
+
+	return nil
+}
+
 func resourceArmKubernetesClusterCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).kubernetesClustersClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).kubernetesClustersExtendedClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	tenantId := meta.(*ArmClient).tenantId
 
 	log.Printf("[INFO] preparing arguments for Managed Kubernetes Cluster create/update.")
@@ -525,8 +669,8 @@ func resourceArmKubernetesClusterCreateUpdate(d *schema.ResourceData, meta inter
 	resGroup := d.Get("resource_group_name").(string)
 	name := d.Get("name").(string)
 
-	if requireResourcesToBeImported && d.IsNewResource() {
-		existing, err := client.Get(ctx, resGroup, name)
+	if meta.(*ArmClient).requireResourcesToBeImported && d.IsNewResource() {
+		existing, _, err := client.Get(ctx, resGroup, name)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
 				return fmt.Errorf("Error checking for presence of existing Kubernetes Cluster %q (Resource Group %q): %s", name, resGroup, err)
@@ -543,7 +687,7 @@ func resourceArmKubernetesClusterCreateUpdate(d *schema.ResourceData, meta inter
 	kubernetesVersion := d.Get("kubernetes_version").(string)
 
 	linuxProfile := expandKubernetesClusterLinuxProfile(d)
-	agentProfiles := expandKubernetesClusterAgentPoolProfiles(d)
+	agentProfiles, agentProfilesExtended := expandKubernetesClusterAgentPoolProfiles(d)
 	servicePrincipalProfile := expandAzureRmKubernetesClusterServicePrincipal(d)
 	networkProfile := expandKubernetesClusterNetworkProfile(d)
 	addonProfiles := expandKubernetesClusterAddonProfiles(d)
@@ -565,6 +709,67 @@ func resourceArmKubernetesClusterCreateUpdate(d *schema.ResourceData, meta inter
 	rbacRaw := d.Get("role_based_access_control").([]interface{})
 	rbacEnabled, azureADProfile := expandKubernetesClusterRoleBasedAccessControl(rbacRaw, tenantId)
 
+	// the control plane is upgraded first, followed by the Agent Pool defined within the Cluster
+	for poolName, props := range agentProfilesExtended {
+		if props.Type == azure.KubernetesAgentPoolTypeVirtualMachineScaleSets && kubernetesVersion != "" {
+			props.OrchestratorVersion = utils.String(kubernetesVersion)
+			agentProfilesExtended[poolName] = props
+		}
+	}
+
+	if !d.IsNewResource() {
+		// Node Pools managed by the `azurerm_kubernetes_cluster_node_pool` resource are retained as-is,
+		// since omitting them from the Cluster would remove them
+		existing, existingExtended, err := client.Get(ctx, resGroup, name)
+		if err != nil {
+			return fmt.Errorf("Error retrieving Managed Kubernetes Cluster %q (Resource Group %q): %+v", name, resGroup, err)
+		}
+
+		if props := existing.ManagedClusterProperties; props != nil && props.AgentPoolProfiles != nil {
+			for _, profile := range *props.AgentPoolProfiles {
+				if profile.Name == nil {
+					continue
+				}
+
+				if _, ok := agentProfilesExtended[*profile.Name]; ok {
+					continue
+				}
+
+				agentProfiles = append(agentProfiles, profile)
+				agentProfilesExtended[*profile.Name] = existingExtended.AgentPoolProfiles[*profile.Name]
+			}
+		}
+	}
+
+	// a Cluster using a Managed Identity has a placeholder Service Principal
+	identity := expandKubernetesClusterIdentity(d)
+	if identity != nil && servicePrincipalProfile == nil {
+		servicePrincipalProfile = &containerservice.ManagedClusterServicePrincipalProfile{
+			ClientID: utils.String(azure.KubernetesClusterManagedIdentityClientID),
+		}
+	}
+
+	// the credentials of the Service Principal can't be changed by updating the Cluster, so are reset first
+	if !d.IsNewResource() && d.HasChange("service_principal") && servicePrincipalProfile != nil {
+		log.Printf("[DEBUG] Resetting the Service Principal for Managed Kubernetes Cluster %q (Resource Group %q)..", name, resGroup)
+		future, err := client.ResetServicePrincipalProfile(ctx, resGroup, name, *servicePrincipalProfile)
+		if err != nil {
+			return fmt.Errorf("Error resetting the Service Principal for Managed Kubernetes Cluster %q (Resource Group %q): %+v", name, resGroup, err)
+		}
+
+		if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("Error waiting for the Service Principal to be reset for Managed Kubernetes Cluster %q (Resource Group %q): %+v", name, resGroup, err)
+		}
+	}
+
+	extended := azure.KubernetesClusterExtendedProperties{
+		AgentPoolProfiles:      agentProfilesExtended,
+		APIServerAccessProfile: expandKubernetesClusterAPIServerAccessProfile(d),
+		Identity:               identity,
+		LoadBalancerSku:        expandKubernetesClusterLoadBalancerSku(d),
+		WindowsProfile:         expandKubernetesClusterWindowsProfile(d),
+	}
+
 	parameters := containerservice.ManagedCluster{
 		Name:     &name,
 		Location: &location,
@@ -582,7 +787,7 @@ func resourceArmKubernetesClusterCreateUpdate(d *schema.ResourceData, meta inter
 		Tags: expandTags(tags),
 	}
 
-	future, err := client.CreateOrUpdate(ctx, resGroup, name, parameters)
+	future, err := client.CreateOrUpdate(ctx, resGroup, name, parameters, extended)
 	if err != nil {
 		return fmt.Errorf("Error creating/updating Managed Kubernetes Cluster %q (Resource Group %q): %+v", name, resGroup, err)
 	}
@@ -591,7 +796,7 @@ func resourceArmKubernetesClusterCreateUpdate(d *schema.ResourceData, meta inter
 		return fmt.Errorf("Error waiting for completion of Managed Kubernetes Cluster %q (Resource Group %q): %+v", name, resGroup, err)
 	}
 
-	read, err := client.Get(ctx, resGroup, name)
+	read, _, err := client.Get(ctx, resGroup, name)
 	if err != nil {
 		return fmt.Errorf("Error retrieving Managed Kubernetes Cluster %q (Resource Group %q): %+v", name, resGroup, err)
 	}
@@ -607,7 +812,9 @@ func resourceArmKubernetesClusterCreateUpdate(d *schema.ResourceData, meta inter
 
 func resourceArmKubernetesClusterRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).kubernetesClustersClient
-	ctx := meta.(*ArmClient).StopContext
+	extendedClient := meta.(*ArmClient).kubernetesClustersExtendedClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -616,7 +823,7 @@ func resourceArmKubernetesClusterRead(d *schema.ResourceData, meta interface{})
 	resGroup := id.ResourceGroup
 	name := id.Path["managedClusters"]
 
-	resp, err := client.Get(ctx, resGroup, name)
+	resp, extended, err := extendedClient.Get(ctx, resGroup, name)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
 			log.Printf("[DEBUG] Managed Kubernetes Cluster %q was not found in Resource Group %q - removing from state!", name, resGroup)
@@ -638,6 +845,29 @@ func resourceArmKubernetesClusterRead(d *schema.ResourceData, meta interface{})
 		d.Set("location", azureRMNormalizeLocation(*location))
 	}
 
+	kubeConfigRaw, kubeConfig := flattenKubernetesClusterAccessProfile(profile)
+
+	if err := d.Set("identity", flattenKubernetesClusterIdentity(extended.Identity)); err != nil {
+		return fmt.Errorf("Error setting `identity`: %+v", err)
+	}
+
+	authorizedIPRanges, privateCluster := flattenKubernetesClusterAPIServerAccessProfile(extended.APIServerAccessProfile)
+	if err := d.Set("api_server_authorized_ip_ranges", authorizedIPRanges); err != nil {
+		return fmt.Errorf("Error setting `api_server_authorized_ip_ranges`: %+v", err)
+	}
+	d.Set("private_cluster_enabled", privateCluster)
+
+	// the Private FQDN of the API Server isn't returned by the API, however it's the Server within the Kube Config
+	privateFqdn := ""
+	if privateCluster && kubeConfigRaw != nil {
+		host, err := kubernetes.ParseKubeConfigServerHost(*kubeConfigRaw)
+		if err != nil {
+			return fmt.Errorf("Error parsing the Private FQDN for Managed Kubernetes Cluster %q (Resource Group %q) from the Kube Config: %+v", name, resGroup, err)
+		}
+		privateFqdn = host
+	}
+	d.Set("private_fqdn", privateFqdn)
+
 	if props := resp.ManagedClusterProperties; props != nil {
 		d.Set("dns_prefix", props.DNSPrefix)
 		d.Set("fqdn", props.Fqdn)
@@ -649,7 +879,9 @@ func resourceArmKubernetesClusterRead(d *schema.ResourceData, meta interface{})
 			return fmt.Errorf("Error setting `addon_profile`: %+v", err)
 		}
 
-		agentPoolProfiles := flattenKubernetesClusterAgentPoolProfiles(props.AgentPoolProfiles, resp.Fqdn)
+		// other Agent Pools within the Cluster are managed by the `azurerm_kubernetes_cluster_node_pool` resource
+		agentPoolName := d.Get("agent_pool_profile.0.name").(string)
+		agentPoolProfiles := flattenKubernetesClusterAgentPoolProfiles(props.AgentPoolProfiles, extended.AgentPoolProfiles, resp.Fqdn, agentPoolName)
 		if err := d.Set("agent_pool_profile", agentPoolProfiles); err != nil {
 			return fmt.Errorf("Error setting `agent_pool_profile`: %+v", err)
 		}
@@ -659,7 +891,12 @@ func resourceArmKubernetesClusterRead(d *schema.ResourceData, meta interface{})
 			return fmt.Errorf("Error setting `linux_profile`: %+v", err)
 		}
 
-		networkProfile := flattenKubernetesClusterNetworkProfile(props.NetworkProfile)
+		windowsProfile := flattenKubernetesClusterWindowsProfile(extended.WindowsProfile, d)
+		if err := d.Set("windows_profile", windowsProfile); err != nil {
+			return fmt.Errorf("Error setting `windows_profile`: %+v", err)
+		}
+
+		networkProfile := flattenKubernetesClusterNetworkProfile(props.NetworkProfile, extended.LoadBalancerSku)
 		if err := d.Set("network_profile", networkProfile); err != nil {
 			return fmt.Errorf("Error setting `network_profile`: %+v", err)
 		}
@@ -669,7 +906,7 @@ func resourceArmKubernetesClusterRead(d *schema.ResourceData, meta interface{})
 			return fmt.Errorf("Error setting `role_based_access_control`: %+v", err)
 		}
 
-		servicePrincipal := flattenAzureRmKubernetesClusterServicePrincipalProfile(props.ServicePrincipalProfile)
+		servicePrincipal := flattenAzureRmKubernetesClusterServicePrincipalProfile(props.ServicePrincipalProfile, d)
 		if err := d.Set("service_principal", servicePrincipal); err != nil {
 			return fmt.Errorf("Error setting `service_principal`: %+v", err)
 		}
@@ -692,7 +929,6 @@ func resourceArmKubernetesClusterRead(d *schema.ResourceData, meta interface{})
 		}
 	}
 
-	kubeConfigRaw, kubeConfig := flattenKubernetesClusterAccessProfile(profile)
 	d.Set("kube_config_raw", kubeConfigRaw)
 	if err := d.Set("kube_config", kubeConfig); err != nil {
 		return fmt.Errorf("Error setting `kube_config`: %+v", err)
@@ -705,7 +941,8 @@ func resourceArmKubernetesClusterRead(d *schema.ResourceData, meta interface{})
 
 func resourceArmKubernetesClusterDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).kubernetesClustersClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -873,7 +1110,7 @@ func flattenKubernetesClusterAddonProfiles(profile map[string]*containerservice.
 	return []interface{}{values}
 }
 
-func expandKubernetesClusterAgentPoolProfiles(d *schema.ResourceData) []containerservice.ManagedClusterAgentPoolProfile {
+func expandKubernetesClusterAgentPoolProfiles(d *schema.ResourceData) ([]containerservice.ManagedClusterAgentPoolProfile, map[string]azure.KubernetesAgentPoolExtendedProperties) {
 	configs := d.Get("agent_pool_profile").([]interface{})
 	config := configs[0].(map[string]interface{})
 
@@ -901,10 +1138,17 @@ func expandKubernetesClusterAgentPoolProfiles(d *schema.ResourceData) []containe
 		profile.VnetSubnetID = utils.String(vnetSubnetID)
 	}
 
-	return []containerservice.ManagedClusterAgentPoolProfile{profile}
+	extended := expandKubernetesAgentPoolExtendedProperties(config)
+	extended.Type = config["type"].(string)
+
+	extendedProfiles := map[string]azure.KubernetesAgentPoolExtendedProperties{
+		name: extended,
+	}
+
+	return []containerservice.ManagedClusterAgentPoolProfile{profile}, extendedProfiles
 }
 
-func flattenKubernetesClusterAgentPoolProfiles(profiles *[]containerservice.ManagedClusterAgentPoolProfile, fqdn *string) []interface{} {
+func flattenKubernetesClusterAgentPoolProfiles(profiles *[]containerservice.ManagedClusterAgentPoolProfile, extended map[string]azure.KubernetesAgentPoolExtendedProperties, fqdn *string, name string) []interface{} {
 	if profiles == nil {
 		return []interface{}{}
 	}
@@ -912,6 +1156,11 @@ func flattenKubernetesClusterAgentPoolProfiles(profiles *[]containerservice.Mana
 	agentPoolProfiles := make([]interface{}, 0)
 
 	for _, profile := range *profiles {
+		// when the name isn't known (e.g. during an import) the first Agent Pool is used
+		if profile.Name == nil || (name != "" && *profile.Name != name) {
+			continue
+		}
+
 		agentPoolProfile := make(map[string]interface{})
 
 		if profile.Count != nil {
@@ -947,12 +1196,90 @@ func flattenKubernetesClusterAgentPoolProfiles(profiles *[]containerservice.Mana
 			agentPoolProfile["max_pods"] = int(*profile.MaxPods)
 		}
 
+		props := extended[*profile.Name]
+		poolType := props.Type
+		if poolType == "" {
+			poolType = azure.KubernetesAgentPoolTypeAvailabilitySet
+		}
+		agentPoolProfile["type"] = poolType
+
+		for k, v := range flattenKubernetesAgentPoolExtendedProperties(props) {
+			agentPoolProfile[k] = v
+		}
+
 		agentPoolProfiles = append(agentPoolProfiles, agentPoolProfile)
+		break
 	}
 
 	return agentPoolProfiles
 }
 
+func expandKubernetesAgentPoolExtendedProperties(input map[string]interface{}) azure.KubernetesAgentPoolExtendedProperties {
+	enableAutoScaling := input["enable_auto_scaling"].(bool)
+	props := azure.KubernetesAgentPoolExtendedProperties{
+		EnableAutoScaling: utils.Bool(enableAutoScaling),
+	}
+
+	if enableAutoScaling {
+		props.MinCount = utils.Int32(int32(input["min_count"].(int)))
+		props.MaxCount = utils.Int32(int32(input["max_count"].(int)))
+	}
+
+	if zones := input["availability_zones"].([]interface{}); len(zones) > 0 {
+		props.AvailabilityZones = utils.ExpandStringArray(zones)
+	}
+
+	if taints := input["node_taints"].([]interface{}); len(taints) > 0 {
+		props.NodeTaints = utils.ExpandStringArray(taints)
+	}
+
+	return props
+}
+
+func flattenKubernetesAgentPoolExtendedProperties(input azure.KubernetesAgentPoolExtendedProperties) map[string]interface{} {
+	enableAutoScaling := false
+	if input.EnableAutoScaling != nil {
+		enableAutoScaling = *input.EnableAutoScaling
+	}
+
+	minCount := 0
+	if input.MinCount != nil {
+		minCount = int(*input.MinCount)
+	}
+
+	maxCount := 0
+	if input.MaxCount != nil {
+		maxCount = int(*input.MaxCount)
+	}
+
+	return map[string]interface{}{
+		"enable_auto_scaling": enableAutoScaling,
+		"min_count":           minCount,
+		"max_count":           maxCount,
+		"availability_zones":  utils.FlattenStringArray(input.AvailabilityZones),
+		"node_taints":         utils.FlattenStringArray(input.NodeTaints),
+	}
+}
+
+func kubernetesAgentPoolNodeTaintsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		ForceNew: true,
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: validate.KubernetesNodeTaint,
+		},
+	}
+}
+
+// kubernetesAgentPoolCountDiffSuppressFunc ignores changes to the number of Nodes in an Agent Pool when Autoscaling
+// is enabled, since the number of Nodes is then managed by the Cluster Autoscaler
+func kubernetesAgentPoolCountDiffSuppressFunc(k, _, _ string, d *schema.ResourceData) bool {
+	key := k[:strings.LastIndex(k, ".")+1] + "enable_auto_scaling"
+	return d.Get(key).(bool)
+}
+
 func expandKubernetesClusterLinuxProfile(d *schema.ResourceData) *containerservice.LinuxProfile {
 	profiles := d.Get("linux_profile").([]interface{})
 
@@ -1014,6 +1341,44 @@ func flattenKubernetesClusterLinuxProfile(profile *containerservice.LinuxProfile
 	return []interface{}{values}
 }
 
+func expandKubernetesClusterWindowsProfile(d *schema.ResourceData) *azure.KubernetesClusterWindowsProfile {
+	profiles := d.Get("windows_profile").([]interface{})
+	if len(profiles) == 0 {
+		return nil
+	}
+
+	config := profiles[0].(map[string]interface{})
+
+	return &azure.KubernetesClusterWindowsProfile{
+		AdminUsername: utils.String(config["admin_username"].(string)),
+		AdminPassword: utils.String(config["admin_password"].(string)),
+	}
+}
+
+func flattenKubernetesClusterWindowsProfile(profile *azure.KubernetesClusterWindowsProfile, d *schema.ResourceData) []interface{} {
+	if profile == nil {
+		return []interface{}{}
+	}
+
+	adminUsername := ""
+	if profile.AdminUsername != nil {
+		adminUsername = *profile.AdminUsername
+	}
+
+	// since the password isn't returned we're pulling this out of the existing state (which won't work for Imports)
+	adminPassword := ""
+	if v, ok := d.GetOk("windows_profile.0.admin_password"); ok {
+		adminPassword = v.(string)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"admin_username": adminUsername,
+			"admin_password": adminPassword,
+		},
+	}
+}
+
 func expandKubernetesClusterNetworkProfile(d *schema.ResourceData) *containerservice.NetworkProfile {
 	configs := d.Get("network_profile").([]interface{})
 	if len(configs) == 0 {
@@ -1054,7 +1419,7 @@ func expandKubernetesClusterNetworkProfile(d *schema.ResourceData) *containerser
 	return &networkProfile
 }
 
-func flattenKubernetesClusterNetworkProfile(profile *containerservice.NetworkProfile) []interface{} {
+func flattenKubernetesClusterNetworkProfile(profile *containerservice.NetworkProfile, loadBalancerSku string) []interface{} {
 	if profile == nil {
 		return []interface{}{}
 	}
@@ -1083,9 +1448,21 @@ func flattenKubernetesClusterNetworkProfile(profile *containerservice.NetworkPro
 		values["pod_cidr"] = *profile.PodCidr
 	}
 
+	values["load_balancer_sku"] = loadBalancerSku
+
 	return []interface{}{values}
 }
 
+func expandKubernetesClusterLoadBalancerSku(d *schema.ResourceData) string {
+	configs := d.Get("network_profile").([]interface{})
+	if len(configs) == 0 {
+		return ""
+	}
+
+	config := configs[0].(map[string]interface{})
+	return strings.ToLower(config["load_balancer_sku"].(string))
+}
+
 func expandKubernetesClusterRoleBasedAccessControl(input []interface{}, providerTenantId string) (bool, *containerservice.ManagedClusterAADProfile) {
 	if len(input) == 0 {
 		return false, nil
@@ -1175,7 +1552,7 @@ func expandAzureRmKubernetesClusterServicePrincipal(d *schema.ResourceData) *con
 		return nil
 	}
 
-	configs := value.(*schema.Set).List()
+	configs := value.([]interface{})
 
 	config := configs[0].(map[string]interface{})
 
@@ -1190,38 +1567,94 @@ func expandAzureRmKubernetesClusterServicePrincipal(d *schema.ResourceData) *con
 	return &principal
 }
 
-func flattenAzureRmKubernetesClusterServicePrincipalProfile(profile *containerservice.ManagedClusterServicePrincipalProfile) *schema.Set {
-	if profile == nil {
+func flattenAzureRmKubernetesClusterServicePrincipalProfile(profile *containerservice.ManagedClusterServicePrincipalProfile, d *schema.ResourceData) []interface{} {
+	if profile == nil || profile.ClientID == nil {
+		return []interface{}{}
+	}
+
+	// a Cluster using a Managed Identity has a placeholder Service Principal
+	clientId := *profile.ClientID
+	if clientId == azure.KubernetesClusterManagedIdentityClientID {
+		return []interface{}{}
+	}
+
+	// since the Client Secret isn't returned we're pulling this out of the existing state (which won't work for Imports)
+	clientSecret := ""
+	if v, ok := d.GetOk("service_principal.0.client_secret"); ok {
+		clientSecret = v.(string)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"client_id":     clientId,
+			"client_secret": clientSecret,
+		},
+	}
+}
+
+func expandKubernetesClusterIdentity(d *schema.ResourceData) *azure.KubernetesClusterIdentity {
+	identities := d.Get("identity").([]interface{})
+	if len(identities) == 0 {
 		return nil
 	}
 
-	servicePrincipalProfiles := &schema.Set{
-		F: resourceKubernetesClusterServicePrincipalProfileHash,
+	identity := identities[0].(map[string]interface{})
+
+	return &azure.KubernetesClusterIdentity{
+		Type: identity["type"].(string),
 	}
+}
 
-	values := make(map[string]interface{})
+func flattenKubernetesClusterIdentity(identity *azure.KubernetesClusterIdentity) []interface{} {
+	if identity == nil || identity.Type == "" || strings.EqualFold(identity.Type, "None") {
+		return []interface{}{}
+	}
 
-	if clientId := profile.ClientID; clientId != nil {
-		values["client_id"] = *clientId
+	principalId := ""
+	if identity.PrincipalID != nil {
+		principalId = *identity.PrincipalID
 	}
-	if secret := profile.Secret; secret != nil {
-		values["client_secret"] = *secret
+
+	tenantId := ""
+	if identity.TenantID != nil {
+		tenantId = *identity.TenantID
 	}
 
-	servicePrincipalProfiles.Add(values)
+	return []interface{}{
+		map[string]interface{}{
+			"type":         identity.Type,
+			"principal_id": principalId,
+			"tenant_id":    tenantId,
+		},
+	}
+}
+
+func expandKubernetesClusterAPIServerAccessProfile(d *schema.ResourceData) *azure.KubernetesClusterAPIServerAccessProfile {
+	if d.Get("private_cluster_enabled").(bool) {
+		return &azure.KubernetesClusterAPIServerAccessProfile{
+			EnablePrivateCluster: utils.Bool(true),
+		}
+	}
 
-	return servicePrincipalProfiles
+	// an empty list is sent (rather than omitting it) so that any Authorized IP Ranges are removed
+	authorizedIPRanges := d.Get("api_server_authorized_ip_ranges").(*schema.Set).List()
+
+	return &azure.KubernetesClusterAPIServerAccessProfile{
+		AuthorizedIPRanges: utils.ExpandStringArray(authorizedIPRanges),
+	}
 }
 
-func resourceKubernetesClusterServicePrincipalProfileHash(v interface{}) int {
-	// TODO: this method should be able to be removed in time
-	var buf bytes.Buffer
+func flattenKubernetesClusterAPIServerAccessProfile(profile *azure.KubernetesClusterAPIServerAccessProfile) ([]interface{}, bool) {
+	if profile == nil {
+		return []interface{}{}, false
+	}
 
-	if m, ok := v.(map[string]interface{}); ok {
-		buf.WriteString(fmt.Sprintf("%s-", m["client_id"].(string)))
+	privateCluster := false
+	if profile.EnablePrivateCluster != nil {
+		privateCluster = *profile.EnablePrivateCluster
 	}
 
-	return hashcode.String(buf.String())
+	return utils.FlattenStringArray(profile.AuthorizedIPRanges), privateCluster
 }
 
 func flattenKubernetesClusterKubeConfig(config kubernetes.KubeConfig) []interface{} {
@@ -0,0 +1,49 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func resourceArmKubernetesClusterMigrateState(v int, is *terraform.InstanceState, _ interface{}) (*terraform.InstanceState, error) {
+	switch v {
+	case 0:
+		log.Println("[INFO] Found AzureRM Kubernetes Cluster State v0; migrating to v1")
+		return migrateAzureRMKubernetesClusterStateV0toV1(is)
+	default:
+		return is, fmt.Errorf("Unexpected schema version: %d", v)
+	}
+}
+
+func migrateAzureRMKubernetesClusterStateV0toV1(is *terraform.InstanceState) (*terraform.InstanceState, error) {
+	if is.Empty() {
+		log.Println("[DEBUG] Empty InstanceState; nothing to migrate.")
+		return is, nil
+	}
+
+	log.Printf("[DEBUG] ARM Kubernetes Cluster Attributes before Migration: %#v", is.Attributes)
+
+	// the `service_principal` block was a Set (hashed on the `client_id`) and is now a List - since there's
+	// at most one item this means replacing the hash with the index of the first item
+	prefix := "service_principal."
+	for key, value := range is.Attributes {
+		if !strings.HasPrefix(key, prefix) || key == prefix+"#" {
+			continue
+		}
+
+		segments := strings.SplitN(strings.TrimPrefix(key, prefix), ".", 2)
+		if len(segments) != 2 || segments[0] == "0" {
+			continue
+		}
+
+		delete(is.Attributes, key)
+		is.Attributes[prefix+"0."+segments[1]] = value
+	}
+
+	log.Printf("[DEBUG] ARM Kubernetes Cluster Attributes after State Migration: %#v", is.Attributes)
+
+	return is, nil
+}
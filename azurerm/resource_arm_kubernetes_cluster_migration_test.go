@@ -0,0 +1,64 @@
+package azurerm
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAzureRMKubernetesClusterMigrateState(t *testing.T) {
+	cases := map[string]struct {
+		StateVersion int
+		ID           string
+		Attributes   map[string]string
+		Expected     map[string]string
+	}{
+		"v0_1_without_service_principal": {
+			StateVersion: 0,
+			ID:           "some_id",
+			Attributes: map[string]string{
+				"name": "cluster1",
+			},
+			Expected: map[string]string{
+				"name": "cluster1",
+			},
+		},
+		"v0_1_with_service_principal": {
+			StateVersion: 0,
+			ID:           "some_id",
+			Attributes: map[string]string{
+				"name":                                "cluster1",
+				"service_principal.#":                 "1",
+				"service_principal.1234567.client_id": "00000000-0000-0000-0000-000000000000",
+				"service_principal.1234567.client_secret": "secret",
+				"agent_pool_profile.#":                    "1",
+				"agent_pool_profile.0.name":               "default",
+			},
+			Expected: map[string]string{
+				"name":                              "cluster1",
+				"service_principal.#":               "1",
+				"service_principal.0.client_id":     "00000000-0000-0000-0000-000000000000",
+				"service_principal.0.client_secret": "secret",
+				"agent_pool_profile.#":              "1",
+				"agent_pool_profile.0.name":         "default",
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		is := &terraform.InstanceState{
+			ID:         tc.ID,
+			Attributes: tc.Attributes,
+		}
+		is, err := resourceArmKubernetesClusterMigrateState(tc.StateVersion, is, nil)
+
+		if err != nil {
+			t.Fatalf("bad: %s, err: %#v", name, err)
+		}
+
+		if !reflect.DeepEqual(tc.Expected, is.Attributes) {
+			t.Fatalf("Bad Kubernetes Cluster Migrate\n\n. Got: %+v\n\n expected: %+v", is.Attributes, tc.Expected)
+		}
+	}
+}
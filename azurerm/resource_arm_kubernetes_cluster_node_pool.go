@@ -0,0 +1,340 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/containerservice/mgmt/2018-03-31/containerservice"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmKubernetesClusterNodePool() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmKubernetesClusterNodePoolCreateUpdate,
+		Read:   resourceArmKubernetesClusterNodePoolRead,
+		Update: resourceArmKubernetesClusterNodePoolCreateUpdate,
+		Delete: resourceArmKubernetesClusterNodePoolDelete,
+
+		Importer: azure.ValidateResourceIDPriorToImport(azure.ValidateKubernetesClusterNodePoolID),
+
+		CustomizeDiff: azure.KubernetesClusterNodePoolCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.KubernetesAgentPoolName,
+			},
+
+			"kubernetes_cluster_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     azure.ValidateKubernetesClusterID,
+				DiffSuppressFunc: suppress.CaseDifference,
+			},
+
+			"vm_size": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppress.CaseDifference,
+				ValidateFunc:     validate.NoEmptyStrings,
+			},
+
+			"node_count": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          1,
+				ValidateFunc:     validation.IntBetween(1, 100),
+				DiffSuppressFunc: kubernetesAgentPoolCountDiffSuppressFunc,
+			},
+
+			"enable_auto_scaling": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"min_count": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 100),
+			},
+
+			"max_count": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 100),
+			},
+
+			"availability_zones": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+			},
+
+			"node_taints": kubernetesAgentPoolNodeTaintsSchema(),
+
+			"kubernetes_version": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"os_disk_size_gb": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"os_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  string(containerservice.Linux),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(containerservice.Linux),
+					string(containerservice.Windows),
+				}, true),
+				DiffSuppressFunc: suppress.CaseDifference,
+			},
+
+			"max_pods": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"vnet_subnet_id": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateFunc:     azure.ValidateSubnetID,
+				DiffSuppressFunc: suppress.CaseDifference,
+			},
+		},
+	}
+}
+
+func resourceArmKubernetesClusterNodePoolCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).kubernetesClustersExtendedClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	clusterId, err := azure.ParseKubernetesClusterID(d.Get("kubernetes_cluster_id").(string))
+	if err != nil {
+		return err
+	}
+
+	name := d.Get("name").(string)
+	resourceGroup := clusterId.ResourceGroup
+	clusterName := clusterId.Name
+
+	if meta.(*ArmClient).requireResourcesToBeImported && d.IsNewResource() {
+		existing, err := client.GetAgentPool(ctx, resourceGroup, clusterName, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Node Pool %q (Kubernetes Cluster %q / Resource Group %q): %+v", name, clusterName, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_kubernetes_cluster_node_pool", *existing.ID)
+		}
+	}
+
+	cluster, clusterExtended, err := client.Get(ctx, resourceGroup, clusterName)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Managed Kubernetes Cluster %q (Resource Group %q): %+v", clusterName, resourceGroup, err)
+	}
+
+	// additional Node Pools can only be added to Clusters whose Agent Pools are backed by Virtual Machine Scale Sets
+	for poolName, props := range clusterExtended.AgentPoolProfiles {
+		if poolName != name && props.Type != azure.KubernetesAgentPoolTypeVirtualMachineScaleSets {
+			return fmt.Errorf("Node Pools can only be added to Managed Kubernetes Clusters using `%s` - Agent Pool %q is of type %q", azure.KubernetesAgentPoolTypeVirtualMachineScaleSets, poolName, props.Type)
+		}
+	}
+
+	osType := d.Get("os_type").(string)
+	if strings.EqualFold(osType, string(containerservice.Windows)) && clusterExtended.WindowsProfile == nil {
+		return fmt.Errorf("Windows Node Pools can only be added to Managed Kubernetes Clusters with a `windows_profile`")
+	}
+
+	kubernetesVersion := d.Get("kubernetes_version").(string)
+	if props := cluster.ManagedClusterProperties; props != nil && props.KubernetesVersion != nil {
+		if err := azure.ValidateKubernetesAgentPoolVersion(kubernetesVersion, *props.KubernetesVersion); err != nil {
+			return err
+		}
+	}
+
+	extended := expandKubernetesAgentPoolExtendedProperties(map[string]interface{}{
+		"enable_auto_scaling": d.Get("enable_auto_scaling"),
+		"min_count":           d.Get("min_count"),
+		"max_count":           d.Get("max_count"),
+		"availability_zones":  d.Get("availability_zones"),
+		"node_taints":         d.Get("node_taints"),
+	})
+	extended.Type = azure.KubernetesAgentPoolTypeVirtualMachineScaleSets
+	if kubernetesVersion != "" {
+		extended.OrchestratorVersion = utils.String(kubernetesVersion)
+	}
+
+	props := azure.KubernetesAgentPoolProperties{
+		KubernetesAgentPoolExtendedProperties: extended,
+		Count:                                 utils.Int32(int32(d.Get("node_count").(int))),
+		VMSize:                                d.Get("vm_size").(string),
+		OsType:                                osType,
+	}
+
+	if v := d.Get("os_disk_size_gb").(int); v > 0 {
+		props.OsDiskSizeGB = utils.Int32(int32(v))
+	}
+
+	if v := d.Get("max_pods").(int); v > 0 {
+		props.MaxPods = utils.Int32(int32(v))
+	}
+
+	if v := d.Get("vnet_subnet_id").(string); v != "" {
+		props.VnetSubnetID = utils.String(v)
+	}
+
+	pool := azure.KubernetesAgentPool{
+		Name:       utils.String(name),
+		Properties: &props,
+	}
+
+	log.Printf("[INFO] Creating/updating Node Pool %q (Kubernetes Cluster %q / Resource Group %q)..", name, clusterName, resourceGroup)
+	future, err := client.CreateOrUpdateAgentPool(ctx, resourceGroup, clusterName, name, pool)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating Node Pool %q (Kubernetes Cluster %q / Resource Group %q): %+v", name, clusterName, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of Node Pool %q (Kubernetes Cluster %q / Resource Group %q): %+v", name, clusterName, resourceGroup, err)
+	}
+
+	read, err := client.GetAgentPool(ctx, resourceGroup, clusterName, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Node Pool %q (Kubernetes Cluster %q / Resource Group %q): %+v", name, clusterName, resourceGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID for Node Pool %q (Kubernetes Cluster %q / Resource Group %q)", name, clusterName, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmKubernetesClusterNodePoolRead(d, meta)
+}
+
+func resourceArmKubernetesClusterNodePoolRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).kubernetesClustersExtendedClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseKubernetesClusterNodePoolID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.GetAgentPool(ctx, id.ResourceGroup, id.ClusterName, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Node Pool %q was not found in Kubernetes Cluster %q / Resource Group %q - removing from state!", id.Name, id.ClusterName, id.ResourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Node Pool %q (Kubernetes Cluster %q / Resource Group %q): %+v", id.Name, id.ClusterName, id.ResourceGroup, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("kubernetes_cluster_id", azure.NewKubernetesClusterID(id.SubscriptionID, id.ResourceGroup, id.ClusterName).ID())
+
+	if props := resp.Properties; props != nil {
+		count := 0
+		if props.Count != nil {
+			count = int(*props.Count)
+		}
+		d.Set("node_count", count)
+
+		osDiskSizeGB := 0
+		if props.OsDiskSizeGB != nil {
+			osDiskSizeGB = int(*props.OsDiskSizeGB)
+		}
+		d.Set("os_disk_size_gb", osDiskSizeGB)
+
+		maxPods := 0
+		if props.MaxPods != nil {
+			maxPods = int(*props.MaxPods)
+		}
+		d.Set("max_pods", maxPods)
+
+		d.Set("vm_size", props.VMSize)
+		d.Set("os_type", props.OsType)
+		d.Set("vnet_subnet_id", props.VnetSubnetID)
+		d.Set("kubernetes_version", props.OrchestratorVersion)
+
+		for k, v := range flattenKubernetesAgentPoolExtendedProperties(props.KubernetesAgentPoolExtendedProperties) {
+			if err := d.Set(k, v); err != nil {
+				return fmt.Errorf("Error setting `%s`: %+v", k, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceArmKubernetesClusterNodePoolDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).kubernetesClustersExtendedClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseKubernetesClusterNodePoolID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	future, err := client.DeleteAgentPool(ctx, id.ResourceGroup, id.ClusterName, id.Name)
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Node Pool %q (Kubernetes Cluster %q / Resource Group %q): %+v", id.Name, id.ClusterName, id.ResourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error waiting for deletion of Node Pool %q (Kubernetes Cluster %q / Resource Group %q): %+v", id.Name, id.ClusterName, id.ResourceGroup, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,223 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2018-05-01/resources"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmManagementGroupTemplateDeployment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmManagementGroupTemplateDeploymentCreateUpdate,
+		Read:   resourceArmManagementGroupTemplateDeploymentRead,
+		Update: resourceArmManagementGroupTemplateDeploymentCreateUpdate,
+		Delete: resourceArmManagementGroupTemplateDeploymentDelete,
+
+		Importer: azure.ValidateResourceIDPriorToImport(azure.ValidateManagementGroupTemplateDeploymentID),
+
+		CustomizeDiff: azure.TemplateDeploymentCustomizeDiff(resourceArmManagementGroupTemplateDeploymentWhatIf,
+			"name", "management_group_id", "location", "template_body", "parameters_body"),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(180 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(180 * time.Minute),
+			Delete: schema.DefaultTimeout(180 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"management_group_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     azure.ValidateManagementGroupID,
+				DiffSuppressFunc: suppress.CaseDifference,
+			},
+
+			"location": locationSchema(),
+
+			"template_body": {
+				Type:         schema.TypeString,
+				Required:     true,
+				StateFunc:    normalizeJson,
+				ValidateFunc: validation.ValidateJsonString,
+			},
+
+			"parameters_body": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				StateFunc:    normalizeJson,
+				ValidateFunc: validation.ValidateJsonString,
+			},
+
+			"delete_deployed_resources": templateDeploymentDeleteDeployedResourcesSchema(),
+
+			"outputs_json": templateDeploymentOutputsJSONSchema(),
+
+			"template_changes": templateDeploymentTemplateChangesSchema(),
+		},
+	}
+}
+
+func resourceArmManagementGroupTemplateDeploymentCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).managementGroupDeploymentsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	location := azure.NormalizeLocation(d.Get("location").(string))
+	managementGroupId, err := azure.ParseManagementGroupID(d.Get("management_group_id").(string))
+	if err != nil {
+		return err
+	}
+	managementGroup := managementGroupId.Name
+
+	if meta.(*ArmClient).requireResourcesToBeImported && d.IsNewResource() {
+		existing, err := client.Get(ctx, managementGroup, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Management Group Template Deployment %q (Management Group %q): %+v", name, managementGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_management_group_template_deployment", *existing.ID)
+		}
+	}
+
+	properties, err := expandTemplateDeploymentProperties(d)
+	if err != nil {
+		return err
+	}
+
+	deployment := resources.Deployment{
+		Location:   utils.String(location),
+		Properties: properties,
+	}
+
+	log.Printf("[INFO] Deploying Management Group Template Deployment %q (Management Group %q)..", name, managementGroup)
+	future, err := client.CreateOrUpdate(ctx, managementGroup, name, deployment)
+	if err != nil {
+		return fmt.Errorf("Error creating Management Group Template Deployment %q (Management Group %q): %+v", name, managementGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation of Management Group Template Deployment %q (Management Group %q): %+v", name, managementGroup, err)
+	}
+
+	d.SetId(azure.NewManagementGroupTemplateDeploymentID(managementGroup, name).ID())
+
+	return resourceArmManagementGroupTemplateDeploymentRead(d, meta)
+}
+
+func resourceArmManagementGroupTemplateDeploymentRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).managementGroupDeploymentsClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseManagementGroupTemplateDeploymentID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ManagementGroupName, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Management Group Template Deployment %q (Management Group %q) was not found - removing from state", id.Name, id.ManagementGroupName)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Management Group Template Deployment %q (Management Group %q): %+v", id.Name, id.ManagementGroupName, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("management_group_id", azure.NewManagementGroupID(id.ManagementGroupName).ID())
+	if location := resp.Location; location != nil {
+		d.Set("location", azure.NormalizeLocation(*location))
+	}
+
+	template, err := client.ExportTemplate(ctx, id.ManagementGroupName, id.Name)
+	if err != nil {
+		return fmt.Errorf("Error exporting the Template of Management Group Template Deployment %q (Management Group %q): %+v", id.Name, id.ManagementGroupName, err)
+	}
+
+	return flattenTemplateDeploymentTemplateAndOutputs(d, template.Template, resp.Properties)
+}
+
+func resourceArmManagementGroupTemplateDeploymentWhatIf(d *schema.ResourceDiff, meta interface{}) ([]azure.TemplateDeploymentWhatIfChange, error) {
+	client := meta.(*ArmClient).templateDeploymentWhatIfClient
+	ctx, cancel := context.WithTimeout(meta.(*ArmClient).StopContext, templateDeploymentWhatIfTimeout)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	location := azure.NormalizeLocation(d.Get("location").(string))
+	managementGroupId, err := azure.ParseManagementGroupID(d.Get("management_group_id").(string))
+	if err != nil {
+		return nil, err
+	}
+
+	properties, err := expandTemplateDeploymentProperties(d)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.WhatIfAtManagementGroup(ctx, managementGroupId.Name, name, azure.TemplateDeploymentWhatIf{
+		Location:   utils.String(location),
+		Properties: properties,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Error evaluating the `what-if` of Management Group Template Deployment %q (Management Group %q): %+v", name, managementGroupId.Name, err)
+	}
+
+	return flattenTemplateDeploymentWhatIfResult(result), nil
+}
+
+func resourceArmManagementGroupTemplateDeploymentDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	deploymentsClient := client.managementGroupDeploymentsClient
+	ctx, cancel := timeouts.ForDelete(client.StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseManagementGroupTemplateDeploymentID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if d.Get("delete_deployed_resources").(bool) {
+		operations, err := listManagementGroupTemplateDeploymentOperations(ctx, client, id.ManagementGroupName, id.Name)
+		if err != nil {
+			return err
+		}
+
+		if err := deleteTemplateDeploymentResources(ctx, client, operations); err != nil {
+			return fmt.Errorf("Error deleting the resources deployed by Management Group Template Deployment %q (Management Group %q): %+v", id.Name, id.ManagementGroupName, err)
+		}
+	}
+
+	if _, err := deploymentsClient.Delete(ctx, id.ManagementGroupName, id.Name); err != nil {
+		return fmt.Errorf("Error deleting Management Group Template Deployment %q (Management Group %q): %+v", id.Name, id.ManagementGroupName, err)
+	}
+
+	description := fmt.Sprintf("Management Group Template Deployment %q (Management Group %q)", id.Name, id.ManagementGroupName)
+	return waitForTemplateDeploymentToBeDeleted(description, d.Timeout(schema.TimeoutDelete), func() (resources.DeploymentExtended, error) {
+		return deploymentsClient.Get(ctx, id.ManagementGroupName, id.Name)
+	})
+}
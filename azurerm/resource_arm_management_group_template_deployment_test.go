@@ -0,0 +1,211 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMManagementGroupTemplateDeployment_basic(t *testing.T) {
+	resourceName := "azurerm_management_group_template_deployment.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMManagementGroupTemplateDeploymentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMManagementGroupTemplateDeployment_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMManagementGroupTemplateDeploymentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "template_changes.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "outputs_json", fmt.Sprintf(`{"allowedLocations":["%s"]}`, azure.NormalizeLocation(location))),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"parameters_body", "template_changes"},
+			},
+		},
+	})
+}
+
+func TestAccAzureRMManagementGroupTemplateDeployment_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_management_group_template_deployment.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMManagementGroupTemplateDeploymentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMManagementGroupTemplateDeployment_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMManagementGroupTemplateDeploymentExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMManagementGroupTemplateDeployment_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_management_group_template_deployment"),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMManagementGroupTemplateDeploymentExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		id, err := azure.ParseManagementGroupTemplateDeploymentID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).managementGroupDeploymentsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, id.ManagementGroupName, id.Name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Management Group Template Deployment %q (Management Group %q) does not exist", id.Name, id.ManagementGroupName)
+			}
+
+			return fmt.Errorf("Bad: Get on managementGroupDeploymentsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMManagementGroupTemplateDeploymentDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).managementGroupDeploymentsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_management_group_template_deployment" {
+			continue
+		}
+
+		id, err := azure.ParseManagementGroupTemplateDeploymentID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.Get(ctx, id.ManagementGroupName, id.Name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(resp.Response) {
+				return err
+			}
+			continue
+		}
+
+		return fmt.Errorf("Management Group Template Deployment %q (Management Group %q) still exists", id.Name, id.ManagementGroupName)
+	}
+
+	return nil
+}
+
+func testAccAzureRMManagementGroupTemplateDeployment_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_management_group" "test" {
+  display_name = "acctestmg-%[1]d"
+}
+
+resource "azurerm_management_group_template_deployment" "test" {
+  name                = "acctestmgdeploy-%[1]d"
+  management_group_id = "${azurerm_management_group.test.id}"
+  location            = "%[2]s"
+
+  template_body = <<DEPLOY
+{
+  "$schema": "https://schema.management.azure.com/schemas/2019-08-01/managementGroupDeploymentTemplate.json#",
+  "contentVersion": "1.0.0.0",
+  "parameters": {
+    "policyName": {
+      "type": "string"
+    },
+    "allowedLocations": {
+      "type": "array"
+    }
+  },
+  "resources": [
+    {
+      "type": "Microsoft.Authorization/policyDefinitions",
+      "apiVersion": "2018-05-01",
+      "name": "[parameters('policyName')]",
+      "properties": {
+        "policyType": "Custom",
+        "mode": "All",
+        "displayName": "[parameters('policyName')]",
+        "parameters": {},
+        "policyRule": {
+          "if": {
+            "not": {
+              "field": "location",
+              "in": "[parameters('allowedLocations')]"
+            }
+          },
+          "then": {
+            "effect": "audit"
+          }
+        }
+      }
+    }
+  ],
+  "outputs": {
+    "allowedLocations": {
+      "type": "array",
+      "value": "[parameters('allowedLocations')]"
+    }
+  }
+}
+DEPLOY
+
+  parameters_body = <<PARAMS
+{
+  "policyName": {
+    "value": "acctestpol-%[1]d"
+  },
+  "allowedLocations": {
+    "value": ["%[3]s"]
+  }
+}
+PARAMS
+}
+`, rInt, location, azure.NormalizeLocation(location))
+}
+
+func testAccAzureRMManagementGroupTemplateDeployment_requiresImport(rInt int, location string) string {
+	template := testAccAzureRMManagementGroupTemplateDeployment_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_management_group_template_deployment" "import" {
+  name                = "${azurerm_management_group_template_deployment.test.name}"
+  management_group_id = "${azurerm_management_group_template_deployment.test.management_group_id}"
+  location            = "${azurerm_management_group_template_deployment.test.location}"
+  template_body       = "${azurerm_management_group_template_deployment.test.template_body}"
+  parameters_body     = "${azurerm_management_group_template_deployment.test.parameters_body}"
+}
+`, template)
+}
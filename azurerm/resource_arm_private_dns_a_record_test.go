@@ -0,0 +1,223 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMPrivateDnsARecord_basic(t *testing.T) {
+	resourceName := "azurerm_private_dns_a_record.test"
+	ri := tf.AccRandTimeInt()
+	config := testAccAzureRMPrivateDnsARecord_basic(ri, testLocation())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMPrivateDnsARecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMPrivateDnsARecordExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "fqdn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMPrivateDnsARecord_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_private_dns_a_record.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMPrivateDnsARecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMPrivateDnsARecord_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMPrivateDnsARecordExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMPrivateDnsARecord_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_private_dns_a_record"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMPrivateDnsARecord_update(t *testing.T) {
+	resourceName := "azurerm_private_dns_a_record.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMPrivateDnsARecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMPrivateDnsARecord_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMPrivateDnsARecordExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "records.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				Config: testAccAzureRMPrivateDnsARecord_updated(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMPrivateDnsARecordExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "records.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "ttl", "600"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMPrivateDnsARecordExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		id, err := azure.ParsePrivateDnsARecordID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).privateDnsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.GetRecordSet(ctx, id.ID())
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Private DNS A Record %q (Zone %q / Resource Group %q) does not exist", id.Name, id.ZoneName, id.ResourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get Private DNS A Record: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMPrivateDnsARecordDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).privateDnsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_private_dns_a_record" {
+			continue
+		}
+
+		id, err := azure.ParsePrivateDnsARecordID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.GetRecordSet(ctx, id.ID())
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Private DNS A Record %q (Zone %q / Resource Group %q) still exists", id.Name, id.ZoneName, id.ResourceGroup)
+	}
+
+	return nil
+}
+
+func testAccAzureRMPrivateDnsARecord_template(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_private_dns_zone" "test" {
+  name                = "acctestzone%d.com"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+`, rInt, location, rInt)
+}
+
+func testAccAzureRMPrivateDnsARecord_basic(rInt int, location string) string {
+	template := testAccAzureRMPrivateDnsARecord_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_private_dns_a_record" "test" {
+  name                = "myarecord%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  zone_name           = "${azurerm_private_dns_zone.test.name}"
+  ttl                 = 300
+  records             = ["1.2.3.4", "1.2.4.5"]
+}
+`, template, rInt)
+}
+
+func testAccAzureRMPrivateDnsARecord_requiresImport(rInt int, location string) string {
+	template := testAccAzureRMPrivateDnsARecord_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_private_dns_a_record" "import" {
+  name                = "${azurerm_private_dns_a_record.test.name}"
+  resource_group_name = "${azurerm_private_dns_a_record.test.resource_group_name}"
+  zone_name           = "${azurerm_private_dns_a_record.test.zone_name}"
+  ttl                 = 300
+  records             = ["1.2.3.4", "1.2.4.5"]
+}
+`, template)
+}
+
+func testAccAzureRMPrivateDnsARecord_updated(rInt int, location string) string {
+	template := testAccAzureRMPrivateDnsARecord_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_private_dns_a_record" "test" {
+  name                = "myarecord%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  zone_name           = "${azurerm_private_dns_zone.test.name}"
+  ttl                 = 600
+  records             = ["1.2.3.4", "1.2.4.5", "1.2.3.7"]
+
+  tags = {
+    environment = "staging"
+  }
+}
+`, template, rInt)
+}
@@ -0,0 +1,223 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMPrivateDnsCNameRecord_basic(t *testing.T) {
+	resourceName := "azurerm_private_dns_cname_record.test"
+	ri := tf.AccRandTimeInt()
+	config := testAccAzureRMPrivateDnsCNameRecord_basic(ri, testLocation())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMPrivateDnsCNameRecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMPrivateDnsCNameRecordExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "fqdn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMPrivateDnsCNameRecord_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_private_dns_cname_record.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMPrivateDnsCNameRecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMPrivateDnsCNameRecord_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMPrivateDnsCNameRecordExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMPrivateDnsCNameRecord_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_private_dns_cname_record"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMPrivateDnsCNameRecord_update(t *testing.T) {
+	resourceName := "azurerm_private_dns_cname_record.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMPrivateDnsCNameRecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMPrivateDnsCNameRecord_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMPrivateDnsCNameRecordExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "record", "contoso.com"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				Config: testAccAzureRMPrivateDnsCNameRecord_updated(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMPrivateDnsCNameRecordExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "record", "contoso.co.uk"),
+					resource.TestCheckResourceAttr(resourceName, "ttl", "600"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMPrivateDnsCNameRecordExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		id, err := azure.ParsePrivateDnsCNameRecordID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).privateDnsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.GetRecordSet(ctx, id.ID())
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Private DNS CNAME Record %q (Zone %q / Resource Group %q) does not exist", id.Name, id.ZoneName, id.ResourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get Private DNS CNAME Record: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMPrivateDnsCNameRecordDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).privateDnsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_private_dns_cname_record" {
+			continue
+		}
+
+		id, err := azure.ParsePrivateDnsCNameRecordID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.GetRecordSet(ctx, id.ID())
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Private DNS CNAME Record %q (Zone %q / Resource Group %q) still exists", id.Name, id.ZoneName, id.ResourceGroup)
+	}
+
+	return nil
+}
+
+func testAccAzureRMPrivateDnsCNameRecord_template(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_private_dns_zone" "test" {
+  name                = "acctestzone%d.com"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+`, rInt, location, rInt)
+}
+
+func testAccAzureRMPrivateDnsCNameRecord_basic(rInt int, location string) string {
+	template := testAccAzureRMPrivateDnsCNameRecord_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_private_dns_cname_record" "test" {
+  name                = "acctestcname%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  zone_name           = "${azurerm_private_dns_zone.test.name}"
+  ttl                 = 300
+  record              = "contoso.com"
+}
+`, template, rInt)
+}
+
+func testAccAzureRMPrivateDnsCNameRecord_requiresImport(rInt int, location string) string {
+	template := testAccAzureRMPrivateDnsCNameRecord_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_private_dns_cname_record" "import" {
+  name                = "${azurerm_private_dns_cname_record.test.name}"
+  resource_group_name = "${azurerm_private_dns_cname_record.test.resource_group_name}"
+  zone_name           = "${azurerm_private_dns_cname_record.test.zone_name}"
+  ttl                 = 300
+  record              = "contoso.com"
+}
+`, template)
+}
+
+func testAccAzureRMPrivateDnsCNameRecord_updated(rInt int, location string) string {
+	template := testAccAzureRMPrivateDnsCNameRecord_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_private_dns_cname_record" "test" {
+  name                = "acctestcname%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  zone_name           = "${azurerm_private_dns_zone.test.name}"
+  ttl                 = 600
+  record              = "contoso.co.uk"
+
+  tags = {
+    environment = "staging"
+  }
+}
+`, template, rInt)
+}
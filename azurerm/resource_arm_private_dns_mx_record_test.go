@@ -0,0 +1,255 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMPrivateDnsMxRecord_basic(t *testing.T) {
+	resourceName := "azurerm_private_dns_mx_record.test"
+	ri := tf.AccRandTimeInt()
+	config := testAccAzureRMPrivateDnsMxRecord_basic(ri, testLocation())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMPrivateDnsMxRecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMPrivateDnsMxRecordExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "fqdn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMPrivateDnsMxRecord_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_private_dns_mx_record.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMPrivateDnsMxRecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMPrivateDnsMxRecord_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMPrivateDnsMxRecordExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMPrivateDnsMxRecord_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_private_dns_mx_record"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMPrivateDnsMxRecord_update(t *testing.T) {
+	resourceName := "azurerm_private_dns_mx_record.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMPrivateDnsMxRecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMPrivateDnsMxRecord_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMPrivateDnsMxRecordExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "record.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				Config: testAccAzureRMPrivateDnsMxRecord_updated(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMPrivateDnsMxRecordExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "record.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "ttl", "600"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMPrivateDnsMxRecordExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		id, err := azure.ParsePrivateDnsMxRecordID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).privateDnsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.GetRecordSet(ctx, id.ID())
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Private DNS MX Record %q (Zone %q / Resource Group %q) does not exist", id.Name, id.ZoneName, id.ResourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get Private DNS MX Record: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMPrivateDnsMxRecordDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).privateDnsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_private_dns_mx_record" {
+			continue
+		}
+
+		id, err := azure.ParsePrivateDnsMxRecordID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.GetRecordSet(ctx, id.ID())
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Private DNS MX Record %q (Zone %q / Resource Group %q) still exists", id.Name, id.ZoneName, id.ResourceGroup)
+	}
+
+	return nil
+}
+
+func testAccAzureRMPrivateDnsMxRecord_template(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_private_dns_zone" "test" {
+  name                = "acctestzone%d.com"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+`, rInt, location, rInt)
+}
+
+func testAccAzureRMPrivateDnsMxRecord_basic(rInt int, location string) string {
+	template := testAccAzureRMPrivateDnsMxRecord_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_private_dns_mx_record" "test" {
+  name                = "acctestmx%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  zone_name           = "${azurerm_private_dns_zone.test.name}"
+  ttl                 = 300
+
+  record {
+    preference = 10
+    exchange   = "mx1.contoso.com"
+  }
+
+  record {
+    preference = 20
+    exchange   = "backupmx.contoso.com"
+  }
+}
+`, template, rInt)
+}
+
+func testAccAzureRMPrivateDnsMxRecord_requiresImport(rInt int, location string) string {
+	template := testAccAzureRMPrivateDnsMxRecord_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_private_dns_mx_record" "import" {
+  name                = "${azurerm_private_dns_mx_record.test.name}"
+  resource_group_name = "${azurerm_private_dns_mx_record.test.resource_group_name}"
+  zone_name           = "${azurerm_private_dns_mx_record.test.zone_name}"
+  ttl                 = 300
+
+  record {
+    preference = 10
+    exchange   = "mx1.contoso.com"
+  }
+
+  record {
+    preference = 20
+    exchange   = "backupmx.contoso.com"
+  }
+}
+`, template)
+}
+
+func testAccAzureRMPrivateDnsMxRecord_updated(rInt int, location string) string {
+	template := testAccAzureRMPrivateDnsMxRecord_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_private_dns_mx_record" "test" {
+  name                = "acctestmx%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  zone_name           = "${azurerm_private_dns_zone.test.name}"
+  ttl                 = 600
+
+  record {
+    preference = 10
+    exchange   = "mx1.contoso.com"
+  }
+
+  record {
+    preference = 20
+    exchange   = "backupmx.contoso.com"
+  }
+
+  record {
+    preference = 50
+    exchange   = "mx2.contoso.com"
+  }
+
+  tags = {
+    environment = "staging"
+  }
+}
+`, template, rInt)
+}
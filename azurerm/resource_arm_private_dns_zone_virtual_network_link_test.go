@@ -0,0 +1,200 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMPrivateDnsZoneVirtualNetworkLink_basic(t *testing.T) {
+	resourceName := "azurerm_private_dns_zone_virtual_network_link.test"
+	ri := tf.AccRandTimeInt()
+	config := testAccAzureRMPrivateDnsZoneVirtualNetworkLink_basic(ri, testLocation(), false)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMPrivateDnsZoneVirtualNetworkLinkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMPrivateDnsZoneVirtualNetworkLinkExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "registration_enabled", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMPrivateDnsZoneVirtualNetworkLink_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_private_dns_zone_virtual_network_link.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMPrivateDnsZoneVirtualNetworkLinkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMPrivateDnsZoneVirtualNetworkLink_basic(ri, location, false),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMPrivateDnsZoneVirtualNetworkLinkExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMPrivateDnsZoneVirtualNetworkLink_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_private_dns_zone_virtual_network_link"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMPrivateDnsZoneVirtualNetworkLink_registrationEnabled(t *testing.T) {
+	resourceName := "azurerm_private_dns_zone_virtual_network_link.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMPrivateDnsZoneVirtualNetworkLinkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMPrivateDnsZoneVirtualNetworkLink_basic(ri, location, false),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMPrivateDnsZoneVirtualNetworkLinkExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "registration_enabled", "false"),
+				),
+			},
+			{
+				Config: testAccAzureRMPrivateDnsZoneVirtualNetworkLink_basic(ri, location, true),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMPrivateDnsZoneVirtualNetworkLinkExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "registration_enabled", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMPrivateDnsZoneVirtualNetworkLinkExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		id, err := azure.ParsePrivateDnsZoneVirtualNetworkLinkID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).privateDnsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.GetVirtualNetworkLink(ctx, *id)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Virtual Network Link %q (Private DNS Zone %q / Resource Group %q) does not exist", id.Name, id.ZoneName, id.ResourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get Virtual Network Link: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMPrivateDnsZoneVirtualNetworkLinkDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).privateDnsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_private_dns_zone_virtual_network_link" {
+			continue
+		}
+
+		id, err := azure.ParsePrivateDnsZoneVirtualNetworkLinkID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.GetVirtualNetworkLink(ctx, *id)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Virtual Network Link %q (Private DNS Zone %q / Resource Group %q) still exists", id.Name, id.ZoneName, id.ResourceGroup)
+	}
+
+	return nil
+}
+
+func testAccAzureRMPrivateDnsZoneVirtualNetworkLink_basic(rInt int, location string, registrationEnabled bool) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvnet%d"
+  address_space       = ["10.0.0.0/16"]
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_private_dns_zone" "test" {
+  name                = "acctestzone%d.com"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_private_dns_zone_virtual_network_link" "test" {
+  name                  = "acctestlink%d"
+  private_dns_zone_name = "${azurerm_private_dns_zone.test.name}"
+  resource_group_name   = "${azurerm_resource_group.test.name}"
+  virtual_network_id    = "${azurerm_virtual_network.test.id}"
+  registration_enabled  = %t
+}
+`, rInt, location, rInt, rInt, rInt, registrationEnabled)
+}
+
+func testAccAzureRMPrivateDnsZoneVirtualNetworkLink_requiresImport(rInt int, location string) string {
+	template := testAccAzureRMPrivateDnsZoneVirtualNetworkLink_basic(rInt, location, false)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_private_dns_zone_virtual_network_link" "import" {
+  name                  = "${azurerm_private_dns_zone_virtual_network_link.test.name}"
+  private_dns_zone_name = "${azurerm_private_dns_zone_virtual_network_link.test.private_dns_zone_name}"
+  resource_group_name   = "${azurerm_private_dns_zone_virtual_network_link.test.resource_group_name}"
+  virtual_network_id    = "${azurerm_private_dns_zone_virtual_network_link.test.virtual_network_id}"
+}
+`, template)
+}
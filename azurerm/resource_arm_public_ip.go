@@ -4,12 +4,15 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-08-01/network"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
@@ -36,6 +39,13 @@ func resourceArmPublicIp() *schema.Resource {
 			},
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -130,12 +140,40 @@ func resourceArmPublicIp() *schema.Resource {
 
 			"tags": tagsSchema(),
 		},
+
+		CustomizeDiff: azure.CustomizeDiffAll(resourceArmPublicIpCustomizeDiff, azure.ZonesCustomizeDiff),
 	}
 }
 
+func resourceArmPublicIpCustomizeDiff(d *schema.ResourceDiff, _ interface{}) error {
+	if !d.NewValueKnown("sku") || !d.NewValueKnown("ip_version") || !d.NewValueKnown("allocation_method") || !d.NewValueKnown("public_ip_address_allocation") {
+		return nil
+	}
+
+	// both fields are Computed, so the deprecated field is only used when it's being changed (or is the only one set)
+	ipAllocationMethod := d.Get("allocation_method").(string)
+	if ipAllocationMethod == "" || d.HasChange("public_ip_address_allocation") {
+		ipAllocationMethod = d.Get("public_ip_address_allocation").(string)
+	}
+	if ipAllocationMethod == "" {
+		return nil
+	}
+
+	if strings.EqualFold(d.Get("ip_version").(string), string(network.IPv6)) && strings.EqualFold(ipAllocationMethod, string(network.Static)) {
+		return fmt.Errorf("Static IP allocation can't be used with IPv6 Public IP addresses - `allocation_method` must be `Dynamic`.")
+	}
+
+	if strings.EqualFold(d.Get("sku").(string), string(network.PublicIPAddressSkuNameStandard)) && !strings.EqualFold(ipAllocationMethod, string(network.Static)) {
+		return fmt.Errorf("Static IP allocation must be used when creating Standard SKU public IP addresses.")
+	}
+
+	return nil
+}
+
 func resourceArmPublicIpCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).publicIPClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for AzureRM Public IP creation.")
 
@@ -157,19 +195,16 @@ func resourceArmPublicIpCreateUpdate(d *schema.ResourceData, meta interface{}) e
 		return fmt.Errorf("Either `allocation_method` or `public_ip_address_allocation` must be specified.")
 	}
 
-	if strings.EqualFold(string(ipVersion), string(network.IPv6)) {
-		if strings.EqualFold(ipAllocationMethod, "static") {
-			return fmt.Errorf("Cannot specify publicIpAllocationMethod as Static for IPv6 PublicIp")
-		}
+	// these are also validated at plan time, but only when the values are known at that point
+	if strings.EqualFold(string(ipVersion), string(network.IPv6)) && strings.EqualFold(ipAllocationMethod, string(network.Static)) {
+		return fmt.Errorf("Static IP allocation can't be used with IPv6 Public IP addresses - `allocation_method` must be `Dynamic`.")
 	}
 
-	if strings.EqualFold(sku, "standard") {
-		if !strings.EqualFold(ipAllocationMethod, "static") {
-			return fmt.Errorf("Static IP allocation must be used when creating Standard SKU public IP addresses.")
-		}
+	if strings.EqualFold(sku, string(network.PublicIPAddressSkuNameStandard)) && !strings.EqualFold(ipAllocationMethod, string(network.Static)) {
+		return fmt.Errorf("Static IP allocation must be used when creating Standard SKU public IP addresses.")
 	}
 
-	if requireResourcesToBeImported && d.IsNewResource() {
+	if meta.(*ArmClient).requireResourcesToBeImported && d.IsNewResource() {
 		existing, err := client.Get(ctx, resGroup, name, "")
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
@@ -238,7 +273,8 @@ func resourceArmPublicIpCreateUpdate(d *schema.ResourceData, meta interface{}) e
 
 func resourceArmPublicIpRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).publicIPClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -290,7 +326,8 @@ func resourceArmPublicIpRead(d *schema.ResourceData, meta interface{}) error {
 
 func resourceArmPublicIpDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).publicIPClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -1,17 +1,23 @@
 package azurerm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2018-02-01/storage"
+	mainStorage "github.com/Azure/azure-sdk-for-go/storage"
 	"github.com/hashicorp/go-getter/helper/url"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -24,12 +30,17 @@ func resourceArmStorageAccount() *schema.Resource {
 		Update: resourceArmStorageAccountUpdate,
 		Delete: resourceArmStorageAccountDelete,
 
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
-		},
+		Importer:      azure.ValidateResourceIDPriorToImport(azure.ValidateStorageAccountID),
 		MigrateState:  resourceStorageAccountMigrateState,
 		SchemaVersion: 2,
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -189,6 +200,108 @@ func resourceArmStorageAccount() *schema.Resource {
 				},
 			},
 
+			"blob_properties": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cors_rule": azure.SchemaStorageAccountCorsRule(),
+
+						"delete_retention_policy": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"days": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Default:      7,
+										ValidateFunc: validation.IntBetween(1, 365),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			// the Queue Service has Logging and Metrics enabled by default, so this is Computed
+			"queue_properties": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cors_rule": azure.SchemaStorageAccountCorsRule(),
+
+						"logging": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"version": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validate.NoEmptyStrings,
+									},
+
+									"delete": {
+										Type:     schema.TypeBool,
+										Required: true,
+									},
+
+									"read": {
+										Type:     schema.TypeBool,
+										Required: true,
+									},
+
+									"write": {
+										Type:     schema.TypeBool,
+										Required: true,
+									},
+
+									"retention_policy_days": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntBetween(1, 365),
+									},
+								},
+							},
+						},
+
+						"hour_metrics": schemaStorageAccountQueueMetrics(),
+
+						"minute_metrics": schemaStorageAccountQueueMetrics(),
+					},
+				},
+			},
+
+			"static_website": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"index_document": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+
+						"error_404_document": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+					},
+				},
+			},
+
 			"primary_location": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -270,6 +383,26 @@ func resourceArmStorageAccount() *schema.Resource {
 				Computed: true,
 			},
 
+			"primary_web_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"primary_web_host": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"secondary_web_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"secondary_web_host": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
 			"primary_access_key": {
 				Type:      schema.TypeString,
 				Sensitive: true,
@@ -340,6 +473,42 @@ func resourceArmStorageAccount() *schema.Resource {
 				ValidateFunc: validateAzureRMStorageAccountTags,
 			},
 		},
+
+		CustomizeDiff: azure.StorageAccountCustomizeDiff,
+	}
+}
+
+func schemaStorageAccountQueueMetrics() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Computed: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"version": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+
+				"enabled": {
+					Type:     schema.TypeBool,
+					Required: true,
+				},
+
+				"include_apis": {
+					Type:     schema.TypeBool,
+					Optional: true,
+				},
+
+				"retention_policy_days": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntBetween(1, 365),
+				},
+			},
+		},
 	}
 }
 
@@ -367,13 +536,14 @@ func validateAzureRMStorageAccountTags(v interface{}, _ string) (warnings []stri
 }
 
 func resourceArmStorageAccountCreate(d *schema.ResourceData, meta interface{}) error {
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	client := meta.(*ArmClient).storageServiceClient
 
 	storageAccountName := d.Get("name").(string)
 	resourceGroupName := d.Get("resource_group_name").(string)
 
-	if requireResourcesToBeImported {
+	if meta.(*ArmClient).requireResourcesToBeImported {
 		existing, err := client.GetProperties(ctx, resourceGroupName, storageAccountName)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
@@ -478,6 +648,26 @@ func resourceArmStorageAccountCreate(d *schema.ResourceData, meta interface{}) e
 	log.Printf("[INFO] storage account %q ID: %q", storageAccountName, *account.ID)
 	d.SetId(*account.ID)
 
+	blobProperties := azure.StorageServiceProperties{}
+	if v, ok := d.GetOk("blob_properties"); ok {
+		blobProperties.Cors, blobProperties.DeleteRetentionPolicy = expandStorageAccountBlobProperties(v.([]interface{}))
+	}
+	if v, ok := d.GetOk("static_website"); ok {
+		blobProperties.StaticWebsite = expandStorageAccountStaticWebsite(v.([]interface{}))
+	}
+	if blobProperties.Cors != nil || blobProperties.StaticWebsite != nil {
+		if err := setAzureRmStorageAccountServiceProperties(ctx, meta, resourceGroupName, storageAccountName, "blob", blobProperties); err != nil {
+			return err
+		}
+	}
+
+	if v, ok := d.GetOk("queue_properties"); ok {
+		queueProperties := expandStorageAccountQueueProperties(v.([]interface{}))
+		if err := setAzureRmStorageAccountServiceProperties(ctx, meta, resourceGroupName, storageAccountName, "queue", queueProperties); err != nil {
+			return err
+		}
+	}
+
 	return resourceArmStorageAccountRead(d, meta)
 }
 
@@ -485,7 +675,8 @@ func resourceArmStorageAccountCreate(d *schema.ResourceData, meta interface{}) e
 // and idempotent operation for CreateOrUpdate. In particular updating all of the parameters
 // available requires a call to Update per parameter...
 func resourceArmStorageAccountUpdate(d *schema.ResourceData, meta interface{}) error {
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	client := meta.(*ArmClient).storageServiceClient
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -645,12 +836,40 @@ func resourceArmStorageAccountUpdate(d *schema.ResourceData, meta interface{}) e
 		d.SetPartial("network_rules")
 	}
 
+	if d.HasChange("blob_properties") || d.HasChange("static_website") {
+		// only the properties which have changed are sent, such that the others are left as-is
+		blobProperties := azure.StorageServiceProperties{}
+		if d.HasChange("blob_properties") {
+			blobProperties.Cors, blobProperties.DeleteRetentionPolicy = expandStorageAccountBlobProperties(d.Get("blob_properties").([]interface{}))
+		}
+		if d.HasChange("static_website") {
+			blobProperties.StaticWebsite = expandStorageAccountStaticWebsite(d.Get("static_website").([]interface{}))
+		}
+
+		if err := setAzureRmStorageAccountServiceProperties(ctx, meta, resourceGroupName, storageAccountName, "blob", blobProperties); err != nil {
+			return err
+		}
+
+		d.SetPartial("blob_properties")
+		d.SetPartial("static_website")
+	}
+
+	if d.HasChange("queue_properties") {
+		queueProperties := expandStorageAccountQueueProperties(d.Get("queue_properties").([]interface{}))
+		if err := setAzureRmStorageAccountServiceProperties(ctx, meta, resourceGroupName, storageAccountName, "queue", queueProperties); err != nil {
+			return err
+		}
+
+		d.SetPartial("queue_properties")
+	}
+
 	d.Partial(false)
 	return resourceArmStorageAccountRead(d, meta)
 }
 
 func resourceArmStorageAccountRead(d *schema.ResourceData, meta interface{}) error {
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	client := meta.(*ArmClient).storageServiceClient
 	endpointSuffix := meta.(*ArmClient).environment.StorageEndpointSuffix
 
@@ -762,13 +981,18 @@ func resourceArmStorageAccountRead(d *schema.ResourceData, meta interface{}) err
 		return err
 	}
 
+	if err := readAzureRmStorageAccountServiceProperties(ctx, d, meta, resGroup, name, resp); err != nil {
+		return err
+	}
+
 	flattenAndSetTags(d, resp.Tags)
 
 	return nil
 }
 
 func resourceArmStorageAccountDelete(d *schema.ResourceData, meta interface{}) error {
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	client := meta.(*ArmClient).storageServiceClient
 
 	id, err := parseAzureResourceID(d.Id())
@@ -1093,6 +1317,21 @@ func flattenAndSetAzureRmStorageAccountPrimaryEndpoints(d *schema.ResourceData,
 	d.Set("primary_file_endpoint", fileEndpoint)
 	d.Set("primary_file_host", fileHost)
 
+	var webEndpoint, webHost string
+	if primary != nil {
+		if v := primary.Web; v != nil {
+			webEndpoint = *v
+
+			u, err := url.Parse(*v)
+			if err != nil {
+				return fmt.Errorf("invalid web endpoint for parsing: %q", *v)
+			}
+			webHost = u.Host
+		}
+	}
+	d.Set("primary_web_endpoint", webEndpoint)
+	d.Set("primary_web_host", webHost)
+
 	if primary == nil {
 		return fmt.Errorf("primary endpoints should not be empty")
 	}
@@ -1146,5 +1385,302 @@ func flattenAndSetAzureRmStorageAccountSecondaryEndpoints(d *schema.ResourceData
 	d.Set("secondary_table_endpoint", tableEndpoint)
 	d.Set("secondary_table_host", tableHost)
 
+	var webEndpoint, webHost string
+	if secondary != nil {
+		if v := secondary.Web; v != nil {
+			webEndpoint = *v
+
+			u, err := url.Parse(*v)
+			if err != nil {
+				return fmt.Errorf("invalid web endpoint for parsing: %q", *v)
+			}
+			webHost = u.Host
+		}
+	}
+	d.Set("secondary_web_endpoint", webEndpoint)
+	d.Set("secondary_web_host", webHost)
+
+	return nil
+}
+
+func setAzureRmStorageAccountServiceProperties(ctx context.Context, meta interface{}, resourceGroup, name, service string, properties azure.StorageServiceProperties) error {
+	client, _, err := meta.(*ArmClient).getStorageServicePropertiesClientForStorageAccount(ctx, resourceGroup, name, service)
+	if err != nil {
+		return fmt.Errorf("Error building %s Service Properties Client for Storage Account %q (Resource Group %q): %+v", service, name, resourceGroup, err)
+	}
+
+	if err := client.SetServiceProperties(ctx, properties); err != nil {
+		return fmt.Errorf("Error updating %s Service Properties for Storage Account %q (Resource Group %q): %+v", service, name, resourceGroup, err)
+	}
+
 	return nil
 }
+
+// readAzureRmStorageAccountServiceProperties sets the Blob & Queue Service Properties of the Storage Account - which are
+// retrieved from the data-plane API, and as such can be blocked by the Storage Account's firewall (in which case a
+// warning is logged and the existing values are kept)
+func readAzureRmStorageAccountServiceProperties(ctx context.Context, d *schema.ResourceData, meta interface{}, resourceGroup, name string, account storage.Account) error {
+	supportsBlobProperties := account.Sku != nil && account.Sku.Tier == storage.Standard
+	supportsQueueProperties := supportsBlobProperties && account.Kind != storage.BlobStorage
+
+	blobProperties := make([]interface{}, 0)
+	staticWebsite := make([]interface{}, 0)
+	if supportsBlobProperties {
+		props, err := getAzureRmStorageAccountServiceProperties(ctx, meta, resourceGroup, name, "blob")
+		if err != nil {
+			return err
+		}
+		if props == nil {
+			blobProperties = d.Get("blob_properties").([]interface{})
+			staticWebsite = d.Get("static_website").([]interface{})
+		} else {
+			blobProperties = flattenStorageAccountBlobProperties(*props)
+			staticWebsite = flattenStorageAccountStaticWebsite(props.StaticWebsite)
+		}
+	}
+	if err := d.Set("blob_properties", blobProperties); err != nil {
+		return fmt.Errorf("Error setting `blob_properties`: %+v", err)
+	}
+	if err := d.Set("static_website", staticWebsite); err != nil {
+		return fmt.Errorf("Error setting `static_website`: %+v", err)
+	}
+
+	queueProperties := make([]interface{}, 0)
+	if supportsQueueProperties {
+		props, err := getAzureRmStorageAccountServiceProperties(ctx, meta, resourceGroup, name, "queue")
+		if err != nil {
+			return err
+		}
+		if props == nil {
+			queueProperties = d.Get("queue_properties").([]interface{})
+		} else {
+			queueProperties = flattenStorageAccountQueueProperties(*props)
+		}
+	}
+	if err := d.Set("queue_properties", queueProperties); err != nil {
+		return fmt.Errorf("Error setting `queue_properties`: %+v", err)
+	}
+
+	return nil
+}
+
+// getAzureRmStorageAccountServiceProperties returns the properties of the Service, or nil if access to these was denied
+func getAzureRmStorageAccountServiceProperties(ctx context.Context, meta interface{}, resourceGroup, name, service string) (*azure.StorageServiceProperties, error) {
+	client, _, err := meta.(*ArmClient).getStorageServicePropertiesClientForStorageAccount(ctx, resourceGroup, name, service)
+	if err != nil {
+		return nil, fmt.Errorf("Error building %s Service Properties Client for Storage Account %q (Resource Group %q): %+v", service, name, resourceGroup, err)
+	}
+
+	props, err := client.GetServiceProperties(ctx)
+	if err != nil {
+		if utils.ResponseWasForbidden(props.Response) {
+			log.Printf("[WARN] Access to the %s Service Properties for Storage Account %q (Resource Group %q) was denied (this is likely due to the Network Rules) - skipping: %+v", service, name, resourceGroup, err)
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("Error retrieving %s Service Properties for Storage Account %q (Resource Group %q): %+v", service, name, resourceGroup, err)
+	}
+
+	return &props, nil
+}
+
+// expandStorageAccountBlobProperties returns the CORS Rules and Delete Retention Policy for the Blob Service - where
+// removing the `blob_properties` block removes the CORS Rules and disables the Delete Retention Policy
+func expandStorageAccountBlobProperties(input []interface{}) (*mainStorage.Cors, *mainStorage.RetentionPolicy) {
+	cors := azure.ExpandStorageAccountCorsRules([]interface{}{})
+	deleteRetentionPolicy := &mainStorage.RetentionPolicy{
+		Enabled: false,
+	}
+
+	if len(input) == 0 || input[0] == nil {
+		return cors, deleteRetentionPolicy
+	}
+	v := input[0].(map[string]interface{})
+
+	cors = azure.ExpandStorageAccountCorsRules(v["cors_rule"].([]interface{}))
+
+	if policies := v["delete_retention_policy"].([]interface{}); len(policies) > 0 && policies[0] != nil {
+		policy := policies[0].(map[string]interface{})
+		days := policy["days"].(int)
+		deleteRetentionPolicy = &mainStorage.RetentionPolicy{
+			Enabled: true,
+			Days:    &days,
+		}
+	}
+
+	return cors, deleteRetentionPolicy
+}
+
+func flattenStorageAccountBlobProperties(input azure.StorageServiceProperties) []interface{} {
+	corsRules := azure.FlattenStorageAccountCorsRules(input.Cors)
+
+	deleteRetentionPolicies := make([]interface{}, 0)
+	if policy := input.DeleteRetentionPolicy; policy != nil && policy.Enabled {
+		days := 0
+		if policy.Days != nil {
+			days = *policy.Days
+		}
+
+		deleteRetentionPolicies = append(deleteRetentionPolicies, map[string]interface{}{
+			"days": days,
+		})
+	}
+
+	if len(corsRules) == 0 && len(deleteRetentionPolicies) == 0 {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"cors_rule":               corsRules,
+			"delete_retention_policy": deleteRetentionPolicies,
+		},
+	}
+}
+
+// expandStorageAccountStaticWebsite returns the Static Website configuration for the Blob Service - where removing
+// the `static_website` block disables the Static Website
+func expandStorageAccountStaticWebsite(input []interface{}) *azure.StorageStaticWebsite {
+	if len(input) == 0 {
+		return &azure.StorageStaticWebsite{
+			Enabled: false,
+		}
+	}
+
+	website := azure.StorageStaticWebsite{
+		Enabled: true,
+	}
+
+	// an empty block is valid, since both documents are optional
+	if v, ok := input[0].(map[string]interface{}); ok {
+		website.IndexDocument = v["index_document"].(string)
+		website.ErrorDocument404Path = v["error_404_document"].(string)
+	}
+
+	return &website
+}
+
+func flattenStorageAccountStaticWebsite(input *azure.StorageStaticWebsite) []interface{} {
+	if input == nil || !input.Enabled {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"index_document":     input.IndexDocument,
+			"error_404_document": input.ErrorDocument404Path,
+		},
+	}
+}
+
+func expandStorageAccountQueueProperties(input []interface{}) azure.StorageServiceProperties {
+	properties := azure.StorageServiceProperties{
+		Cors: azure.ExpandStorageAccountCorsRules([]interface{}{}),
+	}
+
+	if len(input) == 0 || input[0] == nil {
+		return properties
+	}
+	v := input[0].(map[string]interface{})
+
+	properties.Cors = azure.ExpandStorageAccountCorsRules(v["cors_rule"].([]interface{}))
+
+	if loggings := v["logging"].([]interface{}); len(loggings) > 0 && loggings[0] != nil {
+		logging := loggings[0].(map[string]interface{})
+		properties.Logging = &mainStorage.Logging{
+			Version:         logging["version"].(string),
+			Delete:          logging["delete"].(bool),
+			Read:            logging["read"].(bool),
+			Write:           logging["write"].(bool),
+			RetentionPolicy: expandStorageAccountRetentionPolicy(logging["retention_policy_days"].(int)),
+		}
+	}
+
+	properties.HourMetrics = expandStorageAccountQueueMetrics(v["hour_metrics"].([]interface{}))
+	properties.MinuteMetrics = expandStorageAccountQueueMetrics(v["minute_metrics"].([]interface{}))
+
+	return properties
+}
+
+func expandStorageAccountQueueMetrics(input []interface{}) *mainStorage.Metrics {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+	v := input[0].(map[string]interface{})
+
+	metrics := mainStorage.Metrics{
+		Version:         v["version"].(string),
+		Enabled:         v["enabled"].(bool),
+		RetentionPolicy: expandStorageAccountRetentionPolicy(v["retention_policy_days"].(int)),
+	}
+
+	// the API only accepts `IncludeAPIs` when the Metrics are enabled
+	if metrics.Enabled {
+		metrics.IncludeAPIs = utils.Bool(v["include_apis"].(bool))
+	}
+
+	return &metrics
+}
+
+func expandStorageAccountRetentionPolicy(days int) *mainStorage.RetentionPolicy {
+	if days == 0 {
+		return &mainStorage.RetentionPolicy{
+			Enabled: false,
+		}
+	}
+
+	return &mainStorage.RetentionPolicy{
+		Enabled: true,
+		Days:    &days,
+	}
+}
+
+func flattenStorageAccountQueueProperties(input azure.StorageServiceProperties) []interface{} {
+	logging := make([]interface{}, 0)
+	if v := input.Logging; v != nil {
+		logging = append(logging, map[string]interface{}{
+			"version":               v.Version,
+			"delete":                v.Delete,
+			"read":                  v.Read,
+			"write":                 v.Write,
+			"retention_policy_days": flattenStorageAccountRetentionPolicy(v.RetentionPolicy),
+		})
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"cors_rule":      azure.FlattenStorageAccountCorsRules(input.Cors),
+			"logging":        logging,
+			"hour_metrics":   flattenStorageAccountQueueMetrics(input.HourMetrics),
+			"minute_metrics": flattenStorageAccountQueueMetrics(input.MinuteMetrics),
+		},
+	}
+}
+
+func flattenStorageAccountQueueMetrics(input *mainStorage.Metrics) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	includeAPIs := false
+	if input.IncludeAPIs != nil {
+		includeAPIs = *input.IncludeAPIs
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"version":               input.Version,
+			"enabled":               input.Enabled,
+			"include_apis":          includeAPIs,
+			"retention_policy_days": flattenStorageAccountRetentionPolicy(input.RetentionPolicy),
+		},
+	}
+}
+
+func flattenStorageAccountRetentionPolicy(input *mainStorage.RetentionPolicy) int {
+	if input == nil || !input.Enabled || input.Days == nil {
+		return 0
+	}
+
+	return *input.Days
+}
@@ -0,0 +1,429 @@
+package azurerm
+
+import (
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// the only type of Blob which Management Policies can currently be applied to
+const storageManagementPolicyBlobTypeBlockBlob = "blockBlob"
+
+func resourceArmStorageManagementPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmStorageManagementPolicyCreateUpdate,
+		Read:   resourceArmStorageManagementPolicyRead,
+		Update: resourceArmStorageManagementPolicyCreateUpdate,
+		Delete: resourceArmStorageManagementPolicyDelete,
+
+		Importer: azure.ImportStorageManagementPolicy(),
+
+		CustomizeDiff: azure.StorageManagementPolicyCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"storage_account_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     azure.ValidateStorageAccountID,
+				DiffSuppressFunc: suppress.CaseDifference,
+			},
+
+			"rule": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringMatch(
+								regexp.MustCompile(`^[a-zA-Z0-9]{1,256}$`),
+								"The name of a Rule can only contain letters and numbers, and must be between 1 and 256 characters long.",
+							),
+						},
+
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+
+						// the API defaults the Blob Types when the filters are omitted, so this is Computed
+						"filters": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"prefix_match": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.NoZeroValues,
+										},
+										Set: schema.HashString,
+									},
+
+									"blob_types": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Computed: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+											ValidateFunc: validation.StringInSlice([]string{
+												storageManagementPolicyBlobTypeBlockBlob,
+											}, false),
+										},
+										Set: schema.HashString,
+									},
+								},
+							},
+						},
+
+						"actions": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"base_blob": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"tier_to_cool_after_days_since_modification_greater_than":    storageManagementPolicyDaysSchema(),
+												"tier_to_archive_after_days_since_modification_greater_than": storageManagementPolicyDaysSchema(),
+												"delete_after_days_since_modification_greater_than":          storageManagementPolicyDaysSchema(),
+											},
+										},
+									},
+
+									"snapshot": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"delete_after_days_since_creation_greater_than": storageManagementPolicyDaysSchema(),
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// storageManagementPolicyDaysSchema returns the schema for the number of days after which an Action is taken - where
+// the default of `-1` means the Action isn't taken (since `0` is a valid number of days)
+func storageManagementPolicyDaysSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Default:      -1,
+		ValidateFunc: validation.IntBetween(0, 99999),
+	}
+}
+
+func resourceArmStorageManagementPolicyCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).storageManagementPoliciesClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	account, err := azure.ParseStorageAccountID(d.Get("storage_account_id").(string))
+	if err != nil {
+		return err
+	}
+	id := azure.NewStorageManagementPolicyID(account.SubscriptionID, account.ResourceGroup, account.Name, azure.StorageManagementPolicyName)
+
+	if d.IsNewResource() && meta.(*ArmClient).requireResourcesToBeImported {
+		existing, err := client.Get(ctx, id)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Management Policy for Storage Account %q (Resource Group %q): %+v", account.Name, account.ResourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_storage_management_policy", *existing.ID)
+		}
+	}
+
+	policy := azure.StorageManagementPolicy{
+		Properties: &azure.StorageManagementPolicyProperties{
+			Policy: &azure.StorageManagementPolicySchema{
+				Rules: expandStorageManagementPolicyRules(d.Get("rule").([]interface{})),
+			},
+		},
+	}
+
+	if err := client.CreateOrUpdate(ctx, id, policy); err != nil {
+		return fmt.Errorf("Error creating/updating Management Policy for Storage Account %q (Resource Group %q): %+v", account.Name, account.ResourceGroup, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceArmStorageManagementPolicyRead(d, meta)
+}
+
+func resourceArmStorageManagementPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).storageManagementPoliciesClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseStorageManagementPolicyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Management Policy for Storage Account %q (Resource Group %q): %+v", id.AccountName, id.ResourceGroup, err)
+	}
+
+	d.Set("storage_account_id", azure.NewStorageAccountID(id.SubscriptionID, id.ResourceGroup, id.AccountName).ID())
+
+	var rules *[]azure.StorageManagementPolicyRule
+	if props := resp.Properties; props != nil && props.Policy != nil {
+		rules = props.Policy.Rules
+	}
+	if err := d.Set("rule", flattenStorageManagementPolicyRules(rules)); err != nil {
+		return fmt.Errorf("Error setting `rule`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceArmStorageManagementPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).storageManagementPoliciesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseStorageManagementPolicyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if resp, err := client.Delete(ctx, *id); err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("Error deleting Management Policy for Storage Account %q (Resource Group %q): %+v", id.AccountName, id.ResourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+func expandStorageManagementPolicyRules(input []interface{}) *[]azure.StorageManagementPolicyRule {
+	rules := make([]azure.StorageManagementPolicyRule, 0)
+
+	for _, raw := range input {
+		v := raw.(map[string]interface{})
+
+		rules = append(rules, azure.StorageManagementPolicyRule{
+			Name:    utils.String(v["name"].(string)),
+			Enabled: utils.Bool(v["enabled"].(bool)),
+			Type:    "Lifecycle",
+			Definition: &azure.StorageManagementPolicyRuleDefinition{
+				Filters: expandStorageManagementPolicyFilters(v["filters"].([]interface{})),
+				Actions: expandStorageManagementPolicyActions(v["actions"].([]interface{})),
+			},
+		})
+	}
+
+	return &rules
+}
+
+func expandStorageManagementPolicyFilters(input []interface{}) *azure.StorageManagementPolicyFilter {
+	blobTypes := []string{storageManagementPolicyBlobTypeBlockBlob}
+	filters := azure.StorageManagementPolicyFilter{
+		BlobTypes: &blobTypes,
+	}
+
+	if len(input) == 0 || input[0] == nil {
+		return &filters
+	}
+	v := input[0].(map[string]interface{})
+
+	if prefixes := v["prefix_match"].(*schema.Set).List(); len(prefixes) > 0 {
+		filters.PrefixMatch = utils.ExpandStringArray(prefixes)
+	}
+
+	if types := v["blob_types"].(*schema.Set).List(); len(types) > 0 {
+		filters.BlobTypes = utils.ExpandStringArray(types)
+	}
+
+	return &filters
+}
+
+func expandStorageManagementPolicyActions(input []interface{}) *azure.StorageManagementPolicyAction {
+	actions := azure.StorageManagementPolicyAction{}
+	if len(input) == 0 || input[0] == nil {
+		return &actions
+	}
+	v := input[0].(map[string]interface{})
+
+	if baseBlobs := v["base_blob"].([]interface{}); len(baseBlobs) > 0 && baseBlobs[0] != nil {
+		baseBlob := baseBlobs[0].(map[string]interface{})
+		actions.BaseBlob = &azure.StorageManagementPolicyBaseBlob{
+			TierToCool:    expandStorageManagementPolicyDaysAfterModification(baseBlob["tier_to_cool_after_days_since_modification_greater_than"].(int)),
+			TierToArchive: expandStorageManagementPolicyDaysAfterModification(baseBlob["tier_to_archive_after_days_since_modification_greater_than"].(int)),
+			Delete:        expandStorageManagementPolicyDaysAfterModification(baseBlob["delete_after_days_since_modification_greater_than"].(int)),
+		}
+	}
+
+	if snapshots := v["snapshot"].([]interface{}); len(snapshots) > 0 && snapshots[0] != nil {
+		snapshot := snapshots[0].(map[string]interface{})
+		if days := snapshot["delete_after_days_since_creation_greater_than"].(int); days >= 0 {
+			actions.Snapshot = &azure.StorageManagementPolicySnapshot{
+				Delete: &azure.StorageManagementPolicyDaysAfterCreation{
+					DaysAfterCreationGreaterThan: utils.Int32(int32(days)),
+				},
+			}
+		}
+	}
+
+	return &actions
+}
+
+func expandStorageManagementPolicyDaysAfterModification(days int) *azure.StorageManagementPolicyDaysAfterModification {
+	if days < 0 {
+		return nil
+	}
+
+	return &azure.StorageManagementPolicyDaysAfterModification{
+		DaysAfterModificationGreaterThan: utils.Int32(int32(days)),
+	}
+}
+
+func flattenStorageManagementPolicyRules(input *[]azure.StorageManagementPolicyRule) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, rule := range *input {
+		name := ""
+		if rule.Name != nil {
+			name = *rule.Name
+		}
+
+		enabled := false
+		if rule.Enabled != nil {
+			enabled = *rule.Enabled
+		}
+
+		filters := make([]interface{}, 0)
+		actions := make([]interface{}, 0)
+		if definition := rule.Definition; definition != nil {
+			filters = flattenStorageManagementPolicyFilters(definition.Filters)
+			actions = flattenStorageManagementPolicyActions(definition.Actions)
+		}
+
+		results = append(results, map[string]interface{}{
+			"name":    name,
+			"enabled": enabled,
+			"filters": filters,
+			"actions": actions,
+		})
+	}
+
+	return results
+}
+
+func flattenStorageManagementPolicyFilters(input *azure.StorageManagementPolicyFilter) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	prefixes := make([]interface{}, 0)
+	if input.PrefixMatch != nil {
+		for _, v := range *input.PrefixMatch {
+			prefixes = append(prefixes, v)
+		}
+	}
+
+	blobTypes := make([]interface{}, 0)
+	if input.BlobTypes != nil {
+		for _, v := range *input.BlobTypes {
+			blobTypes = append(blobTypes, v)
+		}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"prefix_match": schema.NewSet(schema.HashString, prefixes),
+			"blob_types":   schema.NewSet(schema.HashString, blobTypes),
+		},
+	}
+}
+
+func flattenStorageManagementPolicyActions(input *azure.StorageManagementPolicyAction) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	baseBlobs := make([]interface{}, 0)
+	if baseBlob := input.BaseBlob; baseBlob != nil {
+		baseBlobs = append(baseBlobs, map[string]interface{}{
+			"tier_to_cool_after_days_since_modification_greater_than":    flattenStorageManagementPolicyDaysAfterModification(baseBlob.TierToCool),
+			"tier_to_archive_after_days_since_modification_greater_than": flattenStorageManagementPolicyDaysAfterModification(baseBlob.TierToArchive),
+			"delete_after_days_since_modification_greater_than":          flattenStorageManagementPolicyDaysAfterModification(baseBlob.Delete),
+		})
+	}
+
+	snapshots := make([]interface{}, 0)
+	if snapshot := input.Snapshot; snapshot != nil {
+		days := -1
+		if snapshot.Delete != nil && snapshot.Delete.DaysAfterCreationGreaterThan != nil {
+			days = int(*snapshot.Delete.DaysAfterCreationGreaterThan)
+		}
+
+		snapshots = append(snapshots, map[string]interface{}{
+			"delete_after_days_since_creation_greater_than": days,
+		})
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"base_blob": baseBlobs,
+			"snapshot":  snapshots,
+		},
+	}
+}
+
+func flattenStorageManagementPolicyDaysAfterModification(input *azure.StorageManagementPolicyDaysAfterModification) int {
+	if input == nil || input.DaysAfterModificationGreaterThan == nil {
+		return -1
+	}
+
+	return int(*input.DaysAfterModificationGreaterThan)
+}
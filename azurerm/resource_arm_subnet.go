@@ -3,11 +3,15 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-08-01/network"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -15,12 +19,17 @@ var subnetResourceName = "azurerm_subnet"
 
 func resourceArmSubnet() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceArmSubnetCreateUpdate,
-		Read:   resourceArmSubnetRead,
-		Update: resourceArmSubnetCreateUpdate,
-		Delete: resourceArmSubnetDelete,
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Create:   resourceArmSubnetCreateUpdate,
+		Read:     resourceArmSubnetRead,
+		Update:   resourceArmSubnetCreateUpdate,
+		Delete:   resourceArmSubnetDelete,
+		Importer: azure.ValidateResourceIDPriorToImport(azure.ValidateSubnetID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -44,15 +53,19 @@ func resourceArmSubnet() *schema.Resource {
 			},
 
 			"network_security_group_id": {
-				Type:       schema.TypeString,
-				Optional:   true,
-				Deprecated: "Use the `azurerm_subnet_network_security_group_association` resource instead.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     azure.ValidateNetworkSecurityGroupIDOrEmpty,
+				DiffSuppressFunc: suppress.CaseDifference,
+				Deprecated:       "Use the `azurerm_subnet_network_security_group_association` resource instead.",
 			},
 
 			"route_table_id": {
-				Type:       schema.TypeString,
-				Optional:   true,
-				Deprecated: "Use the `azurerm_subnet_route_table_association` resource instead.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     azure.ValidateRouteTableIDOrEmpty,
+				DiffSuppressFunc: suppress.CaseDifference,
+				Deprecated:       "Use the `azurerm_subnet_route_table_association` resource instead.",
 			},
 
 			"ip_configurations": {
@@ -121,7 +134,8 @@ func resourceArmSubnet() *schema.Resource {
 
 func resourceArmSubnetCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).subnetClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for Azure ARM Subnet creation.")
 
@@ -129,7 +143,7 @@ func resourceArmSubnetCreateUpdate(d *schema.ResourceData, meta interface{}) err
 	vnetName := d.Get("virtual_network_name").(string)
 	resGroup := d.Get("resource_group_name").(string)
 
-	if requireResourcesToBeImported && d.IsNewResource() {
+	if meta.(*ArmClient).requireResourcesToBeImported && d.IsNewResource() {
 		existing, err := client.Get(ctx, resGroup, vnetName, name, "")
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
@@ -220,7 +234,8 @@ func resourceArmSubnetCreateUpdate(d *schema.ResourceData, meta interface{}) err
 
 func resourceArmSubnetRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).subnetClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -247,15 +262,24 @@ func resourceArmSubnetRead(d *schema.ResourceData, meta interface{}) error {
 	if props := resp.SubnetPropertiesFormat; props != nil {
 		d.Set("address_prefix", props.AddressPrefix)
 
-		var securityGroupId *string
-		if props.NetworkSecurityGroup != nil {
-			securityGroupId = props.NetworkSecurityGroup.ID
+		// Azure returns these IDs with inconsistent casing (e.g. `resourcegroups`), so they're normalised
+		securityGroupId := ""
+		if props.NetworkSecurityGroup != nil && props.NetworkSecurityGroup.ID != nil {
+			id, err := azure.ParseNetworkSecurityGroupID(*props.NetworkSecurityGroup.ID)
+			if err != nil {
+				return fmt.Errorf("Error parsing Network Security Group ID %q: %+v", *props.NetworkSecurityGroup.ID, err)
+			}
+			securityGroupId = id.ID()
 		}
 		d.Set("network_security_group_id", securityGroupId)
 
-		var routeTableId string
+		routeTableId := ""
 		if props.RouteTable != nil && props.RouteTable.ID != nil {
-			routeTableId = *props.RouteTable.ID
+			id, err := azure.ParseRouteTableID(*props.RouteTable.ID)
+			if err != nil {
+				return fmt.Errorf("Error parsing Route Table ID %q: %+v", *props.RouteTable.ID, err)
+			}
+			routeTableId = id.ID()
 		}
 		d.Set("route_table_id", routeTableId)
 
@@ -280,7 +304,8 @@ func resourceArmSubnetRead(d *schema.ResourceData, meta interface{}) error {
 
 func resourceArmSubnetDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).subnetClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
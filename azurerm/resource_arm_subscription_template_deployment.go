@@ -0,0 +1,212 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2018-05-01/resources"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmSubscriptionTemplateDeployment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmSubscriptionTemplateDeploymentCreateUpdate,
+		Read:   resourceArmSubscriptionTemplateDeploymentRead,
+		Update: resourceArmSubscriptionTemplateDeploymentCreateUpdate,
+		Delete: resourceArmSubscriptionTemplateDeploymentDelete,
+
+		Importer: azure.ValidateResourceIDPriorToImport(azure.ValidateSubscriptionTemplateDeploymentID),
+
+		CustomizeDiff: azure.TemplateDeploymentCustomizeDiff(resourceArmSubscriptionTemplateDeploymentWhatIf,
+			"name", "location", "template_body", "parameters_body"),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(180 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(180 * time.Minute),
+			Delete: schema.DefaultTimeout(180 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"location": locationSchema(),
+
+			"template_body": {
+				Type:         schema.TypeString,
+				Required:     true,
+				StateFunc:    normalizeJson,
+				ValidateFunc: validation.ValidateJsonString,
+			},
+
+			"parameters_body": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				StateFunc:    normalizeJson,
+				ValidateFunc: validation.ValidateJsonString,
+			},
+
+			"delete_deployed_resources": templateDeploymentDeleteDeployedResourcesSchema(),
+
+			"outputs_json": templateDeploymentOutputsJSONSchema(),
+
+			"template_changes": templateDeploymentTemplateChangesSchema(),
+		},
+	}
+}
+
+func resourceArmSubscriptionTemplateDeploymentCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).deploymentsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	location := azure.NormalizeLocation(d.Get("location").(string))
+
+	if meta.(*ArmClient).requireResourcesToBeImported && d.IsNewResource() {
+		existing, err := client.GetAtSubscriptionScope(ctx, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Subscription Template Deployment %q: %+v", name, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_subscription_template_deployment", *existing.ID)
+		}
+	}
+
+	properties, err := expandTemplateDeploymentProperties(d)
+	if err != nil {
+		return err
+	}
+
+	deployment := resources.Deployment{
+		Location:   utils.String(location),
+		Properties: properties,
+	}
+
+	log.Printf("[INFO] Deploying Subscription Template Deployment %q..", name)
+	future, err := client.CreateOrUpdateAtSubscriptionScope(ctx, name, deployment)
+	if err != nil {
+		return fmt.Errorf("Error creating Subscription Template Deployment %q: %+v", name, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation of Subscription Template Deployment %q: %+v", name, err)
+	}
+
+	read, err := client.GetAtSubscriptionScope(ctx, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Subscription Template Deployment %q: %+v", name, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Subscription Template Deployment %q ID", name)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmSubscriptionTemplateDeploymentRead(d, meta)
+}
+
+func resourceArmSubscriptionTemplateDeploymentRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).deploymentsClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseSubscriptionTemplateDeploymentID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.GetAtSubscriptionScope(ctx, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Subscription Template Deployment %q was not found - removing from state", id.Name)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Subscription Template Deployment %q: %+v", id.Name, err)
+	}
+
+	d.Set("name", resp.Name)
+	if location := resp.Location; location != nil {
+		d.Set("location", azure.NormalizeLocation(*location))
+	}
+
+	template, err := client.ExportTemplateAtSubscriptionScope(ctx, id.Name)
+	if err != nil {
+		return fmt.Errorf("Error exporting the Template of Subscription Template Deployment %q: %+v", id.Name, err)
+	}
+
+	return flattenTemplateDeploymentTemplateAndOutputs(d, template.Template, resp.Properties)
+}
+
+func resourceArmSubscriptionTemplateDeploymentWhatIf(d *schema.ResourceDiff, meta interface{}) ([]azure.TemplateDeploymentWhatIfChange, error) {
+	client := meta.(*ArmClient).templateDeploymentWhatIfClient
+	ctx, cancel := context.WithTimeout(meta.(*ArmClient).StopContext, templateDeploymentWhatIfTimeout)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	location := azure.NormalizeLocation(d.Get("location").(string))
+
+	properties, err := expandTemplateDeploymentProperties(d)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.WhatIfAtSubscription(ctx, name, azure.TemplateDeploymentWhatIf{
+		Location:   utils.String(location),
+		Properties: properties,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Error evaluating the `what-if` of Subscription Template Deployment %q: %+v", name, err)
+	}
+
+	return flattenTemplateDeploymentWhatIfResult(result), nil
+}
+
+func resourceArmSubscriptionTemplateDeploymentDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient)
+	deploymentsClient := client.deploymentsClient
+	ctx, cancel := timeouts.ForDelete(client.StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseSubscriptionTemplateDeploymentID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if d.Get("delete_deployed_resources").(bool) {
+		operations, err := listSubscriptionTemplateDeploymentOperations(ctx, client, id.Name)
+		if err != nil {
+			return err
+		}
+
+		if err := deleteTemplateDeploymentResources(ctx, client, operations); err != nil {
+			return fmt.Errorf("Error deleting the resources deployed by Subscription Template Deployment %q: %+v", id.Name, err)
+		}
+	}
+
+	if _, err := deploymentsClient.DeleteAtSubscriptionScope(ctx, id.Name); err != nil {
+		return fmt.Errorf("Error deleting Subscription Template Deployment %q: %+v", id.Name, err)
+	}
+
+	description := fmt.Sprintf("Subscription Template Deployment %q", id.Name)
+	return waitForTemplateDeploymentToBeDeleted(description, d.Timeout(schema.TimeoutDelete), func() (resources.DeploymentExtended, error) {
+		return deploymentsClient.GetAtSubscriptionScope(ctx, id.Name)
+	})
+}
@@ -0,0 +1,319 @@
+package azurerm
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMSubscriptionTemplateDeployment_basic(t *testing.T) {
+	resourceName := "azurerm_subscription_template_deployment.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMSubscriptionTemplateDeploymentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMSubscriptionTemplateDeployment_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSubscriptionTemplateDeploymentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "template_changes.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "outputs_json", fmt.Sprintf(`{"resourceGroup":{"name":"acctestRG-%d","tags":{"deployment":"acctestsubdeploy-%d"}}}`, ri, ri)),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"delete_deployed_resources", "parameters_body", "template_changes"},
+			},
+		},
+	})
+}
+
+func TestAccAzureRMSubscriptionTemplateDeployment_requiresImport(t *testing.T) {
+	if !requireResourcesToBeImported {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_subscription_template_deployment.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMSubscriptionTemplateDeploymentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMSubscriptionTemplateDeployment_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSubscriptionTemplateDeploymentExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMSubscriptionTemplateDeployment_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_subscription_template_deployment"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMSubscriptionTemplateDeployment_update(t *testing.T) {
+	resourceName := "azurerm_subscription_template_deployment.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMSubscriptionTemplateDeploymentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMSubscriptionTemplateDeployment_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSubscriptionTemplateDeploymentExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAzureRMSubscriptionTemplateDeployment_nestedStorageAccount(ri, acctest.RandString(5), location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSubscriptionTemplateDeploymentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "template_changes.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMSubscriptionTemplateDeploymentExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		id, err := azure.ParseSubscriptionTemplateDeploymentID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).deploymentsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.GetAtSubscriptionScope(ctx, id.Name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Subscription Template Deployment %q does not exist", id.Name)
+			}
+
+			return fmt.Errorf("Bad: Get on deploymentsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMSubscriptionTemplateDeploymentDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient)
+	ctx := client.StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_subscription_template_deployment" {
+			continue
+		}
+
+		id, err := azure.ParseSubscriptionTemplateDeploymentID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.deploymentsClient.GetAtSubscriptionScope(ctx, id.Name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(resp.Response) {
+				return err
+			}
+		} else {
+			return fmt.Errorf("Subscription Template Deployment %q still exists", id.Name)
+		}
+
+		// the Resource Group created by the Template Deployment should also have been deleted
+		var outputs struct {
+			ResourceGroup struct {
+				Name string `json:"name"`
+			} `json:"resourceGroup"`
+		}
+		if err := json.Unmarshal([]byte(rs.Primary.Attributes["outputs_json"]), &outputs); err != nil {
+			return fmt.Errorf("Error parsing `outputs_json`: %+v", err)
+		}
+
+		group, err := client.resourceGroupsClient.Get(ctx, outputs.ResourceGroup.Name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(group.Response) {
+				return err
+			}
+			continue
+		}
+
+		return fmt.Errorf("Resource Group %q deployed by Subscription Template Deployment %q still exists", outputs.ResourceGroup.Name, id.Name)
+	}
+
+	return nil
+}
+
+func testAccAzureRMSubscriptionTemplateDeployment_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_subscription_template_deployment" "test" {
+  name                      = "acctestsubdeploy-%[1]d"
+  location                  = "%[2]s"
+  delete_deployed_resources = true
+
+  template_body = <<DEPLOY
+{
+  "$schema": "https://schema.management.azure.com/schemas/2018-05-01/subscriptionDeploymentTemplate.json#",
+  "contentVersion": "1.0.0.0",
+  "parameters": {
+    "resourceGroupName": {
+      "type": "string"
+    }
+  },
+  "resources": [
+    {
+      "type": "Microsoft.Resources/resourceGroups",
+      "apiVersion": "2018-05-01",
+      "location": "%[2]s",
+      "name": "[parameters('resourceGroupName')]",
+      "tags": {
+        "deployment": "acctestsubdeploy-%[1]d"
+      },
+      "properties": {}
+    }
+  ],
+  "outputs": {
+    "resourceGroup": {
+      "type": "object",
+      "value": {
+        "name": "[parameters('resourceGroupName')]",
+        "tags": {
+          "deployment": "acctestsubdeploy-%[1]d"
+        }
+      }
+    }
+  }
+}
+DEPLOY
+
+  parameters_body = <<PARAMS
+{
+  "resourceGroupName": {
+    "value": "acctestRG-%[1]d"
+  }
+}
+PARAMS
+}
+`, rInt, location)
+}
+
+func testAccAzureRMSubscriptionTemplateDeployment_requiresImport(rInt int, location string) string {
+	template := testAccAzureRMSubscriptionTemplateDeployment_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_subscription_template_deployment" "import" {
+  name            = "${azurerm_subscription_template_deployment.test.name}"
+  location        = "${azurerm_subscription_template_deployment.test.location}"
+  template_body   = "${azurerm_subscription_template_deployment.test.template_body}"
+  parameters_body = "${azurerm_subscription_template_deployment.test.parameters_body}"
+}
+`, template)
+}
+
+func testAccAzureRMSubscriptionTemplateDeployment_nestedStorageAccount(rInt int, rString string, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_subscription_template_deployment" "test" {
+  name                      = "acctestsubdeploy-%[1]d"
+  location                  = "%[3]s"
+  delete_deployed_resources = true
+
+  template_body = <<DEPLOY
+{
+  "$schema": "https://schema.management.azure.com/schemas/2018-05-01/subscriptionDeploymentTemplate.json#",
+  "contentVersion": "1.0.0.0",
+  "parameters": {
+    "resourceGroupName": {
+      "type": "string"
+    }
+  },
+  "resources": [
+    {
+      "type": "Microsoft.Resources/resourceGroups",
+      "apiVersion": "2018-05-01",
+      "location": "%[3]s",
+      "name": "[parameters('resourceGroupName')]",
+      "tags": {
+        "deployment": "acctestsubdeploy-%[1]d"
+      },
+      "properties": {}
+    },
+    {
+      "type": "Microsoft.Resources/deployments",
+      "apiVersion": "2018-05-01",
+      "name": "storage",
+      "resourceGroup": "[parameters('resourceGroupName')]",
+      "dependsOn": [
+        "[parameters('resourceGroupName')]"
+      ],
+      "properties": {
+        "mode": "Incremental",
+        "template": {
+          "$schema": "https://schema.management.azure.com/schemas/2015-01-01/deploymentTemplate.json#",
+          "contentVersion": "1.0.0.0",
+          "resources": [
+            {
+              "type": "Microsoft.Storage/storageAccounts",
+              "apiVersion": "2018-07-01",
+              "name": "acctestsa%[2]s",
+              "location": "%[3]s",
+              "kind": "StorageV2",
+              "sku": {
+                "name": "Standard_LRS"
+              }
+            }
+          ]
+        }
+      }
+    }
+  ],
+  "outputs": {
+    "resourceGroup": {
+      "type": "object",
+      "value": {
+        "name": "[parameters('resourceGroupName')]"
+      }
+    }
+  }
+}
+DEPLOY
+
+  parameters_body = <<PARAMS
+{
+  "resourceGroupName": {
+    "value": "acctestRG-%[1]d"
+  }
+}
+PARAMS
+}
+`, rInt, rString, location)
+}
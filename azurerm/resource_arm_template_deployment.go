@@ -13,7 +13,9 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -24,6 +26,16 @@ func resourceArmTemplateDeployment() *schema.Resource {
 		Update: resourceArmTemplateDeploymentCreateUpdate,
 		Delete: resourceArmTemplateDeploymentDelete,
 
+		CustomizeDiff: azure.TemplateDeploymentCustomizeDiff(resourceArmTemplateDeploymentWhatIf,
+			"name", "resource_group_name", "template_body", "parameters", "parameters_body", "deployment_mode"),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(180 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(180 * time.Minute),
+			Delete: schema.DefaultTimeout(180 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -63,10 +75,16 @@ func resourceArmTemplateDeployment() *schema.Resource {
 				DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
 			},
 
+			"delete_deployed_resources": templateDeploymentDeleteDeployedResourcesSchema(),
+
 			"outputs": {
 				Type:     schema.TypeMap,
 				Computed: true,
 			},
+
+			"outputs_json": templateDeploymentOutputsJSONSchema(),
+
+			"template_changes": templateDeploymentTemplateChangesSchema(),
 		},
 	}
 }
@@ -74,13 +92,13 @@ func resourceArmTemplateDeployment() *schema.Resource {
 func resourceArmTemplateDeploymentCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient)
 	deployClient := client.deploymentsClient
-	ctx := client.StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(client.StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	resourceGroup := d.Get("resource_group_name").(string)
-	deploymentMode := d.Get("deployment_mode").(string)
 
-	if requireResourcesToBeImported && d.IsNewResource() {
+	if meta.(*ArmClient).requireResourcesToBeImported && d.IsNewResource() {
 		existing, err := deployClient.Get(ctx, resourceGroup, name)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
@@ -94,45 +112,13 @@ func resourceArmTemplateDeploymentCreateUpdate(d *schema.ResourceData, meta inte
 	}
 
 	log.Printf("[INFO] preparing arguments for AzureRM Template Deployment creation.")
-	properties := resources.DeploymentProperties{
-		Mode: resources.DeploymentMode(deploymentMode),
-	}
-
-	if v, ok := d.GetOk("parameters"); ok {
-		params := v.(map[string]interface{})
-
-		newParams := make(map[string]interface{}, len(params))
-		for key, val := range params {
-			newParams[key] = struct {
-				Value interface{}
-			}{
-				Value: val,
-			}
-		}
-
-		properties.Parameters = &newParams
-	}
-
-	if v, ok := d.GetOk("parameters_body"); ok {
-		params, err := expandParametersBody(v.(string))
-		if err != nil {
-			return err
-		}
-
-		properties.Parameters = &params
-	}
-
-	if v, ok := d.GetOk("template_body"); ok {
-		template, err := expandTemplateBody(v.(string))
-		if err != nil {
-			return err
-		}
-
-		properties.Template = &template
+	properties, err := expandResourceGroupTemplateDeploymentProperties(d)
+	if err != nil {
+		return err
 	}
 
 	deployment := resources.Deployment{
-		Properties: &properties,
+		Properties: properties,
 	}
 
 	future, err := deployClient.CreateOrUpdate(ctx, resourceGroup, name, deployment)
@@ -160,17 +146,15 @@ func resourceArmTemplateDeploymentCreateUpdate(d *schema.ResourceData, meta inte
 func resourceArmTemplateDeploymentRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient)
 	deployClient := client.deploymentsClient
-	ctx := client.StopContext
+	ctx, cancel := timeouts.ForRead(client.StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := azure.ParseTemplateDeploymentID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	name := id.Path["deployments"]
-	if name == "" {
-		name = id.Path["Deployments"]
-	}
+	name := id.Name
 
 	resp, err := deployClient.Get(ctx, resourceGroup, name)
 	if err != nil {
@@ -182,7 +166,11 @@ func resourceArmTemplateDeploymentRead(d *schema.ResourceData, meta interface{})
 	}
 
 	outputs := make(map[string]string)
-	if outs := resp.Properties.Outputs; outs != nil {
+	var outs interface{}
+	if props := resp.Properties; props != nil {
+		outs = props.Outputs
+	}
+	if outs != nil {
 		outsVal := outs.(map[string]interface{})
 		if len(outsVal) > 0 {
 			for key, output := range outsVal {
@@ -220,22 +208,69 @@ func resourceArmTemplateDeploymentRead(d *schema.ResourceData, meta interface{})
 		}
 	}
 
-	return d.Set("outputs", outputs)
+	if err := d.Set("outputs", outputs); err != nil {
+		return fmt.Errorf("Error setting `outputs`: %+v", err)
+	}
+
+	outputsJson, err := azure.FlattenTemplateDeploymentOutputs(outs)
+	if err != nil {
+		return err
+	}
+	d.Set("outputs_json", outputsJson)
+
+	// the changes are only relevant to the plan in which the Template was deployed
+	if err := d.Set("template_changes", []string{}); err != nil {
+		return fmt.Errorf("Error setting `template_changes`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceArmTemplateDeploymentWhatIf(d *schema.ResourceDiff, meta interface{}) ([]azure.TemplateDeploymentWhatIfChange, error) {
+	client := meta.(*ArmClient).templateDeploymentWhatIfClient
+	ctx, cancel := context.WithTimeout(meta.(*ArmClient).StopContext, templateDeploymentWhatIfTimeout)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	properties, err := expandResourceGroupTemplateDeploymentProperties(d)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := client.WhatIfAtResourceGroup(ctx, resourceGroup, name, azure.TemplateDeploymentWhatIf{
+		Properties: properties,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Error evaluating the `what-if` of Template Deployment %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	return flattenTemplateDeploymentWhatIfResult(result), nil
 }
 
 func resourceArmTemplateDeploymentDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient)
 	deployClient := client.deploymentsClient
-	ctx := client.StopContext
+	ctx, cancel := timeouts.ForDelete(client.StopContext, d)
+	defer cancel()
 
-	id, err := parseAzureResourceID(d.Id())
+	id, err := azure.ParseTemplateDeploymentID(d.Id())
 	if err != nil {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	name := id.Path["deployments"]
-	if name == "" {
-		name = id.Path["Deployments"]
+	name := id.Name
+
+	if d.Get("delete_deployed_resources").(bool) {
+		operations, err := listResourceGroupTemplateDeploymentOperations(ctx, client, resourceGroup, name)
+		if err != nil {
+			return err
+		}
+
+		if err := deleteTemplateDeploymentResources(ctx, client, operations); err != nil {
+			return fmt.Errorf("Error deleting the resources deployed by Template Deployment %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
 	}
 
 	_, err = deployClient.Delete(ctx, resourceGroup, name)
@@ -243,10 +278,54 @@ func resourceArmTemplateDeploymentDelete(d *schema.ResourceData, meta interface{
 		return err
 	}
 
-	return waitForTemplateDeploymentToBeDeleted(ctx, deployClient, resourceGroup, name)
+	description := fmt.Sprintf("Template Deployment (%q in Resource Group %q)", name, resourceGroup)
+	return waitForTemplateDeploymentToBeDeleted(description, d.Timeout(schema.TimeoutDelete), func() (resources.DeploymentExtended, error) {
+		return deployClient.Get(ctx, resourceGroup, name)
+	})
 }
 
 // TODO: move this out into the new `helpers` structure
+func expandResourceGroupTemplateDeploymentProperties(d templateDeploymentData) (*resources.DeploymentProperties, error) {
+	properties := resources.DeploymentProperties{
+		Mode: resources.DeploymentMode(d.Get("deployment_mode").(string)),
+	}
+
+	if v, ok := d.GetOk("parameters"); ok {
+		params := v.(map[string]interface{})
+
+		newParams := make(map[string]interface{}, len(params))
+		for key, val := range params {
+			newParams[key] = struct {
+				Value interface{}
+			}{
+				Value: val,
+			}
+		}
+
+		properties.Parameters = &newParams
+	}
+
+	if v, ok := d.GetOk("parameters_body"); ok {
+		params, err := expandParametersBody(v.(string))
+		if err != nil {
+			return nil, err
+		}
+
+		properties.Parameters = &params
+	}
+
+	if v, ok := d.GetOk("template_body"); ok {
+		template, err := expandTemplateBody(v.(string))
+		if err != nil {
+			return nil, err
+		}
+
+		properties.Template = &template
+	}
+
+	return &properties, nil
+}
+
 func expandParametersBody(body string) (map[string]interface{}, error) {
 	var parametersBody map[string]interface{}
 	if err := json.Unmarshal([]byte(body), &parametersBody); err != nil {
@@ -276,33 +355,33 @@ func normalizeJson(jsonString interface{}) string {
 	return string(b[:])
 }
 
-func waitForTemplateDeploymentToBeDeleted(ctx context.Context, client resources.DeploymentsClient, resourceGroup, name string) error {
+func waitForTemplateDeploymentToBeDeleted(description string, timeout time.Duration, get func() (resources.DeploymentExtended, error)) error {
 	// we can't use the Waiter here since the API returns a 200 once it's deleted which is considered a polling status code..
-	log.Printf("[DEBUG] Waiting for Template Deployment (%q in Resource Group %q) to be deleted", name, resourceGroup)
+	log.Printf("[DEBUG] Waiting for %s to be deleted", description)
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{"200"},
 		Target:  []string{"404"},
-		Refresh: templateDeploymentStateStatusCodeRefreshFunc(ctx, client, resourceGroup, name),
-		Timeout: 40 * time.Minute,
+		Refresh: templateDeploymentStateStatusCodeRefreshFunc(description, get),
+		Timeout: timeout,
 	}
 	if _, err := stateConf.WaitForState(); err != nil {
-		return fmt.Errorf("Error waiting for Template Deployment (%q in Resource Group %q) to be deleted: %+v", name, resourceGroup, err)
+		return fmt.Errorf("Error waiting for %s to be deleted: %+v", description, err)
 	}
 
 	return nil
 }
 
-func templateDeploymentStateStatusCodeRefreshFunc(ctx context.Context, client resources.DeploymentsClient, resourceGroup, name string) resource.StateRefreshFunc {
+func templateDeploymentStateStatusCodeRefreshFunc(description string, get func() (resources.DeploymentExtended, error)) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
-		res, err := client.Get(ctx, resourceGroup, name)
+		res, err := get()
 
-		log.Printf("Retrieving Template Deployment %q (Resource Group %q) returned Status %d", resourceGroup, name, res.StatusCode)
+		log.Printf("Retrieving %s returned Status %d", description, res.StatusCode)
 
 		if err != nil {
 			if utils.ResponseWasNotFound(res.Response) {
 				return res, strconv.Itoa(res.StatusCode), nil
 			}
-			return nil, "", fmt.Errorf("Error polling for the status of the Template Deployment %q (RG: %q): %+v", name, resourceGroup, err)
+			return nil, "", fmt.Errorf("Error polling for the status of the %s: %+v", description, err)
 		}
 
 		return res, strconv.Itoa(res.StatusCode), nil
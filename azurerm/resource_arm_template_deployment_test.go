@@ -5,7 +5,9 @@ import (
 	"net/http"
 	"regexp"
 	"testing"
+	"time"
 
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2018-05-01/resources"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
@@ -154,6 +156,7 @@ func TestAccAzureRMTemplateDeployment_withOutputs(t *testing.T) {
 					resource.TestCheckOutput("tfFalseOutput", "0"),
 					resource.TestCheckOutput("tfTrueOutput", "1"),
 					resource.TestCheckResourceAttr("azurerm_template_deployment.test", "outputs.stringOutput", "Standard_GRS"),
+					resource.TestCheckResourceAttrSet("azurerm_template_deployment.test", "outputs_json"),
 				),
 			},
 		},
@@ -227,7 +230,10 @@ func testCheckAzureRMTemplateDeploymentDisappears(resourceName string) resource.
 			return fmt.Errorf("Failed deleting Deployment %q (Resource Group %q): %+v", deploymentName, resourceGroup, err)
 		}
 
-		return waitForTemplateDeploymentToBeDeleted(ctx, client, resourceGroup, deploymentName)
+		description := fmt.Sprintf("Template Deployment (%q in Resource Group %q)", deploymentName, resourceGroup)
+		return waitForTemplateDeploymentToBeDeleted(description, 40*time.Minute, func() (resources.DeploymentExtended, error) {
+			return client.Get(ctx, resourceGroup, deploymentName)
+		})
 	}
 }
 
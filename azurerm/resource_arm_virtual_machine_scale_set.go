@@ -2,19 +2,26 @@ package azurerm
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"log"
+	"net/http"
 	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
+	"github.com/Azure/go-autorest/autorest"
 
 	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/structure"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/set"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
@@ -26,8 +33,13 @@ func resourceArmVirtualMachineScaleSet() *schema.Resource {
 		Update: resourceArmVirtualMachineScaleSetCreateUpdate,
 		Delete: resourceArmVirtualMachineScaleSetDelete,
 
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Importer: azure.ValidateResourceIDPriorToImport(azure.ValidateVirtualMachineScaleSetID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -127,9 +139,10 @@ func resourceArmVirtualMachineScaleSet() *schema.Resource {
 			},
 
 			"health_probe_id": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ValidateFunc: azure.ValidateResourceID,
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     azure.ValidateLoadBalancerProbeID,
+				DiffSuppressFunc: suppress.CaseDifference,
 			},
 
 			"automatic_os_upgrade": {
@@ -176,6 +189,49 @@ func resourceArmVirtualMachineScaleSet() *schema.Resource {
 				DiffSuppressFunc: azureRmVirtualMachineScaleSetSuppressRollingUpgradePolicyDiff,
 			},
 
+			"upgrade_instances_on_image_change": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"scale_in_policy": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  azure.VirtualMachineScaleSetScaleInPolicyDefault,
+				ValidateFunc: validation.StringInSlice([]string{
+					azure.VirtualMachineScaleSetScaleInPolicyDefault,
+					azure.VirtualMachineScaleSetScaleInPolicyNewestVM,
+					azure.VirtualMachineScaleSetScaleInPolicyOldestVM,
+				}, false),
+			},
+
+			"instance_protection": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"instance_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+
+						"protect_from_scale_in": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+
+						"protect_from_scale_set_actions": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+
 			"overprovision": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -250,9 +306,10 @@ func resourceArmVirtualMachineScaleSet() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"source_vault_id": {
-							Type:         schema.TypeString,
-							Required:     true,
-							ValidateFunc: azure.ValidateResourceID,
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateFunc:     azure.ValidateKeyVaultID,
+							DiffSuppressFunc: suppress.CaseDifference,
 						},
 
 						"vault_certificates": {
@@ -397,9 +454,10 @@ func resourceArmVirtualMachineScaleSet() *schema.Resource {
 						},
 
 						"network_security_group_id": {
-							Type:         schema.TypeString,
-							Optional:     true,
-							ValidateFunc: azure.ValidateResourceID,
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateFunc:     azure.ValidateNetworkSecurityGroupID,
+							DiffSuppressFunc: suppress.CaseDifference,
 						},
 
 						"dns_settings": {
@@ -432,9 +490,10 @@ func resourceArmVirtualMachineScaleSet() *schema.Resource {
 									},
 
 									"subnet_id": {
-										Type:         schema.TypeString,
-										Required:     true,
-										ValidateFunc: azure.ValidateResourceID,
+										Type:             schema.TypeString,
+										Required:         true,
+										ValidateFunc:     azure.ValidateSubnetID,
+										DiffSuppressFunc: suppress.CaseDifference,
 									},
 
 									"application_gateway_backend_address_pool_ids": {
@@ -449,9 +508,9 @@ func resourceArmVirtualMachineScaleSet() *schema.Resource {
 										Optional: true,
 										Elem: &schema.Schema{
 											Type:         schema.TypeString,
-											ValidateFunc: azure.ValidateResourceID,
+											ValidateFunc: azure.ValidateApplicationSecurityGroupID,
 										},
-										Set:      schema.HashString,
+										Set:      set.HashStringIgnoreCase,
 										MaxItems: 20,
 									},
 
@@ -736,21 +795,22 @@ func resourceArmVirtualMachineScaleSet() *schema.Resource {
 			"tags": tagsSchema(),
 		},
 
-		CustomizeDiff: azureRmVirtualMachineScaleSetCustomizeDiff,
+		CustomizeDiff: azure.CustomizeDiffAll(azureRmVirtualMachineScaleSetCustomizeDiff, azure.ZonesCustomizeDiff),
 	}
 }
 
 func resourceArmVirtualMachineScaleSetCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).vmScaleSetClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).vmScaleSetExtendedClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for Azure ARM Virtual Machine Scale Set creation.")
 
 	name := d.Get("name").(string)
 	resGroup := d.Get("resource_group_name").(string)
 
-	if requireResourcesToBeImported && d.IsNewResource() {
-		existing, err := client.Get(ctx, resGroup, name)
+	if meta.(*ArmClient).requireResourcesToBeImported && d.IsNewResource() {
+		existing, _, err := client.Get(ctx, resGroup, name)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
 				return fmt.Errorf("Error checking for presence of existing Virtual Machine Scale Set %q (Resource Group %q): %s", name, resGroup, err)
@@ -828,6 +888,12 @@ func resourceArmVirtualMachineScaleSetCreateUpdate(d *schema.ResourceData, meta
 		SinglePlacementGroup: &singlePlacementGroup,
 	}
 
+	// in Manual mode the Rolling Upgrade Policy is only used to upgrade the instances when the image changes
+	upgradeInstances := d.Get("upgrade_instances_on_image_change").(bool)
+	if upgradeInstances && !strings.EqualFold(upgradePolicy, string(compute.Rolling)) {
+		scaleSetProps.UpgradePolicy.RollingUpgradePolicy = nil
+	}
+
 	if strings.EqualFold(priority, string(compute.Low)) {
 		scaleSetProps.VirtualMachineProfile.EvictionPolicy = compute.VirtualMachineEvictionPolicyTypes(evictionPolicy)
 	}
@@ -869,7 +935,13 @@ func resourceArmVirtualMachineScaleSetCreateUpdate(d *schema.ResourceData, meta
 		properties.Plan = plan
 	}
 
-	future, err := client.CreateOrUpdate(ctx, resGroup, name, properties)
+	extended := azure.VirtualMachineScaleSetExtendedProperties{
+		ScaleInPolicy: &azure.VirtualMachineScaleSetScaleInPolicy{
+			Rules: &[]string{d.Get("scale_in_policy").(string)},
+		},
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resGroup, name, properties, extended)
 	if err != nil {
 		return err
 	}
@@ -878,7 +950,7 @@ func resourceArmVirtualMachineScaleSetCreateUpdate(d *schema.ResourceData, meta
 		return err
 	}
 
-	read, err := client.Get(ctx, resGroup, name)
+	read, _, err := client.Get(ctx, resGroup, name)
 	if err != nil {
 		return err
 	}
@@ -888,12 +960,26 @@ func resourceArmVirtualMachineScaleSetCreateUpdate(d *schema.ResourceData, meta
 
 	d.SetId(*read.ID)
 
+	if d.HasChange("instance_protection") {
+		if err := updateAzureRmVirtualMachineScaleSetInstanceProtection(ctx, d, meta, resGroup, name); err != nil {
+			return err
+		}
+	}
+
+	// in Manual mode the instances remain on the previous image until they're upgraded
+	if !d.IsNewResource() && upgradeInstances && d.HasChange("storage_profile_image_reference") && strings.EqualFold(upgradePolicy, string(compute.Manual)) {
+		if err := upgradeAzureRmVirtualMachineScaleSetInstances(ctx, d, meta, resGroup, name); err != nil {
+			return err
+		}
+	}
+
 	return resourceArmVirtualMachineScaleSetRead(d, meta)
 }
 
 func resourceArmVirtualMachineScaleSetRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).vmScaleSetClient
-	ctx := meta.(*ArmClient).StopContext
+	client := meta.(*ArmClient).vmScaleSetExtendedClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -902,7 +988,7 @@ func resourceArmVirtualMachineScaleSetRead(d *schema.ResourceData, meta interfac
 	resGroup := id.ResourceGroup
 	name := id.Path["virtualMachineScaleSets"]
 
-	resp, err := client.Get(ctx, resGroup, name)
+	resp, extended, err := client.Get(ctx, resGroup, name)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
 			log.Printf("[INFO] AzureRM Virtual Machine Scale Set (%s) Not Found. Removing from State", name)
@@ -1034,6 +1120,20 @@ func resourceArmVirtualMachineScaleSetRead(d *schema.ResourceData, meta interfac
 
 	}
 
+	scaleInPolicy := azure.VirtualMachineScaleSetScaleInPolicyDefault
+	if policy := extended.ScaleInPolicy; policy != nil && policy.Rules != nil && len(*policy.Rules) > 0 {
+		scaleInPolicy = (*policy.Rules)[0]
+	}
+	d.Set("scale_in_policy", scaleInPolicy)
+
+	instanceProtection, err := flattenAzureRmVirtualMachineScaleSetInstanceProtection(ctx, d, client, resGroup, name)
+	if err != nil {
+		return err
+	}
+	if err := d.Set("instance_protection", instanceProtection); err != nil {
+		return fmt.Errorf("[DEBUG] Error setting `instance_protection`: %#v", err)
+	}
+
 	if plan := resp.Plan; plan != nil {
 		flattenedPlan := flattenAzureRmVirtualMachineScaleSetPlan(plan)
 		if err := d.Set("plan", flattenedPlan); err != nil {
@@ -1048,7 +1148,8 @@ func resourceArmVirtualMachineScaleSetRead(d *schema.ResourceData, meta interfac
 
 func resourceArmVirtualMachineScaleSetDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).vmScaleSetClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -2209,10 +2310,16 @@ func azureRmVirtualMachineScaleSetSuppressRollingUpgradePolicyDiff(k, _, new str
 	return false
 }
 
-// Make sure rolling_upgrade_policy is default value when upgrade_policy_mode is not Rolling.
+// Make sure rolling_upgrade_policy is default value when upgrade_policy_mode is not Rolling - unless it's used
+// to upgrade the instances when the image changes in Manual mode.
 func azureRmVirtualMachineScaleSetCustomizeDiff(d *schema.ResourceDiff, _ interface{}) error {
 	mode := d.Get("upgrade_policy_mode").(string)
-	if strings.ToLower(mode) != "rolling" {
+	upgradeInstances := d.Get("upgrade_instances_on_image_change").(bool)
+	if upgradeInstances && !strings.EqualFold(mode, string(compute.Manual)) {
+		return fmt.Errorf("`upgrade_instances_on_image_change` can only be enabled when `upgrade_policy_mode` is `Manual`, since otherwise the instances are upgraded by the Scale Set")
+	}
+
+	if strings.ToLower(mode) != "rolling" && !upgradeInstances {
 		if policyRaw, ok := d.GetOk("rolling_upgrade_policy.0"); ok {
 			policy := policyRaw.(map[string]interface{})
 			isDefault := (policy["max_batch_instance_percent"].(int) == 20) &&
@@ -2226,3 +2333,264 @@ func azureRmVirtualMachineScaleSetCustomizeDiff(d *schema.ResourceDiff, _ interf
 	}
 	return nil
 }
+
+func flattenAzureRmVirtualMachineScaleSetInstanceProtection(ctx context.Context, d *schema.ResourceData, client azure.VirtualMachineScaleSetsClient, resGroup string, name string) ([]interface{}, error) {
+	results := make([]interface{}, 0)
+
+	// only the instances which are configured are tracked, since instances come and go as the Scale Set is scaled
+	for _, raw := range d.Get("instance_protection").(*schema.Set).List() {
+		instanceId := raw.(map[string]interface{})["instance_id"].(string)
+
+		policy, err := client.GetInstanceProtectionPolicy(ctx, resGroup, name, instanceId)
+		if err != nil {
+			if isAzureRmVirtualMachineScaleSetInstanceNotFound(err) {
+				log.Printf("[DEBUG] Instance %q was not found in Virtual Machine Scale Set %q (Resource Group %q) - removing its protection from state", instanceId, name, resGroup)
+				continue
+			}
+
+			return nil, fmt.Errorf("Error retrieving Protection Policy for Instance %q of Virtual Machine Scale Set %q (Resource Group %q): %+v", instanceId, name, resGroup, err)
+		}
+
+		protectFromScaleIn := false
+		if policy.ProtectFromScaleIn != nil {
+			protectFromScaleIn = *policy.ProtectFromScaleIn
+		}
+
+		protectFromScaleSetActions := false
+		if policy.ProtectFromScaleSetActions != nil {
+			protectFromScaleSetActions = *policy.ProtectFromScaleSetActions
+		}
+
+		results = append(results, map[string]interface{}{
+			"instance_id":                    instanceId,
+			"protect_from_scale_in":          protectFromScaleIn,
+			"protect_from_scale_set_actions": protectFromScaleSetActions,
+		})
+	}
+
+	return results, nil
+}
+
+func updateAzureRmVirtualMachineScaleSetInstanceProtection(ctx context.Context, d *schema.ResourceData, meta interface{}, resGroup string, name string) error {
+	client := meta.(*ArmClient).vmScaleSetExtendedClient
+
+	old, new := d.GetChange("instance_protection")
+
+	policies := make(map[string]azure.VirtualMachineScaleSetVMProtectionPolicy)
+
+	// instances which are no longer configured are unprotected
+	for _, raw := range old.(*schema.Set).List() {
+		instanceId := raw.(map[string]interface{})["instance_id"].(string)
+		policies[instanceId] = azure.VirtualMachineScaleSetVMProtectionPolicy{
+			ProtectFromScaleIn:         utils.Bool(false),
+			ProtectFromScaleSetActions: utils.Bool(false),
+		}
+	}
+
+	for _, raw := range new.(*schema.Set).List() {
+		v := raw.(map[string]interface{})
+		policies[v["instance_id"].(string)] = azure.VirtualMachineScaleSetVMProtectionPolicy{
+			ProtectFromScaleIn:         utils.Bool(v["protect_from_scale_in"].(bool)),
+			ProtectFromScaleSetActions: utils.Bool(v["protect_from_scale_set_actions"].(bool)),
+		}
+	}
+
+	for instanceId, policy := range policies {
+		log.Printf("[DEBUG] Updating the Protection Policy for Instance %q of Virtual Machine Scale Set %q (Resource Group %q)..", instanceId, name, resGroup)
+		future, err := client.UpdateInstanceProtectionPolicy(ctx, resGroup, name, instanceId, policy)
+		if err != nil {
+			// there's nothing to unprotect when an instance has been removed
+			if isAzureRmVirtualMachineScaleSetInstanceNotFound(err) && !*policy.ProtectFromScaleIn && !*policy.ProtectFromScaleSetActions {
+				continue
+			}
+
+			return fmt.Errorf("Error updating Protection Policy for Instance %q of Virtual Machine Scale Set %q (Resource Group %q): %+v", instanceId, name, resGroup, err)
+		}
+
+		if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("Error waiting for the Protection Policy of Instance %q of Virtual Machine Scale Set %q (Resource Group %q) to be updated: %+v", instanceId, name, resGroup, err)
+		}
+	}
+
+	return nil
+}
+
+func isAzureRmVirtualMachineScaleSetInstanceNotFound(err error) bool {
+	if e, ok := err.(autorest.DetailedError); ok && e.Response != nil {
+		return e.Response.StatusCode == http.StatusNotFound
+	}
+
+	return false
+}
+
+// upgradeAzureRmVirtualMachineScaleSetInstances rolls the latest model of the Scale Set out to the instances which
+// aren't using it, in batches as defined by the `rolling_upgrade_policy` - waiting for the instances in each batch
+// to become healthy, and aborting when too many instances are unhealthy
+func upgradeAzureRmVirtualMachineScaleSetInstances(ctx context.Context, d *schema.ResourceData, meta interface{}, resGroup string, name string) error {
+	client := meta.(*ArmClient).vmScaleSetClient
+	vmsClient := meta.(*ArmClient).vmScaleSetVMsClient
+
+	maxBatchInstancePercent := 20
+	maxUnhealthyInstancePercent := 20
+	maxUnhealthyUpgradedInstancePercent := 20
+	pauseTimeBetweenBatches := "PT0S"
+	if v, ok := d.GetOk("rolling_upgrade_policy.0"); ok {
+		policy := v.(map[string]interface{})
+		maxBatchInstancePercent = policy["max_batch_instance_percent"].(int)
+		maxUnhealthyInstancePercent = policy["max_unhealthy_instance_percent"].(int)
+		maxUnhealthyUpgradedInstancePercent = policy["max_unhealthy_upgraded_instance_percent"].(int)
+		pauseTimeBetweenBatches = policy["pause_time_between_batches"].(string)
+	}
+
+	pause, err := azure.ParseISO8601Duration(pauseTimeBetweenBatches)
+	if err != nil {
+		return fmt.Errorf("Error parsing `pause_time_between_batches`: %+v", err)
+	}
+
+	// instances which are protected from Scale Set actions can't be upgraded
+	protected := make(map[string]bool)
+	for _, raw := range d.Get("instance_protection").(*schema.Set).List() {
+		v := raw.(map[string]interface{})
+		if v["protect_from_scale_set_actions"].(bool) {
+			protected[v["instance_id"].(string)] = true
+		}
+	}
+
+	outdatedInstanceIds := make([]string, 0)
+	instances, err := vmsClient.ListComplete(ctx, resGroup, name, "", "", "")
+	if err != nil {
+		return fmt.Errorf("Error listing Instances of Virtual Machine Scale Set %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+	for instances.NotDone() {
+		instance := instances.Value()
+		if instance.InstanceID != nil {
+			props := instance.VirtualMachineScaleSetVMProperties
+			if props != nil && props.LatestModelApplied != nil && !*props.LatestModelApplied && !protected[*instance.InstanceID] {
+				outdatedInstanceIds = append(outdatedInstanceIds, *instance.InstanceID)
+			}
+		}
+
+		if err := instances.NextWithContext(ctx); err != nil {
+			return fmt.Errorf("Error listing Instances of Virtual Machine Scale Set %q (Resource Group %q): %+v", name, resGroup, err)
+		}
+	}
+
+	batches := azure.VirtualMachineScaleSetUpgradeBatches(outdatedInstanceIds, maxBatchInstancePercent)
+	unhealthyUpgradedInstances := 0
+	upgradedInstances := 0
+
+	for i, batch := range batches {
+		if i > 0 && pause > 0 {
+			log.Printf("[DEBUG] Pausing for %s before upgrading the next batch of Instances of Virtual Machine Scale Set %q (Resource Group %q)..", pause, name, resGroup)
+			select {
+			case <-ctx.Done():
+				return fmt.Errorf("Error upgrading Instances of Virtual Machine Scale Set %q (Resource Group %q): %+v", name, resGroup, ctx.Err())
+			case <-time.After(pause):
+			}
+		}
+
+		unhealthyInstances := 0
+		for _, instanceId := range batch {
+			view, err := vmsClient.GetInstanceView(ctx, resGroup, name, instanceId)
+			if err != nil {
+				return fmt.Errorf("Error retrieving Instance View for Instance %q of Virtual Machine Scale Set %q (Resource Group %q): %+v", instanceId, name, resGroup, err)
+			}
+
+			if health := virtualMachineScaleSetInstanceHealth(view); health == "Unhealthy" || health == "Failed" {
+				unhealthyInstances++
+			}
+		}
+		if unhealthyInstances*100 > maxUnhealthyInstancePercent*len(batch) {
+			return fmt.Errorf("Error upgrading Instances of Virtual Machine Scale Set %q (Resource Group %q): aborting since %d of the %d Instances in the next batch are unhealthy, which exceeds `max_unhealthy_instance_percent` (%d%%)", name, resGroup, unhealthyInstances, len(batch), maxUnhealthyInstancePercent)
+		}
+
+		log.Printf("[DEBUG] Upgrading Instances %q of Virtual Machine Scale Set %q (Resource Group %q) (batch %d of %d)..", strings.Join(batch, ", "), name, resGroup, i+1, len(batches))
+		future, err := client.UpdateInstances(ctx, resGroup, name, compute.VirtualMachineScaleSetVMInstanceRequiredIDs{
+			InstanceIds: &batch,
+		})
+		if err != nil {
+			return fmt.Errorf("Error upgrading Instances %q of Virtual Machine Scale Set %q (Resource Group %q): %+v", strings.Join(batch, ", "), name, resGroup, err)
+		}
+
+		if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("Error waiting for Instances %q of Virtual Machine Scale Set %q (Resource Group %q) to be upgraded: %+v", strings.Join(batch, ", "), name, resGroup, err)
+		}
+
+		for _, instanceId := range batch {
+			upgradedInstances++
+
+			stateConf := &resource.StateChangeConf{
+				Pending:    []string{"Pending", "Unhealthy"},
+				Target:     []string{"Healthy"},
+				Refresh:    virtualMachineScaleSetInstanceHealthRefreshFunc(ctx, vmsClient, resGroup, name, instanceId),
+				Timeout:    d.Timeout(schema.TimeoutUpdate),
+				MinTimeout: 15 * time.Second,
+			}
+			if deadline, ok := ctx.Deadline(); ok {
+				stateConf.Timeout = time.Until(deadline)
+			}
+			if _, err := stateConf.WaitForState(); err != nil {
+				log.Printf("[DEBUG] Instance %q of Virtual Machine Scale Set %q (Resource Group %q) is unhealthy after being upgraded: %+v", instanceId, name, resGroup, err)
+				unhealthyUpgradedInstances++
+			}
+		}
+
+		if unhealthyUpgradedInstances*100 > maxUnhealthyUpgradedInstancePercent*upgradedInstances {
+			return fmt.Errorf("Error upgrading Instances of Virtual Machine Scale Set %q (Resource Group %q): aborting since %d of %d upgraded Instances are unhealthy, which exceeds `max_unhealthy_upgraded_instance_percent` (%d%%)", name, resGroup, unhealthyUpgradedInstances, upgradedInstances, maxUnhealthyUpgradedInstancePercent)
+		}
+	}
+
+	return nil
+}
+
+func virtualMachineScaleSetInstanceHealthRefreshFunc(ctx context.Context, client compute.VirtualMachineScaleSetVMsClient, resGroup string, name string, instanceId string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		view, err := client.GetInstanceView(ctx, resGroup, name, instanceId)
+		if err != nil {
+			return nil, "", fmt.Errorf("Error retrieving Instance View for Instance %q of Virtual Machine Scale Set %q (Resource Group %q): %+v", instanceId, name, resGroup, err)
+		}
+
+		return view, virtualMachineScaleSetInstanceHealth(view), nil
+	}
+}
+
+// virtualMachineScaleSetInstanceHealth returns the health of an instance - which is reported by the Application
+// Health extension when it's installed, otherwise an instance is healthy once it's been provisioned and is running
+func virtualMachineScaleSetInstanceHealth(view compute.VirtualMachineScaleSetVMInstanceView) string {
+	if health := view.VMHealth; health != nil && health.Status != nil && health.Status.Code != nil {
+		switch strings.ToLower(*health.Status.Code) {
+		case "healthstate/healthy":
+			return "Healthy"
+		case "healthstate/unhealthy":
+			return "Unhealthy"
+		default:
+			return "Pending"
+		}
+	}
+
+	provisioned := false
+	running := false
+	if statuses := view.Statuses; statuses != nil {
+		for _, status := range *statuses {
+			if status.Code == nil {
+				continue
+			}
+
+			code := strings.ToLower(*status.Code)
+			switch {
+			case strings.HasPrefix(code, "provisioningstate/failed"):
+				return "Failed"
+			case code == "provisioningstate/succeeded":
+				provisioned = true
+			case code == "powerstate/running":
+				running = true
+			}
+		}
+	}
+
+	if provisioned && running {
+		return "Healthy"
+	}
+
+	return "Pending"
+}
@@ -968,6 +968,97 @@ func TestAccAzureRMVirtualMachineScaleSet_upgradeModeUpdate(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMVirtualMachineScaleSet_rollingImageUpgrade(t *testing.T) {
+	resourceName := "azurerm_virtual_machine_scale_set.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualMachineScaleSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVirtualMachineScaleSet_rollingImageUpgrade(ri, location, "16.04-LTS"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualMachineScaleSetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "upgrade_instances_on_image_change", "true"),
+					resource.TestCheckResourceAttr(resourceName, "rolling_upgrade_policy.0.max_batch_instance_percent", "50"),
+				),
+			},
+			{
+				Config: testAccAzureRMVirtualMachineScaleSet_rollingImageUpgrade(ri, location, "18.04-LTS"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualMachineScaleSetExists(resourceName),
+					testCheckAzureRMVirtualMachineScaleSetInstancesUpToDate(resourceName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMVirtualMachineScaleSet_scaleInPolicy(t *testing.T) {
+	resourceName := "azurerm_virtual_machine_scale_set.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualMachineScaleSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVirtualMachineScaleSet_scaleInPolicy(ri, location, "OldestVM"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualMachineScaleSetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "scale_in_policy", "OldestVM"),
+				),
+			},
+			{
+				Config: testAccAzureRMVirtualMachineScaleSet_scaleInPolicy(ri, location, "NewestVM"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualMachineScaleSetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "scale_in_policy", "NewestVM"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"os_profile.0.admin_password"},
+			},
+		},
+	})
+}
+
+func TestAccAzureRMVirtualMachineScaleSet_instanceProtection(t *testing.T) {
+	resourceName := "azurerm_virtual_machine_scale_set.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualMachineScaleSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVirtualMachineScaleSet_instanceProtection(ri, location, true),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualMachineScaleSetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "instance_protection.#", "1"),
+				),
+			},
+			{
+				Config: testAccAzureRMVirtualMachineScaleSet_instanceProtection(ri, location, false),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualMachineScaleSetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "instance_protection.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAzureRMVirtualMachineScaleSet_importBasic_managedDisk_withZones(t *testing.T) {
 	resourceName := "azurerm_virtual_machine_scale_set.test"
 
@@ -1059,6 +1150,38 @@ func testCheckAzureRMVirtualMachineScaleSetDisappears(name string) resource.Test
 	}
 }
 
+func testCheckAzureRMVirtualMachineScaleSetInstancesUpToDate(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		client := testAccProvider.Meta().(*ArmClient).vmScaleSetVMsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		instances, err := client.ListComplete(ctx, resourceGroup, name, "", "", "")
+		if err != nil {
+			return fmt.Errorf("Bad: listing Instances of Virtual Machine Scale Set %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+		for instances.NotDone() {
+			instance := instances.Value()
+			if props := instance.VirtualMachineScaleSetVMProperties; props != nil && props.LatestModelApplied != nil && !*props.LatestModelApplied {
+				return fmt.Errorf("Bad: Instance %q of Virtual Machine Scale Set %q (Resource Group %q) isn't using the latest model", *instance.InstanceID, name, resourceGroup)
+			}
+
+			if err := instances.NextWithContext(ctx); err != nil {
+				return fmt.Errorf("Bad: listing Instances of Virtual Machine Scale Set %q (Resource Group %q): %+v", name, resourceGroup, err)
+			}
+		}
+
+		return nil
+	}
+}
+
 func testCheckAzureRMVirtualMachineScaleSetDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*ArmClient).vmScaleSetClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
@@ -5012,3 +5135,108 @@ resource "azurerm_virtual_machine_scale_set" "test" {
 }
 `, rInt, location, rString)
 }
+
+func testAccAzureRMVirtualMachineScaleSet_rollingImageUpgrade(rInt int, location string, sku string) string {
+	settings := `
+  upgrade_instances_on_image_change = true
+
+  rolling_upgrade_policy {
+    max_batch_instance_percent              = 50
+    max_unhealthy_instance_percent          = 50
+    max_unhealthy_upgraded_instance_percent = 50
+    pause_time_between_batches              = "PT30S"
+  }
+`
+	return testAccAzureRMVirtualMachineScaleSet_manualTemplate(rInt, location, settings, sku)
+}
+
+func testAccAzureRMVirtualMachineScaleSet_scaleInPolicy(rInt int, location string, policy string) string {
+	settings := fmt.Sprintf(`
+  scale_in_policy = "%s"
+`, policy)
+	return testAccAzureRMVirtualMachineScaleSet_manualTemplate(rInt, location, settings, "16.04-LTS")
+}
+
+func testAccAzureRMVirtualMachineScaleSet_instanceProtection(rInt int, location string, protected bool) string {
+	// overprovisioning is disabled so that the Instance ID's are predictable
+	settings := `
+  overprovision = false
+`
+	if protected {
+		settings += `
+  instance_protection {
+    instance_id                    = "0"
+    protect_from_scale_in          = true
+    protect_from_scale_set_actions = true
+  }
+`
+	}
+	return testAccAzureRMVirtualMachineScaleSet_manualTemplate(rInt, location, settings, "16.04-LTS")
+}
+
+func testAccAzureRMVirtualMachineScaleSet_manualTemplate(rInt int, location string, settings string, sku string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctvn-%[1]d"
+  address_space       = ["10.0.0.0/16"]
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "acctsub-%[1]d"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  virtual_network_name = "${azurerm_virtual_network.test.name}"
+  address_prefix       = "10.0.2.0/24"
+}
+
+resource "azurerm_virtual_machine_scale_set" "test" {
+  name                = "acctvmss-%[1]d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  upgrade_policy_mode = "Manual"
+%[3]s
+  sku {
+    name     = "Standard_F2"
+    tier     = "Standard"
+    capacity = 2
+  }
+
+  os_profile {
+    computer_name_prefix = "testvm-%[1]d"
+    admin_username       = "myadmin"
+    admin_password       = "Passwword1234"
+  }
+
+  network_profile {
+    name    = "TestNetworkProfile-%[1]d"
+    primary = true
+
+    ip_configuration {
+      name      = "TestIPConfiguration"
+      primary   = true
+      subnet_id = "${azurerm_subnet.test.id}"
+    }
+  }
+
+  storage_profile_os_disk {
+    name              = ""
+    caching           = "ReadWrite"
+    create_option     = "FromImage"
+    managed_disk_type = "Standard_LRS"
+  }
+
+  storage_profile_image_reference {
+    publisher = "Canonical"
+    offer     = "UbuntuServer"
+    sku       = "%[4]s"
+    version   = "latest"
+  }
+}
+`, rInt, location, settings, sku)
+}
@@ -3,6 +3,7 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-08-01/network"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -10,6 +11,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
@@ -21,8 +23,13 @@ func resourceArmVirtualNetworkGatewayConnection() *schema.Resource {
 		Update: resourceArmVirtualNetworkGatewayConnectionCreateUpdate,
 		Delete: resourceArmVirtualNetworkGatewayConnectionDelete,
 
-		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+		Importer: azure.ValidateResourceIDPriorToImport(azure.ValidateVirtualNetworkGatewayConnectionID),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -37,6 +44,14 @@ func resourceArmVirtualNetworkGatewayConnection() *schema.Resource {
 
 			"location": locationSchema(),
 
+			"subscription_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.UUID,
+			},
+
 			"type": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -50,10 +65,11 @@ func resourceArmVirtualNetworkGatewayConnection() *schema.Resource {
 			},
 
 			"virtual_network_gateway_id": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ForceNew:     true,
-				ValidateFunc: azure.ValidateResourceID,
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     azure.ValidateVirtualNetworkGatewayID,
+				DiffSuppressFunc: suppress.CaseDifference,
 			},
 
 			"authorization_key": {
@@ -64,23 +80,26 @@ func resourceArmVirtualNetworkGatewayConnection() *schema.Resource {
 			},
 
 			"express_route_circuit_id": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ForceNew:     true,
-				ValidateFunc: azure.ValidateResourceIDOrEmpty,
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateFunc:     azure.ValidateExpressRouteCircuitIDOrEmpty,
+				DiffSuppressFunc: suppress.CaseDifference,
 			},
 
 			"peer_virtual_network_gateway_id": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ForceNew:     true,
-				ValidateFunc: azure.ValidateResourceIDOrEmpty,
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateFunc:     azure.ValidateVirtualNetworkGatewayIDOrEmpty,
+				DiffSuppressFunc: suppress.CaseDifference,
 			},
 
 			"local_network_gateway_id": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ValidateFunc: azure.ValidateResourceIDOrEmpty,
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     azure.ValidateLocalNetworkGatewayIDOrEmpty,
+				DiffSuppressFunc: suppress.CaseDifference,
 			},
 
 			"enable_bgp": {
@@ -227,14 +246,26 @@ func resourceArmVirtualNetworkGatewayConnection() *schema.Resource {
 
 func resourceArmVirtualNetworkGatewayConnectionCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).vnetGatewayConnectionsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for AzureRM Virtual Network Gateway Connection creation.")
 
 	name := d.Get("name").(string)
 	resGroup := d.Get("resource_group_name").(string)
 
-	if requireResourcesToBeImported && d.IsNewResource() {
+	// the Connection is managed in the specified Subscription, which defaults to that of the Virtual Network Gateway
+	if v, ok := d.GetOk("subscription_id"); ok {
+		client.SubscriptionID = v.(string)
+	} else {
+		gatewayId, err := parseAzureResourceID(d.Get("virtual_network_gateway_id").(string))
+		if err != nil {
+			return err
+		}
+		client.SubscriptionID = gatewayId.SubscriptionID
+	}
+
+	if meta.(*ArmClient).requireResourcesToBeImported && d.IsNewResource() {
 		existing, err := client.Get(ctx, resGroup, name)
 		if err != nil {
 			if !utils.ResponseWasNotFound(existing.Response) {
@@ -286,12 +317,16 @@ func resourceArmVirtualNetworkGatewayConnectionCreateUpdate(d *schema.ResourceDa
 
 func resourceArmVirtualNetworkGatewayConnectionRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).vnetGatewayConnectionsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	resGroup, name, err := resourceGroupAndVirtualNetworkGatewayConnectionFromId(d.Id())
+	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return err
 	}
+	client.SubscriptionID = id.SubscriptionID
+	resGroup := id.ResourceGroup
+	name := id.Path["connections"]
 
 	resp, err := client.Get(ctx, resGroup, name)
 	if err != nil {
@@ -305,6 +340,7 @@ func resourceArmVirtualNetworkGatewayConnectionRead(d *schema.ResourceData, meta
 	conn := *resp.VirtualNetworkGatewayConnectionPropertiesFormat
 
 	d.Set("name", resp.Name)
+	d.Set("subscription_id", id.SubscriptionID)
 	d.Set("resource_group_name", resGroup)
 	if location := resp.Location; location != nil {
 		d.Set("location", azureRMNormalizeLocation(*location))
@@ -365,12 +401,16 @@ func resourceArmVirtualNetworkGatewayConnectionRead(d *schema.ResourceData, meta
 
 func resourceArmVirtualNetworkGatewayConnectionDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).vnetGatewayConnectionsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
-	resGroup, name, err := resourceGroupAndVirtualNetworkGatewayConnectionFromId(d.Id())
+	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return err
 	}
+	client.SubscriptionID = id.SubscriptionID
+	resGroup := id.ResourceGroup
+	name := id.Path["connections"]
 
 	future, err := client.Delete(ctx, resGroup, name)
 	if err != nil {
@@ -492,17 +532,6 @@ func getArmVirtualNetworkGatewayConnectionProperties(d *schema.ResourceData) (*n
 	return props, nil
 }
 
-func resourceGroupAndVirtualNetworkGatewayConnectionFromId(virtualNetworkGatewayConnectionId string) (string, string, error) {
-	id, err := parseAzureResourceID(virtualNetworkGatewayConnectionId)
-	if err != nil {
-		return "", "", err
-	}
-	name := id.Path["connections"]
-	resGroup := id.ResourceGroup
-
-	return resGroup, name, nil
-}
-
 func expandArmVirtualNetworkGatewayConnectionIpsecPolicies(schemaIpsecPolicies []interface{}) *[]network.IpsecPolicy {
 	ipsecPolicies := make([]network.IpsecPolicy, 0, len(schemaIpsecPolicies))
 
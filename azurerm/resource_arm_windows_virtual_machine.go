@@ -0,0 +1,455 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmWindowsVirtualMachine() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmWindowsVirtualMachineCreate,
+		Read:   resourceArmWindowsVirtualMachineRead,
+		Update: resourceArmWindowsVirtualMachineUpdate,
+		Delete: resourceArmWindowsVirtualMachineDelete,
+
+		Importer: importVirtualMachine(compute.Windows, "azurerm_windows_virtual_machine"),
+
+		CustomizeDiff: azure.CustomizeDiffAll(
+			azure.VirtualMachineOSDiskCustomizeDiff,
+			azure.VirtualMachineIdentityCustomizeDiff,
+		),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(45 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(45 * time.Minute),
+			Delete: schema.DefaultTimeout(45 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"location": locationSchema(),
+
+			"size": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"admin_username": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"admin_password": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Sensitive:    true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"computer_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.WindowsComputerName,
+			},
+
+			"custom_data": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Sensitive:    true,
+				ValidateFunc: validate.Base64String(),
+			},
+
+			"network_interface_ids": azure.SchemaVirtualMachineNetworkInterfaceIDs(),
+
+			"os_disk": azure.SchemaVirtualMachineOSDisk(),
+
+			"source_image_id": azure.SchemaVirtualMachineSourceImageID(),
+
+			"source_image_reference": azure.SchemaVirtualMachineSourceImageReference(),
+
+			"availability_set_id": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateFunc:     azure.ValidateAvailabilitySetID,
+				DiffSuppressFunc: suppress.CaseDifference,
+				ConflictsWith:    []string{"zone"},
+			},
+
+			"zone": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ValidateFunc:  validate.NoEmptyStrings,
+				ConflictsWith: []string{"availability_set_id"},
+			},
+
+			"boot_diagnostics": azure.SchemaVirtualMachineBootDiagnostics(),
+
+			"identity": azure.SchemaVirtualMachineIdentity(),
+
+			"enable_automatic_updates": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  true,
+			},
+
+			"license_type": azure.SchemaVirtualMachineLicenseType(),
+
+			"provision_vm_agent": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  true,
+			},
+
+			"timezone": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"tags": tagsSchema(),
+
+			"private_ip_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"public_ip_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"virtual_machine_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmWindowsVirtualMachineCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).vmClient
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	if meta.(*ArmClient).requireResourcesToBeImported {
+		existing, err := client.Get(ctx, resourceGroup, name, "")
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Windows Virtual Machine %q (Resource Group %q): %+v", name, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_windows_virtual_machine", *existing.ID)
+		}
+	}
+
+	imageReference, err := azure.ExpandVirtualMachineSourceImageReference(d.Get("source_image_reference").([]interface{}), d.Get("source_image_id").(string))
+	if err != nil {
+		return err
+	}
+
+	adminUsername := d.Get("admin_username").(string)
+	computerName := d.Get("computer_name").(string)
+	if computerName == "" {
+		// the Computer Name defaults to the name of the Virtual Machine, which has fewer restrictions
+		if _, errs := validate.WindowsComputerName(name, "computer_name"); len(errs) > 0 {
+			return fmt.Errorf("Unable to use the `name` %q as the Computer Name, a `computer_name` must be specified: %+v", name, errs[0])
+		}
+		computerName = name
+	}
+
+	osProfile := compute.OSProfile{
+		AdminUsername: utils.String(adminUsername),
+		ComputerName:  utils.String(computerName),
+		AdminPassword: utils.String(d.Get("admin_password").(string)),
+		WindowsConfiguration: &compute.WindowsConfiguration{
+			EnableAutomaticUpdates: utils.Bool(d.Get("enable_automatic_updates").(bool)),
+			ProvisionVMAgent:       utils.Bool(d.Get("provision_vm_agent").(bool)),
+		},
+	}
+
+	if v := d.Get("timezone").(string); v != "" {
+		osProfile.WindowsConfiguration.TimeZone = utils.String(v)
+	}
+
+	if v := d.Get("custom_data").(string); v != "" {
+		osProfile.CustomData = utils.String(v)
+	}
+
+	props := compute.VirtualMachineProperties{
+		HardwareProfile: &compute.HardwareProfile{
+			VMSize: compute.VirtualMachineSizeTypes(d.Get("size").(string)),
+		},
+		OsProfile: &osProfile,
+		NetworkProfile: &compute.NetworkProfile{
+			NetworkInterfaces: azure.ExpandVirtualMachineNetworkInterfaceIDs(d.Get("network_interface_ids").([]interface{})),
+		},
+		StorageProfile: &compute.StorageProfile{
+			ImageReference: imageReference,
+			OsDisk:         azure.ExpandVirtualMachineOSDisk(d.Get("os_disk").([]interface{}), compute.Windows),
+		},
+		DiagnosticsProfile: azure.ExpandVirtualMachineBootDiagnostics(d.Get("boot_diagnostics").([]interface{})),
+	}
+
+	if v := d.Get("license_type").(string); v != "" {
+		props.LicenseType = utils.String(v)
+	}
+
+	if v := d.Get("availability_set_id").(string); v != "" {
+		props.AvailabilitySet = &compute.SubResource{
+			ID: utils.String(v),
+		}
+	}
+
+	vm := compute.VirtualMachine{
+		Name:                     utils.String(name),
+		Location:                 utils.String(azureRMNormalizeLocation(d.Get("location").(string))),
+		VirtualMachineProperties: &props,
+		Tags:                     expandTags(d.Get("tags").(map[string]interface{})),
+	}
+
+	if v := d.Get("identity").([]interface{}); len(v) > 0 {
+		vm.Identity = azure.ExpandVirtualMachineIdentity(v)
+	}
+
+	if v := d.Get("zone").(string); v != "" {
+		vm.Zones = &[]string{v}
+	}
+
+	azureRMLockByName(name, virtualMachineResourceName)
+	defer azureRMUnlockByName(name, virtualMachineResourceName)
+
+	log.Printf("[DEBUG] Creating Windows Virtual Machine %q (Resource Group %q)..", name, resourceGroup)
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, vm)
+	if err != nil {
+		return fmt.Errorf("Error creating Windows Virtual Machine %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation of Windows Virtual Machine %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, name, "")
+	if err != nil {
+		return fmt.Errorf("Error retrieving Windows Virtual Machine %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID for Windows Virtual Machine %q (Resource Group %q)", name, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmWindowsVirtualMachineRead(d, meta)
+}
+
+func resourceArmWindowsVirtualMachineRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).vmClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseVirtualMachineID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Windows Virtual Machine %q was not found in Resource Group %q - removing from state!", id.Name, id.ResourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Windows Virtual Machine %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	zone := ""
+	if zones := resp.Zones; zones != nil && len(*zones) > 0 {
+		zone = (*zones)[0]
+	}
+	d.Set("zone", zone)
+
+	if err := d.Set("identity", azure.FlattenVirtualMachineIdentity(resp.Identity)); err != nil {
+		return fmt.Errorf("Error setting `identity`: %+v", err)
+	}
+
+	if props := resp.VirtualMachineProperties; props != nil {
+		d.Set("virtual_machine_id", props.VMID)
+		d.Set("license_type", azure.FlattenVirtualMachineLicenseType(props.LicenseType))
+
+		availabilitySetId := ""
+		if props.AvailabilitySet != nil && props.AvailabilitySet.ID != nil {
+			availabilitySetId = *props.AvailabilitySet.ID
+		}
+		d.Set("availability_set_id", availabilitySetId)
+
+		if profile := props.HardwareProfile; profile != nil {
+			d.Set("size", string(profile.VMSize))
+		}
+
+		if err := d.Set("boot_diagnostics", azure.FlattenVirtualMachineBootDiagnostics(props.DiagnosticsProfile)); err != nil {
+			return fmt.Errorf("Error setting `boot_diagnostics`: %+v", err)
+		}
+
+		if profile := props.NetworkProfile; profile != nil {
+			if err := d.Set("network_interface_ids", azure.FlattenVirtualMachineNetworkInterfaceIDs(profile.NetworkInterfaces)); err != nil {
+				return fmt.Errorf("Error setting `network_interface_ids`: %+v", err)
+			}
+		}
+
+		if profile := props.OsProfile; profile != nil {
+			d.Set("admin_username", profile.AdminUsername)
+			d.Set("computer_name", profile.ComputerName)
+
+			if config := profile.WindowsConfiguration; config != nil {
+				d.Set("enable_automatic_updates", config.EnableAutomaticUpdates)
+				d.Set("provision_vm_agent", config.ProvisionVMAgent)
+				d.Set("timezone", config.TimeZone)
+			}
+		}
+
+		if profile := props.StorageProfile; profile != nil {
+			if err := d.Set("os_disk", azure.FlattenVirtualMachineOSDisk(profile.OsDisk)); err != nil {
+				return fmt.Errorf("Error setting `os_disk`: %+v", err)
+			}
+
+			sourceImageId := ""
+			if profile.ImageReference != nil && profile.ImageReference.ID != nil {
+				sourceImageId = *profile.ImageReference.ID
+			}
+			d.Set("source_image_id", sourceImageId)
+
+			if err := d.Set("source_image_reference", azure.FlattenVirtualMachineSourceImageReference(profile.ImageReference)); err != nil {
+				return fmt.Errorf("Error setting `source_image_reference`: %+v", err)
+			}
+		}
+
+		privateIPAddress, publicIPAddress, err := retrieveAzureRmVirtualMachineIPAddresses(ctx, meta, props)
+		if err != nil {
+			return fmt.Errorf("Error retrieving IP Addresses for Windows Virtual Machine %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+		}
+		d.Set("private_ip_address", privateIPAddress)
+		d.Set("public_ip_address", publicIPAddress)
+
+		host := publicIPAddress
+		if host == "" {
+			host = privateIPAddress
+		}
+		d.SetConnInfo(map[string]string{
+			"type": "winrm",
+			"host": host,
+		})
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func resourceArmWindowsVirtualMachineUpdate(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := timeouts.ForUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseVirtualMachineID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	update := compute.VirtualMachineUpdate{
+		VirtualMachineProperties: &compute.VirtualMachineProperties{
+			HardwareProfile: &compute.HardwareProfile{
+				VMSize: compute.VirtualMachineSizeTypes(d.Get("size").(string)),
+			},
+			NetworkProfile: &compute.NetworkProfile{
+				NetworkInterfaces: azure.ExpandVirtualMachineNetworkInterfaceIDs(d.Get("network_interface_ids").([]interface{})),
+			},
+			StorageProfile: &compute.StorageProfile{
+				OsDisk: &compute.OSDisk{
+					Caching:                 compute.CachingTypes(d.Get("os_disk.0.caching").(string)),
+					WriteAcceleratorEnabled: utils.Bool(d.Get("os_disk.0.write_accelerator_enabled").(bool)),
+				},
+			},
+			DiagnosticsProfile: azure.ExpandVirtualMachineBootDiagnostics(d.Get("boot_diagnostics").([]interface{})),
+		},
+		Tags: expandTags(d.Get("tags").(map[string]interface{})),
+	}
+
+	if d.HasChange("license_type") {
+		licenseType := d.Get("license_type").(string)
+		if licenseType == "" {
+			// the License Type has to be explicitly removed
+			licenseType = "None"
+		}
+		update.VirtualMachineProperties.LicenseType = utils.String(licenseType)
+	}
+
+	if d.HasChange("identity") {
+		update.Identity = azure.ExpandVirtualMachineIdentity(d.Get("identity").([]interface{}))
+	}
+
+	azureRMLockByName(id.Name, virtualMachineResourceName)
+	defer azureRMUnlockByName(id.Name, virtualMachineResourceName)
+
+	if err := updateAzureRmVirtualMachine(ctx, d, meta, id, update); err != nil {
+		return err
+	}
+
+	return resourceArmWindowsVirtualMachineRead(d, meta)
+}
+
+func resourceArmWindowsVirtualMachineDelete(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseVirtualMachineID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	azureRMLockByName(id.Name, virtualMachineResourceName)
+	defer azureRMUnlockByName(id.Name, virtualMachineResourceName)
+
+	return deleteAzureRmVirtualMachine(ctx, meta, id)
+}
@@ -0,0 +1,176 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/profiles/2017-03-09/resources/mgmt/resources"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
+)
+
+const resourceProviderRegistrationResourceName = "azurerm_resource_provider_registration"
+
+// resourceProviderRegistrations tracks which Resource Providers are known to be registered in the Subscription,
+// so that each Resource Provider is registered (at most) once, the first time a Resource using it is created
+type resourceProviderRegistrations struct {
+	lock       sync.RWMutex
+	registered map[string]bool
+}
+
+func newResourceProviderRegistrations(available []resources.Provider) *resourceProviderRegistrations {
+	registrations := &resourceProviderRegistrations{
+		registered: make(map[string]bool),
+	}
+
+	for _, p := range available {
+		if p.Namespace != nil && p.RegistrationState != nil && strings.EqualFold(*p.RegistrationState, "Registered") {
+			registrations.registered[strings.ToLower(*p.Namespace)] = true
+		}
+	}
+
+	return registrations
+}
+
+func (r *resourceProviderRegistrations) isRegistered(namespace string) bool {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	return r.registered[strings.ToLower(namespace)]
+}
+
+func (r *resourceProviderRegistrations) markAsRegistered(namespace string) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.registered[strings.ToLower(namespace)] = true
+}
+
+// ensureRegistered registers the Resource Provider in the Subscription if it's not already registered,
+// waiting for the registration to complete
+func (r *resourceProviderRegistrations) ensureRegistered(ctx context.Context, client resources.ProvidersClient, namespace string) error {
+	if r.isRegistered(namespace) {
+		return nil
+	}
+
+	azureRMLockByName(namespace, resourceProviderRegistrationResourceName)
+	defer azureRMUnlockByName(namespace, resourceProviderRegistrationResourceName)
+
+	// another operation may have registered this whilst we were waiting for the lock
+	if r.isRegistered(namespace) {
+		return nil
+	}
+
+	provider, err := client.Get(ctx, namespace, "")
+	if err != nil {
+		return fmt.Errorf("Error retrieving the registration state of Resource Provider %q: %+v", namespace, err)
+	}
+
+	if provider.RegistrationState == nil || !strings.EqualFold(*provider.RegistrationState, "Registered") {
+		log.Printf("[DEBUG] Registering Resource Provider %q", namespace)
+		if _, err := client.Register(ctx, namespace); err != nil {
+			return fmt.Errorf("Error registering Resource Provider %q: %+v", namespace, err)
+		}
+
+		stateConf := &resource.StateChangeConf{
+			Pending:    []string{"NotRegistered", "Registering", "Unregistered"},
+			Target:     []string{"Registered"},
+			Refresh:    resourceProviderRegistrationStateRefreshFunc(ctx, client, namespace),
+			MinTimeout: 10 * time.Second,
+			Timeout:    10 * time.Minute,
+		}
+		if deadline, ok := ctx.Deadline(); ok {
+			stateConf.Timeout = time.Until(deadline)
+		}
+
+		if _, err := stateConf.WaitForState(); err != nil {
+			return fmt.Errorf("Error waiting for Resource Provider %q to be registered: %+v", namespace, err)
+		}
+	}
+
+	r.markAsRegistered(namespace)
+	return nil
+}
+
+func resourceProviderRegistrationStateRefreshFunc(ctx context.Context, client resources.ProvidersClient, namespace string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		provider, err := client.Get(ctx, namespace, "")
+		if err != nil {
+			return nil, "", fmt.Errorf("Error retrieving the registration state of Resource Provider %q: %+v", namespace, err)
+		}
+
+		state := ""
+		if provider.RegistrationState != nil {
+			state = *provider.RegistrationState
+		}
+
+		return provider, state, nil
+	}
+}
+
+// withResourceProviderRegistration wraps the CRUD functions of the resource such that the Resource Provider it uses
+// is registered before it's first created, and errors caused by an unregistered Resource Provider are made clearer
+func withResourceProviderRegistration(name string, r *schema.Resource) *schema.Resource {
+	namespace := resourceProviderNamespaces()[name]
+
+	if r.Create != nil {
+		create := withMissingSubscriptionRegistrationDetails(namespace, crudFunc(r.Create))
+		r.Create = func(d *schema.ResourceData, meta interface{}) error {
+			client, ok := meta.(*ArmClient)
+			if ok && client != nil && client.resourceProviderRegistrations != nil && namespace != "" {
+				ctx, cancel := timeouts.ForCreate(client.StopContext, d)
+				defer cancel()
+
+				if err := client.resourceProviderRegistrations.ensureRegistered(ctx, client.providersClient, namespace); err != nil {
+					return fmt.Errorf("%+v\n\nThe Resource Provider %q is required by %q - either grant permission to register it, register it manually, "+
+						"or set `skip_provider_registration` to `true` in the Provider block to skip registration.", err, namespace, name)
+				}
+			}
+
+			return create(d, meta)
+		}
+	}
+	if r.Read != nil {
+		r.Read = schema.ReadFunc(withMissingSubscriptionRegistrationDetails(namespace, crudFunc(r.Read)))
+	}
+	if r.Update != nil {
+		r.Update = schema.UpdateFunc(withMissingSubscriptionRegistrationDetails(namespace, crudFunc(r.Update)))
+	}
+	if r.Delete != nil {
+		r.Delete = schema.DeleteFunc(withMissingSubscriptionRegistrationDetails(namespace, crudFunc(r.Delete)))
+	}
+
+	return r
+}
+
+// withMissingSubscriptionRegistrationDetails appends which Resource Provider needs to be registered
+// to errors returned when an API call fails with `MissingSubscriptionRegistration`
+func withMissingSubscriptionRegistrationDetails(namespace string, f crudFunc) crudFunc {
+	return func(d *schema.ResourceData, meta interface{}) error {
+		err := f(d, meta)
+		if err == nil {
+			return nil
+		}
+
+		unregistered, ok := azure.UnregisteredResourceProvider(err)
+		if !ok {
+			return err
+		}
+		if unregistered == "" {
+			unregistered = namespace
+		}
+		if unregistered == "" {
+			return err
+		}
+
+		return fmt.Errorf("%+v\n\nThe Resource Provider %q isn't registered in this Subscription. It can be registered by an account with "+
+			"permission to do so (for example using `az provider register --namespace %s`), or by adding it to `resource_providers_to_register` "+
+			"in the Provider block.", err, unregistered, unregistered)
+	}
+}
@@ -114,3 +114,188 @@ func flattenAndSetTags(d *schema.ResourceData, tagMap map[string]*string) {
 
 	d.Set("tags", output)
 }
+
+// providerTags contains the tags configured within the Provider block, which apply to every resource
+type providerTags struct {
+	// defaultTags are merged into the tags of every resource, with the tags defined on the resource taking precedence
+	defaultTags map[string]string
+
+	// ignoredKeys and ignoredKeyPrefixes are tags (such as those added by Azure Policy) which are excluded when reading resources
+	ignoredKeys        []string
+	ignoredKeyPrefixes []string
+}
+
+func expandProviderTags(d *schema.ResourceData) providerTags {
+	output := providerTags{
+		defaultTags: make(map[string]string),
+	}
+
+	if v, ok := d.GetOk("default_tags"); ok {
+		if raw := v.([]interface{}); len(raw) > 0 && raw[0] != nil {
+			block := raw[0].(map[string]interface{})
+			for k, v := range block["tags"].(map[string]interface{}) {
+				//Validate should have ignored this error already
+				value, _ := tagValueToString(v)
+				output.defaultTags[k] = value
+			}
+		}
+	}
+
+	if v, ok := d.GetOk("ignore_tags"); ok {
+		if raw := v.([]interface{}); len(raw) > 0 && raw[0] != nil {
+			block := raw[0].(map[string]interface{})
+			for _, key := range block["keys"].(*schema.Set).List() {
+				output.ignoredKeys = append(output.ignoredKeys, key.(string))
+			}
+			for _, prefix := range block["key_prefixes"].(*schema.Set).List() {
+				output.ignoredKeyPrefixes = append(output.ignoredKeyPrefixes, prefix.(string))
+			}
+		}
+	}
+
+	return output
+}
+
+// isIgnored returns whether the tag key is excluded by `ignore_tags` - as tag keys are case-insensitive in Azure
+// so is this comparison
+func (t providerTags) isIgnored(key string) bool {
+	for _, v := range t.ignoredKeys {
+		if strings.EqualFold(key, v) {
+			return true
+		}
+	}
+
+	for _, v := range t.ignoredKeyPrefixes {
+		if strings.HasPrefix(strings.ToLower(key), strings.ToLower(v)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// mergeDefaultTags returns the resource's tags merged with the default tags, with the resource's tags taking precedence
+func (t providerTags) mergeDefaultTags(tagsMap map[string]interface{}) map[string]interface{} {
+	output := make(map[string]interface{}, len(tagsMap)+len(t.defaultTags))
+
+	existing := make(map[string]bool, len(tagsMap))
+	for k, v := range tagsMap {
+		output[k] = v
+		existing[strings.ToLower(k)] = true
+	}
+
+	for k, v := range t.defaultTags {
+		if !existing[strings.ToLower(k)] {
+			output[k] = v
+		}
+	}
+
+	return output
+}
+
+// removeIgnoredTags returns a copy of the tags without any tags excluded by `ignore_tags`
+func (t providerTags) removeIgnoredTags(tagsMap map[string]interface{}) map[string]interface{} {
+	output := make(map[string]interface{}, len(tagsMap))
+
+	for k, v := range tagsMap {
+		if !t.isIgnored(k) {
+			output[k] = v
+		}
+	}
+
+	return output
+}
+
+// withProviderTags applies the `default_tags` and `ignore_tags` configured in the Provider block to the
+// resource, if it exposes a `tags` map
+//
+// The default tags are merged into the planned tags, such that they're included in the tags sent by
+// `expandTags` and don't show a diff once they're present in the state; ignored tags are removed after
+// the resource has been read.
+func withProviderTags(r *schema.Resource, isDataSource bool) *schema.Resource {
+	s, ok := r.Schema["tags"]
+	if !ok || s.Type != schema.TypeMap || !s.Computed {
+		return r
+	}
+
+	if r.Read != nil {
+		r.Read = schema.ReadFunc(withIgnoredTagsRemoved(crudFunc(r.Read)))
+	}
+
+	if isDataSource {
+		return r
+	}
+
+	if r.Create != nil {
+		r.Create = schema.CreateFunc(withIgnoredTagsRemoved(crudFunc(r.Create)))
+	}
+	if r.Update != nil {
+		r.Update = schema.UpdateFunc(withIgnoredTagsRemoved(crudFunc(r.Update)))
+	}
+
+	customizeDiff := r.CustomizeDiff
+	r.CustomizeDiff = func(d *schema.ResourceDiff, meta interface{}) error {
+		if customizeDiff != nil {
+			if err := customizeDiff(d, meta); err != nil {
+				return err
+			}
+		}
+
+		return mergeDefaultTagsIntoDiff(d, meta)
+	}
+
+	return r
+}
+
+func withIgnoredTagsRemoved(f crudFunc) crudFunc {
+	return func(d *schema.ResourceData, meta interface{}) error {
+		if err := f(d, meta); err != nil {
+			return err
+		}
+
+		client, ok := meta.(*ArmClient)
+		if !ok || client == nil || d.Id() == "" {
+			return nil
+		}
+
+		tagsMap, ok := d.Get("tags").(map[string]interface{})
+		if !ok {
+			return nil
+		}
+
+		filtered := client.providerTags.removeIgnoredTags(tagsMap)
+		if len(filtered) == len(tagsMap) {
+			return nil
+		}
+
+		return d.Set("tags", filtered)
+	}
+}
+
+func mergeDefaultTagsIntoDiff(d *schema.ResourceDiff, meta interface{}) error {
+	client, ok := meta.(*ArmClient)
+	if !ok || client == nil || len(client.providerTags.defaultTags) == 0 {
+		return nil
+	}
+
+	// the tags can't be merged until they're known, e.g. when they're interpolated from another resource
+	if !d.NewValueKnown("tags") {
+		return nil
+	}
+
+	tagsMap, ok := d.Get("tags").(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	merged := client.providerTags.mergeDefaultTags(tagsMap)
+	if len(merged) == len(tagsMap) {
+		return nil
+	}
+
+	if _, errors := validateAzureRMTags(merged, "tags"); len(errors) > 0 {
+		return fmt.Errorf("Error merging the Provider's `default_tags` into `tags`: %+v", errors[0])
+	}
+
+	return d.SetNew("tags", merged)
+}
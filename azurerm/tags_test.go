@@ -2,8 +2,13 @@ package azurerm
 
 import (
 	"fmt"
+	"reflect"
 	"strings"
 	"testing"
+
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
 )
 
 func TestValidateMaximumNumberOfARMTags(t *testing.T) {
@@ -113,3 +118,103 @@ func TestFilterARMTags(t *testing.T) {
 		t.Fatalf("Expected %v in filtered tag map, got %v", valueData[1], *filtered["key2"])
 	}
 }
+
+func TestProviderTagsMergeDefaultTags(t *testing.T) {
+	tags := providerTags{
+		defaultTags: map[string]string{
+			"cost-centre": "1234",
+			"owner":       "platform",
+		},
+	}
+
+	merged := tags.mergeDefaultTags(map[string]interface{}{
+		"Owner":       "networking",
+		"environment": "production",
+	})
+
+	expected := map[string]interface{}{
+		"Owner":       "networking",
+		"environment": "production",
+		"cost-centre": "1234",
+	}
+	if !reflect.DeepEqual(merged, expected) {
+		t.Fatalf("Expected %+v but got %+v", expected, merged)
+	}
+}
+
+func TestProviderTagsRemoveIgnoredTags(t *testing.T) {
+	tags := providerTags{
+		ignoredKeys:        []string{"CreatedBy"},
+		ignoredKeyPrefixes: []string{"policy-"},
+	}
+
+	filtered := tags.removeIgnoredTags(map[string]interface{}{
+		"createdby":     "someone",
+		"Policy-Source": "initiative",
+		"environment":   "production",
+	})
+
+	expected := map[string]interface{}{
+		"environment": "production",
+	}
+	if !reflect.DeepEqual(filtered, expected) {
+		t.Fatalf("Expected %+v but got %+v", expected, filtered)
+	}
+}
+
+func TestWithProviderTagsDiff(t *testing.T) {
+	meta := &ArmClient{
+		providerTags: providerTags{
+			defaultTags: map[string]string{
+				"cost-centre": "1234",
+			},
+		},
+	}
+
+	resource := withProviderTags(&schema.Resource{
+		Create: func(d *schema.ResourceData, meta interface{}) error { return nil },
+		Read:   func(d *schema.ResourceData, meta interface{}) error { return nil },
+		Update: func(d *schema.ResourceData, meta interface{}) error { return nil },
+		Delete: func(d *schema.ResourceData, meta interface{}) error { return nil },
+		Schema: map[string]*schema.Schema{
+			"tags": tagsSchema(),
+		},
+	}, false)
+
+	raw, err := config.NewRawConfig(map[string]interface{}{
+		"tags": map[string]interface{}{
+			"environment": "production",
+		},
+	})
+	if err != nil {
+		t.Fatalf("Error building config: %+v", err)
+	}
+	resourceConfig := terraform.NewResourceConfig(raw)
+
+	// the default tags should be added to a new resource
+	diff, err := resource.Diff(nil, resourceConfig, meta)
+	if err != nil {
+		t.Fatalf("Error diffing: %+v", err)
+	}
+	if v := diff.Attributes["tags.cost-centre"]; v == nil || v.New != "1234" {
+		t.Fatalf("Expected the default tag to be added but got %+v", diff.Attributes)
+	}
+
+	// and shouldn't cause a diff once they're present in the state
+	state := &terraform.InstanceState{
+		ID: "example",
+		Attributes: map[string]string{
+			"id":               "example",
+			"tags.%":           "2",
+			"tags.environment": "production",
+			"tags.cost-centre": "1234",
+		},
+	}
+	diff, err = resource.Diff(state, resourceConfig, meta)
+	if err != nil {
+		t.Fatalf("Error diffing: %+v", err)
+	}
+	if diff != nil && !diff.Empty() {
+		t.Fatalf("Expected no diff but got %+v", diff.Attributes)
+	}
+}
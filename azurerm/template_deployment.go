@@ -0,0 +1,318 @@
+package azurerm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2018-05-01/resources"
+	"github.com/Azure/go-autorest/autorest"
+	az "github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+)
+
+// templateDeploymentWhatIfTimeout is the maximum time spent evaluating the `what-if` of a Template Deployment during a plan
+const templateDeploymentWhatIfTimeout = 10 * time.Minute
+
+// templateDeploymentData is implemented by both the ResourceData and ResourceDiff, so that the Deployment Properties
+// can be built both when the Template is deployed and when the `what-if` of the Deployment is evaluated during a plan
+type templateDeploymentData interface {
+	Get(key string) interface{}
+	GetOk(key string) (interface{}, bool)
+}
+
+func templateDeploymentTemplateChangesSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+	}
+}
+
+func templateDeploymentDeleteDeployedResourcesSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  false,
+	}
+}
+
+func templateDeploymentOutputsJSONSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+}
+
+// expandTemplateDeploymentProperties builds the (Incremental) Deployment Properties for the `template_body`
+// and `parameters_body` used by the Subscription and Management Group scoped Template Deployments
+func expandTemplateDeploymentProperties(d templateDeploymentData) (*resources.DeploymentProperties, error) {
+	template, err := expandTemplateBody(d.Get("template_body").(string))
+	if err != nil {
+		return nil, err
+	}
+
+	properties := resources.DeploymentProperties{
+		Mode:     resources.Incremental,
+		Template: &template,
+	}
+
+	if v, ok := d.GetOk("parameters_body"); ok {
+		params, err := expandParametersBody(v.(string))
+		if err != nil {
+			return nil, err
+		}
+
+		properties.Parameters = &params
+	}
+
+	return &properties, nil
+}
+
+// flattenTemplateDeploymentWhatIfResult returns the changes from the `what-if` of a Template Deployment
+func flattenTemplateDeploymentWhatIfResult(input azure.TemplateDeploymentWhatIfResult) []azure.TemplateDeploymentWhatIfChange {
+	if props := input.Properties; props != nil && props.Changes != nil {
+		return *props.Changes
+	}
+
+	return []azure.TemplateDeploymentWhatIfChange{}
+}
+
+// flattenTemplateDeploymentTemplateAndOutputs sets the `template_body` and `outputs_json` of a Subscription
+// or Management Group scoped Template Deployment - and clears the `template_changes` planned when it was deployed
+func flattenTemplateDeploymentTemplateAndOutputs(d *schema.ResourceData, template interface{}, props *resources.DeploymentPropertiesExtended) error {
+	if template != nil {
+		body, err := json.Marshal(template)
+		if err != nil {
+			return fmt.Errorf("Error serializing `template_body` to JSON: %+v", err)
+		}
+		d.Set("template_body", normalizeJson(string(body)))
+	}
+
+	var outputs interface{}
+	if props != nil {
+		outputs = props.Outputs
+	}
+
+	outputsJson, err := azure.FlattenTemplateDeploymentOutputs(outputs)
+	if err != nil {
+		return err
+	}
+	d.Set("outputs_json", outputsJson)
+
+	if err := d.Set("template_changes", []string{}); err != nil {
+		return fmt.Errorf("Error setting `template_changes`: %+v", err)
+	}
+
+	return nil
+}
+
+// listTemplateDeploymentOperations returns the Operations of the Deployment, including those of any Deployments
+// nested within it (for example a Deployment into a Resource Group from a Subscription scoped Template)
+func listTemplateDeploymentOperations(ctx context.Context, client *ArmClient, operations []azure.TemplateDeploymentOperation) ([]azure.TemplateDeploymentOperation, error) {
+	result := make([]azure.TemplateDeploymentOperation, 0)
+
+	for _, operation := range operations {
+		result = append(result, operation)
+
+		if operation.Properties == nil || operation.Properties.TargetResource == nil || operation.Properties.TargetResource.ID == nil {
+			continue
+		}
+		id := *operation.Properties.TargetResource.ID
+
+		var nested []azure.TemplateDeploymentOperation
+		if deploymentId, err := azure.ParseTemplateDeploymentID(id); err == nil && strings.EqualFold(deploymentId.SubscriptionID, client.subscriptionId) {
+			if nested, err = listResourceGroupTemplateDeploymentOperations(ctx, client, deploymentId.ResourceGroup, deploymentId.Name); err != nil {
+				return nil, err
+			}
+		} else if deploymentId, err := azure.ParseSubscriptionTemplateDeploymentID(id); err == nil && strings.EqualFold(deploymentId.SubscriptionID, client.subscriptionId) {
+			if nested, err = listSubscriptionTemplateDeploymentOperations(ctx, client, deploymentId.Name); err != nil {
+				return nil, err
+			}
+		} else {
+			continue
+		}
+
+		nested, err := listTemplateDeploymentOperations(ctx, client, nested)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, nested...)
+	}
+
+	return result, nil
+}
+
+func listResourceGroupTemplateDeploymentOperations(ctx context.Context, client *ArmClient, resourceGroup string, name string) ([]azure.TemplateDeploymentOperation, error) {
+	operations, err := client.deploymentOperationsClient.ListAtResourceGroup(ctx, resourceGroup, name)
+	if err != nil {
+		return nil, fmt.Errorf("Error listing the Operations of Template Deployment %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	return operations, nil
+}
+
+func listSubscriptionTemplateDeploymentOperations(ctx context.Context, client *ArmClient, name string) ([]azure.TemplateDeploymentOperation, error) {
+	operations, err := client.deploymentOperationsClient.ListAtSubscription(ctx, name)
+	if err != nil {
+		return nil, fmt.Errorf("Error listing the Operations of Subscription Template Deployment %q: %+v", name, err)
+	}
+
+	return operations, nil
+}
+
+func listManagementGroupTemplateDeploymentOperations(ctx context.Context, client *ArmClient, managementGroupName string, name string) ([]azure.TemplateDeploymentOperation, error) {
+	operations, err := client.deploymentOperationsClient.ListAtManagementGroup(ctx, managementGroupName, name)
+	if err != nil {
+		return nil, fmt.Errorf("Error listing the Operations of Management Group Template Deployment %q (Management Group %q): %+v", name, managementGroupName, err)
+	}
+
+	return operations, nil
+}
+
+// deleteTemplateDeploymentResources deletes each of the resources created by the Operations of a Template Deployment,
+// such that deleting the Deployment removes the resources it created rather than only the Deployment record. Resources
+// which existed prior to the Deployment (and were only updated by it) are left as-is
+func deleteTemplateDeploymentResources(ctx context.Context, client *ArmClient, operations []azure.TemplateDeploymentOperation) error {
+	operations, err := listTemplateDeploymentOperations(ctx, client, operations)
+	if err != nil {
+		return err
+	}
+
+	retained, err := templateDeploymentRetainedResourceGroups(ctx, client, operations)
+	if err != nil {
+		return err
+	}
+
+	for _, id := range azure.TemplateDeploymentResourceIDs(operations, retained) {
+		log.Printf("[DEBUG] Deleting %q deployed by the Template Deployment..", id)
+		if err := deleteTemplateDeploymentResource(ctx, client, id); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// templateDeploymentRetainedResourceGroups returns the Resource Groups created by the Template Deployment which contain
+// resources it didn't create - such that these (and the other resources within them) aren't deleted with the Deployment
+func templateDeploymentRetainedResourceGroups(ctx context.Context, client *ArmClient, operations []azure.TemplateDeploymentOperation) ([]string, error) {
+	created := make(map[string]struct{})
+	for _, id := range azure.TemplateDeploymentCreatedResourceIDs(operations) {
+		created[strings.ToLower(id)] = struct{}{}
+	}
+
+	retained := make([]string, 0)
+	for _, id := range azure.TemplateDeploymentCreatedResourceIDs(operations) {
+		resourceGroupId, err := azure.ParseResourceGroupID(id)
+		if err != nil || !strings.EqualFold(resourceGroupId.SubscriptionID, client.subscriptionId) {
+			continue
+		}
+
+		iterator, err := client.resourcesClient.ListByResourceGroupComplete(ctx, resourceGroupId.Name, "", "", nil)
+		if err != nil {
+			if response.WasNotFound(iterator.Response().Response.Response) {
+				continue
+			}
+			return nil, fmt.Errorf("Error listing the resources within Resource Group %q: %+v", resourceGroupId.Name, err)
+		}
+
+		for iterator.NotDone() {
+			if v := iterator.Value(); v.ID != nil {
+				if _, ok := created[strings.ToLower(*v.ID)]; !ok {
+					log.Printf("[DEBUG] Retaining Resource Group %q since it contains %q which wasn't created by the Template Deployment", resourceGroupId.Name, *v.ID)
+					retained = append(retained, id)
+					break
+				}
+			}
+
+			if err := iterator.NextWithContext(ctx); err != nil {
+				return nil, fmt.Errorf("Error listing the resources within Resource Group %q: %+v", resourceGroupId.Name, err)
+			}
+		}
+	}
+
+	return retained, nil
+}
+
+func deleteTemplateDeploymentResource(ctx context.Context, client *ArmClient, id string) error {
+	if resourceGroupId, err := azure.ParseResourceGroupID(id); err == nil {
+		future, err := client.resourceGroupsClient.Delete(ctx, resourceGroupId.Name)
+		if err != nil {
+			if response.WasNotFound(future.Response()) {
+				return nil
+			}
+			return fmt.Errorf("Error deleting Resource Group %q deployed by the Template Deployment: %+v", resourceGroupId.Name, err)
+		}
+
+		if err = future.WaitForCompletionRef(ctx, client.resourceGroupsClient.Client); err != nil {
+			return fmt.Errorf("Error waiting for the deletion of Resource Group %q deployed by the Template Deployment: %+v", resourceGroupId.Name, err)
+		}
+
+		return nil
+	}
+
+	namespace, resourceType, err := azure.ParseResourceProviderAndType(id)
+	if err != nil {
+		return err
+	}
+
+	// the API Version used to delete the resource has to be one supported by its Resource Provider
+	provider, err := client.providersClient.Get(ctx, namespace, "")
+	if err != nil {
+		return fmt.Errorf("Error retrieving the Resource Provider %q to delete %q: %+v", namespace, id, err)
+	}
+
+	apiVersion := ""
+	if provider.ResourceTypes != nil {
+		for _, v := range *provider.ResourceTypes {
+			if v.ResourceType != nil && strings.EqualFold(*v.ResourceType, resourceType) && v.APIVersions != nil {
+				apiVersion = azure.LatestAPIVersion(*v.APIVersions)
+				break
+			}
+		}
+	}
+	if apiVersion == "" {
+		return fmt.Errorf("Error determining the API Version to delete %q: Resource Type %q was not found in Resource Provider %q", id, resourceType, namespace)
+	}
+
+	resourcesClient := client.resourcesClient
+	req, err := autorest.CreatePreparer(
+		autorest.AsDelete(),
+		autorest.WithBaseURL(resourcesClient.BaseURI),
+		autorest.WithPath(id),
+		autorest.WithQueryParameters(map[string]interface{}{
+			"api-version": apiVersion,
+		})).Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		return fmt.Errorf("Error preparing the request to delete %q: %+v", id, err)
+	}
+
+	resp, err := autorest.SendWithSender(resourcesClient, req)
+	if err != nil {
+		return fmt.Errorf("Error deleting %q deployed by the Template Deployment: %+v", id, err)
+	}
+	if resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusNoContent {
+		return nil
+	}
+
+	future := resources.DeleteByIDFuture{}
+	if future.Future, err = az.NewFutureFromResponse(resp); err != nil {
+		return fmt.Errorf("Error deleting %q deployed by the Template Deployment: %+v", id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, resourcesClient.Client); err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+		return fmt.Errorf("Error waiting for the deletion of %q deployed by the Template Deployment: %+v", id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,284 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// importVirtualMachine returns a ResourceImporter which only allows Virtual Machines using the specified
+// Operating System and Managed Disks to be imported - since Virtual Machines using Unmanaged Disks (or the
+// other Operating System) can only be managed using the `azurerm_virtual_machine` resource
+func importVirtualMachine(osType compute.OperatingSystemTypes, resourceType string) *schema.ResourceImporter {
+	return &schema.ResourceImporter{
+		State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+			client := meta.(*ArmClient).vmClient
+			ctx := meta.(*ArmClient).StopContext
+
+			id, err := azure.ParseVirtualMachineID(d.Id())
+			if err != nil {
+				return nil, fmt.Errorf("Error importing %q: %+v", d.Id(), err)
+			}
+
+			vm, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+			if err != nil {
+				return nil, fmt.Errorf("Error retrieving Virtual Machine %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+			}
+
+			if props := vm.VirtualMachineProperties; props != nil && props.StorageProfile != nil && props.StorageProfile.OsDisk != nil {
+				osDisk := props.StorageProfile.OsDisk
+				if osDisk.OsType != osType {
+					return nil, fmt.Errorf("Error importing %q: the Virtual Machine is running %q rather than %q - this can be imported using the `azurerm_%s_virtual_machine` resource", d.Id(), string(osDisk.OsType), string(osType), strings.ToLower(string(osDisk.OsType)))
+				}
+
+				if osDisk.ManagedDisk == nil {
+					return nil, fmt.Errorf("Error importing %q: the Virtual Machine uses Unmanaged Disks, which are only supported by the `azurerm_virtual_machine` resource", d.Id())
+				}
+			}
+
+			log.Printf("[DEBUG] Importing Virtual Machine %q (Resource Group %q) as a %q", id.Name, id.ResourceGroup, resourceType)
+			return []*schema.ResourceData{d}, nil
+		},
+	}
+}
+
+// updateAzureRmVirtualMachine applies the changes to a Virtual Machine in-place - when a change can't be applied
+// whilst the Virtual Machine is running, it's deallocated first and then started again once the changes are applied
+func updateAzureRmVirtualMachine(ctx context.Context, d *schema.ResourceData, meta interface{}, id *azure.VirtualMachineID, update compute.VirtualMachineUpdate) error {
+	client := meta.(*ArmClient).vmClient
+
+	shouldDeallocate := false
+
+	// Network Interfaces can only be changed and OS Disks can only be resized whilst deallocated
+	if d.HasChange("network_interface_ids") || d.HasChange("os_disk.0.disk_size_gb") {
+		shouldDeallocate = true
+	}
+
+	if d.HasChange("size") {
+		// sizes which aren't available on the current hardware cluster require the Virtual Machine to be deallocated
+		size := d.Get("size").(string)
+		availableSizes, err := client.ListAvailableSizes(ctx, id.ResourceGroup, id.Name)
+		if err != nil {
+			return fmt.Errorf("Error retrieving available sizes for Virtual Machine %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+		}
+
+		if !azure.VirtualMachineSizeIsAvailable(availableSizes.Value, size) {
+			log.Printf("[DEBUG] Size %q isn't available on the current hardware cluster for Virtual Machine %q (Resource Group %q) - deallocating to resize", size, id.Name, id.ResourceGroup)
+			shouldDeallocate = true
+		}
+	}
+
+	shouldStart := false
+	if shouldDeallocate {
+		running, err := virtualMachineIsRunning(ctx, client, id)
+		if err != nil {
+			return err
+		}
+		shouldStart = running
+
+		log.Printf("[DEBUG] Deallocating Virtual Machine %q (Resource Group %q)..", id.Name, id.ResourceGroup)
+		future, err := client.Deallocate(ctx, id.ResourceGroup, id.Name)
+		if err != nil {
+			return fmt.Errorf("Error deallocating Virtual Machine %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+		}
+
+		if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("Error waiting for Virtual Machine %q (Resource Group %q) to be deallocated: %+v", id.Name, id.ResourceGroup, err)
+		}
+	}
+
+	if d.HasChange("os_disk.0.disk_size_gb") {
+		if err := resizeAzureRmVirtualMachineOSDisk(ctx, meta, d.Get("os_disk.0.id").(string), d.Get("os_disk.0.disk_size_gb").(int)); err != nil {
+			return err
+		}
+	}
+
+	log.Printf("[DEBUG] Updating Virtual Machine %q (Resource Group %q)..", id.Name, id.ResourceGroup)
+	future, err := client.Update(ctx, id.ResourceGroup, id.Name, update)
+	if err != nil {
+		return fmt.Errorf("Error updating Virtual Machine %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for update of Virtual Machine %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	if shouldStart {
+		log.Printf("[DEBUG] Starting Virtual Machine %q (Resource Group %q)..", id.Name, id.ResourceGroup)
+		future, err := client.Start(ctx, id.ResourceGroup, id.Name)
+		if err != nil {
+			return fmt.Errorf("Error starting Virtual Machine %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+		}
+
+		if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("Error waiting for Virtual Machine %q (Resource Group %q) to start: %+v", id.Name, id.ResourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+func resizeAzureRmVirtualMachineOSDisk(ctx context.Context, meta interface{}, diskId string, sizeGB int) error {
+	client := meta.(*ArmClient).diskClient
+
+	id, err := azure.ParseManagedDiskID(diskId)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Resizing OS Disk %q (Resource Group %q) to %dGB..", id.Name, id.ResourceGroup, sizeGB)
+	update := compute.DiskUpdate{
+		DiskUpdateProperties: &compute.DiskUpdateProperties{
+			DiskSizeGB: utils.Int32(int32(sizeGB)),
+		},
+	}
+	future, err := client.Update(ctx, id.ResourceGroup, id.Name, update)
+	if err != nil {
+		return fmt.Errorf("Error resizing OS Disk %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for OS Disk %q (Resource Group %q) to be resized: %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	return nil
+}
+
+func virtualMachineIsRunning(ctx context.Context, client compute.VirtualMachinesClient, id *azure.VirtualMachineID) (bool, error) {
+	view, err := client.InstanceView(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		return false, fmt.Errorf("Error retrieving Instance View for Virtual Machine %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	if statuses := view.Statuses; statuses != nil {
+		for _, status := range *statuses {
+			if status.Code != nil && strings.EqualFold(*status.Code, "PowerState/running") {
+				return true, nil
+			}
+		}
+	}
+
+	return false, nil
+}
+
+// deleteAzureRmVirtualMachine deletes the Virtual Machine and it's OS Disk, which is managed as a part of it
+func deleteAzureRmVirtualMachine(ctx context.Context, meta interface{}, id *azure.VirtualMachineID) error {
+	client := meta.(*ArmClient).vmClient
+	diskClient := meta.(*ArmClient).diskClient
+
+	existing, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(existing.Response) {
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Virtual Machine %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		return fmt.Errorf("Error deleting Virtual Machine %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for deletion of Virtual Machine %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	if props := existing.VirtualMachineProperties; props != nil && props.StorageProfile != nil && props.StorageProfile.OsDisk != nil {
+		if disk := props.StorageProfile.OsDisk.ManagedDisk; disk != nil && disk.ID != nil {
+			diskId, err := azure.ParseManagedDiskID(*disk.ID)
+			if err != nil {
+				return err
+			}
+
+			log.Printf("[DEBUG] Deleting OS Disk %q (Resource Group %q) of Virtual Machine %q..", diskId.Name, diskId.ResourceGroup, id.Name)
+			diskFuture, err := diskClient.Delete(ctx, diskId.ResourceGroup, diskId.Name)
+			if err != nil {
+				if response.WasNotFound(diskFuture.Response()) {
+					return nil
+				}
+
+				return fmt.Errorf("Error deleting OS Disk %q (Resource Group %q): %+v", diskId.Name, diskId.ResourceGroup, err)
+			}
+
+			if err = diskFuture.WaitForCompletionRef(ctx, diskClient.Client); err != nil {
+				return fmt.Errorf("Error waiting for deletion of OS Disk %q (Resource Group %q): %+v", diskId.Name, diskId.ResourceGroup, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// retrieveAzureRmVirtualMachineIPAddresses returns the Private and Public IP Addresses of the primary
+// IP Configuration on the primary Network Interface of the Virtual Machine
+func retrieveAzureRmVirtualMachineIPAddresses(ctx context.Context, meta interface{}, props *compute.VirtualMachineProperties) (string, string, error) {
+	nicClient := meta.(*ArmClient).ifaceClient
+	pipClient := meta.(*ArmClient).publicIPClient
+
+	if props == nil || props.NetworkProfile == nil {
+		return "", "", nil
+	}
+
+	nicIds := azure.FlattenVirtualMachineNetworkInterfaceIDs(props.NetworkProfile.NetworkInterfaces)
+	if len(nicIds) == 0 {
+		return "", "", nil
+	}
+
+	nicId, err := azure.ParseNetworkInterfaceID(nicIds[0].(string))
+	if err != nil {
+		return "", "", err
+	}
+
+	nic, err := nicClient.Get(ctx, nicId.ResourceGroup, nicId.Name, "")
+	if err != nil {
+		return "", "", fmt.Errorf("Error retrieving Network Interface %q (Resource Group %q): %+v", nicId.Name, nicId.ResourceGroup, err)
+	}
+
+	privateIPAddress := ""
+	publicIPAddress := ""
+	if nicProps := nic.InterfacePropertiesFormat; nicProps != nil && nicProps.IPConfigurations != nil {
+		for _, config := range *nicProps.IPConfigurations {
+			configProps := config.InterfaceIPConfigurationPropertiesFormat
+			if configProps == nil {
+				continue
+			}
+
+			// pick out the primary if multiple IP Configurations are assigned
+			if len(*nicProps.IPConfigurations) > 1 && (configProps.Primary == nil || !*configProps.Primary) {
+				continue
+			}
+
+			if configProps.PrivateIPAddress != nil {
+				privateIPAddress = *configProps.PrivateIPAddress
+			}
+
+			if configProps.PublicIPAddress != nil && configProps.PublicIPAddress.ID != nil {
+				pipId, err := azure.ParsePublicIPAddressID(*configProps.PublicIPAddress.ID)
+				if err != nil {
+					return "", "", err
+				}
+
+				pip, err := pipClient.Get(ctx, pipId.ResourceGroup, pipId.Name, "")
+				if err != nil {
+					return "", "", fmt.Errorf("Error retrieving Public IP Address %q (Resource Group %q): %+v", pipId.Name, pipId.ResourceGroup, err)
+				}
+
+				if pipProps := pip.PublicIPAddressPropertiesFormat; pipProps != nil && pipProps.IPAddress != nil {
+					publicIPAddress = *pipProps.IPAddress
+				}
+			}
+
+			break
+		}
+	}
+
+	return privateIPAddress, publicIPAddress, nil
+}
@@ -0,0 +1,250 @@
+// generate-resource-ids generates a typed Resource ID (a struct with a Parse function, an ID formatter and
+// a ValidateFunc) for each of the Resource IDs defined in the input file, alongside tests for each of them.
+//
+// Usage: go run main.go -input=resource_ids.txt -output=resource_ids_generated.go -test-output=resource_ids_generated_test.go
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"os"
+	"strings"
+	"unicode"
+)
+
+type resourceID struct {
+	// Name is the name of the type, e.g. `Subnet`
+	Name string
+
+	// Format is the format of the Resource ID, e.g. `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}`
+	Format string
+
+	// Fields are the names of the placeholders within the Format, in order
+	Fields []string
+}
+
+func main() {
+	input := flag.String("input", "resource_ids.txt", "the file containing the Resource ID definitions")
+	output := flag.String("output", "resource_ids_generated.go", "the file to write the generated Resource IDs to")
+	testOutput := flag.String("test-output", "resource_ids_generated_test.go", "the file to write the generated tests to")
+	pkg := flag.String("package", "azure", "the name of the package the generated code belongs to")
+	flag.Parse()
+
+	ids, err := parseDefinitions(*input)
+	if err != nil {
+		log.Fatalf("Error parsing the Resource ID definitions: %+v", err)
+	}
+
+	if err := writeGoFile(*output, generateCode(*pkg, ids)); err != nil {
+		log.Fatalf("Error writing the generated Resource IDs: %+v", err)
+	}
+
+	if err := writeGoFile(*testOutput, generateTests(*pkg, ids)); err != nil {
+		log.Fatalf("Error writing the generated tests: %+v", err)
+	}
+}
+
+func parseDefinitions(path string) ([]resourceID, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	ids := make([]resourceID, 0)
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		parts := strings.Fields(line)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("expected the line %q to contain a name and a format", line)
+		}
+
+		id := resourceID{
+			Name:   parts[0],
+			Format: parts[1],
+		}
+		for _, segment := range strings.Split(strings.Trim(id.Format, "/"), "/") {
+			if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+				id.Fields = append(id.Fields, strings.Trim(segment, "{}"))
+			}
+		}
+
+		if len(id.Fields) == 0 {
+			return nil, fmt.Errorf("expected the format %q to contain at least one placeholder", id.Format)
+		}
+
+		ids = append(ids, id)
+	}
+
+	return ids, scanner.Err()
+}
+
+func generateCode(pkg string, ids []resourceID) string {
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by scripts/generate-resource-ids; DO NOT EDIT.\n\n")
+	fmt.Fprintf(&buf, "package %s\n\n", pkg)
+
+	for _, id := range ids {
+		displayName := displayName(id.Name)
+		formatConst := lowerFirst(id.Name) + "IDFormat"
+
+		fields := make([]string, 0)
+		args := make([]string, 0)
+		assignments := make([]string, 0)
+		values := make([]string, 0)
+		for i, field := range id.Fields {
+			fields = append(fields, fmt.Sprintf("\t%s string", fieldName(field)))
+			args = append(args, argName(field))
+			assignments = append(assignments, fmt.Sprintf("\t\t%s: %s,", fieldName(field), argName(field)))
+			values = append(values, fmt.Sprintf("\t\t%s: values[%d],", fieldName(field), i))
+		}
+
+		fmt.Fprintf(&buf, "const %s = %q\n\n", formatConst, id.Format)
+
+		fmt.Fprintf(&buf, "// %sID is the Resource ID of %s\n", id.Name, withArticle(displayName))
+		fmt.Fprintf(&buf, "type %sID struct {\n%s\n}\n\n", id.Name, strings.Join(fields, "\n"))
+
+		fmt.Fprintf(&buf, "// New%sID returns the Resource ID of the %s\n", id.Name, displayName)
+		fmt.Fprintf(&buf, "func New%sID(%s string) %sID {\n\treturn %sID{\n%s\n\t}\n}\n\n", id.Name, strings.Join(args, ", "), id.Name, id.Name, strings.Join(assignments, "\n"))
+
+		fmt.Fprintf(&buf, "// ID returns the formatted Resource ID of the %s\n", displayName)
+		idValues := make([]string, 0)
+		for _, field := range id.Fields {
+			idValues = append(idValues, "id."+fieldName(field))
+		}
+		fmt.Fprintf(&buf, "func (id %sID) ID() string {\n\treturn formatResourceID(%s, %s)\n}\n\n", id.Name, formatConst, strings.Join(idValues, ", "))
+
+		fmt.Fprintf(&buf, "// Parse%sID parses the Resource ID of %s, matching the segments of the ID case-insensitively\n", id.Name, withArticle(displayName))
+		fmt.Fprintf(&buf, "func Parse%sID(input string) (*%sID, error) {\n", id.Name, id.Name)
+		fmt.Fprintf(&buf, "\tvalues, err := parseResourceIDWithFormat(%q, %s, input)\n", displayName, formatConst)
+		fmt.Fprintf(&buf, "\tif err != nil {\n\t\treturn nil, err\n\t}\n\n")
+		fmt.Fprintf(&buf, "\treturn &%sID{\n%s\n\t}, nil\n}\n\n", id.Name, strings.Join(values, "\n"))
+
+		fmt.Fprintf(&buf, "// Validate%sID validates that the value is the Resource ID of %s\n", id.Name, withArticle(displayName))
+		fmt.Fprintf(&buf, "func Validate%sID(i interface{}, k string) (warnings []string, errors []error) {\n", id.Name)
+		fmt.Fprintf(&buf, "\treturn validateResourceIDWithParser(i, k, func(input string) error {\n\t\t_, err := Parse%sID(input)\n\t\treturn err\n\t})\n}\n\n", id.Name)
+
+		fmt.Fprintf(&buf, "// Validate%sIDOrEmpty validates that the value is either empty or the Resource ID of %s\n", id.Name, withArticle(displayName))
+		fmt.Fprintf(&buf, "func Validate%sIDOrEmpty(i interface{}, k string) (warnings []string, errors []error) {\n", id.Name)
+		fmt.Fprintf(&buf, "\tif v, ok := i.(string); ok && v == \"\" {\n\t\treturn\n\t}\n\n")
+		fmt.Fprintf(&buf, "\treturn Validate%sID(i, k)\n}\n\n", id.Name)
+	}
+
+	return buf.String()
+}
+
+func generateTests(pkg string, ids []resourceID) string {
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by scripts/generate-resource-ids; DO NOT EDIT.\n\n")
+	fmt.Fprintf(&buf, "package %s\n\n", pkg)
+	buf.WriteString("import (\n\t\"strings\"\n\t\"testing\"\n)\n\n")
+
+	for _, id := range ids {
+		args := make([]string, 0)
+		checks := make([]string, 0)
+		for _, field := range id.Fields {
+			value := fmt.Sprintf("%q", argName(field)+"1")
+			if field == "subscriptionId" {
+				value = `"00000000-0000-0000-0000-000000000000"`
+			}
+			args = append(args, value)
+			checks = append(checks, fmt.Sprintf("\tif parsed.%[1]s != %[2]s {\n\t\tt.Fatalf(\"Expected %[1]s to be %%q but got %%q\", %[2]s, parsed.%[1]s)\n\t}", fieldName(field), value))
+		}
+
+		fmt.Fprintf(&buf, "func Test%sID(t *testing.T) {\n", id.Name)
+		fmt.Fprintf(&buf, "\tid := New%sID(%s).ID()\n\n", id.Name, strings.Join(args, ", "))
+
+		buf.WriteString("\t// the ID should round-trip, regardless of the casing of the segments\n")
+		buf.WriteString("\tfor _, input := range []string{id, strings.ToLower(id), strings.ToUpper(id)} {\n")
+		fmt.Fprintf(&buf, "\t\tparsed, err := Parse%sID(input)\n", id.Name)
+		buf.WriteString("\t\tif err != nil {\n\t\t\tt.Fatalf(\"Expected %q to parse but got: %+v\", input, err)\n\t\t}\n")
+		buf.WriteString("\t\tif !strings.EqualFold(parsed.ID(), id) {\n\t\t\tt.Fatalf(\"Expected the ID to be %q but got %q\", id, parsed.ID())\n\t\t}\n")
+		buf.WriteString("\t}\n\n")
+
+		fmt.Fprintf(&buf, "\tparsed, err := Parse%sID(id)\n", id.Name)
+		buf.WriteString("\tif err != nil {\n\t\tt.Fatalf(\"Expected %q to parse but got: %+v\", id, err)\n\t}\n")
+		buf.WriteString(strings.Join(checks, "\n") + "\n\n")
+
+		buf.WriteString("\tfor _, input := range []string{\"\", \"random\", id + \"/extra/segment\", id[:strings.LastIndex(id, \"/\")]} {\n")
+		fmt.Fprintf(&buf, "\t\tif _, err := Parse%sID(input); err == nil {\n", id.Name)
+		buf.WriteString("\t\t\tt.Fatalf(\"Expected %q not to parse\", input)\n\t\t}\n")
+		fmt.Fprintf(&buf, "\t\tif _, errors := Validate%sID(input, \"id\"); len(errors) == 0 {\n", id.Name)
+		buf.WriteString("\t\t\tt.Fatalf(\"Expected %q not to be valid\", input)\n\t\t}\n")
+		buf.WriteString("\t}\n\n")
+		fmt.Fprintf(&buf, "\tif _, errors := Validate%sIDOrEmpty(\"\", \"id\"); len(errors) > 0 {\n", id.Name)
+		buf.WriteString("\t\tt.Fatalf(\"Expected an empty value to be valid but got: %+v\", errors)\n\t}\n")
+		fmt.Fprintf(&buf, "\tif _, errors := Validate%sIDOrEmpty(\"random\", \"id\"); len(errors) == 0 {\n", id.Name)
+		buf.WriteString("\t\tt.Fatalf(\"Expected %q not to be valid\", \"random\")\n\t}\n")
+		buf.WriteString("}\n\n")
+	}
+
+	return buf.String()
+}
+
+func writeGoFile(path string, code string) error {
+	formatted, err := format.Source([]byte(code))
+	if err != nil {
+		return fmt.Errorf("formatting the generated code: %+v", err)
+	}
+
+	return ioutil.WriteFile(path, formatted, 0644)
+}
+
+// fieldName returns the name of the struct field for the placeholder, e.g. `virtualNetworkName` becomes `VirtualNetworkName`
+func fieldName(placeholder string) string {
+	if placeholder == "subscriptionId" {
+		return "SubscriptionID"
+	}
+
+	return strings.ToUpper(placeholder[:1]) + placeholder[1:]
+}
+
+func argName(placeholder string) string {
+	if placeholder == "subscriptionId" {
+		return "subscriptionID"
+	}
+
+	return placeholder
+}
+
+func lowerFirst(input string) string {
+	return strings.ToLower(input[:1]) + input[1:]
+}
+
+func withArticle(input string) string {
+	if strings.ContainsAny(input[:1], "AEIOU") {
+		return "an " + input
+	}
+
+	return "a " + input
+}
+
+// displayName splits the name of the type into words, e.g. `PublicIPAddress` becomes `Public IP Address`
+func displayName(name string) string {
+	runes := []rune(name)
+	words := make([]string, 0)
+
+	start := 0
+	for i := 1; i < len(runes); i++ {
+		current, previous := runes[i], runes[i-1]
+		nextIsLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+
+		if unicode.IsUpper(current) && (unicode.IsLower(previous) || nextIsLower) {
+			words = append(words, string(runes[start:i]))
+			start = i
+		}
+	}
+	words = append(words, string(runes[start:]))
+
+	return strings.Join(words, " ")
+}